@@ -1,6 +1,11 @@
 package app
 
 import (
+	migrationante "selfchain/x/migration/ante"
+	migrationkeeper "selfchain/x/migration/keeper"
+	selfvestingante "selfchain/x/selfvesting/ante"
+	selfvestingkeeper "selfchain/x/selfvesting/keeper"
+
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
 	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
@@ -24,6 +29,8 @@ type HandlerOptions struct {
 	WasmKeeper        *wasmkeeper.Keeper
 	WasmConfig        *wasmTypes.WasmConfig
 	TXCounterStoreKey corestoretypes.KVStoreService
+	MigrationKeeper   *migrationkeeper.Keeper
+	SelfvestingKeeper *selfvestingkeeper.Keeper
 }
 
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
@@ -42,6 +49,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.TXCounterStoreKey == nil {
 		return nil, errorsmod.Wrap(errors.ErrLogic, "wasm store service is required for ante builder")
 	}
+	if options.MigrationKeeper == nil {
+		return nil, errorsmod.Wrap(errors.ErrLogic, "migration keeper is required for ante builder")
+	}
+	if options.SelfvestingKeeper == nil {
+		return nil, errorsmod.Wrap(errors.ErrLogic, "selfvesting keeper is required for ante builder")
+	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
@@ -52,13 +65,29 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		// The fee decorators are nested rather than chained since each one only hands the fee of the transactions
+		// it doesn't handle to the next one. The release fee decorator comes first to send the fee of a release
+		// to its beneficiary out of the released tokens, and wraps the migrator fee decorator, which waives the
+		// fee of the migrators. Both end with the fee decorator of the SDK, which checks the fee against the
+		// minimum gas prices, sets the priority and deducts the fee unless it has been waived.
+		selfvestingante.NewReleaseFeeDecorator( // pays the fee of releases out of the released tokens
+			*options.SelfvestingKeeper,
+			migrationante.NewMigratorFeeDecorator( // waives the fee of the migrators within their gas budget
+				*options.MigrationKeeper,
+				options.AccountKeeper,
+				options.BankKeeper,
+				options.FeegrantKeeper,
+				options.TxFeeChecker,
+				ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+			),
+		),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
+		migrationante.NewMigrationDedupDecorator(*options.MigrationKeeper), // after signature verification, see its doc
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	v2 "selfchain/upgrades/v2"
+	v3 "selfchain/upgrades/v3"
 	"strings"
 
 	"cosmossdk.io/client/v2/autocli"
@@ -614,20 +615,12 @@ func New(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, sk
 		app.GetSubspace(selfvestingmoduletypes.ModuleName),
 
 		app.BankKeeper,
+		app.FeeGrantKeeper,
+		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	selfvestingModule := selfvestingmodule.NewAppModule(appCodec, app.SelfvestingKeeper, app.AccountKeeper, app.BankKeeper)
 
-	app.MigrationKeeper = *migrationmodulekeeper.NewKeeper(
-		appCodec,
-		keys[migrationmoduletypes.StoreKey],
-		keys[migrationmoduletypes.MemStoreKey],
-		app.GetSubspace(migrationmoduletypes.ModuleName),
-
-		app.SelfvestingKeeper,
-		app.BankKeeper,
-	)
-	migrationModule := migrationmodule.NewAppModule(appCodec, app.MigrationKeeper, app.AccountKeeper, app.BankKeeper)
-
 	wasmDir := filepath.Join(homePath, "wasm")
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
@@ -659,6 +652,21 @@ func New(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, sk
 		wasmOpts...,
 	)
 
+	// The migration keeper executes the callback of the contracts receiving migrations
+	app.MigrationKeeper = *migrationmodulekeeper.NewKeeper(
+		appCodec,
+		keys[migrationmoduletypes.StoreKey],
+		keys[migrationmoduletypes.MemStoreKey],
+		app.GetSubspace(migrationmoduletypes.ModuleName),
+
+		app.SelfvestingKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	migrationModule := migrationmodule.NewAppModule(appCodec, app.MigrationKeeper, app.AccountKeeper, app.BankKeeper)
+
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
 	/**** IBC Routing ****/
@@ -992,6 +1000,8 @@ func New(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, sk
 			},
 		)
 
+		app.UpgradeKeeper.SetUpgradeHandler(v3.UpgradeName, v3.CreateUpgradeHandler(app.mm, app.configurator))
+
 		if ui, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk(); err == nil &&
 			ui.Name == v2.UpgradeName && !app.UpgradeKeeper.IsSkipHeight(ui.Height) {
 
@@ -1251,6 +1261,8 @@ func (app *App) setAnteHandler(txConfig client.TxConfig, wasmConfig *wasmtypes.W
 			WasmConfig:        wasmConfig, // <-- never nil
 			TXCounterStoreKey: txCounterStoreKey,
 			WasmKeeper:        &app.WasmKeeper,
+			MigrationKeeper:   &app.MigrationKeeper,
+			SelfvestingKeeper: &app.SelfvestingKeeper,
 		},
 	)
 	if err != nil {
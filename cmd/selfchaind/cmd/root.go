@@ -46,6 +46,7 @@ import (
 
 	"selfchain/app"
 	appparams "selfchain/app/params"
+	migrationcli "selfchain/x/migration/client/cli"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmcli "github.com/CosmWasm/wasmd/x/wasm/client/cli"
@@ -182,6 +183,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appparams.EncodingConfig
 		server.StatusCommand(),
 		queryCommand(),
 		txCommand(app.ModuleBasics),
+		migrationCommand(),
 		keys.Commands(),
 	)
 
@@ -249,6 +251,23 @@ func queryCommand() *cobra.Command {
 	return cmd
 }
 
+// migrationCommand returns the sub-command of the offline migration tools
+func migrationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "migration",
+		Short:                      "Migration tools subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		migrationcli.CmdReconcile(app.DefaultNodeHome),
+	)
+
+	return cmd
+}
+
 // txCommand returns the sub-command to send transactions to the app
 func txCommand(moduleBasics module.BasicManager) *cobra.Command {
 	cmd := &cobra.Command{
@@ -7,28 +7,31 @@ import (
 	test "selfchain/x/migration/tests/mock"
 	"selfchain/x/migration/types"
 
-	dbm "github.com/cometbft/cometbft-db"
-	"github.com/cometbft/cometbft/libs/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"cosmossdk.io/log"
+	metrics "cosmossdk.io/store/metrics"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 )
 
 func MigrationKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
-	return MigrationKeeperWithMocks(t, nil, nil)
+	return MigrationKeeperWithMocks(t, nil, nil, nil, nil)
 }
 
-func MigrationKeeperWithMocks(t testing.TB, selfvestingKeeper *test.MockSelfvestingKeeper, bankKeeper *test.MockBankKeeper) (*keeper.Keeper, sdk.Context) {
-	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+func MigrationKeeperWithMocks(t testing.TB, selfvestingKeeper *test.MockSelfvestingKeeper, bankKeeper *test.MockBankKeeper, distrKeeper *test.MockDistrKeeper, wasmKeeper *test.MockWasmKeeper) (*keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
 	db := dbm.NewMemDB()
-	stateStore := store.NewCommitMultiStore(db)
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
@@ -49,6 +52,9 @@ func MigrationKeeperWithMocks(t testing.TB, selfvestingKeeper *test.MockSelfvest
 		paramsSubspace,
 		selfvestingKeeper,
 		bankKeeper,
+		distrKeeper,
+		wasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
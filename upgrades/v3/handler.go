@@ -0,0 +1,27 @@
+package v3
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// CreateUpgradeHandler runs the store migrations of the modules whose consensus version has been bumped,
+// the selfvesting and migration modules going from version 1 to 2
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(context context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(context)
+		ctx.Logger().Info("Starting upgrade v3")
+
+		newVM, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			ctx.Logger().Error("Failed to run module migrations for v3", "error", err)
+			return nil, err
+		}
+
+		ctx.Logger().Info("Upgrade v3 complete")
+		return newVM, nil
+	}
+}
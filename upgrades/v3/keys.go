@@ -0,0 +1,8 @@
+// upgrades/v3/keys.go
+package v3
+
+const (
+	// UpgradeName is the name of the upgrade moving the selfvesting positions under their own ids and the
+	// migrations under their compact keys
+	UpgradeName = "v3.0.0"
+)
@@ -0,0 +1,95 @@
+package ante
+
+import (
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MigrationDedupDecorator rejects from the mempool the migrations that have already been processed or that
+// are already waiting in the mempool, so that migrators racing on the same deposit don't pay for a
+// transaction bound to fail. Messages nested in an authz MsgExec are checked as well, on behalf of the
+// grantee that signed the MsgExec.
+//
+// It only acts while checking transactions and must come after the signature verification: the migrations
+// of a transaction are marked as pending only once the rest of the ante handler accepted it.
+type MigrationDedupDecorator struct {
+	k keeper.Keeper
+}
+
+func NewMigrationDedupDecorator(k keeper.Keeper) MigrationDedupDecorator {
+	return MigrationDedupDecorator{k: k}
+}
+
+func (d MigrationDedupDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.checkMsgs(ctx, tx.GetMsgs(), ""); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs checks the migrations of a list of messages. The signer is the grantee of the outermost MsgExec
+// the messages are nested in, or empty for the messages of the transaction which are signed by their creator.
+func (d MigrationDedupDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg, signer string) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *types.MsgMigrate:
+			migrationSigner := signer
+			if migrationSigner == "" {
+				migrationSigner = msg.Creator
+			}
+
+			if err := d.checkMigration(ctx, msg, migrationSigner); err != nil {
+				return err
+			}
+		case *authz.MsgExec:
+			nestedMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			execSigner := signer
+			if execSigner == "" {
+				execSigner = msg.Grantee
+			}
+
+			if err := d.checkMsgs(ctx, nestedMsgs, execSigner); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d MigrationDedupDecorator) checkMigration(ctx sdk.Context, msg *types.MsgMigrate, signer string) error {
+	msgHash := msg.Hash()
+
+	if d.k.IsMigrationProcessed(ctx, msgHash) {
+		return sdkerrors.Wrapf(types.ErrMigrationProcessed, "migration %s", msgHash)
+	}
+
+	if d.k.IsMigrationPending(ctx, msgHash) {
+		return sdkerrors.Wrapf(types.ErrMigrationPending, "migration %s", msgHash)
+	}
+
+	// Only the migrations signed by registered migrators hold the deposit, anybody else could otherwise keep
+	// the migrators' transactions out of the mempool by copying their messages. The creator of a nested
+	// migration is only the granter the MsgExec claims to act for, so the grantee must be a migrator too.
+	if _, isMigrator := d.k.GetMigrator(ctx, signer); !isMigrator {
+		return nil
+	}
+	if _, isMigrator := d.k.GetMigrator(ctx, msg.Creator); isMigrator {
+		d.k.SetPendingMigration(ctx, msgHash)
+	}
+
+	return nil
+}
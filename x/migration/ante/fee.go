@@ -0,0 +1,80 @@
+package ante
+
+import (
+	"context"
+
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MigratorFeeDecorator waives the fee of the transactions made only of migrations signed by a registered
+// migrator, as long as their gas fits in the budget of the block and the quota of the migrator. The fee of
+// any other transaction is handled by the wrapped fee decorator.
+//
+// A waived transaction still goes through the SDK fee decorator, so its fee must meet the minimum gas prices
+// of the validator and sets its priority, but nothing is deducted from the fee payer.
+type MigratorFeeDecorator struct {
+	k         keeper.Keeper
+	waiveFee  sdk.AnteDecorator
+	deductFee sdk.AnteDecorator
+}
+
+func NewMigratorFeeDecorator(
+	k keeper.Keeper,
+	ak ante.AccountKeeper,
+	bk authtypes.BankKeeper,
+	fk ante.FeegrantKeeper,
+	txFeeChecker ante.TxFeeChecker,
+	deductFee sdk.AnteDecorator,
+) MigratorFeeDecorator {
+	return MigratorFeeDecorator{
+		k:         k,
+		waiveFee:  ante.NewDeductFeeDecorator(ak, waivedFeeBankKeeper{bk}, fk, txFeeChecker),
+		deductFee: deductFee,
+	}
+}
+
+func (d MigratorFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// The signature of the migrator is verified by the decorators that come next. Fee grants are left to the
+	// wrapped fee decorator since the allowance would be used up for a fee that isn't paid.
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.FeeGranter() == nil {
+		if migrator, ok := migratorOf(feeTx); ok && d.k.WaiveFee(ctx, migrator, feeTx.GetGas()) {
+			return d.waiveFee.AnteHandle(ctx, tx, simulate, next)
+		}
+	}
+
+	return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+}
+
+// migratorOf returns the migrator of a transaction made only of migrations sent by the same account
+func migratorOf(tx sdk.Tx) (string, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return "", false
+	}
+
+	var migrator string
+	for i, msg := range msgs {
+		migrate, ok := msg.(*types.MsgMigrate)
+		if !ok || (i > 0 && migrate.Creator != migrator) {
+			return "", false
+		}
+		migrator = migrate.Creator
+	}
+
+	return migrator, true
+}
+
+// waivedFeeBankKeeper is the bank keeper of the SDK fee decorator of waived transactions, which doesn't take
+// the fee from the fee payer
+type waivedFeeBankKeeper struct {
+	authtypes.BankKeeper
+}
+
+func (waivedFeeBankKeeper) SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error {
+	return nil
+}
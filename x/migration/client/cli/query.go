@@ -31,6 +31,33 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListMigrator())
 	cmd.AddCommand(CmdShowMigrator())
 	cmd.AddCommand(CmdShowConfig())
+	cmd.AddCommand(CmdListTokenSunset())
+	cmd.AddCommand(CmdShowTokenSunset())
+	cmd.AddCommand(CmdListSourceChain())
+	cmd.AddCommand(CmdShowSourceChain())
+	cmd.AddCommand(CmdListSourceChainStats())
+	cmd.AddCommand(CmdShowSourceChainStats())
+	cmd.AddCommand(CmdListMigrationFee())
+	cmd.AddCommand(CmdShowMigrationFee())
+	cmd.AddCommand(CmdListLockupTier())
+	cmd.AddCommand(CmdShowLockupTier())
+	cmd.AddCommand(CmdListRatioSchedule())
+	cmd.AddCommand(CmdShowRatioSchedule())
+	cmd.AddCommand(CmdShowCurrentRatio())
+	cmd.AddCommand(CmdListWithdrawal())
+	cmd.AddCommand(CmdShowWithdrawal())
+	cmd.AddCommand(CmdShowWithdrawalConfig())
+	cmd.AddCommand(CmdListMigrationArchive())
+	cmd.AddCommand(CmdShowMigrationArchive())
+	cmd.AddCommand(CmdShowMigrationTotals())
+	cmd.AddCommand(CmdListTokenStats())
+	cmd.AddCommand(CmdShowTokenStats())
+	cmd.AddCommand(CmdListMigratorStats())
+	cmd.AddCommand(CmdShowMigratorStats())
+	cmd.AddCommand(CmdListDailyStats())
+	cmd.AddCommand(CmdShowDailyStats())
+	cmd.AddCommand(CmdShowFeeWaiverConfig())
+	cmd.AddCommand(CmdShowFeeAllowanceConfig())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
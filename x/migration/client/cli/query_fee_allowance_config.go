@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"selfchain/x/migration/types"
+)
+
+func CmdShowFeeAllowanceConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-fee-allowance-config",
+		Short: "shows fee-allowance-config",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetFeeAllowanceConfigRequest{}
+
+			res, err := queryClient.FeeAllowanceConfig(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
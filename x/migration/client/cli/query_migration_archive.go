@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdListMigrationArchive() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-migration-archive",
+		Short: "list all migration-archive",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllMigrationArchiveRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MigrationArchiveAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowMigrationArchive() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-migration-archive [token] [source-chain-id] [bucket]",
+		Short: "shows a migration-archive",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argToken, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argSourceChainId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argBucket, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetMigrationArchiveRequest{
+				Token:         argToken,
+				SourceChainId: argSourceChainId,
+				Bucket:        argBucket,
+			}
+
+			res, err := queryClient.MigrationArchive(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
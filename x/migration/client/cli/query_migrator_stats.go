@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListMigratorStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-migrator-stats",
+		Short: "list all migrator-stats",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllMigratorStatsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MigratorStatsAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowMigratorStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-migrator-stats [migrator]",
+		Short: "shows a migrator-stats",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argMigrator := args[0]
+
+			params := &types.QueryGetMigratorStatsRequest{
+				Migrator: argMigrator,
+			}
+
+			res, err := queryClient.MigratorStats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
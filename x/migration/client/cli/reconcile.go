@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"selfchain/x/migration/client/reconcile"
+	"selfchain/x/migration/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagFormat    = "format"
+	FlagDataDir   = "data-dir"
+	FlagDbBackend = "db-backend"
+
+	reconcilePageLimit = 1000
+)
+
+func CmdReconcile(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile [deposits-file]",
+		Short: "Compare an export of the bridge deposit logs to the migrations stored on chain",
+		Long: `Compare an export of the bridge deposit logs to the migrations stored on chain and report the
+deposits that have never been migrated, the migrations that don't match any deposit and the deposits
+migrated with a different amount, address or token.
+
+The export is either a JSON array or a CSV file with a header row, using the columns txHash, logIndex,
+ethAddress, destAddress, amount, token and the optional sourceChainId and blockNumber.
+
+Migrations are queried from --node unless --data-dir is set, in which case they are read from the
+application database of a stopped node. Archived migrations only remain as summaries, which are listed
+in the report. A deposit without a migration is reported as archived rather than never migrated when
+its block number falls in an archived range or, with --data-dir, when the hash of its migration has
+been archived.`,
+		Example: fmt.Sprintf(`%[1]s migration reconcile deposits.csv --node tcp://localhost:26657
+%[1]s migration reconcile deposits.json --data-dir ~/.selfchain/data`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			deposits, err := readDeposits(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			var migrations []types.TokenMigration
+			var archive reconcile.Archive
+			dataDir, _ := cmd.Flags().GetString(FlagDataDir)
+			if dataDir != "" {
+				backend, _ := cmd.Flags().GetString(FlagDbBackend)
+				migrations, archive, err = readStoredMigrations(dataDir, dbm.BackendType(backend))
+			} else {
+				clientCtx, ctxErr := client.GetClientQueryContext(cmd)
+				if ctxErr != nil {
+					return ctxErr
+				}
+				if migrations, err = queryMigrations(cmd.Context(), clientCtx); err == nil {
+					archive.Summaries, err = queryArchives(cmd.Context(), clientCtx)
+				}
+			}
+			if err != nil {
+				return err
+			}
+
+			report := reconcile.Reconcile(deposits, migrations, archive)
+			out, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, "", "Format of the deposits file, json or csv (default from the file extension)")
+	cmd.Flags().String(FlagDataDir, "", fmt.Sprintf("Read the migrations from the data directory of a stopped node, e.g. %s", filepath.Join(defaultNodeHome, "data")))
+	cmd.Flags().String(FlagDbBackend, string(dbm.GoLevelDBBackend), "Database backend of the node")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readDeposits(cmd *cobra.Command, path string) ([]reconcile.Deposit, error) {
+	format, _ := cmd.Flags().GetString(FlagFormat)
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return reconcile.ParseDeposits(file, format)
+}
+
+// queryMigrations pages through all the migrations of the node
+func queryMigrations(ctx context.Context, clientCtx client.Context) ([]types.TokenMigration, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+
+	var migrations []types.TokenMigration
+	var nextKey []byte
+	for {
+		res, err := queryClient.TokenMigrationAll(ctx, &types.QueryAllTokenMigrationRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: reconcilePageLimit},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		migrations = append(migrations, res.TokenMigration...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return migrations, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// queryArchives pages through all the archive summaries of the node
+func queryArchives(ctx context.Context, clientCtx client.Context) ([]types.MigrationArchive, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+
+	var archives []types.MigrationArchive
+	var nextKey []byte
+	for {
+		res, err := queryClient.MigrationArchiveAll(ctx, &types.QueryAllMigrationArchiveRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: reconcilePageLimit},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		archives = append(archives, res.MigrationArchive...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return archives, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// readStoredMigrations reads the migrations and the archives at the latest height committed by a node.
+// The node must be stopped since its database can't be opened twice.
+func readStoredMigrations(dataDir string, backend dbm.BackendType) ([]types.TokenMigration, reconcile.Archive, error) {
+	db, err := dbm.NewDB("application", backend, dataDir)
+	if err != nil {
+		return nil, reconcile.Archive{}, err
+	}
+	defer db.Close()
+
+	return reconcile.ReadStore(db)
+}
@@ -34,6 +34,21 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddMigrator())
 	cmd.AddCommand(CmdRemoveMigrator())
 	cmd.AddCommand(CmdUpdateConfig())
+	cmd.AddCommand(CmdSetTokenSunset())
+	cmd.AddCommand(CmdSetSourceChain())
+	cmd.AddCommand(CmdSetMigrationFee())
+	cmd.AddCommand(CmdSetLockupTier())
+	cmd.AddCommand(CmdRemoveLockupTier())
+	cmd.AddCommand(CmdSetRatioSchedule())
+	cmd.AddCommand(CmdRemoveRatioSchedule())
+	cmd.AddCommand(CmdSetWithdrawalConfig())
+	cmd.AddCommand(CmdRequestWithdrawal())
+	cmd.AddCommand(CmdSignWithdrawal())
+	cmd.AddCommand(CmdConfirmWithdrawal())
+	cmd.AddCommand(CmdCancelWithdrawal())
+	cmd.AddCommand(CmdArchiveMigrations())
+	cmd.AddCommand(CmdSetFeeWaiverConfig())
+	cmd.AddCommand(CmdSetFeeAllowanceConfig())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdArchiveMigrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive-migrations [token] [before-block]",
+		Short: "Broadcast message archive-migrations",
+		Long:  "Replace the migrations of a token deposited on a source chain in the buckets ending before the given block of that chain with a summary per bucket",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argToken, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argBeforeBlock, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argSourceChainId, err := cmd.Flags().GetUint64(flagSourceChainId)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgArchiveMigrations(
+				clientCtx.GetFromAddress().String(),
+				argToken,
+				argBeforeBlock,
+				argSourceChainId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSourceChainId, types.EthereumChainId, "EVM chain id of the network the blocks belong to")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
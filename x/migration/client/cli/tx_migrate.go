@@ -14,6 +14,14 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	flagSourceChainId = "source-chain-id"
+	flagLockupTier    = "lockup-tier"
+	flagSourceBlock   = "source-block"
+	flagContract      = "contract"
+	flagCallbackMsg   = "callback-msg"
+)
+
 func CmdMigrate() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "migrate [tx_hash] [eth-address] [dest-address] [amount] [token] [log_index]",
@@ -35,6 +43,31 @@ func CmdMigrate() *cobra.Command {
 				return err
 			}
 
+			argSourceChainId, err := cmd.Flags().GetUint64(flagSourceChainId)
+			if err != nil {
+				return err
+			}
+
+			argLockupTier, err := cmd.Flags().GetUint64(flagLockupTier)
+			if err != nil {
+				return err
+			}
+
+			argSourceBlock, err := cmd.Flags().GetUint64(flagSourceBlock)
+			if err != nil {
+				return err
+			}
+
+			argContract, err := cmd.Flags().GetString(flagContract)
+			if err != nil {
+				return err
+			}
+
+			argCallbackMsg, err := cmd.Flags().GetString(flagCallbackMsg)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -48,6 +81,11 @@ func CmdMigrate() *cobra.Command {
 				argAmount,
 				argToken,
 				argLogIndex,
+				argSourceChainId,
+				argLockupTier,
+				argSourceBlock,
+				argContract,
+				argCallbackMsg,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -56,6 +94,11 @@ func CmdMigrate() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagSourceChainId, types.EthereumChainId, "EVM chain id of the network the tokens have been deposited on")
+	cmd.Flags().Uint64(flagLockupTier, types.DefaultLockupTier, "Lockup tier chosen by the holder")
+	cmd.Flags().Uint64(flagSourceBlock, 0, "Block number of the deposit on the source chain")
+	cmd.Flags().String(flagContract, "", "Contract receiving the tokens of a migration released at once, the destination address being the fallback")
+	cmd.Flags().String(flagCallbackMsg, "", "JSON message executed on the contract along with the migrated tokens")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdRequestWithdrawal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-withdrawal [eth-recipient] [amount] [token]",
+		Short: "Broadcast message request-withdrawal",
+		Long: `Burns the given amount of uslf in exchange of the ERC-20 token, released by the vault to the
+Ethereum recipient once the migrators have signed the withdrawal.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argEthRecipient := args[0]
+			argAmount := args[1]
+
+			argToken, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argSourceChainId, err := cmd.Flags().GetUint64(flagSourceChainId)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRequestWithdrawal(
+				clientCtx.GetFromAddress().String(),
+				argEthRecipient,
+				argAmount,
+				argToken,
+				argSourceChainId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSourceChainId, types.EthereumChainId, "EVM chain id of the network of the vault")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
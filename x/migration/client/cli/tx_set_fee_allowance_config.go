@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetFeeAllowanceConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-allowance-config [spend-limit] [expiration]",
+		Short: "Broadcast message set-fee-allowance-config",
+		Long: `Sets the fee allowance in uslf granted to the beneficiaries of new vesting positions, instead of the
+instantly released amount, and the number of seconds after which it expires. A zero spend limit disables it.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSpendLimit := args[0]
+			argExpiration, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeeAllowanceConfig(
+				clientCtx.GetFromAddress().String(),
+				argSpendLimit,
+				argExpiration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetFeeWaiverConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-waiver-config [block-gas-budget] [migrator-gas-quota]",
+		Short: "Broadcast message set-fee-waiver-config",
+		Long: `Sets the gas limit of all the fee-free migrator transactions of a block and the one of each migrator.
+A zero block gas budget disables the fee-free transactions.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBlockGasBudget, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argMigratorGasQuota, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeeWaiverConfig(
+				clientCtx.GetFromAddress().String(),
+				argBlockGasBudget,
+				argMigratorGasQuota,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetLockupTier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-lockup-tier [tier] [duration-multiplier] [bonus-ratio]",
+		Short: "Broadcast message set-lockup-tier",
+		Long: `Sets a lockup tier holders can opt into when migrating. The duration multiplier is applied to the
+configured vesting duration and the bonus ratio to the minted amount, both in percent.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTier, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argDurationMultiplier, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argBonusRatio, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetLockupTier(
+				clientCtx.GetFromAddress().String(),
+				argTier,
+				argDurationMultiplier,
+				argBonusRatio,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
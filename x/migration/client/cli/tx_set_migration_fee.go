@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"selfchain/x/migration/types"
+)
+
+var _ = strconv.Itoa(0)
+
+const (
+	flagWaiveBelow = "waive-below"
+	flagWaiveAbove = "waive-above"
+)
+
+func CmdSetMigrationFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-migration-fee [token] [basis-points] [recipient]",
+		Short: "Broadcast message set-migration-fee",
+		Long: `Sets the protocol fee, in basis points of the minted amount, taken from the migrations of the token.
+The recipient is either community-pool or treasury.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argToken, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argBasisPoints, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argRecipient, ok := types.FeeRecipient_value["FEE_RECIPIENT_"+strings.ToUpper(strings.ReplaceAll(args[2], "-", "_"))]
+			if !ok {
+				return fmt.Errorf("unknown fee recipient %s", args[2])
+			}
+
+			waiveBelow, err := cmd.Flags().GetString(flagWaiveBelow)
+			if err != nil {
+				return err
+			}
+			waiveAbove, err := cmd.Flags().GetString(flagWaiveAbove)
+			if err != nil {
+				return err
+			}
+			treasury, err := cmd.Flags().GetString(flagTreasury)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMigrationFee(
+				clientCtx.GetFromAddress().String(),
+				argToken,
+				argBasisPoints,
+				waiveBelow,
+				waiveAbove,
+				types.FeeRecipient(argRecipient),
+				treasury,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagWaiveBelow, "", "Migrations minting less uslf than this amount are free")
+	cmd.Flags().String(flagWaiveAbove, "", "Migrations minting more uslf than this amount are free")
+	cmd.Flags().String(flagTreasury, "", "Address receiving the fees when the recipient is treasury")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
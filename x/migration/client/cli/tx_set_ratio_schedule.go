@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"selfchain/x/migration/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetRatioSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ratio-schedule [token] [interpolation] [points]",
+		Short: "Broadcast message set-ratio-schedule",
+		Long: `Sets the conversion ratio schedule of the token. The interpolation is either step or linear and the
+points are a comma separated list of unix-time:ratio pairs, the ratio being in percent.
+
+Example: set-ratio-schedule 1 linear 1700000000:100,1702592000:100,1715552000:50`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argToken, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argInterpolation, ok := types.RatioInterpolation_value["RATIO_INTERPOLATION_"+strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("unknown ratio interpolation %s", args[1])
+			}
+			argPoints, err := parseRatioPoints(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRatioSchedule(
+				clientCtx.GetFromAddress().String(),
+				argToken,
+				types.RatioInterpolation(argInterpolation),
+				argPoints,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseRatioPoints(arg string) ([]types.RatioPoint, error) {
+	var points []types.RatioPoint
+	for _, pair := range strings.Split(arg, ",") {
+		timeAndRatio := strings.Split(pair, ":")
+		if len(timeAndRatio) != 2 {
+			return nil, fmt.Errorf("invalid ratio point %s", pair)
+		}
+
+		pointTime, err := cast.ToUint64E(timeAndRatio[0])
+		if err != nil {
+			return nil, err
+		}
+		ratio, err := cast.ToUint64E(timeAndRatio[1])
+		if err != nil {
+			return nil, err
+		}
+
+		points = append(points, types.RatioPoint{Time: pointTime, Ratio: ratio})
+	}
+
+	return points, nil
+}
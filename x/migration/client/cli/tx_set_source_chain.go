@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"selfchain/x/migration/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetSourceChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-source-chain [chain-id] [name] [confirmations] [tokens] [enabled]",
+		Short: "Broadcast message set-source-chain",
+		Long:  "Adds or updates an EVM network tokens can be migrated from. Tokens are given as a comma separated list e.g. 0,1",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argName := args[1]
+			argConfirmations, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+			argTokens := []uint64{}
+			for _, token := range strings.Split(args[3], listSeparator) {
+				if token == "" {
+					continue
+				}
+				argToken, err := cast.ToUint64E(token)
+				if err != nil {
+					return err
+				}
+				argTokens = append(argTokens, argToken)
+			}
+			argEnabled, err := cast.ToBoolE(args[4])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSourceChain(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+				argName,
+				argConfirmations,
+				argTokens,
+				argEnabled,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"selfchain/x/migration/types"
+)
+
+var _ = strconv.Itoa(0)
+
+const (
+	flagTreasury     = "treasury"
+	flagLegacyMinted = "legacy-minted"
+)
+
+func CmdSetTokenSunset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-token-sunset [token] [end-time] [cap] [sweep-mode]",
+		Short: "Broadcast message set-token-sunset",
+		Long: `Sets the unix time after which the token can no longer be migrated, the total amount of uslf that can
+be minted for it (empty for no cap) and what happens to the unclaimed allocation once the migration has ended.
+The sweep mode is one of none, community-pool, treasury or burn. A cap requires the amount of uslf minted for
+the token before minted amounts were tracked.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argToken, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argEndTime, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argCap := args[2]
+			argSweepMode, ok := types.SweepMode_value["SWEEP_MODE_"+strings.ToUpper(strings.ReplaceAll(args[3], "-", "_"))]
+			if !ok {
+				return fmt.Errorf("unknown sweep mode %s", args[3])
+			}
+
+			treasury, err := cmd.Flags().GetString(flagTreasury)
+			if err != nil {
+				return err
+			}
+
+			legacyMinted, err := cmd.Flags().GetString(flagLegacyMinted)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTokenSunset(
+				clientCtx.GetFromAddress().String(),
+				argToken,
+				argEndTime,
+				argCap,
+				types.SweepMode(argSweepMode),
+				treasury,
+				legacyMinted,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagTreasury, "", "Address receiving the unclaimed allocation when the sweep mode is treasury")
+	cmd.Flags().String(flagLegacyMinted, "", "Amount of uslf minted for the token before minted amounts were tracked")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetWithdrawalConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-withdrawal-config [signature-threshold] [expiry] [refund-delay]",
+		Short: "Broadcast message set-withdrawal-config",
+		Long: `Sets the number of migrator signatures the vault requires, the seconds during which a withdrawal can be
+executed and the seconds left to the migrators to confirm its execution before it is refunded.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSignatureThreshold, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argExpiry, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argRefundDelay, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetWithdrawalConfig(
+				clientCtx.GetFromAddress().String(),
+				argSignatureThreshold,
+				argExpiry,
+				argRefundDelay,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,152 @@
+package reconcile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"selfchain/x/migration/types"
+
+	"github.com/spf13/cast"
+)
+
+// Deposit is a deposit log emitted by the bridge contract of a source chain
+type Deposit struct {
+	TxHash        string `json:"txHash"`
+	LogIndex      uint64 `json:"logIndex"`
+	EthAddress    string `json:"ethAddress"`
+	DestAddress   string `json:"destAddress"`
+	Amount        string `json:"amount"`
+	Token         uint64 `json:"token"`
+	SourceChainId uint64 `json:"sourceChainId,omitempty"`
+	BlockNumber   uint64 `json:"blockNumber,omitempty"`
+}
+
+// Supported export formats
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// ParseDeposits reads an export of deposit logs. A JSON export is an array of deposits and a CSV export
+// has a header row naming the columns after the JSON fields. The sourceChainId column is optional and
+// defaults to Ethereum mainnet, the blockNumber column is optional as well.
+func ParseDeposits(r io.Reader, format string) ([]Deposit, error) {
+	switch format {
+	case FormatJSON:
+		var deposits []Deposit
+		if err := json.NewDecoder(r).Decode(&deposits); err != nil {
+			return nil, err
+		}
+		return deposits, nil
+	case FormatCSV:
+		return parseCSV(r)
+	default:
+		return nil, fmt.Errorf("unknown deposit export format %s", format)
+	}
+}
+
+func parseCSV(r io.Reader) ([]Deposit, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing csv header")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"txHash", "logIndex", "ethAddress", "destAddress", "amount", "token"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing csv column %s", name)
+		}
+	}
+
+	var deposits []Deposit
+	for line, record := range records[1:] {
+		value := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		deposit := Deposit{
+			TxHash:      value("txHash"),
+			EthAddress:  value("ethAddress"),
+			DestAddress: value("destAddress"),
+			Amount:      value("amount"),
+		}
+		if deposit.LogIndex, err = cast.ToUint64E(value("logIndex")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid log index (%s)", line+2, err)
+		}
+		if deposit.Token, err = cast.ToUint64E(value("token")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid token (%s)", line+2, err)
+		}
+		if chainId := value("sourceChainId"); chainId != "" {
+			if deposit.SourceChainId, err = cast.ToUint64E(chainId); err != nil {
+				return nil, fmt.Errorf("line %d: invalid source chain id (%s)", line+2, err)
+			}
+		}
+		if block := value("blockNumber"); block != "" {
+			if deposit.BlockNumber, err = cast.ToUint64E(block); err != nil {
+				return nil, fmt.Errorf("line %d: invalid block number (%s)", line+2, err)
+			}
+		}
+
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits, nil
+}
+
+// ChainId returns the EVM chain id of the network of the deposit
+func (d Deposit) ChainId() uint64 {
+	if d.SourceChainId == 0 {
+		return types.EthereumChainId
+	}
+
+	return d.SourceChainId
+}
+
+// key identifies a deposit log regardless of how the migrator encoded its hashes
+func (d Deposit) key() string {
+	return depositKey(d.ChainId(), d.TxHash, d.LogIndex)
+}
+
+// msgHashes returns the replay keys a migration of the deposit can have, the export may not encode the
+// addresses and transaction hash the same way as the migrators
+func (d Deposit) msgHashes() []string {
+	msg := types.MsgMigrate{
+		EthAddress:    d.EthAddress,
+		DestAddress:   d.DestAddress,
+		Amount:        d.Amount,
+		Token:         d.Token,
+		TxHash:        d.TxHash,
+		LogIndex:      d.LogIndex,
+		SourceChainId: d.SourceChainId,
+	}
+	hashes := []string{msg.Hash()}
+
+	msg.EthAddress = "0x" + normalizeHex(d.EthAddress)
+	msg.TxHash = "0x" + normalizeHex(d.TxHash)
+	if hash := msg.Hash(); hash != hashes[0] {
+		hashes = append(hashes, hash)
+	}
+
+	return hashes
+}
+
+func depositKey(chainId uint64, txHash string, logIndex uint64) string {
+	return fmt.Sprintf("%d|%s|%d", chainId, normalizeHex(txHash), logIndex)
+}
+
+func normalizeHex(value string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(value)), "0x")
+}
@@ -0,0 +1,192 @@
+package reconcile
+
+import (
+	"math/big"
+	"sort"
+
+	"selfchain/x/migration/types"
+)
+
+// Mismatch is a deposit whose migration doesn't hold the same data
+type Mismatch struct {
+	Deposit   Deposit              `json:"deposit"`
+	Migration types.TokenMigration `json:"migration"`
+	Fields    []string             `json:"fields"`
+}
+
+// Report lists the discrepancies between the deposits on the source chains and the migrations
+type Report struct {
+	// deposits that have never been migrated
+	Unmigrated []Deposit `json:"unmigrated"`
+
+	// migrations that don't match any deposit, including the ones migrating a deposit more than once
+	Unmatched []types.TokenMigration `json:"unmatched"`
+
+	// deposits migrated with a different amount, address or token
+	Mismatched []Mismatch `json:"mismatched"`
+
+	// deposits whose migration has been archived, they can only be checked against the archive summaries
+	Archived []Deposit `json:"archived"`
+
+	// summaries of the archived migrations, by token, source chain and range of source blocks
+	Archives []types.MigrationArchive `json:"archives"`
+}
+
+// Archive is what remains on chain of the archived migrations
+type Archive struct {
+	// summaries of the archived buckets of migrations
+	Summaries []types.MigrationArchive
+
+	// hashes of the archived migrations, they can only be read from the store of a node
+	Hashes []string
+}
+
+// holds returns whether the migration of a deposit may have been archived. The deposit is found by its
+// hash or, when the export has its block number, by the range of source blocks of the archives.
+func (a Archive) holds(deposit Deposit, hashes map[string]bool) bool {
+	for _, hash := range deposit.msgHashes() {
+		if hashes[hash] {
+			return true
+		}
+	}
+
+	if deposit.BlockNumber == 0 {
+		return false
+	}
+	for _, summary := range a.Summaries {
+		if summary.Token == deposit.Token && summary.SourceChainId == deposit.ChainId() &&
+			summary.FromBlock <= deposit.BlockNumber && deposit.BlockNumber <= summary.ToBlock {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsClean returns whether every deposit has been migrated exactly once. Archived deposits are counted
+// as migrated.
+func (r Report) IsClean() bool {
+	return len(r.Unmigrated) == 0 && len(r.Unmatched) == 0 && len(r.Mismatched) == 0
+}
+
+// Reconcile matches the deposits to the migrations by source chain, transaction hash and log index.
+// Reverted migrations are ignored so their deposit is reported as never migrated. Deposits without a
+// migration that have been archived are reported apart.
+func Reconcile(deposits []Deposit, migrations []types.TokenMigration, archive Archive) Report {
+	report := Report{
+		Unmigrated: []Deposit{},
+		Unmatched:  []types.TokenMigration{},
+		Mismatched: []Mismatch{},
+		Archived:   []Deposit{},
+		Archives:   []types.MigrationArchive{},
+	}
+	report.Archives = append(report.Archives, archive.Summaries...)
+
+	archivedHashes := make(map[string]bool)
+	for _, hash := range archive.Hashes {
+		archivedHashes[hash] = true
+	}
+
+	byDeposit := make(map[string][]types.TokenMigration)
+	for _, migration := range migrations {
+		if migration.Reverted {
+			continue
+		}
+
+		key := migrationKey(migration)
+		byDeposit[key] = append(byDeposit[key], migration)
+	}
+
+	seen := make(map[string]bool)
+	for _, deposit := range deposits {
+		// Exports built from overlapping block ranges can hold the same log twice
+		key := deposit.key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		matches, found := byDeposit[key]
+		if !found {
+			if archive.holds(deposit, archivedHashes) {
+				report.Archived = append(report.Archived, deposit)
+			} else {
+				report.Unmigrated = append(report.Unmigrated, deposit)
+			}
+			continue
+		}
+		delete(byDeposit, key)
+
+		// An exact match is preferred, any other migration of the deposit is a double migration
+		best := 0
+		for i, migration := range matches {
+			if len(deposit.diff(migration)) == 0 {
+				best = i
+				break
+			}
+		}
+
+		if fields := deposit.diff(matches[best]); len(fields) > 0 {
+			report.Mismatched = append(report.Mismatched, Mismatch{
+				Deposit:   deposit,
+				Migration: matches[best],
+				Fields:    fields,
+			})
+		}
+
+		for i, migration := range matches {
+			if i != best {
+				report.Unmatched = append(report.Unmatched, migration)
+			}
+		}
+	}
+
+	// Whatever is left has no deposit. Sort them so that reports can be diffed
+	keys := make([]string, 0, len(byDeposit))
+	for key := range byDeposit {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		report.Unmatched = append(report.Unmatched, byDeposit[key]...)
+	}
+
+	return report
+}
+
+// diff returns the fields of the deposit the migration doesn't match
+func (d Deposit) diff(migration types.TokenMigration) (fields []string) {
+	if !sameAmount(d.Amount, migration.Amount) {
+		fields = append(fields, "amount")
+	}
+	if d.DestAddress != migration.DestAddress {
+		fields = append(fields, "destAddress")
+	}
+	if normalizeHex(d.EthAddress) != normalizeHex(migration.EthAddress) {
+		fields = append(fields, "ethAddress")
+	}
+	if d.Token != migration.Token {
+		fields = append(fields, "token")
+	}
+
+	return fields
+}
+
+func sameAmount(a, b string) bool {
+	amountA, okA := new(big.Int).SetString(a, 10)
+	amountB, okB := new(big.Int).SetString(b, 10)
+	if !okA || !okB {
+		return a == b
+	}
+
+	return amountA.Cmp(amountB) == 0
+}
+
+func migrationKey(migration types.TokenMigration) string {
+	chainId := migration.SourceChainId
+	if chainId == 0 {
+		chainId = types.EthereumChainId
+	}
+
+	return depositKey(chainId, migration.TxHash, migration.LogIndex)
+}
@@ -0,0 +1,179 @@
+package reconcile_test
+
+import (
+	"strings"
+	"testing"
+
+	"selfchain/x/migration/client/reconcile"
+	"selfchain/x/migration/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ethAddress  = "0x1f9090aae28b8a3dceadf281b0f12828e676c326"
+	destAddress = "self1qyfk5yrgfyhlkdf4qjvrgxnly9dwq6k5tqhqlw"
+)
+
+func TestParseDeposits(t *testing.T) {
+	fromJson, err := reconcile.ParseDeposits(strings.NewReader(`[
+		{"txHash": "0xabc", "logIndex": 3, "ethAddress": "`+ethAddress+`", "destAddress": "`+destAddress+`", "amount": "1000", "token": 1},
+		{"txHash": "0xdef", "logIndex": 0, "ethAddress": "`+ethAddress+`", "destAddress": "`+destAddress+`", "amount": "2000", "token": 2, "sourceChainId": 56}
+	]`), reconcile.FormatJSON)
+	require.NoError(t, err)
+
+	fromCsv, err := reconcile.ParseDeposits(strings.NewReader(
+		"txHash,logIndex,ethAddress,destAddress,amount,token,sourceChainId\n"+
+			"0xabc,3,"+ethAddress+","+destAddress+",1000,1,\n"+
+			"0xdef,0,"+ethAddress+","+destAddress+",2000,2,56\n",
+	), reconcile.FormatCSV)
+	require.NoError(t, err)
+
+	require.Equal(t, fromJson, fromCsv)
+	require.Len(t, fromCsv, 2)
+	require.Equal(t, types.EthereumChainId, fromCsv[0].ChainId())
+	require.Equal(t, uint64(56), fromCsv[1].ChainId())
+
+	_, err = reconcile.ParseDeposits(strings.NewReader("txHash,logIndex\n0xabc,3\n"), reconcile.FormatCSV)
+	require.ErrorContains(t, err, "missing csv column")
+
+	_, err = reconcile.ParseDeposits(strings.NewReader(
+		"txHash,logIndex,ethAddress,destAddress,amount,token\n0xabc,x,"+ethAddress+","+destAddress+",1000,1\n",
+	), reconcile.FormatCSV)
+	require.ErrorContains(t, err, "invalid log index")
+
+	withBlock, err := reconcile.ParseDeposits(strings.NewReader(
+		"txHash,logIndex,ethAddress,destAddress,amount,token,blockNumber\n0xabc,3,"+ethAddress+","+destAddress+",1000,1,17000000\n",
+	), reconcile.FormatCSV)
+	require.NoError(t, err)
+	require.Equal(t, uint64(17000000), withBlock[0].BlockNumber)
+
+	_, err = reconcile.ParseDeposits(strings.NewReader("[]"), "xml")
+	require.ErrorContains(t, err, "unknown deposit export format")
+}
+
+func deposit(txHash string, logIndex uint64, amount string) reconcile.Deposit {
+	return reconcile.Deposit{
+		TxHash:      txHash,
+		LogIndex:    logIndex,
+		EthAddress:  ethAddress,
+		DestAddress: destAddress,
+		Amount:      amount,
+		Token:       1,
+	}
+}
+
+func migration(txHash string, logIndex uint64, amount string) types.TokenMigration {
+	return types.TokenMigration{
+		TxHash:      txHash,
+		LogIndex:    logIndex,
+		EthAddress:  ethAddress,
+		DestAddress: destAddress,
+		Amount:      amount,
+		Token:       1,
+		Processed:   true,
+	}
+}
+
+func TestReconcileClean(t *testing.T) {
+	report := reconcile.Reconcile(
+		[]reconcile.Deposit{deposit("0xAAA", 0, "1000"), deposit("0xbbb", 1, "2000"), deposit("0xbbb", 1, "2000")},
+		[]types.TokenMigration{migration("aaa", 0, "1000"), migration("0xBBB", 1, "02000")},
+		reconcile.Archive{},
+	)
+
+	require.True(t, report.IsClean())
+}
+
+func TestReconcileReportsDiscrepancies(t *testing.T) {
+	reverted := migration("0xddd", 0, "4000")
+	reverted.Reverted = true
+
+	otherChain := migration("0xeee", 0, "5000")
+	otherChain.SourceChainId = 56
+
+	report := reconcile.Reconcile(
+		[]reconcile.Deposit{
+			deposit("0xaaa", 0, "1000"),
+			deposit("0xbbb", 0, "2000"),
+			deposit("0xccc", 0, "3000"),
+			deposit("0xddd", 0, "4000"),
+			deposit("0xeee", 0, "5000"),
+		},
+		[]types.TokenMigration{
+			migration("0xaaa", 0, "1000"),
+			migration("0xaaa", 0, "1000"),
+			migration("0xbbb", 0, "2500"),
+			reverted,
+			otherChain,
+			migration("0xfff", 0, "6000"),
+		},
+		reconcile.Archive{},
+	)
+
+	require.False(t, report.IsClean())
+
+	require.Len(t, report.Unmigrated, 3)
+	require.Equal(t, "0xccc", report.Unmigrated[0].TxHash)
+	require.Equal(t, "0xddd", report.Unmigrated[1].TxHash)
+	require.Equal(t, "0xeee", report.Unmigrated[2].TxHash)
+
+	require.Len(t, report.Mismatched, 1)
+	require.Equal(t, "0xbbb", report.Mismatched[0].Deposit.TxHash)
+	require.Equal(t, []string{"amount"}, report.Mismatched[0].Fields)
+
+	require.Len(t, report.Unmatched, 3)
+	require.Equal(t, "0xaaa", report.Unmatched[0].TxHash)
+	require.Equal(t, "0xfff", report.Unmatched[1].TxHash)
+	require.Equal(t, uint64(56), report.Unmatched[2].SourceChainId)
+}
+
+func TestReconcilePrefersExactMatch(t *testing.T) {
+	wrongAddress := migration("0xaaa", 0, "1000")
+	wrongAddress.DestAddress = "self1other"
+
+	report := reconcile.Reconcile(
+		[]reconcile.Deposit{deposit("0xaaa", 0, "1000")},
+		[]types.TokenMigration{wrongAddress, migration("0xaaa", 0, "1000")},
+		reconcile.Archive{},
+	)
+
+	require.Empty(t, report.Mismatched)
+	require.Equal(t, []types.TokenMigration{wrongAddress}, report.Unmatched)
+}
+
+func TestReconcileArchivedDeposits(t *testing.T) {
+	byHash := deposit("0xaaa", 0, "1000")
+	archivedMsg := types.MsgMigrate{
+		EthAddress:  byHash.EthAddress,
+		DestAddress: byHash.DestAddress,
+		Amount:      byHash.Amount,
+		Token:       byHash.Token,
+		TxHash:      byHash.TxHash,
+		LogIndex:    byHash.LogIndex,
+	}
+
+	byBlock := deposit("0xbbb", 0, "2000")
+	byBlock.BlockNumber = 150_000
+
+	otherToken := deposit("0xccc", 0, "3000")
+	otherToken.BlockNumber = 150_000
+	otherToken.Token = 2
+
+	afterArchive := deposit("0xddd", 0, "4000")
+	afterArchive.BlockNumber = 250_000
+
+	summary := types.NewMigrationArchive(1, types.EthereumChainId, 1)
+	report := reconcile.Reconcile(
+		[]reconcile.Deposit{byHash, byBlock, otherToken, afterArchive},
+		nil,
+		reconcile.Archive{
+			Summaries: []types.MigrationArchive{summary},
+			Hashes:    []string{archivedMsg.Hash()},
+		},
+	)
+
+	require.Equal(t, []reconcile.Deposit{byHash, byBlock}, report.Archived)
+	require.Equal(t, []reconcile.Deposit{otherToken, afterArchive}, report.Unmigrated)
+	require.Equal(t, []types.MigrationArchive{summary}, report.Archives)
+}
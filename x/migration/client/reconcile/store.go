@@ -0,0 +1,62 @@
+package reconcile
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// ReadStore reads the migrations and the archives at the latest height committed to the application
+// database of a node
+func ReadStore(db dbm.DB) ([]types.TokenMigration, Archive, error) {
+	var archive Archive
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	if err := ms.LoadLatestVersion(); err != nil {
+		return nil, archive, err
+	}
+	kvStore := ms.GetKVStore(storeKey)
+
+	migrationStore := prefix.NewStore(kvStore, types.KeyPrefix(types.TokenMigrationKeyPrefix))
+	migrationIterator := storetypes.KVStorePrefixIterator(migrationStore, []byte{})
+	defer migrationIterator.Close()
+
+	var migrations []types.TokenMigration
+	for ; migrationIterator.Valid(); migrationIterator.Next() {
+		// Archived migrations only keep their key
+		if len(migrationIterator.Value()) == 0 {
+			archive.Hashes = append(archive.Hashes, types.MsgHashFromTokenMigrationKey(migrationIterator.Key()))
+			continue
+		}
+
+		var migration types.TokenMigration
+		if err := migration.Unmarshal(migrationIterator.Value()); err != nil {
+			return nil, archive, err
+		}
+		if migration.MsgHash == "" {
+			migration.MsgHash = types.MsgHashFromTokenMigrationKey(migrationIterator.Key())
+		}
+		migrations = append(migrations, migration)
+	}
+
+	archiveStore := prefix.NewStore(kvStore, types.KeyPrefix(types.MigrationArchiveKeyPrefix))
+	archiveIterator := storetypes.KVStorePrefixIterator(archiveStore, []byte{})
+	defer archiveIterator.Close()
+
+	for ; archiveIterator.Valid(); archiveIterator.Next() {
+		var summary types.MigrationArchive
+		if err := summary.Unmarshal(archiveIterator.Value()); err != nil {
+			return nil, archive, err
+		}
+		archive.Summaries = append(archive.Summaries, summary)
+	}
+
+	return migrations, archive, nil
+}
@@ -0,0 +1,58 @@
+package reconcile_test
+
+import (
+	"testing"
+
+	"selfchain/x/migration/client/reconcile"
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadStore(t *testing.T) {
+	// Same database as the one read from the --data-dir of a node
+	dataDir := t.TempDir()
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, dataDir)
+	require.NoError(t, err)
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	stored := migration("0xaaa", 0, "1000")
+	stored.MsgHash = (&types.MsgMigrate{TxHash: "0xaaa"}).Hash()
+	value, err := stored.Marshal()
+	require.NoError(t, err)
+
+	archivedHash := (&types.MsgMigrate{TxHash: "0xbbb"}).Hash()
+	summary := types.NewMigrationArchive(1, types.EthereumChainId, 0)
+	summary.Count = 1
+	summaryValue, err := summary.Marshal()
+	require.NoError(t, err)
+
+	kvStore := ms.GetKVStore(storeKey)
+	migrationStore := prefix.NewStore(kvStore, types.KeyPrefix(types.TokenMigrationKeyPrefix))
+	migrationStore.Set(types.TokenMigrationKey(types.MsgHashBytes(stored.MsgHash)), value)
+	migrationStore.Set(types.TokenMigrationKey(types.MsgHashBytes(archivedHash)), []byte{})
+	archiveStore := prefix.NewStore(kvStore, types.KeyPrefix(types.MigrationArchiveKeyPrefix))
+	archiveStore.Set(types.MigrationArchiveKey(summary.Token, summary.SourceChainId, summary.Bucket), summaryValue)
+	ms.Commit()
+	require.NoError(t, db.Close())
+
+	db, err = dbm.NewDB("application", dbm.GoLevelDBBackend, dataDir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrations, archive, err := reconcile.ReadStore(db)
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenMigration{stored}, migrations)
+	require.Equal(t, []string{archivedHash}, archive.Hashes)
+	require.Equal(t, []types.MigrationArchive{summary}, archive.Summaries)
+}
@@ -25,7 +25,81 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if genState.Config != nil {
 		k.SetConfig(ctx, *genState.Config)
 	}
+	// Set all the tokenSunset
+	for _, elem := range genState.TokenSunsetList {
+		k.SetTokenSunset(ctx, elem)
+	}
+	// Set all the sourceChain
+	for _, elem := range genState.SourceChainList {
+		k.SetSourceChain(ctx, elem)
+	}
+	// Set all the sourceChainStats
+	for _, elem := range genState.SourceChainStatsList {
+		k.SetSourceChainStats(ctx, elem)
+	}
+	// Set all the migrationFee
+	for _, elem := range genState.MigrationFeeList {
+		k.SetMigrationFee(ctx, elem)
+	}
+	// Set all the lockupTier
+	for _, elem := range genState.LockupTierList {
+		k.SetLockupTier(ctx, elem)
+	}
+	// Set all the ratioSchedule
+	for _, elem := range genState.RatioScheduleList {
+		k.SetRatioSchedule(ctx, elem)
+	}
+	// Set all the withdrawal, the open ones waiting to be refunded if never executed
+	for _, elem := range genState.WithdrawalList {
+		k.SetWithdrawal(ctx, elem)
+		if elem.IsOpen() {
+			k.InsertWithdrawalQueue(ctx, elem)
+		}
+	}
 
+	// Set withdrawal count
+	k.SetWithdrawalCount(ctx, genState.WithdrawalCount)
+	// Set if defined
+	if genState.WithdrawalConfig != nil {
+		k.SetWithdrawalConfig(ctx, *genState.WithdrawalConfig)
+	}
+
+	// Set all the migrationArchive
+	for _, elem := range genState.MigrationArchiveList {
+		k.SetMigrationArchive(ctx, elem)
+	}
+	// Keep the keys of the archived migrations so that they can't be replayed
+	for _, elem := range genState.ArchivedMsgHashList {
+		k.SetArchivedMigration(ctx, elem)
+	}
+	// Set if defined
+	if genState.MigrationTotals != nil {
+		k.SetMigrationTotals(ctx, *genState.MigrationTotals)
+	}
+	// Set all the tokenStats
+	for _, elem := range genState.TokenStatsList {
+		k.SetTokenStats(ctx, elem)
+	}
+	// Set all the migratorStats
+	for _, elem := range genState.MigratorStatsList {
+		k.SetMigratorStats(ctx, elem)
+	}
+	// Set all the dailyStats
+	for _, elem := range genState.DailyStatsList {
+		k.SetDailyStats(ctx, elem)
+	}
+	// Set all the migrationDestination
+	for _, elem := range genState.MigrationDestinationList {
+		k.SetMigrationDestination(ctx, elem)
+	}
+	// Set if defined
+	if genState.FeeWaiverConfig != nil {
+		k.SetFeeWaiverConfig(ctx, *genState.FeeWaiverConfig)
+	}
+	// Set if defined
+	if genState.FeeAllowanceConfig != nil {
+		k.SetFeeAllowanceConfig(ctx, *genState.FeeAllowanceConfig)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -47,6 +121,40 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if found {
 		genesis.Config = &config
 	}
+	genesis.TokenSunsetList = k.GetAllTokenSunset(ctx)
+	genesis.SourceChainList = k.GetAllSourceChain(ctx)
+	genesis.SourceChainStatsList = k.GetAllSourceChainStats(ctx)
+	genesis.MigrationFeeList = k.GetAllMigrationFee(ctx)
+	genesis.LockupTierList = k.GetAllLockupTier(ctx)
+	genesis.RatioScheduleList = k.GetAllRatioSchedule(ctx)
+	genesis.WithdrawalList = k.GetAllWithdrawal(ctx)
+	genesis.WithdrawalCount = k.GetWithdrawalCount(ctx)
+	// Get all withdrawalConfig
+	withdrawalConfig, found := k.GetWithdrawalConfig(ctx)
+	if found {
+		genesis.WithdrawalConfig = &withdrawalConfig
+	}
+	genesis.MigrationArchiveList = k.GetAllMigrationArchive(ctx)
+	genesis.ArchivedMsgHashList = k.GetAllArchivedMigration(ctx)
+	// Get all migrationTotals
+	migrationTotals, found := k.GetMigrationTotals(ctx)
+	if found {
+		genesis.MigrationTotals = &migrationTotals
+	}
+	genesis.TokenStatsList = k.GetAllTokenStats(ctx)
+	genesis.MigratorStatsList = k.GetAllMigratorStats(ctx)
+	genesis.DailyStatsList = k.GetAllDailyStats(ctx)
+	genesis.MigrationDestinationList = k.GetAllMigrationDestination(ctx)
+	// Get all feeWaiverConfig
+	feeWaiverConfig, found := k.GetFeeWaiverConfig(ctx)
+	if found {
+		genesis.FeeWaiverConfig = &feeWaiverConfig
+	}
+	// Get all feeAllowanceConfig
+	feeAllowanceConfig, found := k.GetFeeAllowanceConfig(ctx)
+	if found {
+		genesis.FeeAllowanceConfig = &feeAllowanceConfig
+	}
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -39,6 +39,127 @@ func TestGenesis(t *testing.T) {
 			VestingCliff:       79,
 			MinMigrationAmount: 70,
 		},
+		TokenSunsetList: []types.TokenSunset{
+			{
+				Token: 0,
+			},
+			{
+				Token: 1,
+			},
+		},
+		SourceChainList: []types.SourceChain{
+			{
+				ChainId: 1,
+			},
+			{
+				ChainId: 56,
+			},
+		},
+		SourceChainStatsList: []types.SourceChainStats{
+			{
+				ChainId: 1,
+			},
+			{
+				ChainId: 56,
+			},
+		},
+		MigrationFeeList: []types.MigrationFee{
+			{
+				Token: 0,
+			},
+			{
+				Token: 1,
+			},
+		},
+		LockupTierList: []types.LockupTier{
+			{
+				Tier: 1,
+			},
+			{
+				Tier: 2,
+			},
+		},
+		RatioScheduleList: []types.RatioSchedule{
+			{
+				Token:  0,
+				Points: []types.RatioPoint{{Time: 0, Ratio: 100}},
+			},
+			{
+				Token:  1,
+				Points: []types.RatioPoint{{Time: 0, Ratio: 100}},
+			},
+		},
+		WithdrawalList: []types.Withdrawal{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		WithdrawalCount: 2,
+		WithdrawalConfig: &types.WithdrawalConfig{
+			SignatureThreshold: 2,
+			Expiry:             86400,
+			RefundDelay:        3600,
+		},
+		MigrationArchiveList: []types.MigrationArchive{
+			{
+				Token:  0,
+				Bucket: 0,
+			},
+			{
+				Token:  1,
+				Bucket: 3,
+			},
+		},
+		ArchivedMsgHashList: []string{
+			"2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+		},
+		MigrationTotals: &types.MigrationTotals{
+			MigrationCount:     2,
+			UniqueDestinations: 1,
+		},
+		TokenStatsList: []types.TokenStats{
+			{
+				Token: 0,
+			},
+			{
+				Token: 1,
+			},
+		},
+		MigratorStatsList: []types.MigratorStats{
+			{
+				Migrator: "0",
+			},
+			{
+				Migrator: "1",
+			},
+		},
+		DailyStatsList: []types.DailyStats{
+			{
+				Day: 0,
+			},
+			{
+				Day: 1,
+			},
+		},
+		MigrationDestinationList: []types.MigrationDestination{
+			{
+				Address: "0",
+			},
+			{
+				Address: "1",
+			},
+		},
+		FeeWaiverConfig: &types.FeeWaiverConfig{
+			BlockGasBudget:   500000,
+			MigratorGasQuota: 300000,
+		},
+		FeeAllowanceConfig: &types.FeeAllowanceConfig{
+			SpendLimit: "500000",
+			Expiration: 2592000,
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -54,5 +175,23 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Acl, got.Acl)
 	require.ElementsMatch(t, genesisState.MigratorList, got.MigratorList)
 	require.Equal(t, genesisState.Config, got.Config)
+	require.ElementsMatch(t, genesisState.TokenSunsetList, got.TokenSunsetList)
+	require.ElementsMatch(t, genesisState.SourceChainList, got.SourceChainList)
+	require.ElementsMatch(t, genesisState.SourceChainStatsList, got.SourceChainStatsList)
+	require.ElementsMatch(t, genesisState.MigrationFeeList, got.MigrationFeeList)
+	require.ElementsMatch(t, genesisState.LockupTierList, got.LockupTierList)
+	require.ElementsMatch(t, genesisState.RatioScheduleList, got.RatioScheduleList)
+	require.ElementsMatch(t, genesisState.WithdrawalList, got.WithdrawalList)
+	require.Equal(t, genesisState.WithdrawalCount, got.WithdrawalCount)
+	require.Equal(t, genesisState.WithdrawalConfig, got.WithdrawalConfig)
+	require.ElementsMatch(t, genesisState.MigrationArchiveList, got.MigrationArchiveList)
+	require.ElementsMatch(t, genesisState.ArchivedMsgHashList, got.ArchivedMsgHashList)
+	require.Equal(t, genesisState.MigrationTotals, got.MigrationTotals)
+	require.ElementsMatch(t, genesisState.TokenStatsList, got.TokenStatsList)
+	require.ElementsMatch(t, genesisState.MigratorStatsList, got.MigratorStatsList)
+	require.ElementsMatch(t, genesisState.DailyStatsList, got.DailyStatsList)
+	require.ElementsMatch(t, genesisState.MigrationDestinationList, got.MigrationDestinationList)
+	require.Equal(t, genesisState.FeeWaiverConfig, got.FeeWaiverConfig)
+	require.Equal(t, genesisState.FeeAllowanceConfig, got.FeeAllowanceConfig)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// releaseMigration sends the instantly released coins of a migration to its contract along with the callback
+// message, in which case the contract is recorded as the recipient of the migration. If there is no contract
+// or if it fails, the coins are sent to the destination address instead.
+//
+// Migrations that vest are rejected before getting here when they name a contract, so the coins sent with
+// the callback are always the whole migrated amount.
+func (k Keeper) releaseMigration(ctx sdk.Context, msg *types.MsgMigrate, tokenMigration *types.TokenMigration, coins sdk.Coins) error {
+	if msg.ContractAddress != "" {
+		err := k.executeCallback(ctx, msg.ContractAddress, []byte(msg.CallbackMsg), coins)
+		if err == nil {
+			tokenMigration.ContractAddress = msg.ContractAddress
+			return nil
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeContractCallbackFailed,
+			sdk.NewAttribute(types.AttributeKeyMsgHash, tokenMigration.MsgHash),
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDestAddress, msg.DestAddress),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		))
+	}
+
+	// We don't need to check the validatity of the address since it's been done in the Msg::ValidateBasic method
+	destAddr, _ := sdk.AccAddressFromBech32(msg.DestAddress)
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, selfvestingTypes.ModuleName, destAddr, coins)
+}
+
+// executeCallback executes the callback message on the contract, sending it the coins from the migration
+// module account. Nothing the contract did is kept if it fails or runs out of its gas allowance.
+func (k Keeper) executeCallback(ctx sdk.Context, contract string, callbackMsg []byte, coins sdk.Coins) (err error) {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+
+	gasMeter := storetypes.NewGasMeter(types.ContractCallbackGasLimit)
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "migration contract callback")
+
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(types.ErrContractCallbackFailed, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(cacheCtx, selfvestingTypes.ModuleName, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if _, err := k.wasmKeeper.Execute(cacheCtx, contractAddr, authtypes.NewModuleAddress(types.ModuleName), callbackMsg, coins); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
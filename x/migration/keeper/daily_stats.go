@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetDailyStats set a specific dailyStats in the store from its index
+func (k Keeper) SetDailyStats(ctx sdk.Context, dailyStats types.DailyStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DailyStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&dailyStats)
+	store.Set(types.DailyStatsKey(
+		dailyStats.Day,
+	), b)
+}
+
+// GetDailyStats returns a dailyStats from its index
+func (k Keeper) GetDailyStats(
+	ctx sdk.Context,
+	day uint64,
+
+) (val types.DailyStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DailyStatsKeyPrefix))
+
+	b := store.Get(types.DailyStatsKey(
+		day,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDailyStats returns all dailyStats
+func (k Keeper) GetAllDailyStats(ctx sdk.Context) (list []types.DailyStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DailyStatsKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DailyStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
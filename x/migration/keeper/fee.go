@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// collectFee moves the fee, which has been minted to the selfvesting module together with the migrated
+// tokens, to the migration module and from there to the configured recipient
+func (k Keeper) collectFee(ctx sdk.Context, migrationFee types.MigrationFee, fee sdkmath.Uint) error {
+	coins := uslfCoins(fee)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, selfvestingTypes.ModuleName, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	switch migrationFee.Recipient {
+	case types.FeeRecipient_FEE_RECIPIENT_TREASURY:
+		treasury, err := sdk.AccAddressFromBech32(migrationFee.Treasury)
+		if err != nil {
+			return err
+		}
+
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasury, coins)
+	default:
+		return k.distrKeeper.FundCommunityPool(ctx, coins, authtypes.NewModuleAddress(types.ModuleName))
+	}
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"time"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetFeeAllowanceConfig set feeAllowanceConfig in the store
+func (k Keeper) SetFeeAllowanceConfig(ctx sdk.Context, feeAllowanceConfig types.FeeAllowanceConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeAllowanceConfigKey))
+	b := k.cdc.MustMarshal(&feeAllowanceConfig)
+	store.Set([]byte{0}, b)
+}
+
+// GetFeeAllowanceConfig returns feeAllowanceConfig
+func (k Keeper) GetFeeAllowanceConfig(ctx sdk.Context) (val types.FeeAllowanceConfig, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeAllowanceConfigKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// grantFeeAllowance has the selfvesting module grant the recipient of a migration an allowance for the
+// fees of releasing its vesting position. It returns false if the allowance is disabled, in which case
+// the recipient is expected to receive the instantly released amount instead.
+func (k Keeper) grantFeeAllowance(ctx sdk.Context, tokenMigration *types.TokenMigration) (bool, error) {
+	config, found := k.GetFeeAllowanceConfig(ctx)
+	if !found || !config.Enabled() {
+		return false, nil
+	}
+
+	spendLimit := config.SpendLimitAmount()
+	expiration := ctx.BlockTime().Add(time.Duration(config.Expiration) * time.Second)
+	if err := k.selfvestingKeeper.GrantFeeAllowance(ctx, tokenMigration.DestAddress, uslfCoins(spendLimit), expiration); err != nil {
+		return false, err
+	}
+
+	tokenMigration.FeeAllowance = spendLimit.String()
+	return true, nil
+}
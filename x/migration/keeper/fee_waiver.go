@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"math"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetFeeWaiverConfig set feeWaiverConfig in the store
+func (k Keeper) SetFeeWaiverConfig(ctx sdk.Context, feeWaiverConfig types.FeeWaiverConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeWaiverConfigKey))
+	b := k.cdc.MustMarshal(&feeWaiverConfig)
+	store.Set([]byte{0}, b)
+}
+
+// GetFeeWaiverConfig returns feeWaiverConfig
+func (k Keeper) GetFeeWaiverConfig(ctx sdk.Context) (val types.FeeWaiverConfig, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeWaiverConfigKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetFeeWaiverUsage returns the gas of the fee-free transactions of the last block that had some
+func (k Keeper) GetFeeWaiverUsage(ctx sdk.Context) (val types.FeeWaiverUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeWaiverUsageKey))
+
+	b := store.Get([]byte{0})
+	if b != nil {
+		k.cdc.MustUnmarshal(b, &val)
+	}
+
+	return val
+}
+
+// GetMigratorFeeWaiverUsage returns the gas of the fee-free transactions of a migrator in the last block
+// it sent some
+func (k Keeper) GetMigratorFeeWaiverUsage(ctx sdk.Context, migrator string) (val types.FeeWaiverUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigratorFeeWaiverUsageKeyPrefix))
+
+	b := store.Get(types.MigratorKey(migrator))
+	if b != nil {
+		k.cdc.MustUnmarshal(b, &val)
+	}
+
+	return val
+}
+
+// WaiveFee tells whether a transaction of the given gas sent by a migrator can be fee-free and, if so,
+// counts its gas against the budget of the block and the quota of the migrator. The fee is waived before the
+// transaction runs, so it is its gas limit that is counted rather than the gas it ends up using: migrators
+// should estimate their gas closely since any unused gas is still taken out of their quota.
+func (k Keeper) WaiveFee(ctx sdk.Context, migrator string, gas uint64) bool {
+	config, found := k.GetFeeWaiverConfig(ctx)
+	if !found || !config.Enabled() {
+		return false
+	}
+
+	if _, isMigrator := k.GetMigrator(ctx, migrator); !isMigrator {
+		return false
+	}
+
+	height := ctx.BlockHeight()
+	blockGasUsed := k.GetFeeWaiverUsage(ctx).GasUsedAt(height)
+	migratorGasUsed := k.GetMigratorFeeWaiverUsage(ctx, migrator).GasUsedAt(height)
+	// The config can be lowered below what has already been used in the block, so the gas is added to the
+	// usage rather than compared to what is left
+	if gas > math.MaxUint64-blockGasUsed || gas > math.MaxUint64-migratorGasUsed {
+		return false
+	}
+	if blockGasUsed+gas > config.BlockGasBudget || migratorGasUsed+gas > config.MigratorGasQuota {
+		return false
+	}
+
+	// The usage of a block replaces the one of the previous blocks so the store doesn't grow
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeWaiverUsageKey))
+	store.Set([]byte{0}, k.cdc.MustMarshal(&types.FeeWaiverUsage{Height: height, GasUsed: blockGasUsed + gas}))
+
+	store = prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigratorFeeWaiverUsageKeyPrefix))
+	store.Set(types.MigratorKey(migrator), k.cdc.MustMarshal(&types.FeeWaiverUsage{Height: height, GasUsed: migratorGasUsed + gas}))
+
+	return true
+}
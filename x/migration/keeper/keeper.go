@@ -21,6 +21,11 @@ type (
 
 		selfvestingKeeper types.SelfvestingKeeper
 		bankKeeper        types.BankKeeper
+		distrKeeper       types.DistrKeeper
+		wasmKeeper        types.WasmKeeper
+
+		// the address capable of executing governance gated messages, typically the x/gov module account
+		authority string
 	}
 )
 
@@ -32,6 +37,9 @@ func NewKeeper(
 
 	selfvestingKeeper types.SelfvestingKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	wasmKeeper types.WasmKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -46,9 +54,18 @@ func NewKeeper(
 
 		selfvestingKeeper: selfvestingKeeper,
 		bankKeeper:        bankKeeper,
+		distrKeeper:       distrKeeper,
+		wasmKeeper:        wasmKeeper,
+
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
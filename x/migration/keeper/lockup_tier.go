@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetLockupTier set a specific lockupTier in the store from its index
+func (k Keeper) SetLockupTier(ctx sdk.Context, lockupTier types.LockupTier) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LockupTierKeyPrefix))
+	b := k.cdc.MustMarshal(&lockupTier)
+	store.Set(types.LockupTierKey(
+		lockupTier.Tier,
+	), b)
+}
+
+// GetLockupTier returns a lockupTier from its index
+func (k Keeper) GetLockupTier(
+	ctx sdk.Context,
+	tier uint64,
+
+) (val types.LockupTier, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LockupTierKeyPrefix))
+
+	b := store.Get(types.LockupTierKey(
+		tier,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveLockupTier removes a lockupTier from the store
+func (k Keeper) RemoveLockupTier(
+	ctx sdk.Context,
+	tier uint64,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LockupTierKeyPrefix))
+	store.Delete(types.LockupTierKey(
+		tier,
+	))
+}
+
+// GetAllLockupTier returns all lockupTier
+func (k Keeper) GetAllLockupTier(ctx sdk.Context) (list []types.LockupTier) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LockupTierKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LockupTier
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// resolveLockupTier returns the tier a migration opted into. The default tier always exists
+func (k Keeper) resolveLockupTier(ctx sdk.Context, tier uint64) (types.LockupTier, error) {
+	if tier == types.DefaultLockupTier {
+		return types.NewDefaultLockupTier(), nil
+	}
+
+	lockupTier, found := k.GetLockupTier(ctx, tier)
+	if !found {
+		return types.LockupTier{}, types.ErrUnknownLockupTier
+	}
+
+	return lockupTier, nil
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/testutil/nullify"
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNLockupTier(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.LockupTier {
+	items := make([]types.LockupTier, n)
+	for i := range items {
+		items[i].Tier = uint64(i + 1)
+		items[i].DurationMultiplier = 100 + uint64(i)*50
+		items[i].BonusRatio = 100 + uint64(i)*5
+
+		keeper.SetLockupTier(ctx, items[i])
+	}
+	return items
+}
+
+func TestLockupTierGet(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNLockupTier(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetLockupTier(ctx,
+			item.Tier,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestLockupTierRemove(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNLockupTier(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveLockupTier(ctx,
+			item.Tier,
+		)
+		_, found := keeper.GetLockupTier(ctx,
+			item.Tier,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestLockupTierGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNLockupTier(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllLockupTier(ctx)),
+	)
+}
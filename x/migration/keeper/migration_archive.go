@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetMigrationArchive set a specific migrationArchive in the store from its index
+func (k Keeper) SetMigrationArchive(ctx sdk.Context, migrationArchive types.MigrationArchive) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationArchiveKeyPrefix))
+	b := k.cdc.MustMarshal(&migrationArchive)
+	store.Set(types.MigrationArchiveKey(
+		migrationArchive.Token,
+		migrationArchive.SourceChainId,
+		migrationArchive.Bucket,
+	), b)
+}
+
+// GetMigrationArchive returns a migrationArchive from its index
+func (k Keeper) GetMigrationArchive(
+	ctx sdk.Context,
+	token uint64,
+	sourceChainId uint64,
+	bucket uint64,
+
+) (val types.MigrationArchive, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationArchiveKeyPrefix))
+
+	b := store.Get(types.MigrationArchiveKey(
+		token,
+		sourceChainId,
+		bucket,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllMigrationArchive returns all migrationArchive
+func (k Keeper) GetAllMigrationArchive(ctx sdk.Context) (list []types.MigrationArchive) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationArchiveKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MigrationArchive
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ArchiveMigrations replaces the migrations of a token deposited on a source chain in the buckets ending
+// before the given block of that chain with a summary per bucket. Their keys are kept so that they can't be
+// replayed. At most limit migrations are archived, done tells whether some are left.
+func (k Keeper) ArchiveMigrations(ctx sdk.Context, token uint64, sourceChainId uint64, beforeBlock uint64, limit int) (archived uint64, done bool) {
+	bucketStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenMigrationBucketKeyPrefix))
+	iterator := bucketStore.Iterator(
+		types.TokenMigrationBucketKey(token, sourceChainId, 0),
+		types.TokenMigrationBucketKey(token, sourceChainId, types.MigrationBucket(beforeBlock)),
+	)
+
+	var keys [][]byte
+	done = true
+	for ; iterator.Valid(); iterator.Next() {
+		if len(keys) == limit {
+			done = false
+			break
+		}
+
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	var migrations []types.TokenMigration
+	for _, key := range keys {
+		migration, found := k.GetTokenMigration(ctx, types.MsgHashFromTokenMigrationKey(key))
+		if !found {
+			continue
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	archives := make(map[uint64]types.MigrationArchive)
+	var buckets []uint64
+	for _, migration := range migrations {
+		bucket := types.MigrationBucket(migration.SourceBlock)
+		archive, found := archives[bucket]
+		if !found {
+			if archive, found = k.GetMigrationArchive(ctx, token, sourceChainId, bucket); !found {
+				archive = types.NewMigrationArchive(token, sourceChainId, bucket)
+			}
+			buckets = append(buckets, bucket)
+		}
+
+		archive.Add(migration)
+		archives[bucket] = archive
+
+		k.SetArchivedMigration(ctx, migration.MsgHash)
+	}
+
+	for _, key := range keys {
+		bucketStore.Delete(key)
+	}
+
+	for _, bucket := range buckets {
+		k.SetMigrationArchive(ctx, archives[bucket])
+	}
+
+	return uint64(len(migrations)), done
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/testutil/nullify"
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNMigrationArchive(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.MigrationArchive {
+	items := make([]types.MigrationArchive, n)
+	for i := range items {
+		items[i] = types.NewMigrationArchive(uint64(i%2), types.EthereumChainId, uint64(i))
+
+		keeper.SetMigrationArchive(ctx, items[i])
+	}
+	return items
+}
+
+func TestMigrationArchiveGet(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNMigrationArchive(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetMigrationArchive(ctx,
+			item.Token,
+			item.SourceChainId,
+			item.Bucket,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestMigrationArchiveGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNMigrationArchive(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllMigrationArchive(ctx)),
+	)
+}
+
+// migrationAt returns a migration of 1 FRONT deposited at the given source block
+func migrationAt(token uint64, sourceBlock uint64, reverted bool) types.TokenMigration {
+	msg := types.MsgMigrate{
+		TxHash:     strconv.FormatUint(sourceBlock, 16),
+		EthAddress: "baf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+		Amount:     "1000000000000000000",
+		Token:      token,
+	}
+
+	return types.TokenMigration{
+		MsgHash:      msg.Hash(),
+		Processed:    true,
+		TxHash:       msg.TxHash,
+		Amount:       msg.Amount,
+		Token:        token,
+		MintedAmount: "1000000",
+		Reverted:     reverted,
+		SourceBlock:  sourceBlock,
+	}
+}
+
+func TestArchiveMigrations(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+
+	bucketSize := types.MigrationBucketSize
+	old := []types.TokenMigration{
+		migrationAt(0, 10, false),
+		migrationAt(0, 20, true),
+		migrationAt(0, bucketSize+1, false),
+	}
+	// Block numbers of other chains aren't comparable so their migrations are left untouched
+	otherChain := migrationAt(0, 11, false)
+	otherChain.SourceChainId = 56
+
+	recent := []types.TokenMigration{
+		migrationAt(0, 2*bucketSize, false),
+		migrationAt(1, 10, false),
+		otherChain,
+	}
+	for _, migration := range append(old, recent...) {
+		keeper.SetTokenMigration(ctx, migration)
+	}
+
+	archived, done := keeper.ArchiveMigrations(ctx, 0, types.EthereumChainId, 2*bucketSize+5, types.MaxArchivedMigrationsPerMsg)
+	require.Equal(t, uint64(3), archived)
+	require.True(t, done)
+
+	for _, migration := range old {
+		_, found := keeper.GetTokenMigration(ctx, migration.MsgHash)
+		require.False(t, found)
+		require.True(t, keeper.IsMigrationProcessed(ctx, migration.MsgHash))
+	}
+	require.ElementsMatch(t, recent, keeper.GetAllTokenMigration(ctx))
+
+	first, found := keeper.GetMigrationArchive(ctx, 0, types.EthereumChainId, 0)
+	require.True(t, found)
+	require.Equal(t, types.MigrationArchive{
+		Token:         0,
+		SourceChainId: types.EthereumChainId,
+		Bucket:        0,
+		FromBlock:     0,
+		ToBlock:       bucketSize - 1,
+		Count:         2,
+		RevertedCount: 1,
+		Amount:        "1000000000000000000",
+		MintedAmount:  "1000000",
+	}, first)
+
+	second, found := keeper.GetMigrationArchive(ctx, 0, types.EthereumChainId, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), second.Count)
+
+	_, found = keeper.GetMigrationArchive(ctx, 0, types.EthereumChainId, 2)
+	require.False(t, found)
+
+	_, found = keeper.GetMigrationArchive(ctx, 0, 56, 0)
+	require.False(t, found)
+
+	require.ElementsMatch(t, []string{old[0].MsgHash, old[1].MsgHash, old[2].MsgHash}, keeper.GetAllArchivedMigration(ctx))
+}
+
+func TestArchiveMigrationsShouldStopAtLimit(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+
+	for block := uint64(1); block <= 3; block++ {
+		keeper.SetTokenMigration(ctx, migrationAt(0, block, false))
+	}
+
+	archived, done := keeper.ArchiveMigrations(ctx, 0, types.EthereumChainId, types.MigrationBucketSize, 2)
+	require.Equal(t, uint64(2), archived)
+	require.False(t, done)
+
+	archived, done = keeper.ArchiveMigrations(ctx, 0, types.EthereumChainId, types.MigrationBucketSize, 2)
+	require.Equal(t, uint64(1), archived)
+	require.True(t, done)
+
+	archive, found := keeper.GetMigrationArchive(ctx, 0, types.EthereumChainId, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(3), archive.Count)
+	require.Equal(t, "3000000", archive.MintedAmount)
+}
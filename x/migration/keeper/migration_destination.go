@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetMigrationDestination set a specific migrationDestination in the store from its index
+func (k Keeper) SetMigrationDestination(ctx sdk.Context, migrationDestination types.MigrationDestination) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationDestinationKeyPrefix))
+	b := k.cdc.MustMarshal(&migrationDestination)
+	store.Set(types.MigrationDestinationKey(
+		migrationDestination.Address,
+	), b)
+}
+
+// GetMigrationDestination returns a migrationDestination from its index
+func (k Keeper) GetMigrationDestination(
+	ctx sdk.Context,
+	address string,
+
+) (val types.MigrationDestination, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationDestinationKeyPrefix))
+
+	b := store.Get(types.MigrationDestinationKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllMigrationDestination returns all migrationDestination
+func (k Keeper) GetAllMigrationDestination(ctx sdk.Context) (list []types.MigrationDestination) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationDestinationKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MigrationDestination
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
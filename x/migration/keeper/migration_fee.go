@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetMigrationFee set a specific migrationFee in the store from its index
+func (k Keeper) SetMigrationFee(ctx sdk.Context, migrationFee types.MigrationFee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationFeeKeyPrefix))
+	b := k.cdc.MustMarshal(&migrationFee)
+	store.Set(types.MigrationFeeKey(
+		migrationFee.Token,
+	), b)
+}
+
+// GetMigrationFee returns a migrationFee from its index
+func (k Keeper) GetMigrationFee(
+	ctx sdk.Context,
+	token uint64,
+
+) (val types.MigrationFee, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationFeeKeyPrefix))
+
+	b := store.Get(types.MigrationFeeKey(
+		token,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllMigrationFee returns all migrationFee
+func (k Keeper) GetAllMigrationFee(ctx sdk.Context) (list []types.MigrationFee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationFeeKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MigrationFee
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetMigrationTotals set migrationTotals in the store
+func (k Keeper) SetMigrationTotals(ctx sdk.Context, migrationTotals types.MigrationTotals) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationTotalsKey))
+	b := k.cdc.MustMarshal(&migrationTotals)
+	store.Set([]byte{0}, b)
+}
+
+// GetMigrationTotals returns migrationTotals
+func (k Keeper) GetMigrationTotals(ctx sdk.Context) (val types.MigrationTotals, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigrationTotalsKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// recordMigrationTotals adds a processed migration to the running totals, including the ones of the day
+func (k Keeper) recordMigrationTotals(ctx sdk.Context, migration types.TokenMigration) {
+	k.addToTotals(ctx, migration)
+
+	day := types.DayOf(ctx.BlockTime())
+	dailyStats, _ := k.GetDailyStats(ctx, day)
+	dailyStats.Day = day
+	dailyStats.Totals.Add(migration, false)
+	k.SetDailyStats(ctx, dailyStats)
+}
+
+// recordRevertTotals takes a reverted migration out of the running totals. The revert is counted on the
+// day it happens.
+func (k Keeper) recordRevertTotals(ctx sdk.Context, migration types.TokenMigration) {
+	k.revertFromTotals(ctx, migration)
+
+	day := types.DayOf(ctx.BlockTime())
+	dailyStats, _ := k.GetDailyStats(ctx, day)
+	dailyStats.Day = day
+	dailyStats.Totals.RevertedCount++
+	k.SetDailyStats(ctx, dailyStats)
+}
+
+// addToTotals adds a migration to the overall totals and to the ones of its token, source chain and migrator
+func (k Keeper) addToTotals(ctx sdk.Context, migration types.TokenMigration) {
+	chainId := sourceChainId(migration)
+	destination, found := k.GetMigrationDestination(ctx, migration.DestAddress)
+	newToken := !destination.HasToken(migration.Token)
+	newChain := !destination.HasChain(chainId)
+	newMigrator := migration.Migrator != "" && !destination.HasMigrator(migration.Migrator)
+	if newToken || newChain || newMigrator {
+		destination.Address = migration.DestAddress
+		if newToken {
+			destination.Tokens = append(destination.Tokens, migration.Token)
+		}
+		if newChain {
+			destination.Chains = append(destination.Chains, chainId)
+		}
+		if newMigrator {
+			destination.Migrators = append(destination.Migrators, migration.Migrator)
+		}
+		k.SetMigrationDestination(ctx, destination)
+	}
+
+	totals, _ := k.GetMigrationTotals(ctx)
+	totals.Add(migration, !found)
+	k.SetMigrationTotals(ctx, totals)
+
+	tokenStats, _ := k.GetTokenStats(ctx, migration.Token)
+	tokenStats.Token = migration.Token
+	tokenStats.Totals.Add(migration, newToken)
+	k.SetTokenStats(ctx, tokenStats)
+
+	chainStats, _ := k.GetSourceChainStats(ctx, chainId)
+	chainStats.ChainId = chainId
+	chainStats.Totals.Add(migration, newChain)
+	k.SetSourceChainStats(ctx, chainStats)
+
+	// Migrations processed before the migrator was recorded only count in the other totals
+	if migration.Migrator != "" {
+		migratorStats, _ := k.GetMigratorStats(ctx, migration.Migrator)
+		migratorStats.Migrator = migration.Migrator
+		migratorStats.Totals.Add(migration, newMigrator)
+		k.SetMigratorStats(ctx, migratorStats)
+	}
+}
+
+// revertFromTotals takes a migration out of the overall totals and of the ones of its token, source chain
+// and migrator
+func (k Keeper) revertFromTotals(ctx sdk.Context, migration types.TokenMigration) {
+	totals, _ := k.GetMigrationTotals(ctx)
+	totals.Revert(migration)
+	k.SetMigrationTotals(ctx, totals)
+
+	tokenStats, _ := k.GetTokenStats(ctx, migration.Token)
+	tokenStats.Token = migration.Token
+	tokenStats.Totals.Revert(migration)
+	k.SetTokenStats(ctx, tokenStats)
+
+	chainStats, _ := k.GetSourceChainStats(ctx, sourceChainId(migration))
+	chainStats.ChainId = sourceChainId(migration)
+	chainStats.Totals.Revert(migration)
+	k.SetSourceChainStats(ctx, chainStats)
+
+	if migration.Migrator != "" {
+		migratorStats, _ := k.GetMigratorStats(ctx, migration.Migrator)
+		migratorStats.Migrator = migration.Migrator
+		migratorStats.Totals.Revert(migration)
+		k.SetMigratorStats(ctx, migratorStats)
+	}
+}
+
+// recordWithdrawalTotals takes the uslf burnt by a withdrawal out of the overall totals and of the ones of
+// its token and source chain. Migrator and daily totals only count migrations.
+func (k Keeper) recordWithdrawalTotals(ctx sdk.Context, withdrawal types.Withdrawal) {
+	k.updateWithdrawalTotals(ctx, withdrawal, (*types.MigrationTotals).Withdraw)
+}
+
+// recordRefundTotals puts the uslf of a refunded withdrawal back into the totals
+func (k Keeper) recordRefundTotals(ctx sdk.Context, withdrawal types.Withdrawal) {
+	k.updateWithdrawalTotals(ctx, withdrawal, (*types.MigrationTotals).RefundWithdrawal)
+}
+
+func (k Keeper) updateWithdrawalTotals(ctx sdk.Context, withdrawal types.Withdrawal, update func(*types.MigrationTotals, string)) {
+	totals, _ := k.GetMigrationTotals(ctx)
+	update(&totals, withdrawal.Amount)
+	k.SetMigrationTotals(ctx, totals)
+
+	tokenStats, _ := k.GetTokenStats(ctx, withdrawal.Token)
+	tokenStats.Token = withdrawal.Token
+	update(&tokenStats.Totals, withdrawal.Amount)
+	k.SetTokenStats(ctx, tokenStats)
+
+	chainStats, _ := k.GetSourceChainStats(ctx, withdrawal.SourceChainId)
+	chainStats.ChainId = withdrawal.SourceChainId
+	update(&chainStats.Totals, withdrawal.Amount)
+	k.SetSourceChainStats(ctx, chainStats)
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/testutil/nullify"
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNTokenStats(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.TokenStats {
+	items := make([]types.TokenStats, n)
+	for i := range items {
+		items[i].Token = uint64(i)
+		items[i].Totals.MigrationCount = uint64(i)
+
+		keeper.SetTokenStats(ctx, items[i])
+	}
+	return items
+}
+
+func createNMigratorStats(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.MigratorStats {
+	items := make([]types.MigratorStats, n)
+	for i := range items {
+		items[i].Migrator = strconv.Itoa(i)
+		items[i].Totals.MigrationCount = uint64(i)
+
+		keeper.SetMigratorStats(ctx, items[i])
+	}
+	return items
+}
+
+func createNDailyStats(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.DailyStats {
+	items := make([]types.DailyStats, n)
+	for i := range items {
+		items[i].Day = uint64(19000 + i)
+		items[i].Totals.MigrationCount = uint64(i)
+
+		keeper.SetDailyStats(ctx, items[i])
+	}
+	return items
+}
+
+func TestTokenStatsGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNTokenStats(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetTokenStats(ctx, item.Token)
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(&item), nullify.Fill(&rst))
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllTokenStats(ctx)),
+	)
+}
+
+func TestMigratorStatsGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNMigratorStats(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetMigratorStats(ctx, item.Migrator)
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(&item), nullify.Fill(&rst))
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllMigratorStats(ctx)),
+	)
+}
+
+func TestDailyStatsGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNDailyStats(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetDailyStats(ctx, item.Day)
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(&item), nullify.Fill(&rst))
+	}
+
+	// Days are kept in chronological order
+	require.Equal(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllDailyStats(ctx)),
+	)
+}
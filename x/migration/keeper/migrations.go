@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves the migrations stored under their hex hash to the compact layout keyed by their binary
+// hash and indexed by token, source chain and source block bucket. The running totals are rebuilt from the
+// moved migrations, which don't tell who submitted them nor when.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefix(types.LegacyTokenMigrationKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	var migrations []types.TokenMigration
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TokenMigration
+		if err := m.keeper.cdc.Unmarshal(iterator.Value(), &val); err != nil {
+			iterator.Close()
+			return err
+		}
+
+		keys = append(keys, iterator.Key())
+		migrations = append(migrations, val)
+	}
+	iterator.Close()
+
+	for i, migration := range migrations {
+		store.Delete(keys[i])
+		m.keeper.SetTokenMigration(ctx, migration)
+
+		m.keeper.addToTotals(ctx, migration)
+		if migration.Reverted {
+			m.keeper.revertFromTotals(ctx, migration)
+		}
+	}
+
+	return nil
+}
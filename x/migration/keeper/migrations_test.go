@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := keepertest.MigrationKeeper(t)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	raw := ctx.MultiStore().(*rootmulti.Store).GetStoreByName(types.StoreKey).(storetypes.KVStore)
+	legacyStore := prefix.NewStore(raw, types.KeyPrefix(types.LegacyTokenMigrationKeyPrefix))
+
+	migrations := []types.TokenMigration{
+		migrationAt(0, 10, false),
+		migrationAt(1, 20, true),
+		{MsgHash: "legacy", Processed: true},
+	}
+	for _, migration := range migrations {
+		legacyStore.Set(types.LegacyTokenMigrationKey(migration.MsgHash), cdc.MustMarshal(&migration))
+	}
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+	iterator := storetypes.KVStorePrefixIterator(legacyStore, []byte{})
+	require.False(t, iterator.Valid())
+	iterator.Close()
+
+	for _, migration := range migrations {
+		rst, found := k.GetTokenMigration(ctx, migration.MsgHash)
+		require.True(t, found)
+		require.Equal(t, migration, rst)
+		require.True(t, k.IsMigrationProcessed(ctx, migration.MsgHash))
+	}
+	require.ElementsMatch(t, migrations, k.GetAllTokenMigration(ctx))
+
+	// Totals are rebuilt from the moved migrations
+	totals, found := k.GetMigrationTotals(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(3), totals.MigrationCount)
+	require.Equal(t, uint64(1), totals.RevertedCount)
+	require.Equal(t, "1000000", totals.MintedAmount)
+
+	tokenStats, found := k.GetTokenStats(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), tokenStats.Totals.RevertedCount)
+	require.Equal(t, "0", tokenStats.Totals.MintedAmount)
+
+	chainStats, found := k.GetSourceChainStats(ctx, types.EthereumChainId)
+	require.True(t, found)
+	require.Equal(t, uint64(3), chainStats.Totals.MigrationCount)
+	require.Equal(t, uint64(1), chainStats.Totals.RevertedCount)
+	require.Equal(t, "1000000", chainStats.Totals.MintedAmount)
+
+	require.Empty(t, k.GetAllMigratorStats(ctx))
+	require.Empty(t, k.GetAllDailyStats(ctx))
+}
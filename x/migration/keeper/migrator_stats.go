@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetMigratorStats set a specific migratorStats in the store from its index
+func (k Keeper) SetMigratorStats(ctx sdk.Context, migratorStats types.MigratorStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigratorStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&migratorStats)
+	store.Set(types.MigratorStatsKey(
+		migratorStats.Migrator,
+	), b)
+}
+
+// GetMigratorStats returns a migratorStats from its index
+func (k Keeper) GetMigratorStats(
+	ctx sdk.Context,
+	migrator string,
+
+) (val types.MigratorStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigratorStatsKeyPrefix))
+
+	b := store.Get(types.MigratorStatsKey(
+		migrator,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllMigratorStats returns all migratorStats
+func (k Keeper) GetAllMigratorStats(ctx sdk.Context) (list []types.MigratorStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MigratorStatsKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MigratorStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ArchiveMigrations(goCtx context.Context, msg *types.MsgArchiveMigrations) (*types.MsgArchiveMigrationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	// Archived migrations can't be reverted anymore but can't be replayed either
+	archived, done := k.Keeper.ArchiveMigrations(ctx, msg.Token, msg.ChainId(), msg.BeforeBlock, types.MaxArchivedMigrationsPerMsg)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeArchiveMigrations,
+		sdk.NewAttribute(types.AttributeKeyToken, strconv.FormatUint(msg.Token, 10)),
+		sdk.NewAttribute(types.AttributeKeySourceChainId, strconv.FormatUint(msg.ChainId(), 10)),
+		sdk.NewAttribute(types.AttributeKeyBeforeBlock, strconv.FormatUint(msg.BeforeBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyArchived, strconv.FormatUint(archived, 10)),
+	))
+
+	return &types.MsgArchiveMigrationsResponse{Archived: archived, Done: done}, nil
+}
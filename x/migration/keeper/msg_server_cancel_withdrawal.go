@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelWithdrawal(goCtx context.Context, msg *types.MsgCancelWithdrawal) (*types.MsgCancelWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Make sure signer is in the list of migrators
+	_, migratorExist := k.GetMigrator(ctx, msg.Creator)
+	if !migratorExist {
+		return nil, types.ErrUnknownMigrator
+	}
+
+	withdrawal, found := k.GetWithdrawal(ctx, msg.Id)
+	if !found {
+		return nil, types.ErrWithdrawalNotFound
+	}
+
+	if !withdrawal.IsOpen() {
+		return nil, types.ErrWithdrawalClosed
+	}
+
+	// A withdrawal that never reached the signature threshold is refunded at its refund time
+	if withdrawal.Status != types.WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED {
+		return nil, types.ErrWithdrawalNotSigned
+	}
+
+	// Until it expires the vault could still execute the withdrawal
+	if uint64(ctx.BlockTime().Unix()) < withdrawal.ExpiresAt {
+		return nil, types.ErrWithdrawalNotExpired
+	}
+
+	if withdrawal.HasConfirmed(msg.Creator) {
+		return nil, types.ErrWithdrawalAlreadyConfirmed
+	}
+
+	if withdrawal.HasCancelled(msg.Creator) {
+		return nil, types.ErrWithdrawalAlreadyCancelled
+	}
+
+	// The burnt uslf are minted back once as many migrators as the vault requires signatures attest that it
+	// never released the tokens
+	withdrawal.Cancellations = append(withdrawal.Cancellations, msg.Creator)
+
+	withdrawalConfig, _ := k.GetWithdrawalConfig(ctx)
+	if uint64(len(withdrawal.Cancellations)) >= withdrawalConfig.SignatureThreshold {
+		if err := k.refundWithdrawal(ctx, withdrawal); err != nil {
+			return nil, err
+		}
+		withdrawal.Status = types.WithdrawalStatus_WITHDRAWAL_STATUS_REFUNDED
+	} else {
+		k.SetWithdrawal(ctx, withdrawal)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelWithdrawal,
+		sdk.NewAttribute(types.AttributeKeyWithdrawalId, strconv.FormatUint(withdrawal.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyMigrator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyStatus, withdrawal.Status.String()),
+	))
+
+	return &types.MsgCancelWithdrawalResponse{}, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ConfirmWithdrawal(goCtx context.Context, msg *types.MsgConfirmWithdrawal) (*types.MsgConfirmWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Make sure signer is in the list of migrators
+	_, migratorExist := k.GetMigrator(ctx, msg.Creator)
+	if !migratorExist {
+		return nil, types.ErrUnknownMigrator
+	}
+
+	withdrawal, found := k.GetWithdrawal(ctx, msg.Id)
+	if !found {
+		return nil, types.ErrWithdrawalNotFound
+	}
+
+	if !withdrawal.IsOpen() {
+		return nil, types.ErrWithdrawalClosed
+	}
+
+	if withdrawal.Status != types.WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED {
+		return nil, types.ErrWithdrawalNotSigned
+	}
+
+	if withdrawal.HasConfirmed(msg.Creator) {
+		return nil, types.ErrWithdrawalAlreadyConfirmed
+	}
+
+	if withdrawal.HasCancelled(msg.Creator) {
+		return nil, types.ErrWithdrawalAlreadyCancelled
+	}
+
+	// A single migrator can't close the withdrawal. The same transaction has to be confirmed by as many
+	// migrators as the vault requires signatures.
+	ethTxHash := strings.ToLower(msg.EthTxHash)
+	withdrawal.Confirmations = append(withdrawal.Confirmations, types.WithdrawalConfirmation{
+		Migrator:  msg.Creator,
+		EthTxHash: ethTxHash,
+	})
+
+	withdrawalConfig, _ := k.GetWithdrawalConfig(ctx)
+	if withdrawal.ConfirmationsOf(ethTxHash) >= withdrawalConfig.SignatureThreshold {
+		// The tokens have been released by the vault so the withdrawal must never be refunded
+		withdrawal.Status = types.WithdrawalStatus_WITHDRAWAL_STATUS_EXECUTED
+		withdrawal.EthTxHash = ethTxHash
+		k.RemoveFromWithdrawalQueue(ctx, withdrawal)
+	}
+
+	k.SetWithdrawal(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConfirmWithdrawal,
+		sdk.NewAttribute(types.AttributeKeyWithdrawalId, strconv.FormatUint(withdrawal.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyMigrator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyEthTxHash, ethTxHash),
+		sdk.NewAttribute(types.AttributeKeyStatus, withdrawal.Status.String()),
+	))
+
+	return &types.MsgConfirmWithdrawalResponse{}, nil
+}
@@ -2,8 +2,6 @@ package keeper
 
 import (
 	"context"
-	"crypto/sha256"
-	"fmt"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"math"
 	"selfchain/x/migration/types"
@@ -33,40 +31,48 @@ func (k msgServer) Migrate(goCtx context.Context, msg *types.MsgMigrate) (*types
 		return nil, types.ErrUnknownMigrator
 	}
 
-	// Create a hash of the message
-	encodedMsg := fmt.Sprintf(
-		"%s|%s|%s|%d|%s|%d",
-		msg.EthAddress,
-		msg.DestAddress,
-		msg.Amount,
-		msg.Token,
-		msg.TxHash,
-		msg.LogIndex,
-	)
-	msgHash := fmt.Sprintf("%x", sha256.Sum256([]byte(encodedMsg)))
-
-	// Check if message i.e. migration request has been processed already
-	_, migrationExists := k.GetTokenMigration(ctx, msgHash)
-	if migrationExists {
+	// The migration of a token can be closed at a given point in time
+	tokenSunset, _ := k.GetTokenSunset(ctx, msg.Token)
+	if tokenSunset.HasEnded(ctx.BlockTime()) {
+		return nil, types.ErrMigrationEnded
+	}
+
+	// The same token can be bridged on several networks
+	chainId := msg.ChainId()
+	if err := k.isSourceChainAllowed(ctx, chainId, msg.Token); err != nil {
+		return nil, err
+	}
+
+	// Create a hash of the message. The source chain is part of it so that a deposit can't be replayed
+	// on another network
+	msgHash := msg.Hash()
+
+	// Check if message i.e. migration request has been processed already, archived migrations included
+	if k.IsMigrationProcessed(ctx, msgHash) {
 		return nil, types.ErrMigrationProcessed
 	}
 
-	// Calculate the correct amount to mint
-	var ratio uint64
-	switch msg.Token {
-	case uint64(types.Front):
-		ratio = types.FRONT_RATIO
-	case uint64(types.Hotcross):
-		ratio = k.HotcrossRatio(ctx)
+	// Calculate the correct amount to mint. The ratio can vary over time to reward early migrators
+	ratio, _, err := k.conversionRatio(ctx, msg.Token)
+	if err != nil {
+		return nil, err
+	}
 
-		if ratio == 0 {
-			return nil, types.ErrHotcrossRatioZero
-		}
+	// Holders can trade a longer lockup for a better ratio
+	lockupTier, err := k.resolveLockupTier(ctx, msg.LockupTier)
+	if err != nil {
+		return nil, err
 	}
 
 	// WEI has 18 decimals whereas our denomiation is uslf thus it has 10^6 (6 decimals).
 	normalizedAmount := amount.QuoUint64(uint64(math.Pow(10, 12)))
-	migrationAmount := normalizedAmount.MulUint64(ratio).Quo(sdkmath.NewUint(100))
+	migrationAmount := lockupTier.ApplyBonus(normalizedAmount.MulUint64(ratio).Quo(sdkmath.NewUint(100)))
+
+	// Never mint more than the allocation of the token
+	if remaining, capped := tokenSunset.RemainingAllocation(); capped && migrationAmount.GT(remaining) {
+		return nil, types.ErrMigrationCapExceeded
+	}
+
 	instantlyReleased := types.GetInstantlyReleasedAmount()
 	migrationCoins := sdk.NewCoins(sdk.NewCoin(
 		types.DENOM,
@@ -79,46 +85,107 @@ func (k msgServer) Migrate(goCtx context.Context, msg *types.MsgMigrate) (*types
 		return nil, sdkerrors.Wrapf(errors.ErrInvalidCoins, "could not mint new coins (%s)", mintError)
 	}
 
-	// We don't need to check the validatity of the address since it's been done in the Msg::ValidateBasic method
-	destAddr, _ := sdk.AccAddressFromBech32(msg.DestAddress)
+	// Take the protocol fee, if any, out of the minted amount
+	migrationFee, _ := k.GetMigrationFee(ctx, msg.Token)
+	fee := migrationFee.Compute(migrationAmount)
+	if !fee.IsZero() {
+		if err := k.collectFee(ctx, migrationFee, fee); err != nil {
+			return nil, err
+		}
+	}
+	receivedAmount := migrationAmount.Sub(fee)
+
+	// Keep track of what the migration produced so that it can be audited and, if needed, reverted
+	tokenMigration := types.TokenMigration{
+		MsgHash:      msgHash,
+		Processed:    true,
+		TxHash:       msg.TxHash,
+		EthAddress:   msg.EthAddress,
+		DestAddress:  msg.DestAddress,
+		Amount:       msg.Amount,
+		Token:        msg.Token,
+		LogIndex:     msg.LogIndex,
+		MintedAmount: migrationAmount.String(),
+
+		SourceChainId: chainId,
+		Fee:           fee.String(),
+		LockupTier:    lockupTier.Tier,
+		SourceBlock:   msg.SourceBlock,
+		Migrator:      msg.Creator,
+	}
 
-	// If the migration amount is LTE then instantlyReleased which is a constant 1 SLF then we don't need to
+	// If the received amount is LTE then instantlyReleased which is a constant 1 SLF then we don't need to
 	// do any vesting. This can happen in two cases:
 	// 1. we migrate 1 FRONT so we get 1 SLF which is instantly released
 	// 2. we migrate X HOTCROSS and since hotcross migration ration will be < 100% then for small amount it will
 	// create less than 1 SLF (which is the instantlyReleased). For example, if we migrate 1 HOTCROSS and the ratio
 	// is 25% then we will get 0.25 SLF. In this case we don't need to create any vesting and we simply mint 0.25 SLF
 	// and transfer to the user.
-	if migrationAmount.LTE(instantlyReleased) {
+	if receivedAmount.LTE(instantlyReleased) {
 		instantlyReleasedCoins := sdk.NewCoins(sdk.NewCoin(
 			types.DENOM,
-			sdkmath.NewIntFromBigInt(migrationAmount.BigInt()),
+			sdkmath.NewIntFromBigInt(receivedAmount.BigInt()),
 		))
 
-		// send the full migration amount to the user
-		k.bankKeeper.SendCoinsFromModuleToAccount(ctx, selfvestingTypes.ModuleName, destAddr, instantlyReleasedCoins)
+		// send the full migration amount to the user, or to the contract receiving the migration
+		if err := k.releaseMigration(ctx, msg, &tokenMigration, instantlyReleasedCoins); err != nil {
+			return nil, err
+		}
+
+		tokenMigration.InstantlyReleased = receivedAmount.String()
+		tokenMigration.VestedAmount = "0"
 	} else {
-		// Transfer a fixed amount to the beneficiary so it can pay gas when releasing tokens from the vesting position
-		instantlyReleasedCoins := sdk.NewCoins(sdk.NewCoin(
-			types.DENOM,
-			sdkmath.NewIntFromBigInt(instantlyReleased.BigInt()),
-		))
-		k.bankKeeper.SendCoinsFromModuleToAccount(ctx, selfvestingTypes.ModuleName, destAddr, instantlyReleasedCoins)
+		// The callback could only carry the instant amount, the rest would vest in a position the contract
+		// doesn't know about
+		if msg.ContractAddress != "" {
+			return nil, sdkerrors.Wrapf(types.ErrContractMigrationVests, "%s uslf would vest", receivedAmount)
+		}
+
+		// Either grant the beneficiary a fee allowance or transfer it a fixed amount, so it can pay gas when
+		// releasing tokens from the vesting position. The spend limit of the allowance stays in the selfvesting
+		// module, which pays the fees.
+		withheld := instantlyReleased
+		feeAllowanceGranted, err := k.grantFeeAllowance(ctx, &tokenMigration)
+		if err != nil {
+			return nil, err
+		}
+
+		if feeAllowanceGranted {
+			withheld = sdkmath.NewUintFromString(tokenMigration.FeeAllowance)
+			instantlyReleased = sdkmath.ZeroUint()
+		} else {
+			instantlyReleasedCoins := sdk.NewCoins(sdk.NewCoin(
+				types.DENOM,
+				sdkmath.NewIntFromBigInt(instantlyReleased.BigInt()),
+			))
+			if err := k.releaseMigration(ctx, msg, &tokenMigration, instantlyReleasedCoins); err != nil {
+				return nil, err
+			}
+		}
 
 		// Add a new beneficiary
-		k.selfvestingKeeper.AddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
+		vestedAmount := receivedAmount.Sub(withheld)
+		_, positionId, err := k.selfvestingKeeper.AddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
 			Beneficiary: msg.DestAddress,
 			Cliff:       config.VestingCliff,
-			Duration:    config.VestingDuration,
-			Amount:      migrationAmount.Sub(instantlyReleased).String(),
+			Duration:    lockupTier.VestingDuration(config.VestingDuration),
+			Amount:      vestedAmount.String(),
+			LockupTier:  lockupTier.Tier,
 		})
+		if err != nil {
+			return nil, err
+		}
+
+		tokenMigration.InstantlyReleased = instantlyReleased.String()
+		tokenMigration.VestedAmount = vestedAmount.String()
+		tokenMigration.PositionId = positionId
 	}
 
 	// Store the token migration so it can't be processed again
-	k.SetTokenMigration(ctx, types.TokenMigration{
-		MsgHash:   msgHash,
-		Processed: true,
-	})
+	k.SetTokenMigration(ctx, tokenMigration)
+	k.addMinted(ctx, msg.Token, migrationAmount)
+	k.recordPeakRatio(ctx, msg.Token, lockupTier.EffectiveRatio(ratio))
+	k.recordMigrationTotals(ctx, tokenMigration)
 
 	return &types.MsgMigrateResponse{}, nil
 }
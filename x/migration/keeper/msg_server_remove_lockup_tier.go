@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RemoveLockupTier(goCtx context.Context, msg *types.MsgRemoveLockupTier) (*types.MsgRemoveLockupTierResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	if _, found := k.GetLockupTier(ctx, msg.Tier); !found {
+		return nil, types.ErrUnknownLockupTier
+	}
+
+	k.Keeper.RemoveLockupTier(ctx, msg.Tier)
+
+	return &types.MsgRemoveLockupTierResponse{}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RemoveRatioSchedule(goCtx context.Context, msg *types.MsgRemoveRatioSchedule) (*types.MsgRemoveRatioScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	if _, found := k.GetRatioSchedule(ctx, msg.Token); !found {
+		return nil, types.ErrRatioScheduleNotFound
+	}
+
+	// The token falls back to its static ratio
+	k.Keeper.RemoveRatioSchedule(ctx, msg.Token)
+
+	return &types.MsgRemoveRatioScheduleResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RequestWithdrawal(goCtx context.Context, msg *types.MsgRequestWithdrawal) (*types.MsgRequestWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawalConfig, found := k.GetWithdrawalConfig(ctx)
+	if !found {
+		return nil, types.ErrWithdrawalsDisabled
+	}
+
+	// Tokens can only be withdrawn to a network they can be migrated from
+	chainId := msg.ChainId()
+	if err := k.isSourceChainAllowed(ctx, chainId, msg.Token); err != nil {
+		return nil, err
+	}
+
+	currentRatio, _, err := k.conversionRatio(ctx, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// Withdrawals are converted back at the highest ratio migrations of the token have been converted at, so
+	// that uslf minted at a lower ratio can't take more tokens out of the vault than were deposited
+	tokenSunset, _ := k.GetTokenSunset(ctx, msg.Token)
+	ratio := tokenSunset.WithdrawalRatio(currentRatio)
+
+	// We don't need to check the validatity of the address since it's been done in the Msg::ValidateBasic method
+	creator, _ := sdk.AccAddressFromBech32(msg.Creator)
+	amount := sdkmath.NewUintFromString(msg.Amount)
+	coins := uslfCoins(amount)
+
+	// Only what has been minted for the token can be withdrawn to it. The withdrawn uslf no longer count as
+	// minted so that migrating the tokens again doesn't count them twice.
+	if err := k.withdrawMinted(ctx, msg.Token, amount); err != nil {
+		return nil, err
+	}
+
+	// Burn the uslf. They are minted back if the withdrawal is never executed
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	now := uint64(ctx.BlockTime().Unix())
+	withdrawal := types.Withdrawal{
+		Id:            k.GetWithdrawalCount(ctx),
+		Creator:       msg.Creator,
+		EthRecipient:  msg.EthRecipient,
+		Token:         msg.Token,
+		SourceChainId: chainId,
+		Amount:        amount.String(),
+		TokenAmount:   types.WithdrawnTokenAmount(amount, ratio).String(),
+		ExpiresAt:     now + withdrawalConfig.Expiry,
+		RefundAt:      now + withdrawalConfig.Expiry + withdrawalConfig.RefundDelay,
+		Status:        types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING,
+	}
+	withdrawal.PayloadHash = withdrawal.ComputePayloadHash()
+
+	k.AppendWithdrawal(ctx, withdrawal)
+	k.InsertWithdrawalQueue(ctx, withdrawal)
+	k.recordWithdrawalTotals(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRequestWithdrawal,
+		sdk.NewAttribute(types.AttributeKeyWithdrawalId, strconv.FormatUint(withdrawal.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyEthRecipient, withdrawal.EthRecipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, withdrawal.Amount),
+		sdk.NewAttribute(types.AttributeKeyTokenAmount, withdrawal.TokenAmount),
+		sdk.NewAttribute(types.AttributeKeyPayloadHash, withdrawal.PayloadHash),
+	))
+
+	return &types.MsgRequestWithdrawalResponse{Id: withdrawal.Id}, nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RevertMigration(goCtx context.Context, msg *types.MsgRevertMigration) (*types.MsgRevertMigrationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	tokenMigration, found := k.GetTokenMigration(ctx, msg.MsgHash)
+	if !found {
+		if k.IsMigrationProcessed(ctx, msg.MsgHash) {
+			return nil, types.ErrMigrationArchived
+		}
+		return nil, types.ErrMigrationNotFound
+	}
+
+	if tokenMigration.Reverted {
+		return nil, types.ErrMigrationReverted
+	}
+
+	// Migrations processed before their outcome was recorded don't tell us where the tokens went
+	if tokenMigration.DestAddress == "" || tokenMigration.MintedAmount == "" {
+		return nil, types.ErrMigrationNotRevertible
+	}
+
+	// The tokens are recovered from the contract the migration was sent to, if any
+	destAddr, err := sdk.AccAddressFromBech32(tokenMigration.Recipient())
+	if err != nil {
+		return nil, err
+	}
+
+	// Claw back whatever has not been released from the vesting position yet. Those tokens are still
+	// held by the selfvesting module so they can be burnt right away. A position opened by a migration
+	// can't be transferred, split, merged or unlocked early, so a position that is gone has been fully
+	// claimed by the destination account.
+	clawedBack := sdkmath.ZeroUint()
+	claimed := sdkmath.ZeroUint()
+	if tokenMigration.VestedAmount != "" && !sdkmath.NewUintFromString(tokenMigration.VestedAmount).IsZero() {
+		clawedBack, claimed, err = k.selfvestingKeeper.ClawbackPosition(ctx, k.vestingPositionId(ctx, tokenMigration))
+		if errors.Is(err, selfvestingTypes.ErrPositionFullyClaimed) {
+			clawedBack, claimed, err = sdkmath.ZeroUint(), sdkmath.NewUintFromString(tokenMigration.VestedAmount), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !clawedBack.IsZero() {
+			if err := k.bankKeeper.BurnCoins(ctx, selfvestingTypes.ModuleName, uslfCoins(clawedBack)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The instantly released amount and everything claimed from the position have reached the destination
+	// account. Burn as much of it as the account still holds and report the rest as a shortfall.
+	released := sdkmath.NewUintFromString(tokenMigration.InstantlyReleased).Add(claimed)
+	spendable := k.bankKeeper.SpendableCoin(ctx, destAddr, types.DENOM)
+	recovered := sdkmath.MinUint(released, sdkmath.NewUintFromBigInt(spendable.Amount.BigInt()))
+
+	if !recovered.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, destAddr, types.ModuleName, uslfCoins(recovered)); err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, uslfCoins(recovered)); err != nil {
+			return nil, err
+		}
+	}
+
+	// The protocol fee has been paid out when migrating and can't be recovered either. Neither can the fee
+	// allowance since only the beneficiary can give it up.
+	burned := clawedBack.Add(recovered)
+	shortfallSpent := released.Sub(recovered)
+	shortfallFee := uintOrZero(tokenMigration.Fee)
+	shortfallFeeAllowance := uintOrZero(tokenMigration.FeeAllowance)
+	shortfall := shortfallSpent.Add(shortfallFee).Add(shortfallFeeAllowance)
+
+	// Keep the record so that the same deposit can't be migrated again
+	tokenMigration.Reverted = true
+	tokenMigration.RevertedBurned = burned.String()
+	tokenMigration.RevertedShortfall = shortfall.String()
+	k.SetTokenMigration(ctx, tokenMigration)
+	k.subMinted(ctx, tokenMigration.Token, sdkmath.NewUintFromString(tokenMigration.MintedAmount))
+	k.recordRevertTotals(ctx, tokenMigration)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevertMigration,
+		sdk.NewAttribute(types.AttributeKeyMsgHash, tokenMigration.MsgHash),
+		sdk.NewAttribute(types.AttributeKeyDestAddress, tokenMigration.Recipient()),
+		sdk.NewAttribute(types.AttributeKeyClawedBack, clawedBack.String()),
+		sdk.NewAttribute(types.AttributeKeyRecovered, recovered.String()),
+		sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+		sdk.NewAttribute(types.AttributeKeyShortfall, shortfall.String()),
+		sdk.NewAttribute(types.AttributeKeyShortfallSpent, shortfallSpent.String()),
+		sdk.NewAttribute(types.AttributeKeyShortfallFee, shortfallFee.String()),
+		sdk.NewAttribute(types.AttributeKeyShortfallFeeAllowance, shortfallFeeAllowance.String()),
+	))
+
+	return &types.MsgRevertMigrationResponse{
+		ClawedBack: clawedBack.String(),
+		Recovered:  recovered.String(),
+		Burned:     burned.String(),
+		Shortfall:  shortfall.String(),
+
+		ShortfallSpent:        shortfallSpent.String(),
+		ShortfallFee:          shortfallFee.String(),
+		ShortfallFeeAllowance: shortfallFeeAllowance.String(),
+	}, nil
+}
+
+// vestingPositionId returns the id of the vesting position opened by a migration. Migrations processed before
+// positions had stable ids only hold the index of the position among the positions of the beneficiary.
+func (k Keeper) vestingPositionId(ctx sdk.Context, tokenMigration types.TokenMigration) uint64 {
+	if tokenMigration.PositionId != 0 {
+		return tokenMigration.PositionId
+	}
+
+	legacyPositionIndex, _ := k.selfvestingKeeper.GetLegacyPositionIndex(ctx, tokenMigration.Recipient(), tokenMigration.PositionIndex)
+	return legacyPositionIndex.PositionId
+}
+
+// uslfCoins converts an amount of uslf into coins
+func uslfCoins(amount sdkmath.Uint) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(types.DENOM, sdkmath.NewIntFromBigInt(amount.BigInt())))
+}
+
+// sourceChainId returns the chain a migration came from. Records created before other chains were supported
+// don't hold one and came from Ethereum mainnet.
+func sourceChainId(tokenMigration types.TokenMigration) uint64 {
+	if tokenMigration.SourceChainId == 0 {
+		return types.EthereumChainId
+	}
+
+	return tokenMigration.SourceChainId
+}
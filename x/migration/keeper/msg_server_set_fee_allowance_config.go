@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetFeeAllowanceConfig(goCtx context.Context, msg *types.MsgSetFeeAllowanceConfig) (*types.MsgSetFeeAllowanceConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	// The allowances granted so far are left untouched
+	k.Keeper.SetFeeAllowanceConfig(ctx, types.FeeAllowanceConfig{
+		SpendLimit: msg.SpendLimit,
+		Expiration: msg.Expiration,
+	})
+
+	return &types.MsgSetFeeAllowanceConfigResponse{}, nil
+}
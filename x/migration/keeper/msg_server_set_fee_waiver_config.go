@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetFeeWaiverConfig(goCtx context.Context, msg *types.MsgSetFeeWaiverConfig) (*types.MsgSetFeeWaiverConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	// The new limits apply to the gas already used in the current block
+	k.Keeper.SetFeeWaiverConfig(ctx, types.FeeWaiverConfig{
+		BlockGasBudget:   msg.BlockGasBudget,
+		MigratorGasQuota: msg.MigratorGasQuota,
+	})
+
+	return &types.MsgSetFeeWaiverConfigResponse{}, nil
+}
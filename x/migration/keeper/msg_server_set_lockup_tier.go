@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetLockupTier(goCtx context.Context, msg *types.MsgSetLockupTier) (*types.MsgSetLockupTierResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	// Store the tier. If it exists it will simply overwrite it. Migrations already processed keep the
+	// terms they've been created with
+	k.Keeper.SetLockupTier(ctx, types.LockupTier{
+		Tier:               msg.Tier,
+		DurationMultiplier: msg.DurationMultiplier,
+		BonusRatio:         msg.BonusRatio,
+	})
+
+	return &types.MsgSetLockupTierResponse{}, nil
+}
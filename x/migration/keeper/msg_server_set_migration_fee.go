@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetMigrationFee(goCtx context.Context, msg *types.MsgSetMigrationFee) (*types.MsgSetMigrationFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	// Store the fee of the token. If it exists it will simply overwrite it
+	k.Keeper.SetMigrationFee(ctx, types.MigrationFee{
+		Token:       msg.Token,
+		BasisPoints: msg.BasisPoints,
+		WaiveBelow:  msg.WaiveBelow,
+		WaiveAbove:  msg.WaiveAbove,
+		Recipient:   msg.Recipient,
+		Treasury:    msg.Treasury,
+	})
+
+	return &types.MsgSetMigrationFeeResponse{}, nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetRatioSchedule(goCtx context.Context, msg *types.MsgSetRatioSchedule) (*types.MsgSetRatioScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	// Store the schedule of the token. If it exists it will simply overwrite it
+	k.Keeper.SetRatioSchedule(ctx, msg.Schedule())
+
+	return &types.MsgSetRatioScheduleResponse{}, nil
+}
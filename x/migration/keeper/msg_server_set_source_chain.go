@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetSourceChain(goCtx context.Context, msg *types.MsgSetSourceChain) (*types.MsgSetSourceChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	// Store the chain configuration. If it exists it will simply overwrite it
+	k.Keeper.SetSourceChain(ctx, types.SourceChain{
+		ChainId:       msg.ChainId,
+		Name:          msg.Name,
+		Confirmations: msg.Confirmations,
+		Tokens:        msg.Tokens,
+		Enabled:       msg.Enabled,
+	})
+
+	return &types.MsgSetSourceChainResponse{}, nil
+}
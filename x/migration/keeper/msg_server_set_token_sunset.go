@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetTokenSunset(goCtx context.Context, msg *types.MsgSetTokenSunset) (*types.MsgSetTokenSunsetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	// Once swept the allocation is gone, so the deadline can no longer be moved
+	tokenSunset, _ := k.GetTokenSunset(ctx, msg.Token)
+	if tokenSunset.Swept {
+		return nil, types.ErrAllocationSwept
+	}
+
+	// The amount minted so far is carried over from the existing record
+	tokenSunset.Token = msg.Token
+	tokenSunset.EndTime = msg.EndTime
+	tokenSunset.Cap = msg.Cap
+	tokenSunset.SweepMode = msg.SweepMode
+	tokenSunset.Treasury = msg.Treasury
+	tokenSunset.LegacyMinted = msg.LegacyMinted
+	k.Keeper.SetTokenSunset(ctx, tokenSunset)
+
+	return &types.MsgSetTokenSunsetResponse{}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetWithdrawalConfig(goCtx context.Context, msg *types.MsgSetWithdrawalConfig) (*types.MsgSetWithdrawalConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acl, aclExists := k.GetAcl(ctx)
+	if !aclExists {
+		panic("ACL does not exist")
+	}
+
+	if acl.Admin != msg.Creator {
+		return nil, types.ErrOnlyAdmin
+	}
+
+	// Withdrawals already requested keep the expiry and refund time they've been created with
+	k.Keeper.SetWithdrawalConfig(ctx, types.WithdrawalConfig{
+		SignatureThreshold: msg.SignatureThreshold,
+		Expiry:             msg.Expiry,
+		RefundDelay:        msg.RefundDelay,
+	})
+
+	return &types.MsgSetWithdrawalConfigResponse{}, nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SignWithdrawal(goCtx context.Context, msg *types.MsgSignWithdrawal) (*types.MsgSignWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Make sure signer is in the list of migrators
+	_, migratorExist := k.GetMigrator(ctx, msg.Creator)
+	if !migratorExist {
+		return nil, types.ErrUnknownMigrator
+	}
+
+	withdrawal, found := k.GetWithdrawal(ctx, msg.Id)
+	if !found {
+		return nil, types.ErrWithdrawalNotFound
+	}
+
+	if !withdrawal.IsOpen() {
+		return nil, types.ErrWithdrawalClosed
+	}
+
+	// The vault would reject the signatures anyway
+	if uint64(ctx.BlockTime().Unix()) >= withdrawal.ExpiresAt {
+		return nil, types.ErrWithdrawalExpired
+	}
+
+	if withdrawal.HasSigned(msg.Creator) {
+		return nil, types.ErrWithdrawalAlreadySigned
+	}
+
+	// The signature is checked by the vault against the signers it trusts
+	withdrawal.Signatures = append(withdrawal.Signatures, types.WithdrawalSignature{
+		Migrator:  msg.Creator,
+		Signature: strings.ToLower(strings.TrimPrefix(msg.Signature, "0x")),
+	})
+
+	withdrawalConfig, _ := k.GetWithdrawalConfig(ctx)
+	if uint64(len(withdrawal.Signatures)) >= withdrawalConfig.SignatureThreshold {
+		withdrawal.Status = types.WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED
+	}
+
+	k.SetWithdrawal(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSignWithdrawal,
+		sdk.NewAttribute(types.AttributeKeyWithdrawalId, strconv.FormatUint(withdrawal.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyMigrator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyStatus, withdrawal.Status.String()),
+	))
+
+	return &types.MsgSignWithdrawalResponse{}, nil
+}
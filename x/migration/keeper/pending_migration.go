@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPendingMigration marks a migration as waiting in the mempool. The check state it is written to is
+// discarded on commit, so the mark only lasts until the next block.
+func (k Keeper) SetPendingMigration(ctx sdk.Context, msgHash string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingMigrationKeyPrefix))
+	store.Set(types.MsgHashBytes(msgHash), []byte{})
+}
+
+// IsMigrationPending returns whether a migration is already waiting in the mempool
+func (k Keeper) IsMigrationPending(ctx sdk.Context, msgHash string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingMigrationKeyPrefix))
+	return store.Has(types.MsgHashBytes(msgHash))
+}
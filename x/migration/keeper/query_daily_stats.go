@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DailyStatsAll(goCtx context.Context, req *types.QueryAllDailyStatsRequest) (*types.QueryAllDailyStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var dailyStatss []types.DailyStats
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	dailyStatsStore := prefix.NewStore(store, types.KeyPrefix(types.DailyStatsKeyPrefix))
+
+	pageRes, err := query.Paginate(dailyStatsStore, req.Pagination, func(key []byte, value []byte) error {
+		var dailyStats types.DailyStats
+		if err := k.cdc.Unmarshal(value, &dailyStats); err != nil {
+			return err
+		}
+
+		dailyStatss = append(dailyStatss, dailyStats)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDailyStatsResponse{DailyStats: dailyStatss, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DailyStats(goCtx context.Context, req *types.QueryGetDailyStatsRequest) (*types.QueryGetDailyStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetDailyStats(
+		ctx,
+		req.Day,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetDailyStatsResponse{DailyStats: val}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"selfchain/x/migration/types"
+)
+
+func (k Keeper) FeeWaiverConfig(goCtx context.Context, req *types.QueryGetFeeWaiverConfigRequest) (*types.QueryGetFeeWaiverConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetFeeWaiverConfig(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetFeeWaiverConfigResponse{FeeWaiverConfig: val}, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) LockupTierAll(goCtx context.Context, req *types.QueryAllLockupTierRequest) (*types.QueryAllLockupTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var lockupTiers []types.LockupTier
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	lockupTierStore := prefix.NewStore(store, types.KeyPrefix(types.LockupTierKeyPrefix))
+
+	pageRes, err := query.Paginate(lockupTierStore, req.Pagination, func(key []byte, value []byte) error {
+		var lockupTier types.LockupTier
+		if err := k.cdc.Unmarshal(value, &lockupTier); err != nil {
+			return err
+		}
+
+		lockupTiers = append(lockupTiers, lockupTier)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllLockupTierResponse{LockupTier: lockupTiers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) LockupTier(goCtx context.Context, req *types.QueryGetLockupTierRequest) (*types.QueryGetLockupTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetLockupTier(
+		ctx,
+		req.Tier,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetLockupTierResponse{LockupTier: val}, nil
+}
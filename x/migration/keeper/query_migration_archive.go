@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MigrationArchiveAll(goCtx context.Context, req *types.QueryAllMigrationArchiveRequest) (*types.QueryAllMigrationArchiveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var migrationArchives []types.MigrationArchive
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	migrationArchiveStore := prefix.NewStore(store, types.KeyPrefix(types.MigrationArchiveKeyPrefix))
+
+	pageRes, err := query.Paginate(migrationArchiveStore, req.Pagination, func(key []byte, value []byte) error {
+		var migrationArchive types.MigrationArchive
+		if err := k.cdc.Unmarshal(value, &migrationArchive); err != nil {
+			return err
+		}
+
+		migrationArchives = append(migrationArchives, migrationArchive)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMigrationArchiveResponse{MigrationArchive: migrationArchives, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MigrationArchive(goCtx context.Context, req *types.QueryGetMigrationArchiveRequest) (*types.QueryGetMigrationArchiveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetMigrationArchive(
+		ctx,
+		req.Token,
+		req.SourceChainId,
+		req.Bucket,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetMigrationArchiveResponse{MigrationArchive: val}, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MigrationFeeAll(goCtx context.Context, req *types.QueryAllMigrationFeeRequest) (*types.QueryAllMigrationFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var migrationFees []types.MigrationFee
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	migrationFeeStore := prefix.NewStore(store, types.KeyPrefix(types.MigrationFeeKeyPrefix))
+
+	pageRes, err := query.Paginate(migrationFeeStore, req.Pagination, func(key []byte, value []byte) error {
+		var migrationFee types.MigrationFee
+		if err := k.cdc.Unmarshal(value, &migrationFee); err != nil {
+			return err
+		}
+
+		migrationFees = append(migrationFees, migrationFee)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMigrationFeeResponse{MigrationFee: migrationFees, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MigrationFee(goCtx context.Context, req *types.QueryGetMigrationFeeRequest) (*types.QueryGetMigrationFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetMigrationFee(
+		ctx,
+		req.Token,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetMigrationFeeResponse{MigrationFee: val}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"selfchain/x/migration/types"
+)
+
+func (k Keeper) MigrationTotals(goCtx context.Context, req *types.QueryGetMigrationTotalsRequest) (*types.QueryGetMigrationTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetMigrationTotals(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetMigrationTotalsResponse{MigrationTotals: val}, nil
+}
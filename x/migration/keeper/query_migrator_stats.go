@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MigratorStatsAll(goCtx context.Context, req *types.QueryAllMigratorStatsRequest) (*types.QueryAllMigratorStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var migratorStatss []types.MigratorStats
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	migratorStatsStore := prefix.NewStore(store, types.KeyPrefix(types.MigratorStatsKeyPrefix))
+
+	pageRes, err := query.Paginate(migratorStatsStore, req.Pagination, func(key []byte, value []byte) error {
+		var migratorStats types.MigratorStats
+		if err := k.cdc.Unmarshal(value, &migratorStats); err != nil {
+			return err
+		}
+
+		migratorStatss = append(migratorStatss, migratorStats)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMigratorStatsResponse{MigratorStats: migratorStatss, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MigratorStats(goCtx context.Context, req *types.QueryGetMigratorStatsRequest) (*types.QueryGetMigratorStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetMigratorStats(
+		ctx,
+		req.Migrator,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetMigratorStatsResponse{MigratorStats: val}, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RatioScheduleAll(goCtx context.Context, req *types.QueryAllRatioScheduleRequest) (*types.QueryAllRatioScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var ratioSchedules []types.RatioSchedule
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	ratioScheduleStore := prefix.NewStore(store, types.KeyPrefix(types.RatioScheduleKeyPrefix))
+
+	pageRes, err := query.Paginate(ratioScheduleStore, req.Pagination, func(key []byte, value []byte) error {
+		var ratioSchedule types.RatioSchedule
+		if err := k.cdc.Unmarshal(value, &ratioSchedule); err != nil {
+			return err
+		}
+
+		ratioSchedules = append(ratioSchedules, ratioSchedule)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRatioScheduleResponse{RatioSchedule: ratioSchedules, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RatioSchedule(goCtx context.Context, req *types.QueryGetRatioScheduleRequest) (*types.QueryGetRatioScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetRatioSchedule(
+		ctx,
+		req.Token,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRatioScheduleResponse{RatioSchedule: val}, nil
+}
+
+func (k Keeper) CurrentRatio(goCtx context.Context, req *types.QueryCurrentRatioRequest) (*types.QueryCurrentRatioResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Token != uint64(types.Front) && req.Token != uint64(types.Hotcross) {
+		return nil, status.Error(codes.InvalidArgument, types.ErrTokenNotSupported.Error())
+	}
+
+	ratio, scheduled, err := k.conversionRatio(ctx, req.Token)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryCurrentRatioResponse{Ratio: ratio, Scheduled: scheduled}, nil
+}
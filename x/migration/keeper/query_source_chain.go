@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SourceChainAll(goCtx context.Context, req *types.QueryAllSourceChainRequest) (*types.QueryAllSourceChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var sourceChains []types.SourceChain
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	sourceChainStore := prefix.NewStore(store, types.KeyPrefix(types.SourceChainKeyPrefix))
+
+	pageRes, err := query.Paginate(sourceChainStore, req.Pagination, func(key []byte, value []byte) error {
+		var sourceChain types.SourceChain
+		if err := k.cdc.Unmarshal(value, &sourceChain); err != nil {
+			return err
+		}
+
+		sourceChains = append(sourceChains, sourceChain)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSourceChainResponse{SourceChain: sourceChains, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SourceChain(goCtx context.Context, req *types.QueryGetSourceChainRequest) (*types.QueryGetSourceChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetSourceChain(
+		ctx,
+		req.ChainId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetSourceChainResponse{SourceChain: val}, nil
+}
+
+func (k Keeper) SourceChainStatsAll(goCtx context.Context, req *types.QueryAllSourceChainStatsRequest) (*types.QueryAllSourceChainStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var sourceChainStats []types.SourceChainStats
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	sourceChainStatsStore := prefix.NewStore(store, types.KeyPrefix(types.SourceChainStatsKeyPrefix))
+
+	pageRes, err := query.Paginate(sourceChainStatsStore, req.Pagination, func(key []byte, value []byte) error {
+		var stats types.SourceChainStats
+		if err := k.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+
+		sourceChainStats = append(sourceChainStats, stats)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSourceChainStatsResponse{SourceChainStats: sourceChainStats, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SourceChainStats(goCtx context.Context, req *types.QueryGetSourceChainStatsRequest) (*types.QueryGetSourceChainStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetSourceChainStats(
+		ctx,
+		req.ChainId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetSourceChainStatsResponse{SourceChainStats: val}, nil
+}
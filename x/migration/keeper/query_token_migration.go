@@ -23,14 +23,25 @@ func (k Keeper) TokenMigrationAll(goCtx context.Context, req *types.QueryAllToke
 	store := ctx.KVStore(k.storeKey)
 	tokenMigrationStore := prefix.NewStore(store, types.KeyPrefix(types.TokenMigrationKeyPrefix))
 
-	pageRes, err := query.Paginate(tokenMigrationStore, req.Pagination, func(key []byte, value []byte) error {
-		var tokenMigration types.TokenMigration
-		if err := k.cdc.Unmarshal(value, &tokenMigration); err != nil {
-			return err
+	pageRes, err := query.FilteredPaginate(tokenMigrationStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		// Archived migrations only keep their key
+		if len(value) == 0 {
+			return false, nil
 		}
 
-		tokenMigrations = append(tokenMigrations, tokenMigration)
-		return nil
+		if accumulate {
+			var tokenMigration types.TokenMigration
+			if err := k.cdc.Unmarshal(value, &tokenMigration); err != nil {
+				return false, err
+			}
+			if tokenMigration.MsgHash == "" {
+				tokenMigration.MsgHash = types.MsgHashFromTokenMigrationKey(key)
+			}
+
+			tokenMigrations = append(tokenMigrations, tokenMigration)
+		}
+
+		return true, nil
 	})
 
 	if err != nil {
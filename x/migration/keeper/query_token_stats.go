@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TokenStatsAll(goCtx context.Context, req *types.QueryAllTokenStatsRequest) (*types.QueryAllTokenStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var tokenStatss []types.TokenStats
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	tokenStatsStore := prefix.NewStore(store, types.KeyPrefix(types.TokenStatsKeyPrefix))
+
+	pageRes, err := query.Paginate(tokenStatsStore, req.Pagination, func(key []byte, value []byte) error {
+		var tokenStats types.TokenStats
+		if err := k.cdc.Unmarshal(value, &tokenStats); err != nil {
+			return err
+		}
+
+		tokenStatss = append(tokenStatss, tokenStats)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTokenStatsResponse{TokenStats: tokenStatss, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TokenStats(goCtx context.Context, req *types.QueryGetTokenStatsRequest) (*types.QueryGetTokenStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetTokenStats(
+		ctx,
+		req.Token,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetTokenStatsResponse{TokenStats: val}, nil
+}
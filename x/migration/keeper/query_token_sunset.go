@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TokenSunsetAll(goCtx context.Context, req *types.QueryAllTokenSunsetRequest) (*types.QueryAllTokenSunsetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var tokenSunsets []types.TokenSunset
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	tokenSunsetStore := prefix.NewStore(store, types.KeyPrefix(types.TokenSunsetKeyPrefix))
+
+	pageRes, err := query.Paginate(tokenSunsetStore, req.Pagination, func(key []byte, value []byte) error {
+		var tokenSunset types.TokenSunset
+		if err := k.cdc.Unmarshal(value, &tokenSunset); err != nil {
+			return err
+		}
+
+		tokenSunsets = append(tokenSunsets, tokenSunset)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTokenSunsetResponse{TokenSunset: tokenSunsets, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TokenSunset(goCtx context.Context, req *types.QueryGetTokenSunsetRequest) (*types.QueryGetTokenSunsetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetTokenSunset(
+		ctx,
+		req.Token,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	// An empty remaining amount means that the token has no cap
+	remaining := ""
+	if amount, capped := val.RemainingAllocation(); capped {
+		remaining = amount.String()
+	}
+
+	return &types.QueryGetTokenSunsetResponse{
+		TokenSunset:   val,
+		TimeRemaining: val.TimeRemaining(ctx.BlockTime()),
+		Remaining:     remaining,
+	}, nil
+}
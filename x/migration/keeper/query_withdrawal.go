@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) WithdrawalAll(goCtx context.Context, req *types.QueryAllWithdrawalRequest) (*types.QueryAllWithdrawalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var withdrawals []types.Withdrawal
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	withdrawalStore := prefix.NewStore(store, types.KeyPrefix(types.WithdrawalKey))
+
+	pageRes, err := query.Paginate(withdrawalStore, req.Pagination, func(key []byte, value []byte) error {
+		var withdrawal types.Withdrawal
+		if err := k.cdc.Unmarshal(value, &withdrawal); err != nil {
+			return err
+		}
+
+		withdrawals = append(withdrawals, withdrawal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllWithdrawalResponse{Withdrawal: withdrawals, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Withdrawal(goCtx context.Context, req *types.QueryGetWithdrawalRequest) (*types.QueryGetWithdrawalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	withdrawal, found := k.GetWithdrawal(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetWithdrawalResponse{Withdrawal: withdrawal}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"selfchain/x/migration/types"
+)
+
+func (k Keeper) WithdrawalConfig(goCtx context.Context, req *types.QueryGetWithdrawalConfigRequest) (*types.QueryGetWithdrawalConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetWithdrawalConfig(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetWithdrawalConfigResponse{WithdrawalConfig: val}, nil
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetRatioSchedule set a specific ratioSchedule in the store from its index
+func (k Keeper) SetRatioSchedule(ctx sdk.Context, ratioSchedule types.RatioSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RatioScheduleKeyPrefix))
+	b := k.cdc.MustMarshal(&ratioSchedule)
+	store.Set(types.RatioScheduleKey(
+		ratioSchedule.Token,
+	), b)
+}
+
+// GetRatioSchedule returns a ratioSchedule from its index
+func (k Keeper) GetRatioSchedule(
+	ctx sdk.Context,
+	token uint64,
+
+) (val types.RatioSchedule, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RatioScheduleKeyPrefix))
+
+	b := store.Get(types.RatioScheduleKey(
+		token,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRatioSchedule removes a ratioSchedule from the store
+func (k Keeper) RemoveRatioSchedule(
+	ctx sdk.Context,
+	token uint64,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RatioScheduleKeyPrefix))
+	store.Delete(types.RatioScheduleKey(
+		token,
+	))
+}
+
+// GetAllRatioSchedule returns all ratioSchedule
+func (k Keeper) GetAllRatioSchedule(ctx sdk.Context) (list []types.RatioSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RatioScheduleKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RatioSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// conversionRatio returns the ratio, in percent, a migration of the token gets at the current block time
+// and whether it comes from the schedule of the token
+func (k Keeper) conversionRatio(ctx sdk.Context, token uint64) (uint64, bool, error) {
+	if schedule, found := k.GetRatioSchedule(ctx, token); found {
+		if ratio, started := schedule.RatioAt(uint64(ctx.BlockTime().Unix())); started {
+			return ratio, true, nil
+		}
+	}
+
+	var ratio uint64
+	switch token {
+	case uint64(types.Front):
+		ratio = types.FRONT_RATIO
+	case uint64(types.Hotcross):
+		ratio = k.HotcrossRatio(ctx)
+
+		if ratio == 0 {
+			return 0, false, types.ErrHotcrossRatioZero
+		}
+	}
+
+	return ratio, false, nil
+}
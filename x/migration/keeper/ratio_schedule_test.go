@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/testutil/nullify"
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNRatioSchedule(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.RatioSchedule {
+	items := make([]types.RatioSchedule, n)
+	for i := range items {
+		items[i].Token = uint64(i)
+		items[i].Points = []types.RatioPoint{{Time: uint64(i), Ratio: 100}}
+
+		keeper.SetRatioSchedule(ctx, items[i])
+	}
+	return items
+}
+
+func TestRatioScheduleGet(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNRatioSchedule(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetRatioSchedule(ctx,
+			item.Token,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestRatioScheduleRemove(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNRatioSchedule(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRatioSchedule(ctx,
+			item.Token,
+		)
+		_, found := keeper.GetRatioSchedule(ctx,
+			item.Token,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestRatioScheduleGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNRatioSchedule(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllRatioSchedule(ctx)),
+	)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RefundExpiredWithdrawals mints back the uslf of the withdrawals that never reached the signature threshold
+// once their refund time is reached. The vault may have executed a signed withdrawal whose confirmations are
+// late, so it is taken out of the queue and only refunded once the migrators cancel it. A withdrawal whose
+// refund fails stays queued and comes up again in the next block.
+func (k Keeper) RefundExpiredWithdrawals(ctx sdk.Context) {
+	for _, id := range k.refundableWithdrawals(ctx) {
+		withdrawal, found := k.GetWithdrawal(ctx, id)
+		if !found {
+			continue
+		}
+
+		if withdrawal.Status == types.WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED {
+			k.RemoveFromWithdrawalQueue(ctx, withdrawal)
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refundWithdrawal(cacheCtx, withdrawal); err != nil {
+			k.Logger(ctx).Error("could not refund withdrawal", "id", id, "err", err)
+			continue
+		}
+		write()
+	}
+}
+
+func (k Keeper) refundWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) error {
+	creator, err := sdk.AccAddressFromBech32(withdrawal.Creator)
+	if err != nil {
+		return err
+	}
+
+	amount := sdkmath.NewUintFromString(withdrawal.Amount)
+	coins := uslfCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, coins); err != nil {
+		return err
+	}
+
+	withdrawal.Status = types.WithdrawalStatus_WITHDRAWAL_STATUS_REFUNDED
+	k.SetWithdrawal(ctx, withdrawal)
+	k.RemoveFromWithdrawalQueue(ctx, withdrawal)
+	k.addMinted(ctx, withdrawal.Token, amount)
+	k.recordRefundTotals(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundWithdrawal,
+		sdk.NewAttribute(types.AttributeKeyWithdrawalId, strconv.FormatUint(withdrawal.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, withdrawal.Creator),
+		sdk.NewAttribute(types.AttributeKeyAmount, withdrawal.Amount),
+	))
+
+	return nil
+}
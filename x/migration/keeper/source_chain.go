@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetSourceChain set a specific sourceChain in the store from its index
+func (k Keeper) SetSourceChain(ctx sdk.Context, sourceChain types.SourceChain) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SourceChainKeyPrefix))
+	b := k.cdc.MustMarshal(&sourceChain)
+	store.Set(types.SourceChainKey(
+		sourceChain.ChainId,
+	), b)
+}
+
+// GetSourceChain returns a sourceChain from its index
+func (k Keeper) GetSourceChain(
+	ctx sdk.Context,
+	chainId uint64,
+
+) (val types.SourceChain, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SourceChainKeyPrefix))
+
+	b := store.Get(types.SourceChainKey(
+		chainId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSourceChain returns all sourceChain
+func (k Keeper) GetAllSourceChain(ctx sdk.Context) (list []types.SourceChain) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SourceChainKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SourceChain
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetSourceChainStats set a specific sourceChainStats in the store from its index
+func (k Keeper) SetSourceChainStats(ctx sdk.Context, sourceChainStats types.SourceChainStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SourceChainStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&sourceChainStats)
+	store.Set(types.SourceChainKey(
+		sourceChainStats.ChainId,
+	), b)
+}
+
+// GetSourceChainStats returns a sourceChainStats from its index
+func (k Keeper) GetSourceChainStats(
+	ctx sdk.Context,
+	chainId uint64,
+
+) (val types.SourceChainStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SourceChainStatsKeyPrefix))
+
+	b := store.Get(types.SourceChainKey(
+		chainId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSourceChainStats returns all sourceChainStats
+func (k Keeper) GetAllSourceChainStats(ctx sdk.Context) (list []types.SourceChainStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SourceChainStatsKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SourceChainStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// isSourceChainAllowed checks that the token can be migrated from the given chain. Ethereum mainnet is
+// allowed until it's explicitly configured so that migrations keep working as they used to.
+func (k Keeper) isSourceChainAllowed(ctx sdk.Context, chainId uint64, token uint64) error {
+	sourceChain, found := k.GetSourceChain(ctx, chainId)
+	if !found {
+		if chainId == types.EthereumChainId {
+			return nil
+		}
+
+		return types.ErrSourceChainNotSupported
+	}
+
+	if !sourceChain.Enabled {
+		return types.ErrSourceChainNotSupported
+	}
+
+	if !sourceChain.SupportsToken(token) {
+		return types.ErrTokenNotSupportedOnChain
+	}
+
+	return nil
+}
+
+// uintOrZero parses an amount that might not have been set yet
+func uintOrZero(amount string) sdkmath.Uint {
+	if amount == "" {
+		return sdkmath.ZeroUint()
+	}
+
+	return sdkmath.NewUintFromString(amount)
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/testutil/nullify"
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNSourceChain(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.SourceChain {
+	items := make([]types.SourceChain, n)
+	for i := range items {
+		items[i].ChainId = uint64(i)
+
+		keeper.SetSourceChain(ctx, items[i])
+	}
+	return items
+}
+
+func createNSourceChainStats(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.SourceChainStats {
+	items := make([]types.SourceChainStats, n)
+	for i := range items {
+		items[i].ChainId = uint64(i)
+
+		keeper.SetSourceChainStats(ctx, items[i])
+	}
+	return items
+}
+
+func TestSourceChainGet(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNSourceChain(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetSourceChain(ctx,
+			item.ChainId,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestSourceChainGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNSourceChain(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllSourceChain(ctx)),
+	)
+}
+
+func TestSourceChainStatsGet(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNSourceChainStats(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetSourceChainStats(ctx,
+			item.ChainId,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestSourceChainStatsGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNSourceChainStats(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllSourceChainStats(ctx)),
+	)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"strconv"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SweepEndedAllocations sweeps the unclaimed allocation of every token whose migration has ended. Each token
+// is swept on its own so a token that can't be swept, e.g. because its treasury is blocked, is left unswept
+// and comes up again in the next block without holding back the others.
+func (k Keeper) SweepEndedAllocations(ctx sdk.Context) {
+	for _, tokenSunset := range k.GetAllTokenSunset(ctx) {
+		if tokenSunset.Swept || tokenSunset.SweepMode == types.SweepMode_SWEEP_MODE_NONE || !tokenSunset.HasEnded(ctx.BlockTime()) {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.sweepAllocation(cacheCtx, tokenSunset); err != nil {
+			k.Logger(ctx).Error("could not sweep unclaimed allocation", "token", tokenSunset.Token, "err", err)
+			continue
+		}
+		write()
+	}
+}
+
+func (k Keeper) sweepAllocation(ctx sdk.Context, tokenSunset types.TokenSunset) error {
+	remaining, _ := tokenSunset.RemainingAllocation()
+	recipient := ""
+
+	if !remaining.IsZero() {
+		coins := uslfCoins(remaining)
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+		switch tokenSunset.SweepMode {
+		case types.SweepMode_SWEEP_MODE_COMMUNITY_POOL:
+			if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+				return err
+			}
+			if err := k.distrKeeper.FundCommunityPool(ctx, coins, moduleAddr); err != nil {
+				return err
+			}
+			recipient = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+		case types.SweepMode_SWEEP_MODE_TREASURY:
+			treasury, err := sdk.AccAddressFromBech32(tokenSunset.Treasury)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasury, coins); err != nil {
+				return err
+			}
+			recipient = tokenSunset.Treasury
+		case types.SweepMode_SWEEP_MODE_BURN:
+			// The allocation has never been minted so there is nothing to do other than giving it up
+		}
+	}
+
+	tokenSunset.Swept = true
+	tokenSunset.SweptAmount = remaining.String()
+	tokenSunset.SweptAt = ctx.BlockTime().Unix()
+	k.SetTokenSunset(ctx, tokenSunset)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSweepAllocation,
+		sdk.NewAttribute(types.AttributeKeyToken, strconv.FormatUint(tokenSunset.Token, 10)),
+		sdk.NewAttribute(types.AttributeKeySweepMode, tokenSunset.SweepMode.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, remaining.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+	))
+
+	return nil
+}
@@ -8,16 +8,33 @@ import (
 	costypes "cosmossdk.io/store/types"
 )
 
-// SetTokenMigration set a specific tokenMigration in the store from its index
+// SetTokenMigration set a specific tokenMigration in the store from its index and adds it to the bucket of
+// its token, source chain and source block
 func (k Keeper) SetTokenMigration(ctx sdk.Context, tokenMigration types.TokenMigration) {
+	msgHash := types.MsgHashBytes(tokenMigration.MsgHash)
+	bucketKey := types.TokenMigrationBucketEntryKey(
+		tokenMigration.Token,
+		sourceChainId(tokenMigration),
+		types.MigrationBucket(tokenMigration.SourceBlock),
+		msgHash,
+	)
+
+	// The hash is the key of the record, there is no need to store it twice
+	if types.IsCanonicalMsgHash(tokenMigration.MsgHash) {
+		tokenMigration.MsgHash = ""
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenMigrationKeyPrefix))
 	b := k.cdc.MustMarshal(&tokenMigration)
 	store.Set(types.TokenMigrationKey(
-		tokenMigration.MsgHash,
+		msgHash,
 	), b)
+
+	bucketStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenMigrationBucketKeyPrefix))
+	bucketStore.Set(bucketKey, []byte{})
 }
 
-// GetTokenMigration returns a tokenMigration from its index
+// GetTokenMigration returns a tokenMigration from its index. Archived migrations aren't found.
 func (k Keeper) GetTokenMigration(
 	ctx sdk.Context,
 	msgHash string,
@@ -26,16 +43,49 @@ func (k Keeper) GetTokenMigration(
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenMigrationKeyPrefix))
 
 	b := store.Get(types.TokenMigrationKey(
-		msgHash,
+		types.MsgHashBytes(msgHash),
 	))
-	if b == nil {
+	if len(b) == 0 {
 		return val, false
 	}
 
 	k.cdc.MustUnmarshal(b, &val)
+	if val.MsgHash == "" {
+		val.MsgHash = msgHash
+	}
+
 	return val, true
 }
 
+// IsMigrationProcessed returns whether a migration has been processed, even if it has been archived since
+func (k Keeper) IsMigrationProcessed(ctx sdk.Context, msgHash string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenMigrationKeyPrefix))
+	return store.Has(types.TokenMigrationKey(types.MsgHashBytes(msgHash)))
+}
+
+// SetArchivedMigration empties the record of an archived migration, keeping its key so that it can't be
+// replayed
+func (k Keeper) SetArchivedMigration(ctx sdk.Context, msgHash string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenMigrationKeyPrefix))
+	store.Set(types.TokenMigrationKey(types.MsgHashBytes(msgHash)), []byte{})
+}
+
+// GetAllArchivedMigration returns the hashes of all the archived migrations
+func (k Keeper) GetAllArchivedMigration(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenMigrationKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Value()) == 0 {
+			list = append(list, types.MsgHashFromTokenMigrationKey(iterator.Key()))
+		}
+	}
+
+	return
+}
+
 // GetAllTokenMigration returns all tokenMigration
 func (k Keeper) GetAllTokenMigration(ctx sdk.Context) (list []types.TokenMigration) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenMigrationKeyPrefix))
@@ -44,10 +94,22 @@ func (k Keeper) GetAllTokenMigration(ctx sdk.Context) (list []types.TokenMigrati
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var val types.TokenMigration
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+		if len(iterator.Value()) == 0 {
+			continue
+		}
+
+		list = append(list, k.unmarshalTokenMigration(iterator.Key(), iterator.Value()))
 	}
 
 	return
 }
+
+// unmarshalTokenMigration decodes a stored record, restoring the hash held by its key
+func (k Keeper) unmarshalTokenMigration(key []byte, value []byte) (val types.TokenMigration) {
+	k.cdc.MustUnmarshal(value, &val)
+	if val.MsgHash == "" {
+		val.MsgHash = types.MsgHashFromTokenMigrationKey(key)
+	}
+
+	return val
+}
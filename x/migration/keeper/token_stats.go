@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetTokenStats set a specific tokenStats in the store from its index
+func (k Keeper) SetTokenStats(ctx sdk.Context, tokenStats types.TokenStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&tokenStats)
+	store.Set(types.TokenStatsKey(
+		tokenStats.Token,
+	), b)
+}
+
+// GetTokenStats returns a tokenStats from its index
+func (k Keeper) GetTokenStats(
+	ctx sdk.Context,
+	token uint64,
+
+) (val types.TokenStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenStatsKeyPrefix))
+
+	b := store.Get(types.TokenStatsKey(
+		token,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllTokenStats returns all tokenStats
+func (k Keeper) GetAllTokenStats(ctx sdk.Context) (list []types.TokenStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenStatsKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TokenStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
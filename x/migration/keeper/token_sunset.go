@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	costypes "cosmossdk.io/store/types"
+)
+
+// SetTokenSunset set a specific tokenSunset in the store from its index
+func (k Keeper) SetTokenSunset(ctx sdk.Context, tokenSunset types.TokenSunset) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenSunsetKeyPrefix))
+	b := k.cdc.MustMarshal(&tokenSunset)
+	store.Set(types.TokenSunsetKey(
+		tokenSunset.Token,
+	), b)
+}
+
+// GetTokenSunset returns a tokenSunset from its index
+func (k Keeper) GetTokenSunset(
+	ctx sdk.Context,
+	token uint64,
+
+) (val types.TokenSunset, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenSunsetKeyPrefix))
+
+	b := store.Get(types.TokenSunsetKey(
+		token,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllTokenSunset returns all tokenSunset
+func (k Keeper) GetAllTokenSunset(ctx sdk.Context) (list []types.TokenSunset) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenSunsetKeyPrefix))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TokenSunset
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// addMinted increases the amount of uslf minted for the given token
+func (k Keeper) addMinted(ctx sdk.Context, token uint64, amount sdkmath.Uint) {
+	tokenSunset, _ := k.GetTokenSunset(ctx, token)
+	tokenSunset.Token = token
+	tokenSunset.Minted = tokenSunset.MintedAmount().Add(amount).String()
+	k.SetTokenSunset(ctx, tokenSunset)
+}
+
+// subMinted decreases the amount of uslf minted for the given token
+func (k Keeper) subMinted(ctx sdk.Context, token uint64, amount sdkmath.Uint) {
+	tokenSunset, found := k.GetTokenSunset(ctx, token)
+	if !found {
+		return
+	}
+
+	minted := tokenSunset.MintedAmount()
+	tokenSunset.Minted = minted.Sub(sdkmath.MinUint(minted, amount)).String()
+	k.SetTokenSunset(ctx, tokenSunset)
+}
+
+// recordPeakRatio keeps track of the highest ratio migrations of the given token have been converted at
+func (k Keeper) recordPeakRatio(ctx sdk.Context, token uint64, ratio uint64) {
+	tokenSunset, _ := k.GetTokenSunset(ctx, token)
+	if ratio <= tokenSunset.PeakRatio {
+		return
+	}
+
+	tokenSunset.Token = token
+	tokenSunset.PeakRatio = ratio
+	k.SetTokenSunset(ctx, tokenSunset)
+}
+
+// withdrawMinted takes withdrawn uslf out of the amount minted for the given token. The amount minted
+// before minted amounts were tracked is used once the tracked one is exhausted.
+func (k Keeper) withdrawMinted(ctx sdk.Context, token uint64, amount sdkmath.Uint) error {
+	tokenSunset, _ := k.GetTokenSunset(ctx, token)
+	if amount.GT(tokenSunset.WithdrawableAmount()) {
+		return types.ErrWithdrawalExceedsMinted
+	}
+
+	minted := tokenSunset.MintedAmount()
+	fromMinted := sdkmath.MinUint(minted, amount)
+	tokenSunset.Token = token
+	tokenSunset.Minted = minted.Sub(fromMinted).String()
+	if fromLegacy := amount.Sub(fromMinted); !fromLegacy.IsZero() {
+		tokenSunset.LegacyMinted = tokenSunset.LegacyMintedAmount().Sub(fromLegacy).String()
+	}
+	k.SetTokenSunset(ctx, tokenSunset)
+
+	return nil
+}
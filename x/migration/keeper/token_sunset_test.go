@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/testutil/nullify"
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNTokenSunset(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.TokenSunset {
+	items := make([]types.TokenSunset, n)
+	for i := range items {
+		items[i].Token = uint64(i)
+
+		keeper.SetTokenSunset(ctx, items[i])
+	}
+	return items
+}
+
+func TestTokenSunsetGet(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNTokenSunset(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetTokenSunset(ctx,
+			item.Token,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestTokenSunsetGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNTokenSunset(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllTokenSunset(ctx)),
+	)
+}
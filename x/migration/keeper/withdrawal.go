@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	costypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetWithdrawalCount get the total number of withdrawal
+func (k Keeper) GetWithdrawalCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.WithdrawalCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetWithdrawalCount set the total number of withdrawal
+func (k Keeper) SetWithdrawalCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.WithdrawalCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendWithdrawal appends a withdrawal in the store with a new id and update the count
+func (k Keeper) AppendWithdrawal(
+	ctx sdk.Context,
+	withdrawal types.Withdrawal,
+) uint64 {
+	// Create the withdrawal
+	count := k.GetWithdrawalCount(ctx)
+
+	// Set the ID of the appended value
+	withdrawal.Id = count
+
+	k.SetWithdrawal(ctx, withdrawal)
+
+	// Update withdrawal count
+	k.SetWithdrawalCount(ctx, count+1)
+
+	return count
+}
+
+// SetWithdrawal set a specific withdrawal in the store
+func (k Keeper) SetWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawalKey))
+	b := k.cdc.MustMarshal(&withdrawal)
+	store.Set(GetWithdrawalIDBytes(withdrawal.Id), b)
+}
+
+// GetWithdrawal returns a withdrawal from its id
+func (k Keeper) GetWithdrawal(ctx sdk.Context, id uint64) (val types.Withdrawal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawalKey))
+	b := store.Get(GetWithdrawalIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllWithdrawal returns all withdrawal
+func (k Keeper) GetAllWithdrawal(ctx sdk.Context) (list []types.Withdrawal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawalKey))
+	iterator := costypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Withdrawal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetWithdrawalIDBytes returns the byte representation of the ID
+func GetWithdrawalIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// InsertWithdrawalQueue adds an open withdrawal to the queue of the withdrawals to refund
+func (k Keeper) InsertWithdrawalQueue(ctx sdk.Context, withdrawal types.Withdrawal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawalQueueKeyPrefix))
+	store.Set(types.WithdrawalQueueKey(withdrawal.RefundAt, withdrawal.Id), GetWithdrawalIDBytes(withdrawal.Id))
+}
+
+// RemoveFromWithdrawalQueue removes a withdrawal from the queue of the withdrawals to refund
+func (k Keeper) RemoveFromWithdrawalQueue(ctx sdk.Context, withdrawal types.Withdrawal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawalQueueKeyPrefix))
+	store.Delete(types.WithdrawalQueueKey(withdrawal.RefundAt, withdrawal.Id))
+}
+
+// refundableWithdrawals returns the ids of the open withdrawals whose refund time has been reached
+func (k Keeper) refundableWithdrawals(ctx sdk.Context) (ids []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawalQueueKeyPrefix))
+	iterator := store.Iterator(nil, types.WithdrawalQueueKey(uint64(ctx.BlockTime().Unix())+1, 0))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+
+	return
+}
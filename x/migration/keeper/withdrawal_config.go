@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetWithdrawalConfig set withdrawalConfig in the store
+func (k Keeper) SetWithdrawalConfig(ctx sdk.Context, withdrawalConfig types.WithdrawalConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawalConfigKey))
+	b := k.cdc.MustMarshal(&withdrawalConfig)
+	store.Set([]byte{0}, b)
+}
+
+// GetWithdrawalConfig returns withdrawalConfig
+func (k Keeper) GetWithdrawalConfig(ctx sdk.Context) (val types.WithdrawalConfig, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawalConfigKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
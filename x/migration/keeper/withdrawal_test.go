@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/testutil/nullify"
+	"selfchain/x/migration/keeper"
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNWithdrawal(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Withdrawal {
+	items := make([]types.Withdrawal, n)
+	for i := range items {
+		items[i].Id = keeper.AppendWithdrawal(ctx, items[i])
+	}
+	return items
+}
+
+func TestWithdrawalGet(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNWithdrawal(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetWithdrawal(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestWithdrawalGetAll(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNWithdrawal(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllWithdrawal(ctx)),
+	)
+}
+
+func TestWithdrawalCount(t *testing.T) {
+	keeper, ctx := keepertest.MigrationKeeper(t)
+	items := createNWithdrawal(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetWithdrawalCount(ctx))
+}
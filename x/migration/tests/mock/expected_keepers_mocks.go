@@ -5,12 +5,14 @@
 package test
 
 import (
+	context "context"
 	reflect "reflect"
+	types0 "selfchain/x/selfvesting/types"
+	time "time"
 
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
-	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "github.com/golang/mock/gomock"
-	selfvestingTypes "selfchain/x/selfvesting/types"
 )
 
 // MockAccountKeeper is a mock of AccountKeeper interface.
@@ -37,10 +39,10 @@ func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
 }
 
 // GetAccount mocks base method.
-func (m *MockAccountKeeper) GetAccount(ctx types.Context, addr types.AccAddress) types0.AccountI {
+func (m *MockAccountKeeper) GetAccount(ctx context.Context, addr types.AccAddress) types.AccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAccount", ctx, addr)
-	ret0, _ := ret[0].(types0.AccountI)
+	ret0, _ := ret[0].(types.AccountI)
 	return ret0
 }
 
@@ -73,8 +75,22 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amounts types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, moduleName, amounts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amounts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amounts)
+}
+
 // MintCoins mocks base method.
-func (m *MockBankKeeper) MintCoins(ctx types.Context, moduleName string, amounts types.Coins) error {
+func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amounts types.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MintCoins", ctx, moduleName, amounts)
 	ret0, _ := ret[0].(error)
@@ -87,8 +103,22 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amounts interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amounts)
 }
 
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
 // SendCoinsFromModuleToAccount mocks base method.
-func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx types.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
 	ret0, _ := ret[0].(error)
@@ -101,36 +131,189 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
+// SpendableCoin mocks base method.
+func (m *MockBankKeeper) SpendableCoin(ctx context.Context, addr types.AccAddress, denom string) types.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableCoin", ctx, addr, denom)
+	ret0, _ := ret[0].(types.Coin)
+	return ret0
+}
+
+// SpendableCoin indicates an expected call of SpendableCoin.
+func (mr *MockBankKeeperMockRecorder) SpendableCoin(ctx, addr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoin", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoin), ctx, addr, denom)
+}
+
+// MockDistrKeeper is a mock of DistrKeeper interface.
+type MockDistrKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistrKeeperMockRecorder
+}
+
+// MockDistrKeeperMockRecorder is the mock recorder for MockDistrKeeper.
+type MockDistrKeeperMockRecorder struct {
+	mock *MockDistrKeeper
+}
+
+// NewMockDistrKeeper creates a new mock instance.
+func NewMockDistrKeeper(ctrl *gomock.Controller) *MockDistrKeeper {
+	mock := &MockDistrKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistrKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistrKeeper) EXPECT() *MockDistrKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockDistrKeeper) FundCommunityPool(ctx context.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockDistrKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistrKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
+
+// MockSelfvestingKeeper is a mock of SelfvestingKeeper interface.
 type MockSelfvestingKeeper struct {
 	ctrl     *gomock.Controller
 	recorder *MockSelfvestingKeeperMockRecorder
 }
 
+// MockSelfvestingKeeperMockRecorder is the mock recorder for MockSelfvestingKeeper.
 type MockSelfvestingKeeperMockRecorder struct {
 	mock *MockSelfvestingKeeper
 }
 
+// NewMockSelfvestingKeeper creates a new mock instance.
 func NewMockSelfvestingKeeper(ctrl *gomock.Controller) *MockSelfvestingKeeper {
 	mock := &MockSelfvestingKeeper{ctrl: ctrl}
 	mock.recorder = &MockSelfvestingKeeperMockRecorder{mock}
 	return mock
 }
 
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockSelfvestingKeeper) EXPECT() *MockSelfvestingKeeperMockRecorder {
 	return m.recorder
 }
 
-func (m *MockSelfvestingKeeper) AddBeneficiary(
-	ctx types.Context,
-	req selfvestingTypes.AddBeneficiaryRequest,
-) (*selfvestingTypes.VestingInfo, error) {
+// AddBeneficiary mocks base method.
+func (m *MockSelfvestingKeeper) AddBeneficiary(ctx types.Context, req types0.AddBeneficiaryRequest) (*types0.VestingInfo, uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddBeneficiary", ctx, req)
-	ret0, _ := ret[0].(error)
-	return nil, ret0
+	ret0, _ := ret[0].(*types0.VestingInfo)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
+// AddBeneficiary indicates an expected call of AddBeneficiary.
 func (mr *MockSelfvestingKeeperMockRecorder) AddBeneficiary(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBeneficiary", reflect.TypeOf((*MockSelfvestingKeeper)(nil).AddBeneficiary), ctx, req)
 }
+
+// ClawbackPosition mocks base method.
+func (m *MockSelfvestingKeeper) ClawbackPosition(ctx types.Context, positionId uint64) (math.Uint, math.Uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClawbackPosition", ctx, positionId)
+	ret0, _ := ret[0].(math.Uint)
+	ret1, _ := ret[1].(math.Uint)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ClawbackPosition indicates an expected call of ClawbackPosition.
+func (mr *MockSelfvestingKeeperMockRecorder) ClawbackPosition(ctx, positionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClawbackPosition", reflect.TypeOf((*MockSelfvestingKeeper)(nil).ClawbackPosition), ctx, positionId)
+}
+
+// GetLegacyPositionIndex mocks base method.
+func (m *MockSelfvestingKeeper) GetLegacyPositionIndex(ctx types.Context, beneficiary string, index uint64) (types0.LegacyPositionIndex, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLegacyPositionIndex", ctx, beneficiary, index)
+	ret0, _ := ret[0].(types0.LegacyPositionIndex)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetLegacyPositionIndex indicates an expected call of GetLegacyPositionIndex.
+func (mr *MockSelfvestingKeeperMockRecorder) GetLegacyPositionIndex(ctx, beneficiary, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLegacyPositionIndex", reflect.TypeOf((*MockSelfvestingKeeper)(nil).GetLegacyPositionIndex), ctx, beneficiary, index)
+}
+
+// GrantFeeAllowance mocks base method.
+func (m *MockSelfvestingKeeper) GrantFeeAllowance(ctx types.Context, beneficiary string, spendLimit types.Coins, expiration time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantFeeAllowance", ctx, beneficiary, spendLimit, expiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantFeeAllowance indicates an expected call of GrantFeeAllowance.
+func (mr *MockSelfvestingKeeperMockRecorder) GrantFeeAllowance(ctx, beneficiary, spendLimit, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantFeeAllowance", reflect.TypeOf((*MockSelfvestingKeeper)(nil).GrantFeeAllowance), ctx, beneficiary, spendLimit, expiration)
+}
+
+// MockWasmKeeper is a mock of WasmKeeper interface.
+type MockWasmKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockWasmKeeperMockRecorder
+}
+
+// MockWasmKeeperMockRecorder is the mock recorder for MockWasmKeeper.
+type MockWasmKeeperMockRecorder struct {
+	mock *MockWasmKeeper
+}
+
+// NewMockWasmKeeper creates a new mock instance.
+func NewMockWasmKeeper(ctrl *gomock.Controller) *MockWasmKeeper {
+	mock := &MockWasmKeeper{ctrl: ctrl}
+	mock.recorder = &MockWasmKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWasmKeeper) EXPECT() *MockWasmKeeperMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockWasmKeeper) Execute(ctx types.Context, contractAddress, caller types.AccAddress, msg []byte, coins types.Coins) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, contractAddress, caller, msg, coins)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockWasmKeeperMockRecorder) Execute(ctx, contractAddress, caller, msg, coins interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockWasmKeeper)(nil).Execute), ctx, contractAddress, caller, msg, coins)
+}
@@ -6,6 +6,7 @@ import (
 
 	selfvestingTypes "selfchain/x/selfvesting/types"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -14,7 +15,7 @@ func coinsOf(amount uint64) sdk.Coins {
 	return sdk.Coins{
 		sdk.Coin{
 			Denom:  types.DENOM,
-			Amount: sdk.NewInt(int64(amount)),
+			Amount: sdkmath.NewInt(int64(amount)),
 		},
 	}
 }
@@ -31,3 +32,29 @@ func (escrow *MockBankKeeper) ExpectReceiveCoins(context context.Context, module
 func (escrow *MockBankKeeper) ExpectMintToModule(context context.Context, amount uint64) *gomock.Call {
 	return escrow.EXPECT().MintCoins(sdk.UnwrapSDKContext(context), selfvestingTypes.ModuleName, coinsOf(amount))
 }
+
+func (escrow *MockBankKeeper) ExpectBurnFromModule(context context.Context, module string, amount uint64) *gomock.Call {
+	return escrow.EXPECT().BurnCoins(sdk.UnwrapSDKContext(context), module, coinsOf(amount))
+}
+
+func (escrow *MockBankKeeper) ExpectSpendableBalance(context context.Context, who string, amount uint64) *gomock.Call {
+	whoAddr, err := sdk.AccAddressFromBech32(who)
+	if err != nil {
+		panic(err)
+	}
+
+	return escrow.EXPECT().SpendableCoin(sdk.UnwrapSDKContext(context), whoAddr, types.DENOM).Return(coinsOf(amount)[0])
+}
+
+func (escrow *MockBankKeeper) ExpectSendToModule(context context.Context, who string, module string, amount uint64) *gomock.Call {
+	whoAddr, err := sdk.AccAddressFromBech32(who)
+	if err != nil {
+		panic(err)
+	}
+
+	return escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), whoAddr, module, coinsOf(amount))
+}
+
+func (escrow *MockBankKeeper) ExpectSendModuleToModule(context context.Context, senderModule string, recipientModule string, amount uint64) *gomock.Call {
+	return escrow.EXPECT().SendCoinsFromModuleToModule(sdk.UnwrapSDKContext(context), senderModule, recipientModule, coinsOf(amount))
+}
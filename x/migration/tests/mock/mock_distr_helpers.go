@@ -0,0 +1,15 @@
+package test
+
+import (
+	"context"
+
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+func (distr *MockDistrKeeper) ExpectFundCommunityPool(context context.Context, amount uint64) *gomock.Call {
+	return distr.EXPECT().FundCommunityPool(sdk.UnwrapSDKContext(context), coinsOf(amount), authtypes.NewModuleAddress(types.ModuleName))
+}
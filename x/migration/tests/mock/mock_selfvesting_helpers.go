@@ -2,9 +2,11 @@ package test
 
 import (
 	"context"
+	"time"
 
 	selfvestingTypes "selfchain/x/selfvesting/types"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -12,3 +14,21 @@ import (
 func (vesting *MockSelfvestingKeeper) ExpectAddBeneficiary(context context.Context, req selfvestingTypes.AddBeneficiaryRequest) *gomock.Call {
 	return vesting.EXPECT().AddBeneficiary(sdk.UnwrapSDKContext(context), req)
 }
+
+func (vesting *MockSelfvestingKeeper) ExpectClawbackPosition(context context.Context, positionId uint64, clawedBack uint64, claimed uint64) *gomock.Call {
+	return vesting.EXPECT().
+		ClawbackPosition(sdk.UnwrapSDKContext(context), positionId).
+		Return(sdkmath.NewUint(clawedBack), sdkmath.NewUint(claimed), nil)
+}
+
+func (vesting *MockSelfvestingKeeper) ExpectLegacyPositionIndex(context context.Context, beneficiary string, index uint64, positionId uint64) *gomock.Call {
+	return vesting.EXPECT().
+		GetLegacyPositionIndex(sdk.UnwrapSDKContext(context), beneficiary, index).
+		Return(selfvestingTypes.LegacyPositionIndex{Beneficiary: beneficiary, Index: index, PositionId: positionId}, true)
+}
+
+func (vesting *MockSelfvestingKeeper) ExpectGrantFeeAllowance(context context.Context, beneficiary string, spendLimit uint64, expiration time.Time) *gomock.Call {
+	return vesting.EXPECT().
+		GrantFeeAllowance(sdk.UnwrapSDKContext(context), beneficiary, coinsOf(spendLimit), expiration).
+		Return(nil)
+}
@@ -0,0 +1,20 @@
+package test
+
+import (
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// ExpectCallback expects the migration module to execute the callback on the contract. The callback runs in
+// a cached context hence the context isn't matched.
+func (wasm *MockWasmKeeper) ExpectCallback(contract string, callbackMsg string, amount uint64) *gomock.Call {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		panic(err)
+	}
+
+	return wasm.EXPECT().Execute(gomock.Any(), contractAddr, authtypes.NewModuleAddress(types.ModuleName), []byte(callbackMsg), coinsOf(amount))
+}
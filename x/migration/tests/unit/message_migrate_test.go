@@ -1,6 +1,9 @@
 package test
 
 import (
+	"crypto/sha256"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"selfchain/testutil/sample"
 	test "selfchain/x/migration/tests"
@@ -135,3 +138,60 @@ func TestValidateBasicEmptyStringValues(t *testing.T) {
 	err2 := msg2.ValidateBasic()
 	require.ErrorIs(t, err2, types.ErrEmptyStringValue)
 }
+
+func TestValidateBasicContractCallback(t *testing.T) {
+	msg := types.MsgMigrate{
+		Creator:         sample.AccAddress(),
+		EthAddress:      "baf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+		DestAddress:     "self184l076mmmeumx2cw6eqdct7tchufux0v43cxcg",
+		Amount:          "2000000000000000000",
+		Token:           uint64(types.Front),
+		TxHash:          "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+		ContractAddress: sample.AccAddress(),
+		CallbackMsg:     `{"stake":{}}`,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	// The callback must be JSON
+	msg.CallbackMsg = "stake"
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidCallback)
+
+	// A callback needs a contract
+	msg.CallbackMsg = `{"stake":{}}`
+	msg.ContractAddress = ""
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidCallback)
+
+	// A contract needs a callback
+	msg.CallbackMsg = ""
+	msg.ContractAddress = sample.AccAddress()
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidCallback)
+}
+
+func TestMsgMigrate_Hash(t *testing.T) {
+	msg := types.MsgMigrate{
+		EthAddress:  "baf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+		DestAddress: test.Alice,
+		Amount:      "1000000000000000000000000",
+		Token:       uint64(types.Front),
+		TxHash:      "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+		LogIndex:    0,
+	}
+
+	// Migrations from Ethereum mainnet keep the hash they had before other chains were supported
+	legacyHash := fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf(
+		"%s|%s|%s|%d|%s|%d",
+		msg.EthAddress,
+		msg.DestAddress,
+		msg.Amount,
+		msg.Token,
+		msg.TxHash,
+		msg.LogIndex,
+	))))
+	require.Equal(t, legacyHash, msg.Hash())
+
+	msg.SourceChainId = types.EthereumChainId
+	require.Equal(t, legacyHash, msg.Hash())
+
+	msg.SourceChainId = 56
+	require.NotEqual(t, legacyHash, msg.Hash())
+}
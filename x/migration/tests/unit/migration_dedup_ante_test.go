@@ -0,0 +1,147 @@
+package test
+
+import (
+	"testing"
+
+	"selfchain/x/migration/ante"
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) {
+	return nil, nil
+}
+
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+// checkTx runs the dedup decorator on the messages the way CheckTx does, i.e. on a branch of the check
+// state that is only kept if the ante handler accepts the transaction
+func checkTx(ctx sdk.Context, decorator ante.MigrationDedupDecorator, msgs ...sdk.Msg) error {
+	cacheCtx, write := ctx.WithIsCheckTx(true).CacheContext()
+	_, err := decorator.AnteHandle(cacheCtx, mockTx{msgs: msgs}, false, nextAnteHandler)
+	if err == nil {
+		write()
+	}
+
+	return err
+}
+
+func TestAnteShouldRejectProcessedMigration(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	_, err := server.Migrate(ctx, oneFrontFrom(0))
+	require.NoError(t, err)
+
+	decorator := ante.NewMigrationDedupDecorator(k)
+	err = checkTx(sdk.UnwrapSDKContext(ctx), decorator, oneFrontFrom(0))
+	require.ErrorIs(t, err, types.ErrMigrationProcessed)
+}
+
+func TestAnteShouldRejectMigrationPendingInMempool(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decorator := ante.NewMigrationDedupDecorator(k)
+
+	require.NoError(t, checkTx(sdkCtx, decorator, oneFrontFrom(0)))
+
+	// The other migrator racing on the same deposit is rejected
+	racing := oneFrontFrom(0)
+	racing.Creator = test.Migrator_2
+	require.ErrorIs(t, checkTx(sdkCtx, decorator, racing), types.ErrMigrationPending)
+
+	// Another deposit is accepted
+	other := oneFrontFrom(0)
+	other.LogIndex = 1
+	require.NoError(t, checkTx(sdkCtx, decorator, other))
+
+	// The same deposit twice in a transaction is rejected as well
+	twice := oneFrontFrom(0)
+	twice.LogIndex = 2
+	require.ErrorIs(t, checkTx(sdkCtx, decorator, twice, twice), types.ErrMigrationPending)
+}
+
+func TestAnteShouldRejectMigrationNestedInAuthzExec(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decorator := ante.NewMigrationDedupDecorator(k)
+
+	grantee := sdk.MustAccAddressFromBech32(test.Migrator_2)
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{oneFrontFrom(0)})
+	require.NoError(t, checkTx(sdkCtx, decorator, &exec))
+
+	require.ErrorIs(t, checkTx(sdkCtx, decorator, oneFrontFrom(0)), types.ErrMigrationPending)
+
+	nested := authz.NewMsgExec(grantee, []sdk.Msg{&exec})
+	require.ErrorIs(t, checkTx(sdkCtx, decorator, &nested), types.ErrMigrationPending)
+}
+
+func TestAnteShouldOnlyMarkMigrationsOfMigrators(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decorator := ante.NewMigrationDedupDecorator(k)
+
+	// Copying the message of a migrator doesn't keep the migrator out of the mempool
+	copied := oneFrontFrom(0)
+	copied.Creator = test.Carol
+	require.NoError(t, checkTx(sdkCtx, decorator, copied))
+	require.NoError(t, checkTx(sdkCtx, decorator, oneFrontFrom(0)))
+}
+
+func TestAnteShouldNotMarkMigrationsExecutedByOthers(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decorator := ante.NewMigrationDedupDecorator(k)
+
+	// The MsgExec of an attacker claiming to act for the migrator doesn't hold the deposit
+	attacker := sdk.MustAccAddressFromBech32(test.Carol)
+	exec := authz.NewMsgExec(attacker, []sdk.Msg{oneFrontFrom(0)})
+	require.NoError(t, checkTx(sdkCtx, decorator, &exec))
+
+	require.NoError(t, checkTx(sdkCtx, decorator, &exec))
+	require.NoError(t, checkTx(sdkCtx, decorator, oneFrontFrom(0)))
+}
+
+func TestAnteShouldOnlyCheckTransactionsInCheckTx(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decorator := ante.NewMigrationDedupDecorator(k)
+
+	require.NoError(t, checkTx(sdkCtx, decorator, oneFrontFrom(0)))
+
+	// Blocks are executed regardless of what went through the mempool
+	_, err := decorator.AnteHandle(sdkCtx.WithIsCheckTx(false), mockTx{msgs: []sdk.Msg{oneFrontFrom(0)}}, false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// And so are simulations
+	_, err = decorator.AnteHandle(sdkCtx.WithIsCheckTx(true), mockTx{msgs: []sdk.Msg{oneFrontFrom(0)}}, true, nextAnteHandler)
+	require.NoError(t, err)
+}
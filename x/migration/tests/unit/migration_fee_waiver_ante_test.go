@@ -0,0 +1,267 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"selfchain/x/migration/ante"
+	"selfchain/x/migration/keeper"
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockFeeTx struct {
+	mockTx
+	gas     uint64
+	granter sdk.AccAddress
+}
+
+func (tx mockFeeTx) GetGas() uint64 {
+	return tx.gas
+}
+
+func (tx mockFeeTx) GetFee() sdk.Coins {
+	return uslf(1000)
+}
+
+func (tx mockFeeTx) FeePayer() []byte {
+	return nil
+}
+
+func (tx mockFeeTx) FeeGranter() []byte {
+	return tx.granter
+}
+
+// deductFeeRecorder stands for the SDK fee decorator and records whether it has been called
+type deductFeeRecorder struct {
+	called *bool
+}
+
+func (d deductFeeRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.called = true
+	return next(ctx, tx, simulate)
+}
+
+// mockAccountKeeper provides the SDK fee decorator with the accounts of any fee payer
+type mockAccountKeeper struct {
+	authante.AccountKeeper
+}
+
+func (mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+// withPriority is a fee checker accepting any fee and giving the transactions the same priority
+func withPriority(priority int64) authante.TxFeeChecker {
+	return func(_ sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		return tx.(sdk.FeeTx).GetFee(), priority, nil
+	}
+}
+
+// runFeeDecorator runs the fee decorator on a transaction, checking its fee with the given checker, and
+// returns whether its fee has been waived along with the context passed to the next decorator
+func runFeeDecorator(ctx sdk.Context, k keeper.Keeper, txFeeChecker authante.TxFeeChecker, tx mockFeeTx) (bool, sdk.Context, error) {
+	deducted := false
+	decorator := ante.NewMigratorFeeDecorator(k, mockAccountKeeper{}, nil, nil, txFeeChecker, deductFeeRecorder{called: &deducted})
+	newCtx, err := decorator.AnteHandle(ctx, tx, false, nextAnteHandler)
+
+	return !deducted, newCtx, err
+}
+
+// isFeeWaived runs the fee decorator on a transaction of the given gas and returns whether its fee has been waived
+func isFeeWaived(ctx sdk.Context, k keeper.Keeper, gas uint64, msgs ...sdk.Msg) bool {
+	waived, _, err := runFeeDecorator(ctx, k, withPriority(0), mockFeeTx{mockTx: mockTx{msgs: msgs}, gas: gas})
+	if err != nil {
+		panic(err)
+	}
+
+	return waived
+}
+
+func setFeeWaiverConfig(ctx sdk.Context, k keeper.Keeper) {
+	k.SetFeeWaiverConfig(ctx, types.FeeWaiverConfig{
+		BlockGasBudget:   500000,
+		MigratorGasQuota: 300000,
+	})
+}
+
+func TestSetFeeWaiverConfigShouldFailIfNotAdmin(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetFeeWaiverConfig(ctx, &types.MsgSetFeeWaiverConfig{
+		Creator:          test.Alice,
+		BlockGasBudget:   500000,
+		MigratorGasQuota: 300000,
+	})
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+
+	_, err = server.SetFeeWaiverConfig(ctx, &types.MsgSetFeeWaiverConfig{
+		Creator:          test.AclAdmin,
+		BlockGasBudget:   500000,
+		MigratorGasQuota: 300000,
+	})
+	require.NoError(t, err)
+
+	res, err := k.FeeWaiverConfig(ctx, &types.QueryGetFeeWaiverConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500000), res.FeeWaiverConfig.BlockGasBudget)
+	require.Equal(t, uint64(300000), res.FeeWaiverConfig.MigratorGasQuota)
+}
+
+func TestShouldNotWaiveFeeIfDisabled(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+
+	require.False(t, isFeeWaived(sdkCtx, k, 100000, oneFrontFrom(0)))
+
+	k.SetFeeWaiverConfig(sdkCtx, types.FeeWaiverConfig{})
+	require.False(t, isFeeWaived(sdkCtx, k, 100000, oneFrontFrom(0)))
+}
+
+func TestShouldWaiveFeeOfMigratorsWithinQuota(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+	setFeeWaiverConfig(sdkCtx, k)
+
+	fromMigrator2 := oneFrontFrom(0)
+	fromMigrator2.Creator = test.Migrator_2
+
+	require.True(t, isFeeWaived(sdkCtx, k, 200000, oneFrontFrom(0)))
+	require.Equal(t, uint64(200000), k.GetMigratorFeeWaiverUsage(sdkCtx, test.Migrator_1).GasUsed)
+
+	// Migrator_1 has 100000 gas left in this block
+	require.False(t, isFeeWaived(sdkCtx, k, 150000, oneFrontFrom(0)))
+	require.True(t, isFeeWaived(sdkCtx, k, 100000, oneFrontFrom(0)))
+
+	// The block has 200000 gas left
+	require.False(t, isFeeWaived(sdkCtx, k, 250000, fromMigrator2))
+	require.True(t, isFeeWaived(sdkCtx, k, 200000, fromMigrator2))
+	require.False(t, isFeeWaived(sdkCtx, k, 1, fromMigrator2))
+	require.Equal(t, types.FeeWaiverUsage{Height: 10, GasUsed: 500000}, k.GetFeeWaiverUsage(sdkCtx))
+
+	// The budget and the quotas are renewed every block
+	sdkCtx = sdkCtx.WithBlockHeight(11)
+	require.True(t, isFeeWaived(sdkCtx, k, 300000, oneFrontFrom(0)))
+	require.Equal(t, types.FeeWaiverUsage{Height: 11, GasUsed: 300000}, k.GetFeeWaiverUsage(sdkCtx))
+}
+
+func TestShouldNotWaiveFeeOnceConfigLoweredBelowUsage(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+	setFeeWaiverConfig(sdkCtx, k)
+
+	require.True(t, isFeeWaived(sdkCtx, k, 250000, oneFrontFrom(0)))
+
+	// Lowering the quota below what Migrator_1 already used doesn't give it more gas
+	k.SetFeeWaiverConfig(sdkCtx, types.FeeWaiverConfig{
+		BlockGasBudget:   500000,
+		MigratorGasQuota: 200000,
+	})
+	require.False(t, isFeeWaived(sdkCtx, k, 1, oneFrontFrom(0)))
+
+	// And neither does lowering the budget below what the block already used
+	fromMigrator2 := oneFrontFrom(0)
+	fromMigrator2.Creator = test.Migrator_2
+	k.SetFeeWaiverConfig(sdkCtx, types.FeeWaiverConfig{
+		BlockGasBudget:   200000,
+		MigratorGasQuota: 300000,
+	})
+	require.False(t, isFeeWaived(sdkCtx, k, 1, fromMigrator2))
+
+	// Gas limits that would overflow the usage are refused as well
+	k.SetFeeWaiverConfig(sdkCtx, types.FeeWaiverConfig{
+		BlockGasBudget:   math.MaxUint64,
+		MigratorGasQuota: math.MaxUint64,
+	})
+	require.False(t, isFeeWaived(sdkCtx, k, math.MaxUint64, fromMigrator2))
+	require.Equal(t, types.FeeWaiverUsage{Height: 10, GasUsed: 250000}, k.GetFeeWaiverUsage(sdkCtx))
+}
+
+func TestShouldOnlyWaiveFeeOfTransactionsMadeOfMigrations(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+	setFeeWaiverConfig(sdkCtx, k)
+
+	fromMigrator2 := oneFrontFrom(0)
+	fromMigrator2.Creator = test.Migrator_2
+	notFromMigrator := oneFrontFrom(0)
+	notFromMigrator.Creator = test.Alice
+
+	// Migrators pay for anything else than migrations
+	require.False(t, isFeeWaived(sdkCtx, k, 100000, oneFrontFrom(0), &types.MsgAddMigrator{Creator: test.Migrator_1}))
+
+	// Only registered migrators are exempted
+	require.False(t, isFeeWaived(sdkCtx, k, 100000, notFromMigrator))
+
+	// The quota is per migrator so migrations of several migrators can't be mixed
+	require.False(t, isFeeWaived(sdkCtx, k, 100000, oneFrontFrom(0), fromMigrator2))
+
+	require.False(t, isFeeWaived(sdkCtx, k, 100000))
+	require.Equal(t, types.FeeWaiverUsage{}, k.GetFeeWaiverUsage(sdkCtx))
+}
+
+func TestShouldCheckFeeOfWaivedTransactions(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+	setFeeWaiverConfig(sdkCtx, k)
+
+	tx := mockFeeTx{mockTx: mockTx{msgs: []sdk.Msg{oneFrontFrom(0)}}, gas: 100000}
+
+	// The fee still has to meet the minimum gas prices of the validator
+	insufficientFee := func(sdk.Context, sdk.Tx) (sdk.Coins, int64, error) {
+		return nil, 0, sdkerrors.ErrInsufficientFee
+	}
+	_, _, err := runFeeDecorator(sdkCtx, k, insufficientFee, tx)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// and gives the transaction its priority
+	waived, newCtx, err := runFeeDecorator(sdkCtx, k, withPriority(10), tx)
+	require.NoError(t, err)
+	require.True(t, waived)
+	require.Equal(t, int64(10), newCtx.Priority())
+}
+
+func TestShouldNotWaiveFeeOfGrantedTransactions(t *testing.T) {
+	_, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+	setFeeWaiverConfig(sdkCtx, k)
+
+	tx := mockFeeTx{
+		mockTx:  mockTx{msgs: []sdk.Msg{oneFrontFrom(0)}},
+		gas:     100000,
+		granter: sdk.MustAccAddressFromBech32(test.Alice),
+	}
+	waived, _, err := runFeeDecorator(sdkCtx, k, func(sdk.Context, sdk.Tx) (sdk.Coins, int64, error) {
+		return nil, 0, errors.New("the fee checker of the waived fees is not called")
+	}, tx)
+	require.NoError(t, err)
+	require.False(t, waived)
+	require.Equal(t, types.FeeWaiverUsage{}, k.GetFeeWaiverUsage(sdkCtx))
+}
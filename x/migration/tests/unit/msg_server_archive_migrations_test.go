@@ -0,0 +1,64 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveMigrationsShouldFailIfNotAdmin(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.ArchiveMigrations(ctx, &types.MsgArchiveMigrations{
+		Creator:     test.Alice,
+		Token:       uint64(types.Front),
+		BeforeBlock: types.MigrationBucketSize,
+	})
+
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+}
+
+func TestArchivedMigrationShouldNotBeReplayedNorReverted(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	msg := oneFrontFrom(0)
+	msg.SourceBlock = 42
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	_, err := server.Migrate(ctx, msg)
+	require.NoError(t, err)
+
+	res, err := server.ArchiveMigrations(ctx, &types.MsgArchiveMigrations{
+		Creator:     test.AclAdmin,
+		Token:       uint64(types.Front),
+		BeforeBlock: types.MigrationBucketSize,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgArchiveMigrationsResponse{Archived: 1, Done: true}, res)
+
+	archive, found := k.GetMigrationArchive(sdk.UnwrapSDKContext(ctx), uint64(types.Front), types.EthereumChainId, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(1), archive.Count)
+	require.Equal(t, msg.Amount, archive.Amount)
+
+	_, err = server.Migrate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrMigrationProcessed)
+
+	_, err = server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   msg.Hash(),
+	})
+	require.ErrorIs(t, err, types.ErrMigrationArchived)
+}
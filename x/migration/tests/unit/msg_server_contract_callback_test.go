@@ -0,0 +1,164 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const callbackMsg = `{"stake":{}}`
+
+// vaultContract is the address of the contract receiving the migrations
+func vaultContract() string {
+	return authtypes.NewModuleAddress("vault").String()
+}
+
+func oneFrontToVault() *types.MsgMigrate {
+	msg := oneFrontFrom(0)
+	msg.ContractAddress = vaultContract()
+	msg.CallbackMsg = callbackMsg
+
+	return msg
+}
+
+func uslf(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(types.DENOM, sdkmath.NewInt(amount)))
+}
+
+func TestShouldMigrateIntoContract(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock, wasmMock := setupWithWasm(t)
+	defer ctrl.Finish()
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), selfvestingTypes.ModuleName, types.ModuleName, uslf(1000000))
+	wasmMock.ExpectCallback(vaultContract(), callbackMsg, 1000000)
+
+	_, err := server.Migrate(ctx, oneFrontToVault())
+	require.NoError(t, err)
+
+	tokenMigrations := k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx))
+	require.Len(t, tokenMigrations, 1)
+	require.Equal(t, vaultContract(), tokenMigrations[0].ContractAddress)
+	require.Equal(t, vaultContract(), tokenMigrations[0].Recipient())
+	require.Equal(t, test.Alice, tokenMigrations[0].DestAddress)
+}
+
+func TestShouldNotMigrateVestingAmountIntoContract(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock, _ := setupWithWasm(t)
+	defer ctrl.Finish()
+
+	msg := oneFrontToVault()
+	msg.Amount = "1000000000000000000000000" // 1 Milion
+
+	// The callback would only carry the instant amount, so the migration is rejected rather than opening a
+	// vesting position for the contract
+	bankMock.ExpectMintToModule(ctx, 1000000000000)
+
+	_, err := server.Migrate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrContractMigrationVests)
+	require.Empty(t, k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx)))
+}
+
+func TestShouldFailMigrationIfFallbackTransferFails(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock, wasmMock := setupWithWasm(t)
+	defer ctrl.Finish()
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), selfvestingTypes.ModuleName, types.ModuleName, uslf(1000000))
+	wasmMock.ExpectCallback(vaultContract(), callbackMsg, 1000000).Return(nil, errors.New("vault is paused"))
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000).Return(errors.New("insufficient funds"))
+
+	_, err := server.Migrate(ctx, oneFrontToVault())
+	require.ErrorContains(t, err, "insufficient funds")
+	require.Empty(t, k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx)))
+}
+
+func TestShouldFallBackToDestinationIfContractFails(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock, wasmMock := setupWithWasm(t)
+	defer ctrl.Finish()
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), selfvestingTypes.ModuleName, types.ModuleName, uslf(1000000))
+	wasmMock.ExpectCallback(vaultContract(), callbackMsg, 1000000).Return(nil, errors.New("vault is paused"))
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+
+	_, err := server.Migrate(ctx, oneFrontToVault())
+	require.NoError(t, err)
+
+	tokenMigrations := k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx))
+	require.Len(t, tokenMigrations, 1)
+	require.Empty(t, tokenMigrations[0].ContractAddress)
+	require.Equal(t, test.Alice, tokenMigrations[0].Recipient())
+
+	events := sdk.UnwrapSDKContext(ctx).EventManager().Events()
+	require.NotEmpty(t, events)
+	failed := events[len(events)-1]
+	require.Equal(t, types.EventTypeContractCallbackFailed, failed.Type)
+	errorAttr, found := failed.GetAttribute(types.AttributeKeyError)
+	require.True(t, found)
+	require.Equal(t, "vault is paused", errorAttr.Value)
+}
+
+func TestShouldFallBackToDestinationIfContractRunsOutOfGas(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock, wasmMock := setupWithWasm(t)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx = sdk.WrapSDKContext(sdkCtx)
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), selfvestingTypes.ModuleName, types.ModuleName, uslf(1000000))
+	wasmMock.ExpectCallback(vaultContract(), callbackMsg, 1000000).
+		DoAndReturn(func(ctx sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress, _ []byte, _ sdk.Coins) ([]byte, error) {
+			ctx.GasMeter().ConsumeGas(types.ContractCallbackGasLimit+1, "endless loop")
+			return nil, nil
+		})
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+
+	_, err := server.Migrate(ctx, oneFrontToVault())
+	require.NoError(t, err)
+
+	tokenMigrations := k.GetAllTokenMigration(sdkCtx)
+	require.Len(t, tokenMigrations, 1)
+	require.Empty(t, tokenMigrations[0].ContractAddress)
+
+	// The gas used by the contract is still paid by the migrator
+	require.GreaterOrEqual(t, sdkCtx.GasMeter().GasConsumed(), types.ContractCallbackGasLimit)
+}
+
+func TestShouldRevertMigrationFromContract(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock, wasmMock := setupWithWasm(t)
+	defer ctrl.Finish()
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), selfvestingTypes.ModuleName, types.ModuleName, uslf(1000000))
+	wasmMock.ExpectCallback(vaultContract(), callbackMsg, 1000000)
+
+	_, err := server.Migrate(ctx, oneFrontToVault())
+	require.NoError(t, err)
+
+	tokenMigrations := k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx))
+	require.Len(t, tokenMigrations, 1)
+
+	// The tokens are recovered from the contract rather than from the destination address
+	bankMock.ExpectSpendableBalance(ctx, vaultContract(), 1000000)
+	bankMock.ExpectSendToModule(ctx, vaultContract(), types.ModuleName, 1000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 1000000)
+
+	res, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   tokenMigrations[0].MsgHash,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1000000", res.Burned)
+}
@@ -0,0 +1,170 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"selfchain/x/migration/keeper"
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const allowanceTime = 1700000000
+
+func setFeeAllowanceConfig(ctx sdk.Context, k keeper.Keeper) {
+	k.SetFeeAllowanceConfig(ctx, types.FeeAllowanceConfig{
+		SpendLimit: "500000",
+		Expiration: 2592000,
+	})
+}
+
+func TestSetFeeAllowanceConfigShouldFailIfNotAdmin(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetFeeAllowanceConfig(ctx, &types.MsgSetFeeAllowanceConfig{
+		Creator:    test.Alice,
+		SpendLimit: "500000",
+		Expiration: 2592000,
+	})
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+
+	_, err = server.SetFeeAllowanceConfig(ctx, &types.MsgSetFeeAllowanceConfig{
+		Creator:    test.AclAdmin,
+		SpendLimit: "500000",
+		Expiration: 2592000,
+	})
+	require.NoError(t, err)
+
+	res, err := k.FeeAllowanceConfig(ctx, &types.QueryGetFeeAllowanceConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "500000", res.FeeAllowanceConfig.SpendLimit)
+	require.Equal(t, uint64(2592000), res.FeeAllowanceConfig.Expiration)
+}
+
+func TestShouldGrantFeeAllowanceInsteadOfReleasingTokens(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, allowanceTime)
+	setFeeAllowanceConfig(sdk.UnwrapSDKContext(ctx), k)
+
+	// Nothing is sent to Alice and the spend limit is kept out of the vesting position
+	bankMock.ExpectMintToModule(ctx, 1000000000000)
+	selfVestingMock.ExpectGrantFeeAllowance(ctx, test.Alice, 500000, time.Unix(allowanceTime+2592000, 0).UTC())
+	selfVestingMock.ExpectAddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    2592000,
+		Amount:      "999999500000",
+	}).Return(nil, uint64(0), nil)
+
+	_, err := server.Migrate(ctx, oneMillionFront())
+	require.NoError(t, err)
+
+	tokenMigrations := k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx))
+	require.Len(t, tokenMigrations, 1)
+	require.Equal(t, "0", tokenMigrations[0].InstantlyReleased)
+	require.Equal(t, "999999500000", tokenMigrations[0].VestedAmount)
+	require.Equal(t, "500000", tokenMigrations[0].FeeAllowance)
+}
+
+func TestShouldReleaseSmallMigrationsDespiteFeeAllowance(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	setFeeAllowanceConfig(sdk.UnwrapSDKContext(ctx), k)
+
+	// There is no vesting position to release so the tokens are sent right away
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+
+	require.NoError(t, migrateOneFront(server, ctx, 0))
+
+	tokenMigrations := k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx))
+	require.Len(t, tokenMigrations, 1)
+	require.Empty(t, tokenMigrations[0].FeeAllowance)
+}
+
+func TestShouldCountFeeAllowanceAsRevertShortfall(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, allowanceTime)
+	setFeeAllowanceConfig(sdk.UnwrapSDKContext(ctx), k)
+
+	bankMock.ExpectMintToModule(ctx, 1000000000000)
+	selfVestingMock.ExpectGrantFeeAllowance(ctx, test.Alice, 500000, time.Unix(allowanceTime+2592000, 0).UTC())
+	selfVestingMock.ExpectAddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    2592000,
+		Amount:      "999999500000",
+	}).Return(nil, uint64(1), nil)
+
+	_, err := server.Migrate(ctx, oneMillionFront())
+	require.NoError(t, err)
+
+	tokenMigrations := k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx))
+	require.Len(t, tokenMigrations, 1)
+
+	selfVestingMock.ExpectClawbackPosition(ctx, 1, 999999500000, 0)
+	bankMock.ExpectBurnFromModule(ctx, selfvestingTypes.ModuleName, 999999500000)
+	bankMock.ExpectSpendableBalance(ctx, test.Alice, 0)
+
+	res, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   tokenMigrations[0].MsgHash,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "999999500000", res.Burned)
+	require.Equal(t, "500000", res.Shortfall)
+}
+
+func TestShouldRecoverPartiallyAfterFeeAllowanceHasBeenSpent(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, allowanceTime)
+	setFeeAllowanceConfig(sdk.UnwrapSDKContext(ctx), k)
+
+	bankMock.ExpectMintToModule(ctx, 1000000000000)
+	selfVestingMock.ExpectGrantFeeAllowance(ctx, test.Alice, 500000, time.Unix(allowanceTime+2592000, 0).UTC())
+	selfVestingMock.ExpectAddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    2592000,
+		Amount:      "999999500000",
+	}).Return(nil, uint64(1), nil)
+
+	_, err := server.Migrate(ctx, oneMillionFront())
+	require.NoError(t, err)
+
+	tokenMigrations := k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx))
+	require.Len(t, tokenMigrations, 1)
+
+	// Alice paid the fees of releasing 200000 uslf with the allowance and only holds 150000 uslf of them
+	selfVestingMock.ExpectClawbackPosition(ctx, 1, 999999300000, 200000)
+	bankMock.ExpectBurnFromModule(ctx, selfvestingTypes.ModuleName, 999999300000)
+	bankMock.ExpectSpendableBalance(ctx, test.Alice, 150000)
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 150000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 150000)
+
+	res, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   tokenMigrations[0].MsgHash,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "150000", res.Recovered)
+	require.Equal(t, "999999450000", res.Burned)
+	require.Equal(t, "550000", res.Shortfall)
+	require.Equal(t, "50000", res.ShortfallSpent)
+	require.Equal(t, "0", res.ShortfallFee)
+	require.Equal(t, "500000", res.ShortfallFeeAllowance)
+}
@@ -0,0 +1,97 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLockupTierShouldFailIfNotAdmin(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetLockupTier(ctx, &types.MsgSetLockupTier{
+		Creator:            test.Alice,
+		Tier:               1,
+		DurationMultiplier: 200,
+		BonusRatio:         110,
+	})
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+
+	_, err = server.RemoveLockupTier(ctx, &types.MsgRemoveLockupTier{
+		Creator: test.Alice,
+		Tier:    1,
+	})
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+}
+
+func TestRemoveLockupTierShouldFailIfUnknown(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.RemoveLockupTier(ctx, &types.MsgRemoveLockupTier{
+		Creator: test.AclAdmin,
+		Tier:    1,
+	})
+	require.ErrorIs(t, err, types.ErrUnknownLockupTier)
+}
+
+func TestMigrateShouldFailWithUnknownLockupTier(t *testing.T) {
+	server, ctx, _, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	msg := oneMillionFront()
+	msg.LockupTier = 1
+
+	_, err := server.Migrate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrUnknownLockupTier)
+}
+
+func TestShouldApplyLockupTierBonus(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetLockupTier(ctx, &types.MsgSetLockupTier{
+		Creator:            test.AclAdmin,
+		Tier:               1,
+		DurationMultiplier: 200, // twice the lockup
+		BonusRatio:         110, // for 10% more SLF
+	})
+	require.NoError(t, err)
+
+	bankMock.ExpectMintToModule(ctx, 1100000000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	selfVestingMock.ExpectAddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    5184000,
+		Amount:      "1099999000000",
+		LockupTier:  1,
+	})
+
+	msg := oneMillionFront()
+	msg.LockupTier = 1
+	_, err = server.Migrate(ctx, msg)
+	require.NoError(t, err)
+
+	tokenMigration, found := k.GetTokenMigration(sdk.UnwrapSDKContext(ctx), msg.Hash())
+	require.True(t, found)
+	require.Equal(t, uint64(1), tokenMigration.LockupTier)
+	require.Equal(t, "1100000000000", tokenMigration.MintedAmount)
+	require.Equal(t, "1099999000000", tokenMigration.VestedAmount)
+
+	// The tier is not part of the hash so the same deposit can't be migrated again under another tier
+	require.Equal(t, oneMillionFront().Hash(), msg.Hash())
+	_, err = server.Migrate(ctx, oneMillionFront())
+	require.ErrorIs(t, err, types.ErrMigrationProcessed)
+}
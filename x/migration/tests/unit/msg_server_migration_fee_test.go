@@ -0,0 +1,129 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func oneMillionFront() *types.MsgMigrate {
+	return &types.MsgMigrate{
+		Creator:     test.Migrator_1,
+		TxHash:      "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+		EthAddress:  "baf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+		DestAddress: test.Alice,
+		Amount:      "1000000000000000000000000", // 1 Milion
+		Token:       uint64(types.Front),
+		LogIndex:    0,
+	}
+}
+
+func TestSetMigrationFeeShouldFailIfNotAdmin(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetMigrationFee(ctx, &types.MsgSetMigrationFee{
+		Creator:     test.Alice,
+		Token:       uint64(types.Front),
+		BasisPoints: 100,
+	})
+
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+}
+
+func TestShouldSendMigrationFeeToCommunityPool(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock, distrMock := setupWithDistr(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetMigrationFee(ctx, &types.MsgSetMigrationFee{
+		Creator:     test.AclAdmin,
+		Token:       uint64(types.Front),
+		BasisPoints: 100, // 1%
+	})
+	require.NoError(t, err)
+
+	bankMock.ExpectMintToModule(ctx, 1000000000000)
+	bankMock.ExpectSendModuleToModule(ctx, selfvestingTypes.ModuleName, types.ModuleName, 10000000000)
+	distrMock.ExpectFundCommunityPool(ctx, 10000000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	selfVestingMock.ExpectAddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    2592000,
+		Amount:      "989999000000",
+	})
+
+	_, err = server.Migrate(ctx, oneMillionFront())
+	require.NoError(t, err)
+
+	tokenMigration, found := k.GetTokenMigration(sdk.UnwrapSDKContext(ctx), oneMillionFront().Hash())
+	require.True(t, found)
+	require.Equal(t, "1000000000000", tokenMigration.MintedAmount)
+	require.Equal(t, "10000000000", tokenMigration.Fee)
+	require.Equal(t, "989999000000", tokenMigration.VestedAmount)
+
+	res, err := k.MigrationTotals(ctx, &types.QueryGetMigrationTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.MigrationTotals.MigrationCount)
+	require.Equal(t, "1000000000000", res.MigrationTotals.MintedAmount)
+	require.Equal(t, "10000000000", res.MigrationTotals.FeesCollected)
+}
+
+func TestShouldSendMigrationFeeToTreasury(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetMigrationFee(ctx, &types.MsgSetMigrationFee{
+		Creator:     test.AclAdmin,
+		Token:       uint64(types.Front),
+		BasisPoints: 500, // 5%
+		Recipient:   types.FeeRecipient_FEE_RECIPIENT_TREASURY,
+		Treasury:    test.Bob,
+	})
+	require.NoError(t, err)
+
+	// 1 FRONT mints 1 SLF which, minus the fee, is instantly released
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.ExpectSendModuleToModule(ctx, selfvestingTypes.ModuleName, types.ModuleName, 50000)
+	bankMock.ExpectReceiveCoins(ctx, types.ModuleName, test.Bob, 50000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 950000)
+
+	require.NoError(t, migrateOneFront(server, ctx, 0))
+
+	tokenMigration, found := k.GetTokenMigration(sdk.UnwrapSDKContext(ctx), oneFrontFrom(0).Hash())
+	require.True(t, found)
+	require.Equal(t, "50000", tokenMigration.Fee)
+	require.Equal(t, "950000", tokenMigration.InstantlyReleased)
+}
+
+func TestShouldWaiveMigrationFee(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetMigrationFee(ctx, &types.MsgSetMigrationFee{
+		Creator:     test.AclAdmin,
+		Token:       uint64(types.Front),
+		BasisPoints: 500,
+		WaiveBelow:  "2000000",
+	})
+	require.NoError(t, err)
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+
+	require.NoError(t, migrateOneFront(server, ctx, 0))
+
+	res, err := k.MigrationTotals(ctx, &types.QueryGetMigrationTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.MigrationTotals.FeesCollected)
+}
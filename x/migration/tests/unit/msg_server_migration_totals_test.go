@@ -0,0 +1,132 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const statsDay = 19675 // 2023-11-14
+
+func TestShouldAggregateMigrationStatistics(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, int64(statsDay*types.SecondsPerDay+3600))
+
+	bankMock.ExpectMintToModule(ctx, 1000000).Times(3)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000).Times(2)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Bob, 1000000)
+
+	require.NoError(t, migrateOneFront(server, ctx, 0))
+
+	msg := oneFrontFrom(0)
+	msg.Creator = test.Migrator_2
+	msg.LogIndex = 1
+	_, err := server.Migrate(ctx, msg)
+	require.NoError(t, err)
+
+	msg = oneFrontFrom(0)
+	msg.Creator = test.Migrator_2
+	msg.DestAddress = test.Bob
+	msg.LogIndex = 2
+	_, err = server.Migrate(ctx, msg)
+	require.NoError(t, err)
+
+	res, err := k.MigrationTotals(ctx, &types.QueryGetMigrationTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.MigrationTotals{
+		MigrationCount:     3,
+		SourceAmount:       "3000000000000000000",
+		MintedAmount:       "3000000",
+		InstantlyReleased:  "3000000",
+		VestedAmount:       "0",
+		UniqueDestinations: 2,
+		FeesCollected:      "0",
+	}, res.MigrationTotals)
+
+	tokenStats, err := k.TokenStats(ctx, &types.QueryGetTokenStatsRequest{Token: uint64(types.Front)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), tokenStats.TokenStats.Totals.MigrationCount)
+	require.Equal(t, uint64(2), tokenStats.TokenStats.Totals.UniqueDestinations)
+
+	migratorStats, err := k.MigratorStats(ctx, &types.QueryGetMigratorStatsRequest{Migrator: test.Migrator_1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), migratorStats.MigratorStats.Totals.MigrationCount)
+	require.Equal(t, "1000000", migratorStats.MigratorStats.Totals.MintedAmount)
+
+	// Alice was first reached by Migrator_1, but she is still a new destination for Migrator_2
+	migratorStats, err = k.MigratorStats(ctx, &types.QueryGetMigratorStatsRequest{Migrator: test.Migrator_2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), migratorStats.MigratorStats.Totals.MigrationCount)
+	require.Equal(t, uint64(2), migratorStats.MigratorStats.Totals.UniqueDestinations)
+	require.Equal(t, "2000000", migratorStats.MigratorStats.Totals.MintedAmount)
+
+	dailyStats, err := k.DailyStats(ctx, &types.QueryGetDailyStatsRequest{Day: statsDay})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), dailyStats.DailyStats.Totals.MigrationCount)
+	require.Equal(t, "3000000", dailyStats.DailyStats.Totals.MintedAmount)
+
+	_, err = k.DailyStats(ctx, &types.QueryGetDailyStatsRequest{Day: statsDay + 1})
+	require.Error(t, err)
+}
+
+func TestShouldTakeRevertedMigrationOutOfStatistics(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, int64(statsDay*types.SecondsPerDay))
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	require.NoError(t, migrateOneFront(server, ctx, 0))
+
+	tokenMigrations := k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx))
+	require.Len(t, tokenMigrations, 1)
+
+	// The revert happens the next day and is counted on that day
+	ctx = atTime(ctx, int64((statsDay+1)*types.SecondsPerDay))
+
+	bankMock.ExpectSpendableBalance(ctx, test.Alice, 1000000)
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 1000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 1000000)
+
+	_, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   tokenMigrations[0].MsgHash,
+	})
+	require.NoError(t, err)
+
+	totals, found := k.GetMigrationTotals(sdk.UnwrapSDKContext(ctx))
+	require.True(t, found)
+	require.Equal(t, types.MigrationTotals{
+		MigrationCount:     1,
+		RevertedCount:      1,
+		SourceAmount:       "0",
+		MintedAmount:       "0",
+		InstantlyReleased:  "0",
+		VestedAmount:       "0",
+		UniqueDestinations: 1,
+		FeesCollected:      "0",
+	}, totals)
+
+	migratorStats, found := k.GetMigratorStats(sdk.UnwrapSDKContext(ctx), test.Migrator_1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), migratorStats.Totals.RevertedCount)
+	require.Equal(t, "0", migratorStats.Totals.MintedAmount)
+
+	dailyStats, found := k.GetDailyStats(sdk.UnwrapSDKContext(ctx), statsDay)
+	require.True(t, found)
+	require.Equal(t, uint64(1), dailyStats.Totals.MigrationCount)
+	require.Equal(t, uint64(0), dailyStats.Totals.RevertedCount)
+
+	dailyStats, found = k.GetDailyStats(sdk.UnwrapSDKContext(ctx), statsDay+1)
+	require.True(t, found)
+	require.Equal(t, uint64(0), dailyStats.Totals.MigrationCount)
+	require.Equal(t, uint64(1), dailyStats.Totals.RevertedCount)
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Hotcross ratio falling linearly from 100% to 50% between 1000 and 1100
+func decayingHotcrossSchedule(creator string) *types.MsgSetRatioSchedule {
+	return &types.MsgSetRatioSchedule{
+		Creator:       creator,
+		Token:         uint64(types.Hotcross),
+		Interpolation: types.RatioInterpolation_RATIO_INTERPOLATION_LINEAR,
+		Points:        []types.RatioPoint{{Time: 1000, Ratio: 100}, {Time: 1100, Ratio: 50}},
+	}
+}
+
+func TestSetRatioScheduleShouldFailIfNotAdmin(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetRatioSchedule(ctx, decayingHotcrossSchedule(test.Alice))
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+
+	_, err = server.RemoveRatioSchedule(ctx, &types.MsgRemoveRatioSchedule{
+		Creator: test.Alice,
+		Token:   uint64(types.Hotcross),
+	})
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+}
+
+func TestRemoveRatioScheduleShouldFailIfUnknown(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.RemoveRatioSchedule(ctx, &types.MsgRemoveRatioSchedule{
+		Creator: test.AclAdmin,
+		Token:   uint64(types.Hotcross),
+	})
+	require.ErrorIs(t, err, types.ErrRatioScheduleNotFound)
+}
+
+func TestShouldMigrateWithScheduledRatio(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetRatioSchedule(ctx, decayingHotcrossSchedule(test.AclAdmin))
+	require.NoError(t, err)
+
+	msg := oneMillionFront()
+	msg.Token = uint64(types.Hotcross)
+
+	// The Hotcross ratio param is not set so the token can't be migrated before the schedule starts
+	_, err = server.Migrate(atTime(ctx, 999), msg)
+	require.ErrorIs(t, err, types.ErrHotcrossRatioZero)
+
+	// Halfway through the decay the ratio is 75%
+	ctx = atTime(ctx, 1050)
+	res, err := k.CurrentRatio(ctx, &types.QueryCurrentRatioRequest{Token: uint64(types.Hotcross)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(75), res.Ratio)
+	require.True(t, res.Scheduled)
+
+	bankMock.ExpectMintToModule(ctx, 750000000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	selfVestingMock.ExpectAddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    2592000,
+		Amount:      "749999000000",
+	})
+
+	_, err = server.Migrate(ctx, msg)
+	require.NoError(t, err)
+
+	tokenMigration, found := k.GetTokenMigration(sdk.UnwrapSDKContext(ctx), msg.Hash())
+	require.True(t, found)
+	require.Equal(t, "750000000000", tokenMigration.MintedAmount)
+}
+
+func TestShouldFallBackToStaticRatio(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetRatioSchedule(ctx, decayingHotcrossSchedule(test.AclAdmin))
+	require.NoError(t, err)
+
+	_, err = server.RemoveRatioSchedule(ctx, &types.MsgRemoveRatioSchedule{
+		Creator: test.AclAdmin,
+		Token:   uint64(types.Hotcross),
+	})
+	require.NoError(t, err)
+
+	k.SetParams(sdk.UnwrapSDKContext(ctx), types.NewParams(25))
+
+	res, err := k.CurrentRatio(atTime(ctx, 1050), &types.QueryCurrentRatioRequest{Token: uint64(types.Hotcross)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), res.Ratio)
+	require.False(t, res.Scheduled)
+
+	res, err = k.CurrentRatio(ctx, &types.QueryCurrentRatioRequest{Token: uint64(types.Front)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.FRONT_RATIO), res.Ratio)
+}
@@ -0,0 +1,189 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevertMigrationShouldFailIfNotAuthority(t *testing.T) {
+	server, ctx, _, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	_, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: test.AclAdmin,
+		MsgHash:   "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+	})
+
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+}
+
+func TestRevertMigrationShouldFailIfUnknownMigration(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	_, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+	})
+
+	require.ErrorIs(t, err, types.ErrMigrationNotFound)
+}
+
+func TestRevertMigrationShouldFailForLegacyRecords(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetTokenMigration(sdk.UnwrapSDKContext(ctx), types.TokenMigration{
+		MsgHash:   "legacy",
+		Processed: true,
+	})
+
+	_, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   "legacy",
+	})
+
+	require.ErrorIs(t, err, types.ErrMigrationNotRevertible)
+}
+
+func TestShouldRevertMigrationAndReportShortfall(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	migrateMsg := &types.MsgMigrate{
+		Creator:     test.Migrator_1,
+		TxHash:      "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+		EthAddress:  "baf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+		DestAddress: test.Alice,
+		Amount:      "1000000000000000000000000", // 1 Milion
+		Token:       0,
+		LogIndex:    0,
+	}
+
+	bankMock.ExpectMintToModule(ctx, 1000000000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	selfVestingMock.ExpectAddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    2592000,
+		Amount:      "999999000000",
+	}).Return(nil, uint64(3), nil)
+
+	_, err := server.Migrate(ctx, migrateMsg)
+	require.NoError(t, err)
+
+	tokenMigrations := k.GetAllTokenMigration(sdk.UnwrapSDKContext(ctx))
+	require.Len(t, tokenMigrations, 1)
+	tokenMigration := tokenMigrations[0]
+	require.Equal(t, "1000000000000", tokenMigration.MintedAmount)
+	require.Equal(t, "1000000", tokenMigration.InstantlyReleased)
+	require.Equal(t, "999999000000", tokenMigration.VestedAmount)
+	require.Equal(t, uint64(3), tokenMigration.PositionId)
+
+	// Alice released 200000 uslf from the position but only 700000 uslf are left in her account
+	selfVestingMock.ExpectClawbackPosition(ctx, 3, 999998800000, 200000)
+	bankMock.ExpectBurnFromModule(ctx, selfvestingTypes.ModuleName, 999998800000)
+	bankMock.ExpectSpendableBalance(ctx, test.Alice, 700000)
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 700000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 700000)
+
+	res, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   tokenMigration.MsgHash,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "999998800000", res.ClawedBack)
+	require.Equal(t, "700000", res.Recovered)
+	require.Equal(t, "999999500000", res.Burned)
+	require.Equal(t, "500000", res.Shortfall)
+	require.Equal(t, "500000", res.ShortfallSpent)
+	require.Equal(t, "0", res.ShortfallFee)
+	require.Equal(t, "0", res.ShortfallFeeAllowance)
+
+	reverted, found := k.GetTokenMigration(sdk.UnwrapSDKContext(ctx), tokenMigration.MsgHash)
+	require.True(t, found)
+	require.True(t, reverted.Reverted)
+	require.Equal(t, "999999500000", reverted.RevertedBurned)
+	require.Equal(t, "500000", reverted.RevertedShortfall)
+
+	// A reverted migration can neither be reverted nor migrated again
+	_, err = server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   tokenMigration.MsgHash,
+	})
+	require.ErrorIs(t, err, types.ErrMigrationReverted)
+
+	_, err = server.Migrate(ctx, migrateMsg)
+	require.ErrorIs(t, err, types.ErrMigrationProcessed)
+}
+
+func TestShouldRevertMigrationOfLegacyPosition(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	// Migrations processed before positions had stable ids point at the position by its index
+	k.SetTokenMigration(sdk.UnwrapSDKContext(ctx), types.TokenMigration{
+		MsgHash:           "legacy",
+		Processed:         true,
+		DestAddress:       test.Alice,
+		MintedAmount:      "1000000000000",
+		InstantlyReleased: "1000000",
+		VestedAmount:      "999999000000",
+		PositionIndex:     2,
+	})
+
+	selfVestingMock.ExpectLegacyPositionIndex(ctx, test.Alice, 2, 7)
+	selfVestingMock.ExpectClawbackPosition(ctx, 7, 999999000000, 0)
+	bankMock.ExpectBurnFromModule(ctx, selfvestingTypes.ModuleName, 999999000000)
+	bankMock.ExpectSpendableBalance(ctx, test.Alice, 1000000)
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 1000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 1000000)
+
+	res, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   "legacy",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "999999000000", res.ClawedBack)
+	require.Equal(t, "1000000000000", res.Burned)
+	require.Equal(t, "0", res.Shortfall)
+}
+
+func TestShouldRevertMigrationOfPrunedPosition(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetTokenMigration(sdk.UnwrapSDKContext(ctx), types.TokenMigration{
+		MsgHash:           "claimed",
+		Processed:         true,
+		DestAddress:       test.Alice,
+		MintedAmount:      "1000000000000",
+		InstantlyReleased: "1000000",
+		VestedAmount:      "999999000000",
+		PositionId:        4,
+	})
+
+	// The position has been fully claimed and pruned so everything reached Alice
+	selfVestingMock.EXPECT().
+		ClawbackPosition(sdk.UnwrapSDKContext(ctx), uint64(4)).
+		Return(sdkmath.ZeroUint(), sdkmath.ZeroUint(), selfvestingTypes.ErrPositionFullyClaimed)
+	bankMock.ExpectSpendableBalance(ctx, test.Alice, 600000000000)
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 600000000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 600000000000)
+
+	res, err := server.RevertMigration(ctx, &types.MsgRevertMigration{
+		Authority: k.GetAuthority(),
+		MsgHash:   "claimed",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.ClawedBack)
+	require.Equal(t, "600000000000", res.Recovered)
+	require.Equal(t, "400000000000", res.Shortfall)
+}
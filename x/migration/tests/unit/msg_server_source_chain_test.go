@@ -0,0 +1,128 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const bscChainId = 56
+
+func oneFrontFrom(sourceChainId uint64) *types.MsgMigrate {
+	return &types.MsgMigrate{
+		Creator:       test.Migrator_1,
+		TxHash:        "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+		EthAddress:    "baf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+		DestAddress:   test.Alice,
+		Amount:        "1000000000000000000",
+		Token:         uint64(types.Front),
+		LogIndex:      0,
+		SourceChainId: sourceChainId,
+	}
+}
+
+func TestSetSourceChainShouldFailIfNotAdmin(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetSourceChain(ctx, &types.MsgSetSourceChain{
+		Creator: test.Alice,
+		ChainId: bscChainId,
+		Name:    "BSC",
+	})
+
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+}
+
+func TestShouldRejectMigrationFromUnknownChain(t *testing.T) {
+	server, ctx, _, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	_, err := server.Migrate(ctx, oneFrontFrom(bscChainId))
+
+	require.ErrorIs(t, err, types.ErrSourceChainNotSupported)
+}
+
+func TestShouldRejectMigrationFromDisabledChainOrUnsupportedToken(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetSourceChain(ctx, &types.MsgSetSourceChain{
+		Creator: test.AclAdmin,
+		ChainId: bscChainId,
+		Name:    "BSC",
+		Tokens:  []uint64{uint64(types.Hotcross)},
+		Enabled: true,
+	})
+	require.NoError(t, err)
+
+	_, err = server.Migrate(ctx, oneFrontFrom(bscChainId))
+	require.ErrorIs(t, err, types.ErrTokenNotSupportedOnChain)
+
+	// Ethereum mainnet can be turned off once it's configured explicitly
+	_, err = server.SetSourceChain(ctx, &types.MsgSetSourceChain{
+		Creator: test.AclAdmin,
+		ChainId: types.EthereumChainId,
+		Name:    "Ethereum",
+		Tokens:  []uint64{uint64(types.Front)},
+		Enabled: false,
+	})
+	require.NoError(t, err)
+
+	_, err = server.Migrate(ctx, oneFrontFrom(0))
+	require.ErrorIs(t, err, types.ErrSourceChainNotSupported)
+}
+
+func TestShouldMigrateSameDepositFromSeveralChains(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetSourceChain(ctx, &types.MsgSetSourceChain{
+		Creator:       test.AclAdmin,
+		ChainId:       bscChainId,
+		Name:          "BSC",
+		Confirmations: 15,
+		Tokens:        []uint64{uint64(types.Front)},
+		Enabled:       true,
+	})
+	require.NoError(t, err)
+
+	bankMock.ExpectMintToModule(ctx, 1000000).Times(2)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000).Times(2)
+
+	_, err = server.Migrate(ctx, oneFrontFrom(0))
+	require.NoError(t, err)
+
+	_, err = server.Migrate(ctx, oneFrontFrom(bscChainId))
+	require.NoError(t, err)
+
+	// A deposit can only be processed once per chain. Zero and the Ethereum chain id are the same chain.
+	_, err = server.Migrate(ctx, oneFrontFrom(types.EthereumChainId))
+	require.ErrorIs(t, err, types.ErrMigrationProcessed)
+
+	_, err = server.Migrate(ctx, oneFrontFrom(bscChainId))
+	require.ErrorIs(t, err, types.ErrMigrationProcessed)
+
+	tokenMigration, found := k.GetTokenMigration(sdk.UnwrapSDKContext(ctx), oneFrontFrom(bscChainId).Hash())
+	require.True(t, found)
+	require.Equal(t, uint64(bscChainId), tokenMigration.SourceChainId)
+
+	for _, chainId := range []uint64{types.EthereumChainId, bscChainId} {
+		res, err := k.SourceChainStats(ctx, &types.QueryGetSourceChainStatsRequest{ChainId: chainId})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.SourceChainStats.Totals.MigrationCount)
+		require.Equal(t, "1000000", res.SourceChainStats.Totals.MintedAmount)
+		require.Equal(t, uint64(1), res.SourceChainStats.Totals.UniqueDestinations)
+	}
+}
@@ -18,10 +18,30 @@ import (
 )
 
 func setup(t testing.TB) (types.MsgServer, context.Context, keeper.Keeper, *gomock.Controller, *mocktest.MockSelfvestingKeeper, *mocktest.MockBankKeeper) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock, _ := setupWithDistr(t)
+
+	return server, ctx, k, ctrl, selfVestingMock, bankMock
+}
+
+func setupWithDistr(t testing.TB) (types.MsgServer, context.Context, keeper.Keeper, *gomock.Controller, *mocktest.MockSelfvestingKeeper, *mocktest.MockBankKeeper, *mocktest.MockDistrKeeper) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock, distrMock, _ := setupWithMocks(t)
+
+	return server, ctx, k, ctrl, selfVestingMock, bankMock, distrMock
+}
+
+func setupWithWasm(t testing.TB) (types.MsgServer, context.Context, keeper.Keeper, *gomock.Controller, *mocktest.MockSelfvestingKeeper, *mocktest.MockBankKeeper, *mocktest.MockWasmKeeper) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock, _, wasmMock := setupWithMocks(t)
+
+	return server, ctx, k, ctrl, selfVestingMock, bankMock, wasmMock
+}
+
+func setupWithMocks(t testing.TB) (types.MsgServer, context.Context, keeper.Keeper, *gomock.Controller, *mocktest.MockSelfvestingKeeper, *mocktest.MockBankKeeper, *mocktest.MockDistrKeeper, *mocktest.MockWasmKeeper) {
 	ctrl := gomock.NewController(t)
 	bankMock := mocktest.NewMockBankKeeper(ctrl)
 	selfVestingMock := mocktest.NewMockSelfvestingKeeper(ctrl)
-	k, ctx := keepertest.MigrationKeeperWithMocks(t, selfVestingMock, bankMock)
+	distrMock := mocktest.NewMockDistrKeeper(ctrl)
+	wasmMock := mocktest.NewMockWasmKeeper(ctrl)
+	k, ctx := keepertest.MigrationKeeperWithMocks(t, selfVestingMock, bankMock, distrMock, wasmMock)
 
 	// setup genesis params for this module
 	genesis := *types.DefaultGenesis()
@@ -47,7 +67,7 @@ func setup(t testing.TB) (types.MsgServer, context.Context, keeper.Keeper, *gomo
 	server := keeper.NewMsgServerImpl(*k)
 	context := sdk.WrapSDKContext(ctx)
 
-	return server, context, *k, ctrl, selfVestingMock, bankMock
+	return server, context, *k, ctrl, selfVestingMock, bankMock, distrMock, wasmMock
 }
 
 func TestShouldFailIfInvalidMigrator(t *testing.T) {
@@ -0,0 +1,205 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const sunsetEndTime = 1700000000
+
+// migrateOneFront migrates 1 FRONT i.e. 1 SLF which is instantly released to Alice
+func migrateOneFront(server types.MsgServer, ctx context.Context, logIndex uint64) error {
+	_, err := server.Migrate(ctx, &types.MsgMigrate{
+		Creator:     test.Migrator_1,
+		TxHash:      "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+		EthAddress:  "baf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+		DestAddress: test.Alice,
+		Amount:      "1000000000000000000",
+		Token:       uint64(types.Front),
+		LogIndex:    logIndex,
+	})
+
+	return err
+}
+
+func atTime(ctx context.Context, unix int64) context.Context {
+	return sdk.WrapSDKContext(sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Unix(unix, 0)))
+}
+
+func TestSetTokenSunsetShouldFailIfNotAdmin(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetTokenSunset(ctx, &types.MsgSetTokenSunset{
+		Creator: test.Alice,
+		Token:   uint64(types.Front),
+		EndTime: sunsetEndTime,
+	})
+
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+}
+
+func TestShouldRejectMigrationAfterSunset(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetTokenSunset(ctx, &types.MsgSetTokenSunset{
+		Creator: test.AclAdmin,
+		Token:   uint64(types.Front),
+		EndTime: sunsetEndTime,
+	})
+	require.NoError(t, err)
+
+	beforeEnd := atTime(ctx, sunsetEndTime-1)
+	bankMock.ExpectMintToModule(beforeEnd, 1000000)
+	bankMock.ExpectReceiveCoins(beforeEnd, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	require.NoError(t, migrateOneFront(server, beforeEnd, 0))
+
+	require.ErrorIs(t, migrateOneFront(server, atTime(ctx, sunsetEndTime), 1), types.ErrMigrationEnded)
+
+	res, err := k.TokenSunset(atTime(ctx, sunsetEndTime-100), &types.QueryGetTokenSunsetRequest{Token: uint64(types.Front)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), res.TimeRemaining)
+	require.Equal(t, "1000000", res.TokenSunset.Minted)
+	require.Equal(t, "", res.Remaining)
+}
+
+func TestShouldRejectMigrationAboveCap(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetTokenSunset(ctx, &types.MsgSetTokenSunset{
+		Creator:      test.AclAdmin,
+		Token:        uint64(types.Front),
+		Cap:          "1500000",
+		LegacyMinted: "0",
+	})
+	require.NoError(t, err)
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	require.NoError(t, migrateOneFront(server, ctx, 0))
+
+	require.ErrorIs(t, migrateOneFront(server, ctx, 1), types.ErrMigrationCapExceeded)
+
+	res, err := k.TokenSunset(ctx, &types.QueryGetTokenSunsetRequest{Token: uint64(types.Front)})
+	require.NoError(t, err)
+	require.Equal(t, "500000", res.Remaining)
+}
+
+func TestShouldCountLegacyMintedAmountAgainstCap(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	// 1 SLF was minted before minted amounts were tracked
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetTokenSunset(ctx, &types.MsgSetTokenSunset{
+		Creator:      test.AclAdmin,
+		Token:        uint64(types.Front),
+		Cap:          "2500000",
+		LegacyMinted: "1000000",
+	})
+	require.NoError(t, err)
+
+	bankMock.ExpectMintToModule(ctx, 1000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	require.NoError(t, migrateOneFront(server, ctx, 0))
+
+	require.ErrorIs(t, migrateOneFront(server, ctx, 1), types.ErrMigrationCapExceeded)
+
+	res, err := k.TokenSunset(ctx, &types.QueryGetTokenSunsetRequest{Token: uint64(types.Front)})
+	require.NoError(t, err)
+	require.Equal(t, "1000000", res.TokenSunset.Minted)
+	require.Equal(t, "500000", res.Remaining)
+}
+
+func TestShouldSweepRemainingAllocation(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		sweepMode types.SweepMode
+		treasury  string
+	}{
+		{
+			desc:      "community pool",
+			sweepMode: types.SweepMode_SWEEP_MODE_COMMUNITY_POOL,
+		},
+		{
+			desc:      "treasury",
+			sweepMode: types.SweepMode_SWEEP_MODE_TREASURY,
+			treasury:  test.Bob,
+		},
+		{
+			desc:      "burn",
+			sweepMode: types.SweepMode_SWEEP_MODE_BURN,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			server, ctx, k, ctrl, _, bankMock, distrMock := setupWithDistr(t)
+			defer ctrl.Finish()
+
+			k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+			_, err := server.SetTokenSunset(ctx, &types.MsgSetTokenSunset{
+				Creator:      test.AclAdmin,
+				Token:        uint64(types.Front),
+				EndTime:      sunsetEndTime,
+				Cap:          "5000000",
+				LegacyMinted: "0",
+				SweepMode:    tc.sweepMode,
+				Treasury:     tc.treasury,
+			})
+			require.NoError(t, err)
+
+			bankMock.ExpectMintToModule(ctx, 1000000)
+			bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+			require.NoError(t, migrateOneFront(server, ctx, 0))
+
+			// Nothing happens before the deadline
+			k.SweepEndedAllocations(sdk.UnwrapSDKContext(atTime(ctx, sunsetEndTime-1)))
+
+			// Sweeps run in a cache context
+			swept := sdk.NewCoins(sdk.NewCoin(types.DENOM, sdkmath.NewInt(4000000)))
+			switch tc.sweepMode {
+			case types.SweepMode_SWEEP_MODE_COMMUNITY_POOL:
+				bankMock.EXPECT().MintCoins(gomock.Any(), types.ModuleName, swept)
+				distrMock.EXPECT().FundCommunityPool(gomock.Any(), swept, authtypes.NewModuleAddress(types.ModuleName))
+			case types.SweepMode_SWEEP_MODE_TREASURY:
+				bankMock.EXPECT().MintCoins(gomock.Any(), types.ModuleName, swept)
+				bankMock.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(test.Bob), swept)
+			}
+
+			// The allocation is swept only once
+			k.SweepEndedAllocations(sdk.UnwrapSDKContext(atTime(ctx, sunsetEndTime)))
+			k.SweepEndedAllocations(sdk.UnwrapSDKContext(atTime(ctx, sunsetEndTime+1)))
+
+			res, err := k.TokenSunset(atTime(ctx, sunsetEndTime+1), &types.QueryGetTokenSunsetRequest{Token: uint64(types.Front)})
+			require.NoError(t, err)
+			require.True(t, res.TokenSunset.Swept)
+			require.Equal(t, "4000000", res.TokenSunset.SweptAmount)
+			require.Equal(t, int64(sunsetEndTime), res.TokenSunset.SweptAt)
+			require.Equal(t, uint64(0), res.TimeRemaining)
+			require.Equal(t, "0", res.Remaining)
+
+			_, err = server.SetTokenSunset(ctx, &types.MsgSetTokenSunset{
+				Creator: test.AclAdmin,
+				Token:   uint64(types.Front),
+				EndTime: sunsetEndTime + 1000,
+			})
+			require.ErrorIs(t, err, types.ErrAllocationSwept)
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"selfchain/x/migration/keeper"
+	test "selfchain/x/migration/tests"
+	mocktest "selfchain/x/migration/tests/mock"
+	"selfchain/x/migration/types"
+	selfvestingTypes "selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	withdrawalRequestedAt = 1000
+	withdrawalExpiry      = 100
+	withdrawalRefundDelay = 50
+	withdrawalRecipient   = "0xbaf6dc2e647aeb6f510f9e318856a1bcd66c5e19"
+)
+
+// signatureOf returns a well formed signature made of the given byte
+func signatureOf(b byte) string {
+	return strings.Repeat(fmt.Sprintf("%02x", b), types.WithdrawalSignatureLength)
+}
+
+// setWithdrawalConfig configures the withdrawals to require two signatures
+func setWithdrawalConfig(t *testing.T, server types.MsgServer, ctx context.Context, k keeper.Keeper) {
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetWithdrawalConfig(ctx, &types.MsgSetWithdrawalConfig{
+		Creator:            test.AclAdmin,
+		SignatureThreshold: 2,
+		Expiry:             withdrawalExpiry,
+		RefundDelay:        withdrawalRefundDelay,
+	})
+	require.NoError(t, err)
+}
+
+// requestOneSlfWithdrawal configures the withdrawals and has Alice withdraw the 1 SLF minted for FRONT
+func requestOneSlfWithdrawal(t *testing.T, server types.MsgServer, ctx context.Context, k keeper.Keeper) uint64 {
+	setWithdrawalConfig(t, server, ctx, k)
+	k.SetTokenSunset(sdk.UnwrapSDKContext(ctx), types.TokenSunset{Token: uint64(types.Front), Minted: "1000000"})
+
+	res, err := server.RequestWithdrawal(ctx, &types.MsgRequestWithdrawal{
+		Creator:      test.Alice,
+		EthRecipient: withdrawalRecipient,
+		Amount:       "1000000",
+		Token:        uint64(types.Front),
+	})
+	require.NoError(t, err)
+
+	return res.Id
+}
+
+func TestRequestWithdrawalShouldFailIfNotConfigured(t *testing.T) {
+	server, ctx, _, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	_, err := server.RequestWithdrawal(ctx, &types.MsgRequestWithdrawal{
+		Creator:      test.Alice,
+		EthRecipient: withdrawalRecipient,
+		Amount:       "1000000",
+		Token:        uint64(types.Front),
+	})
+	require.ErrorIs(t, err, types.ErrWithdrawalsDisabled)
+}
+
+func TestSetWithdrawalConfigShouldFailIfNotAdmin(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+
+	_, err := server.SetWithdrawalConfig(ctx, &types.MsgSetWithdrawalConfig{
+		Creator:            test.Alice,
+		SignatureThreshold: 2,
+		Expiry:             withdrawalExpiry,
+	})
+	require.ErrorIs(t, err, types.ErrOnlyAdmin)
+}
+
+func TestShouldExecuteWithdrawal(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, withdrawalRequestedAt)
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 1000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 1000000)
+	id := requestOneSlfWithdrawal(t, server, ctx, k)
+
+	withdrawal, found := k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), id)
+	require.True(t, found)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING, withdrawal.Status)
+	require.Equal(t, "1000000000000000000", withdrawal.TokenAmount)
+	require.Equal(t, types.EthereumChainId, withdrawal.SourceChainId)
+	require.Equal(t, uint64(withdrawalRequestedAt+withdrawalExpiry), withdrawal.ExpiresAt)
+	require.Equal(t, withdrawal.ComputePayloadHash(), withdrawal.PayloadHash)
+
+	// Only migrators can sign, once each
+	_, err := server.SignWithdrawal(ctx, &types.MsgSignWithdrawal{Creator: test.Alice, Id: id, Signature: signatureOf(1)})
+	require.ErrorIs(t, err, types.ErrUnknownMigrator)
+
+	_, err = server.SignWithdrawal(ctx, &types.MsgSignWithdrawal{Creator: test.Migrator_1, Id: id, Signature: signatureOf(1)})
+	require.NoError(t, err)
+	_, err = server.SignWithdrawal(ctx, &types.MsgSignWithdrawal{Creator: test.Migrator_1, Id: id, Signature: signatureOf(1)})
+	require.ErrorIs(t, err, types.ErrWithdrawalAlreadySigned)
+
+	// The vault can't execute the withdrawal before the threshold is met
+	_, err = server.ConfirmWithdrawal(ctx, &types.MsgConfirmWithdrawal{Creator: test.Migrator_1, Id: id, EthTxHash: "0x01"})
+	require.ErrorIs(t, err, types.ErrWithdrawalNotSigned)
+
+	_, err = server.SignWithdrawal(ctx, &types.MsgSignWithdrawal{Creator: test.Migrator_2, Id: id, Signature: "0x" + signatureOf(2)})
+	require.NoError(t, err)
+
+	res, err := k.Withdrawal(ctx, &types.QueryGetWithdrawalRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED, res.Withdrawal.Status)
+	require.Equal(t, []types.WithdrawalSignature{
+		{Migrator: test.Migrator_1, Signature: signatureOf(1)},
+		{Migrator: test.Migrator_2, Signature: signatureOf(2)},
+	}, res.Withdrawal.Signatures)
+
+	// A single migrator can't mark the withdrawal as executed
+	_, err = server.ConfirmWithdrawal(ctx, &types.MsgConfirmWithdrawal{Creator: test.Migrator_1, Id: id, EthTxHash: "0x01"})
+	require.NoError(t, err)
+	_, err = server.ConfirmWithdrawal(ctx, &types.MsgConfirmWithdrawal{Creator: test.Migrator_1, Id: id, EthTxHash: "0x01"})
+	require.ErrorIs(t, err, types.ErrWithdrawalAlreadyConfirmed)
+
+	withdrawal, _ = k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), id)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED, withdrawal.Status)
+	require.Empty(t, withdrawal.EthTxHash)
+
+	_, err = server.ConfirmWithdrawal(ctx, &types.MsgConfirmWithdrawal{Creator: test.Migrator_2, Id: id, EthTxHash: "0x01"})
+	require.NoError(t, err)
+
+	// An executed withdrawal is never refunded
+	k.RefundExpiredWithdrawals(sdk.UnwrapSDKContext(atTime(ctx, withdrawalRequestedAt+withdrawalExpiry+withdrawalRefundDelay)))
+
+	withdrawal, _ = k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), id)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_EXECUTED, withdrawal.Status)
+	require.Equal(t, "0x01", withdrawal.EthTxHash)
+}
+
+func TestShouldRefundWithdrawalNeverExecuted(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, withdrawalRequestedAt)
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 1000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 1000000)
+	id := requestOneSlfWithdrawal(t, server, ctx, k)
+
+	_, err := server.SignWithdrawal(ctx, &types.MsgSignWithdrawal{Creator: test.Migrator_1, Id: id, Signature: signatureOf(1)})
+	require.NoError(t, err)
+
+	// Signatures are useless once the vault rejects the withdrawal
+	expiredCtx := atTime(ctx, withdrawalRequestedAt+withdrawalExpiry)
+	_, err = server.SignWithdrawal(expiredCtx, &types.MsgSignWithdrawal{Creator: test.Migrator_2, Id: id, Signature: signatureOf(2)})
+	require.ErrorIs(t, err, types.ErrWithdrawalExpired)
+
+	// Migrators are left some time to confirm an execution
+	k.RefundExpiredWithdrawals(sdk.UnwrapSDKContext(atTime(ctx, withdrawalRequestedAt+withdrawalExpiry+withdrawalRefundDelay-1)))
+
+	bankMock.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DENOM, 1000000)))
+	bankMock.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(test.Alice), sdk.NewCoins(sdk.NewInt64Coin(types.DENOM, 1000000)))
+	k.RefundExpiredWithdrawals(sdk.UnwrapSDKContext(atTime(ctx, withdrawalRequestedAt+withdrawalExpiry+withdrawalRefundDelay)))
+	k.RefundExpiredWithdrawals(sdk.UnwrapSDKContext(atTime(ctx, withdrawalRequestedAt+withdrawalExpiry+withdrawalRefundDelay+1)))
+
+	withdrawal, _ := k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), id)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_REFUNDED, withdrawal.Status)
+
+	// The refunded uslf count as minted again
+	tokenSunset, _ := k.GetTokenSunset(sdk.UnwrapSDKContext(ctx), uint64(types.Front))
+	require.Equal(t, "1000000", tokenSunset.Minted)
+
+	totals, _ := k.GetMigrationTotals(sdk.UnwrapSDKContext(ctx))
+	require.Equal(t, "1000000", totals.MintedAmount)
+	require.Equal(t, "0", totals.WithdrawnAmount)
+
+	_, err = server.ConfirmWithdrawal(ctx, &types.MsgConfirmWithdrawal{Creator: test.Migrator_1, Id: id, EthTxHash: "0x01"})
+	require.ErrorIs(t, err, types.ErrWithdrawalClosed)
+}
+
+func TestShouldNotExecuteWithdrawalOnConflictingConfirmations(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, withdrawalRequestedAt)
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 1000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 1000000)
+	id := requestOneSlfWithdrawal(t, server, ctx, k)
+
+	for i, migrator := range []string{test.Migrator_1, test.Migrator_2} {
+		_, err := server.SignWithdrawal(ctx, &types.MsgSignWithdrawal{Creator: migrator, Id: id, Signature: signatureOf(byte(i))})
+		require.NoError(t, err)
+	}
+
+	_, err := server.ConfirmWithdrawal(ctx, &types.MsgConfirmWithdrawal{Creator: test.Migrator_1, Id: id, EthTxHash: "0x01"})
+	require.NoError(t, err)
+	_, err = server.ConfirmWithdrawal(ctx, &types.MsgConfirmWithdrawal{Creator: test.Migrator_2, Id: id, EthTxHash: "0x02"})
+	require.NoError(t, err)
+
+	withdrawal, _ := k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), id)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED, withdrawal.Status)
+	require.Equal(t, []types.WithdrawalConfirmation{
+		{Migrator: test.Migrator_1, EthTxHash: "0x01"},
+		{Migrator: test.Migrator_2, EthTxHash: "0x02"},
+	}, withdrawal.Confirmations)
+}
+
+func TestShouldConvertWithdrawalAtPeakRatio(t *testing.T) {
+	server, ctx, k, ctrl, selfVestingMock, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	k.SetAcl(sdk.UnwrapSDKContext(ctx), types.Acl{Admin: test.AclAdmin})
+	_, err := server.SetRatioSchedule(ctx, decayingHotcrossSchedule(test.AclAdmin))
+	require.NoError(t, err)
+
+	// 1M HOTCROSS are migrated at 75%
+	msg := oneMillionFront()
+	msg.Token = uint64(types.Hotcross)
+
+	ctx = atTime(ctx, 1050)
+	bankMock.ExpectMintToModule(ctx, 750000000000)
+	bankMock.ExpectReceiveCoins(ctx, selfvestingTypes.ModuleName, test.Alice, 1000000)
+	selfVestingMock.ExpectAddBeneficiary(ctx, selfvestingTypes.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    2592000,
+		Amount:      "749999000000",
+	})
+
+	_, err = server.Migrate(ctx, msg)
+	require.NoError(t, err)
+
+	tokenSunset, _ := k.GetTokenSunset(sdk.UnwrapSDKContext(ctx), uint64(types.Hotcross))
+	require.Equal(t, uint64(75), tokenSunset.PeakRatio)
+
+	// Once the ratio has fallen to 50% the uslf would be worth 1.5M HOTCROSS at the current ratio
+	ctx = atTime(ctx, 1100)
+	setWithdrawalConfig(t, server, ctx, k)
+
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 750000000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 750000000000)
+
+	res, err := server.RequestWithdrawal(ctx, &types.MsgRequestWithdrawal{
+		Creator:      test.Alice,
+		EthRecipient: withdrawalRecipient,
+		Amount:       "750000000000",
+		Token:        uint64(types.Hotcross),
+	})
+	require.NoError(t, err)
+
+	withdrawal, _ := k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), res.Id)
+	require.Equal(t, "1000000000000000000000000", withdrawal.TokenAmount)
+
+	// Nothing minted for the token is left to withdraw and it no longer counts as minted
+	_, err = server.RequestWithdrawal(ctx, &types.MsgRequestWithdrawal{
+		Creator:      test.Alice,
+		EthRecipient: withdrawalRecipient,
+		Amount:       "1",
+		Token:        uint64(types.Hotcross),
+	})
+	require.ErrorIs(t, err, types.ErrWithdrawalExceedsMinted)
+
+	tokenSunset, _ = k.GetTokenSunset(sdk.UnwrapSDKContext(ctx), uint64(types.Hotcross))
+	require.Equal(t, "0", tokenSunset.Minted)
+
+	tokenStats, _ := k.GetTokenStats(sdk.UnwrapSDKContext(ctx), uint64(types.Hotcross))
+	require.Equal(t, "0", tokenStats.Totals.MintedAmount)
+	require.Equal(t, "750000000000", tokenStats.Totals.WithdrawnAmount)
+}
+
+// signOneSlfWithdrawal has both migrators sign the withdrawal of 1 SLF by Alice
+func signOneSlfWithdrawal(t *testing.T, server types.MsgServer, ctx context.Context, k keeper.Keeper, bankMock *mocktest.MockBankKeeper) uint64 {
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 1000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 1000000)
+	id := requestOneSlfWithdrawal(t, server, ctx, k)
+
+	for i, migrator := range []string{test.Migrator_1, test.Migrator_2} {
+		_, err := server.SignWithdrawal(ctx, &types.MsgSignWithdrawal{Creator: migrator, Id: id, Signature: signatureOf(byte(i))})
+		require.NoError(t, err)
+	}
+
+	return id
+}
+
+func TestShouldNotRefundSignedWithdrawalConfirmedLate(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, withdrawalRequestedAt)
+	id := signOneSlfWithdrawal(t, server, ctx, k, bankMock)
+
+	// The vault may have released the tokens even though no confirmation has come in by the refund time
+	lateCtx := atTime(ctx, withdrawalRequestedAt+withdrawalExpiry+withdrawalRefundDelay+10)
+	k.RefundExpiredWithdrawals(sdk.UnwrapSDKContext(atTime(ctx, withdrawalRequestedAt+withdrawalExpiry+withdrawalRefundDelay)))
+	k.RefundExpiredWithdrawals(sdk.UnwrapSDKContext(lateCtx))
+
+	withdrawal, _ := k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), id)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED, withdrawal.Status)
+
+	for _, migrator := range []string{test.Migrator_1, test.Migrator_2} {
+		_, err := server.ConfirmWithdrawal(lateCtx, &types.MsgConfirmWithdrawal{Creator: migrator, Id: id, EthTxHash: "0x01"})
+		require.NoError(t, err)
+	}
+
+	withdrawal, _ = k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), id)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_EXECUTED, withdrawal.Status)
+
+	_, err := server.CancelWithdrawal(lateCtx, types.NewMsgCancelWithdrawal(test.Migrator_1, id))
+	require.ErrorIs(t, err, types.ErrWithdrawalClosed)
+}
+
+func TestShouldRefundSignedWithdrawalOnceCancelled(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, withdrawalRequestedAt)
+	id := signOneSlfWithdrawal(t, server, ctx, k, bankMock)
+
+	// Only migrators can cancel, once the vault rejects the withdrawal
+	_, err := server.CancelWithdrawal(ctx, types.NewMsgCancelWithdrawal(test.Migrator_1, id))
+	require.ErrorIs(t, err, types.ErrWithdrawalNotExpired)
+
+	expiredCtx := atTime(ctx, withdrawalRequestedAt+withdrawalExpiry)
+	_, err = server.CancelWithdrawal(expiredCtx, types.NewMsgCancelWithdrawal(test.Alice, id))
+	require.ErrorIs(t, err, types.ErrUnknownMigrator)
+
+	// A single migrator can't have the withdrawal refunded, nor both cancel and confirm it
+	_, err = server.CancelWithdrawal(expiredCtx, types.NewMsgCancelWithdrawal(test.Migrator_1, id))
+	require.NoError(t, err)
+	_, err = server.CancelWithdrawal(expiredCtx, types.NewMsgCancelWithdrawal(test.Migrator_1, id))
+	require.ErrorIs(t, err, types.ErrWithdrawalAlreadyCancelled)
+	_, err = server.ConfirmWithdrawal(expiredCtx, &types.MsgConfirmWithdrawal{Creator: test.Migrator_1, Id: id, EthTxHash: "0x01"})
+	require.ErrorIs(t, err, types.ErrWithdrawalAlreadyCancelled)
+
+	withdrawal, _ := k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), id)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED, withdrawal.Status)
+
+	bankMock.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DENOM, 1000000)))
+	bankMock.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(test.Alice), sdk.NewCoins(sdk.NewInt64Coin(types.DENOM, 1000000)))
+	_, err = server.CancelWithdrawal(expiredCtx, types.NewMsgCancelWithdrawal(test.Migrator_2, id))
+	require.NoError(t, err)
+
+	withdrawal, _ = k.GetWithdrawal(sdk.UnwrapSDKContext(ctx), id)
+	require.Equal(t, types.WithdrawalStatus_WITHDRAWAL_STATUS_REFUNDED, withdrawal.Status)
+	require.Equal(t, []string{test.Migrator_1, test.Migrator_2}, withdrawal.Cancellations)
+
+	totals, _ := k.GetMigrationTotals(sdk.UnwrapSDKContext(ctx))
+	require.Equal(t, "1000000", totals.MintedAmount)
+	require.Equal(t, "0", totals.WithdrawnAmount)
+}
+
+func TestShouldNotCancelWithdrawalBelowSignatureThreshold(t *testing.T) {
+	server, ctx, k, ctrl, _, bankMock := setup(t)
+	defer ctrl.Finish()
+
+	ctx = atTime(ctx, withdrawalRequestedAt)
+	bankMock.ExpectSendToModule(ctx, test.Alice, types.ModuleName, 1000000)
+	bankMock.ExpectBurnFromModule(ctx, types.ModuleName, 1000000)
+	id := requestOneSlfWithdrawal(t, server, ctx, k)
+
+	// It is refunded at its refund time instead
+	_, err := server.CancelWithdrawal(atTime(ctx, withdrawalRequestedAt+withdrawalExpiry), types.NewMsgCancelWithdrawal(test.Migrator_1, id))
+	require.ErrorIs(t, err, types.ErrWithdrawalNotSigned)
+}
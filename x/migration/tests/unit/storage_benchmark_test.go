@@ -0,0 +1,165 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	test "selfchain/x/migration/tests"
+	"selfchain/x/migration/types"
+
+	"cosmossdk.io/store/gaskv"
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+)
+
+// The benchmarks compare the migrations stored under their hex hash, as they were before the compact
+// layout, with the compact layout and with archived buckets. They report the gas of the dedup check and
+// of the write of a migration, and the state taken per migration. The rest of Migrate didn't change so
+// the difference in storage gas is the difference in Migrate gas:
+//
+//	go test ./x/migration/tests/unit -run '^$' -bench 'Migration|Migrate' -benchtime 1000x
+
+// benchMsgMigrate returns a migration of 1 FRONT with the sizes of a real deposit
+func benchMsgMigrate(i int) *types.MsgMigrate {
+	return &types.MsgMigrate{
+		Creator:     test.Migrator_1,
+		TxHash:      fmt.Sprintf("0x%064x", i),
+		EthAddress:  "0xbaf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+		DestAddress: test.Alice,
+		Amount:      "1000000000000000000",
+		Token:       uint64(types.Front),
+		LogIndex:    uint64(i % 4),
+		SourceBlock: uint64(17_000_000 + i*10),
+	}
+}
+
+func benchTokenMigration(i int) types.TokenMigration {
+	msg := benchMsgMigrate(i)
+
+	return types.TokenMigration{
+		MsgHash:           msg.Hash(),
+		Processed:         true,
+		TxHash:            msg.TxHash,
+		EthAddress:        msg.EthAddress,
+		DestAddress:       msg.DestAddress,
+		Amount:            msg.Amount,
+		Token:             msg.Token,
+		LogIndex:          msg.LogIndex,
+		MintedAmount:      "1000000",
+		InstantlyReleased: "1000000",
+		VestedAmount:      "0",
+		SourceChainId:     types.EthereumChainId,
+		Fee:               "0",
+		SourceBlock:       msg.SourceBlock,
+	}
+}
+
+// rawStore returns the migration store without gas metering
+func rawStore(ctx sdk.Context) storetypes.KVStore {
+	return ctx.MultiStore().(*rootmulti.Store).GetStoreByName(types.StoreKey).(storetypes.KVStore)
+}
+
+// stateSize returns the bytes taken by the keys and values under the given prefixes
+func stateSize(ctx sdk.Context, prefixes ...string) (size int) {
+	for _, p := range prefixes {
+		iterator := storetypes.KVStorePrefixIterator(rawStore(ctx), types.KeyPrefix(p))
+		for ; iterator.Valid(); iterator.Next() {
+			size += len(iterator.Key()) + len(iterator.Value())
+		}
+		iterator.Close()
+	}
+
+	return size
+}
+
+func reportPerMigration(b *testing.B, gas storetypes.Gas, size int) {
+	b.ReportMetric(float64(gas)/float64(b.N), "gas/op")
+	b.ReportMetric(float64(size)/float64(b.N), "bytes/op")
+}
+
+func BenchmarkMigrationStorage(b *testing.B) {
+	b.Run("legacy", func(b *testing.B) {
+		_, ctx := keepertest.MigrationKeeper(b)
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+		store := prefix.NewStore(
+			gaskv.NewStore(rawStore(ctx), ctx.GasMeter(), storetypes.KVGasConfig()),
+			types.KeyPrefix(types.LegacyTokenMigrationKeyPrefix),
+		)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			migration := benchTokenMigration(i)
+			key := types.LegacyTokenMigrationKey(migration.MsgHash)
+			if store.Get(key) != nil {
+				b.Fatal("migration already processed")
+			}
+			store.Set(key, cdc.MustMarshal(&migration))
+		}
+		b.StopTimer()
+
+		reportPerMigration(b, ctx.GasMeter().GasConsumed(), stateSize(ctx, types.LegacyTokenMigrationKeyPrefix))
+	})
+
+	b.Run("compact", func(b *testing.B) {
+		k, ctx := keepertest.MigrationKeeper(b)
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			migration := benchTokenMigration(i)
+			if k.IsMigrationProcessed(ctx, migration.MsgHash) {
+				b.Fatal("migration already processed")
+			}
+			k.SetTokenMigration(ctx, migration)
+		}
+		b.StopTimer()
+
+		reportPerMigration(b, ctx.GasMeter().GasConsumed(), stateSize(ctx, "TokenMigration/"))
+	})
+
+	b.Run("archived", func(b *testing.B) {
+		k, ctx := keepertest.MigrationKeeper(b)
+		for i := 0; i < b.N; i++ {
+			k.SetTokenMigration(ctx, benchTokenMigration(i))
+		}
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		b.ResetTimer()
+		for done := false; !done; {
+			_, done = k.ArchiveMigrations(ctx, uint64(types.Front), types.EthereumChainId, ^uint64(0), types.MaxArchivedMigrationsPerMsg)
+		}
+		b.StopTimer()
+
+		// The gas is the one of archiving a migration
+		reportPerMigration(b, ctx.GasMeter().GasConsumed(), stateSize(ctx, "TokenMigration/", types.MigrationArchiveKeyPrefix))
+	})
+}
+
+func BenchmarkMigrate(b *testing.B) {
+	server, ctx, _, ctrl, _, bankMock := setup(b)
+	defer ctrl.Finish()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx = sdk.WrapSDKContext(sdkCtx)
+
+	bankMock.EXPECT().MintCoins(sdkCtx, gomock.Any(), gomock.Any()).AnyTimes()
+	bankMock.EXPECT().SendCoinsFromModuleToAccount(sdkCtx, gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := server.Migrate(ctx, benchMsgMigrate(i)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+
+	reportPerMigration(b, sdkCtx.GasMeter().GasConsumed(), stateSize(sdkCtx, "TokenMigration/"))
+}
@@ -12,6 +12,22 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddMigrator{}, "migration/AddMigrator", nil)
 	cdc.RegisterConcrete(&MsgRemoveMigrator{}, "migration/RemoveMigrator", nil)
 	cdc.RegisterConcrete(&MsgUpdateConfig{}, "migration/UpdateConfig", nil)
+	cdc.RegisterConcrete(&MsgRevertMigration{}, "migration/RevertMigration", nil)
+	cdc.RegisterConcrete(&MsgSetTokenSunset{}, "migration/SetTokenSunset", nil)
+	cdc.RegisterConcrete(&MsgSetSourceChain{}, "migration/SetSourceChain", nil)
+	cdc.RegisterConcrete(&MsgSetMigrationFee{}, "migration/SetMigrationFee", nil)
+	cdc.RegisterConcrete(&MsgSetLockupTier{}, "migration/SetLockupTier", nil)
+	cdc.RegisterConcrete(&MsgRemoveLockupTier{}, "migration/RemoveLockupTier", nil)
+	cdc.RegisterConcrete(&MsgSetRatioSchedule{}, "migration/SetRatioSchedule", nil)
+	cdc.RegisterConcrete(&MsgRemoveRatioSchedule{}, "migration/RemoveRatioSchedule", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawalConfig{}, "migration/SetWithdrawalConfig", nil)
+	cdc.RegisterConcrete(&MsgRequestWithdrawal{}, "migration/RequestWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgSignWithdrawal{}, "migration/SignWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgConfirmWithdrawal{}, "migration/ConfirmWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgCancelWithdrawal{}, "migration/CancelWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgArchiveMigrations{}, "migration/ArchiveMigrations", nil)
+	cdc.RegisterConcrete(&MsgSetFeeWaiverConfig{}, "migration/SetFeeWaiverConfig", nil)
+	cdc.RegisterConcrete(&MsgSetFeeAllowanceConfig{}, "migration/SetFeeAllowanceConfig", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -28,6 +44,52 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateConfig{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevertMigration{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetTokenSunset{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetSourceChain{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetMigrationFee{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetLockupTier{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRemoveLockupTier{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetRatioSchedule{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRemoveRatioSchedule{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetWithdrawalConfig{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestWithdrawal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSignWithdrawal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgConfirmWithdrawal{},
+		&MsgCancelWithdrawal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgArchiveMigrations{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetFeeWaiverConfig{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetFeeAllowanceConfig{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -11,6 +11,9 @@ func GetInstantlyReleasedAmount() sdkmath.Uint {
 
 const DENOM = "uslf"
 
+// EthereumChainId is the EVM chain id of Ethereum mainnet, the network migrations originally came from
+const EthereumChainId uint64 = 1
+
 type Token int64
 
 // Token enum
@@ -19,6 +22,28 @@ const (
 	Hotcross Token = 1
 )
 
+// MaxFeeBasisPoints is the fee taking the whole minted amount
+const MaxFeeBasisPoints uint64 = 10000
+
+// DefaultLockupTier is the tier of the migrations that keep the configured vesting lockup
+const DefaultLockupTier uint64 = 0
+
+// Bounds of the lockup tiers, in percent
+const (
+	MaxLockupDurationMultiplier uint64 = 1000
+	MaxLockupBonusRatio         uint64 = 200
+)
+
+// MaxRatioSchedulePoints bounds the size of a ratio schedule
+const MaxRatioSchedulePoints = 100
+
+// MaxArchivedMigrationsPerMsg bounds the gas of an archiving message, the rest is archived by the next one
+const MaxArchivedMigrationsPerMsg = 1000
+
+// ContractCallbackGasLimit bounds the gas a contract can use when receiving a migration so that a failing
+// contract can't block the migration, which then goes to its destination address
+const ContractCallbackGasLimit uint64 = 1_000_000
+
 // Ratios
 const (
 	FRONT_RATIO    = 100 // 100%
@@ -10,11 +10,40 @@ import (
 
 // x/migration module sentinel errors
 var (
-	ErrInvalidMigrationAmount = sdkerrors.Register(ModuleName, 1100, fmt.Sprintf("Min migration amount is required"))
-	ErrTokenNotSupported      = sdkerrors.Register(ModuleName, 1101, "Token should either Front or Hotcross")
-	ErrEmptyStringValue       = sdkerrors.Register(ModuleName, 1102, "TxHash or EthAddress have empty string value")
-	ErrUnknownMigrator        = sdkerrors.Register(ModuleName, 1103, "Unknown migrator")
-	ErrMigrationProcessed     = sdkerrors.Register(ModuleName, 1104, "The given migration message has been previously processed")
-	ErrOnlyAdmin              = sdkerrors.Register(ModuleName, 1105, "Only admin can update migrators")
-	ErrHotcrossRatioZero      = sdkerrors.Register(ModuleName, 1106, "The Hotcross ratio has not been set yet")
+	ErrInvalidMigrationAmount   = sdkerrors.Register(ModuleName, 1100, fmt.Sprintf("Min migration amount is required"))
+	ErrTokenNotSupported        = sdkerrors.Register(ModuleName, 1101, "Token should either Front or Hotcross")
+	ErrEmptyStringValue         = sdkerrors.Register(ModuleName, 1102, "TxHash or EthAddress have empty string value")
+	ErrUnknownMigrator          = sdkerrors.Register(ModuleName, 1103, "Unknown migrator")
+	ErrMigrationProcessed       = sdkerrors.Register(ModuleName, 1104, "The given migration message has been previously processed")
+	ErrOnlyAdmin                = sdkerrors.Register(ModuleName, 1105, "Only admin can update migrators")
+	ErrHotcrossRatioZero        = sdkerrors.Register(ModuleName, 1106, "The Hotcross ratio has not been set yet")
+	ErrInvalidSigner            = sdkerrors.Register(ModuleName, 1107, "Expected gov account as the only signer")
+	ErrMigrationNotFound        = sdkerrors.Register(ModuleName, 1108, "The given migration has not been processed")
+	ErrMigrationReverted        = sdkerrors.Register(ModuleName, 1109, "The given migration has already been reverted")
+	ErrMigrationNotRevertible   = sdkerrors.Register(ModuleName, 1110, "The given migration record does not hold enough data to be reverted")
+	ErrMigrationEnded           = sdkerrors.Register(ModuleName, 1111, "The migration of the given token has ended")
+	ErrMigrationCapExceeded     = sdkerrors.Register(ModuleName, 1112, "The migration exceeds the allocation of the given token")
+	ErrAllocationSwept          = sdkerrors.Register(ModuleName, 1113, "The unclaimed allocation of the given token has already been swept")
+	ErrSourceChainNotSupported  = sdkerrors.Register(ModuleName, 1114, "The given source chain is not supported")
+	ErrTokenNotSupportedOnChain = sdkerrors.Register(ModuleName, 1115, "The given token can not be migrated from the given source chain")
+	ErrUnknownLockupTier        = sdkerrors.Register(ModuleName, 1116, "The given lockup tier does not exist")
+	ErrRatioScheduleNotFound    = sdkerrors.Register(ModuleName, 1117, "The given token has no ratio schedule")
+	ErrWithdrawalsDisabled      = sdkerrors.Register(ModuleName, 1118, "Withdrawals have not been configured")
+	ErrWithdrawalNotFound       = sdkerrors.Register(ModuleName, 1119, "The given withdrawal does not exist")
+	ErrWithdrawalExpired        = sdkerrors.Register(ModuleName, 1120, "The given withdrawal has expired")
+	ErrWithdrawalAlreadySigned  = sdkerrors.Register(ModuleName, 1121, "The given withdrawal has already been signed by the migrator")
+	ErrWithdrawalNotSigned      = sdkerrors.Register(ModuleName, 1122, "The given withdrawal has not reached the signature threshold")
+	ErrWithdrawalClosed         = sdkerrors.Register(ModuleName, 1123, "The given withdrawal has already been executed or refunded")
+	ErrMigrationArchived        = sdkerrors.Register(ModuleName, 1124, "The given migration has been archived")
+	ErrInvalidCallback          = sdkerrors.Register(ModuleName, 1125, "The contract callback must be a JSON message sent to a contract")
+	ErrContractCallbackFailed   = sdkerrors.Register(ModuleName, 1126, "The contract failed to receive the migration")
+	ErrMigrationPending         = sdkerrors.Register(ModuleName, 1127, "The given migration is already waiting in the mempool")
+)
+
+var (
+	ErrWithdrawalAlreadyConfirmed = sdkerrors.Register(ModuleName, 1128, "The given withdrawal has already been confirmed by the migrator")
+	ErrWithdrawalExceedsMinted    = sdkerrors.Register(ModuleName, 1129, "The withdrawal exceeds the amount minted for the given token")
+	ErrWithdrawalNotExpired       = sdkerrors.Register(ModuleName, 1130, "The given withdrawal can still be executed by the vault")
+	ErrWithdrawalAlreadyCancelled = sdkerrors.Register(ModuleName, 1131, "The given withdrawal has already been cancelled by the migrator")
+	ErrContractMigrationVests     = sdkerrors.Register(ModuleName, 1132, "Only migrations released at once can be sent to a contract")
 )
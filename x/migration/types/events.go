@@ -0,0 +1,47 @@
+package types
+
+// migration module event types
+const (
+	EventTypeRevertMigration = "revert_migration"
+	EventTypeSweepAllocation = "sweep_allocation"
+
+	EventTypeRequestWithdrawal = "request_withdrawal"
+	EventTypeSignWithdrawal    = "sign_withdrawal"
+	EventTypeConfirmWithdrawal = "confirm_withdrawal"
+	EventTypeCancelWithdrawal  = "cancel_withdrawal"
+	EventTypeRefundWithdrawal  = "refund_withdrawal"
+
+	EventTypeArchiveMigrations = "archive_migrations"
+
+	EventTypeContractCallbackFailed = "contract_callback_failed"
+
+	AttributeKeyMsgHash     = "msg_hash"
+	AttributeKeyDestAddress = "dest_address"
+	AttributeKeyClawedBack  = "clawed_back"
+	AttributeKeyRecovered   = "recovered"
+	AttributeKeyBurned      = "burned"
+	AttributeKeyShortfall   = "shortfall"
+	AttributeKeyToken       = "token"
+	AttributeKeySweepMode   = "sweep_mode"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyRecipient   = "recipient"
+
+	AttributeKeyShortfallSpent        = "shortfall_spent"
+	AttributeKeyShortfallFee          = "shortfall_fee"
+	AttributeKeyShortfallFeeAllowance = "shortfall_fee_allowance"
+
+	AttributeKeyWithdrawalId = "withdrawal_id"
+	AttributeKeyEthRecipient = "eth_recipient"
+	AttributeKeyTokenAmount  = "token_amount"
+	AttributeKeyPayloadHash  = "payload_hash"
+	AttributeKeyMigrator     = "migrator"
+	AttributeKeyStatus       = "status"
+	AttributeKeyEthTxHash    = "eth_tx_hash"
+
+	AttributeKeyBeforeBlock   = "before_block"
+	AttributeKeyArchived      = "archived"
+	AttributeKeySourceChainId = "source_chain_id"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyError    = "error"
+)
@@ -4,6 +4,9 @@ import (
 	selfvestingTypes "selfchain/x/selfvesting/types"
 
 	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,10 +19,27 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// DistrKeeper defines the expected interface needed to fund the community pool
+type DistrKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // SelfvestingKeeper defines the expected interface needed to interact with the selfvesting module
 type SelfvestingKeeper interface {
-	AddBeneficiary(ctx sdk.Context, req selfvestingTypes.AddBeneficiaryRequest) (*selfvestingTypes.VestingInfo, error)
+	AddBeneficiary(ctx sdk.Context, req selfvestingTypes.AddBeneficiaryRequest) (*selfvestingTypes.VestingInfo, uint64, error)
+	ClawbackPosition(ctx sdk.Context, positionId uint64) (sdkmath.Uint, sdkmath.Uint, error)
+	GetLegacyPositionIndex(ctx sdk.Context, beneficiary string, index uint64) (selfvestingTypes.LegacyPositionIndex, bool)
+	GrantFeeAllowance(ctx sdk.Context, beneficiary string, spendLimit sdk.Coins, expiration time.Time) error
+}
+
+// WasmKeeper defines the expected interface needed to execute the callback of a contract receiving a migration
+type WasmKeeper interface {
+	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
 }
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the allowance is backed by what would otherwise be instantly released and
+// that it expires
+func (c FeeAllowanceConfig) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	spendLimit, err := sdkmath.ParseUint(c.SpendLimit)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid spend limit (%s)", err)
+	}
+
+	if spendLimit.GT(GetInstantlyReleasedAmount()) {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "spend limit exceeds the instantly released amount of %s", GetInstantlyReleasedAmount())
+	}
+
+	if c.Expiration == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "fee allowance must expire")
+	}
+
+	return nil
+}
+
+// Enabled returns whether the beneficiaries of new vesting positions get a fee allowance
+func (c FeeAllowanceConfig) Enabled() bool {
+	return c.SpendLimit != "" && c.SpendLimit != "0"
+}
+
+// SpendLimitAmount returns the spend limit of the allowance in uslf
+func (c FeeAllowanceConfig) SpendLimitAmount() sdkmath.Uint {
+	return sdkmath.NewUintFromString(c.SpendLimit)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the quota of a migrator fits in the budget of a block
+func (c FeeWaiverConfig) Validate() error {
+	if c.MigratorGasQuota > c.BlockGasBudget {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "migrator gas quota exceeds the block gas budget")
+	}
+
+	return nil
+}
+
+// Enabled returns whether migrators can send fee-free transactions
+func (c FeeWaiverConfig) Enabled() bool {
+	return c.BlockGasBudget > 0 && c.MigratorGasQuota > 0
+}
+
+// GasUsedAt returns the gas used at the given height. The usage of a previous block doesn't count.
+func (u FeeWaiverUsage) GasUsedAt(height int64) uint64 {
+	if u.Height != height {
+		return 0
+	}
+
+	return u.GasUsed
+}
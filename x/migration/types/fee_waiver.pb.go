@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: selfchain/migration/fee_waiver.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeWaiverConfig bounds the gas of the fee-free migrator transactions. A zero block budget disables them.
+// The gas limit of the transactions is counted, not the gas they use.
+type FeeWaiverConfig struct {
+	// gas limit of all the fee-free transactions of a block
+	BlockGasBudget uint64 `protobuf:"varint,1,opt,name=blockGasBudget,proto3" json:"blockGasBudget,omitempty"`
+	// gas limit of the fee-free transactions of a migrator within a block
+	MigratorGasQuota uint64 `protobuf:"varint,2,opt,name=migratorGasQuota,proto3" json:"migratorGasQuota,omitempty"`
+}
+
+func (m *FeeWaiverConfig) Reset()         { *m = FeeWaiverConfig{} }
+func (m *FeeWaiverConfig) String() string { return proto.CompactTextString(m) }
+func (*FeeWaiverConfig) ProtoMessage()    {}
+func (*FeeWaiverConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_803950fd2af75c41, []int{0}
+}
+func (m *FeeWaiverConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeWaiverConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeWaiverConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeWaiverConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeWaiverConfig.Merge(m, src)
+}
+func (m *FeeWaiverConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeWaiverConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeWaiverConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeWaiverConfig proto.InternalMessageInfo
+
+func (m *FeeWaiverConfig) GetBlockGasBudget() uint64 {
+	if m != nil {
+		return m.BlockGasBudget
+	}
+	return 0
+}
+
+func (m *FeeWaiverConfig) GetMigratorGasQuota() uint64 {
+	if m != nil {
+		return m.MigratorGasQuota
+	}
+	return 0
+}
+
+// FeeWaiverUsage is the gas of the fee-free transactions included at a block height
+type FeeWaiverUsage struct {
+	Height  int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+}
+
+func (m *FeeWaiverUsage) Reset()         { *m = FeeWaiverUsage{} }
+func (m *FeeWaiverUsage) String() string { return proto.CompactTextString(m) }
+func (*FeeWaiverUsage) ProtoMessage()    {}
+func (*FeeWaiverUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_803950fd2af75c41, []int{1}
+}
+func (m *FeeWaiverUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeWaiverUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeWaiverUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeWaiverUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeWaiverUsage.Merge(m, src)
+}
+func (m *FeeWaiverUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeWaiverUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeWaiverUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeWaiverUsage proto.InternalMessageInfo
+
+func (m *FeeWaiverUsage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeWaiverUsage) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FeeWaiverConfig)(nil), "selfchain.migration.FeeWaiverConfig")
+	proto.RegisterType((*FeeWaiverUsage)(nil), "selfchain.migration.FeeWaiverUsage")
+}
+
+func init() {
+	proto.RegisterFile("selfchain/migration/fee_waiver.proto", fileDescriptor_803950fd2af75c41)
+}
+
+var fileDescriptor_803950fd2af75c41 = []byte{
+	// 217 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x4e, 0xcd, 0x49,
+	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
+	0x4f, 0x4b, 0x4d, 0x8d, 0x2f, 0x4f, 0xcc, 0x2c, 0x4b, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x12, 0x86, 0xab, 0xd2, 0x83, 0xab, 0x52, 0x4a, 0xe5, 0xe2, 0x77, 0x4b, 0x4d, 0x0d, 0x07,
+	0xab, 0x73, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x17, 0x52, 0xe3, 0xe2, 0x4b, 0xca, 0xc9, 0x4f, 0xce,
+	0x76, 0x4f, 0x2c, 0x76, 0x2a, 0x4d, 0x49, 0x4f, 0x2d, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09,
+	0x42, 0x13, 0x15, 0xd2, 0xe2, 0x12, 0x80, 0x98, 0x93, 0x5f, 0xe4, 0x9e, 0x58, 0x1c, 0x58, 0x9a,
+	0x5f, 0x92, 0x28, 0xc1, 0x04, 0x56, 0x89, 0x21, 0xae, 0xe4, 0xc4, 0xc5, 0x07, 0xb7, 0x26, 0xb4,
+	0x38, 0x31, 0x3d, 0x55, 0x48, 0x8c, 0x8b, 0x2d, 0x23, 0x35, 0x33, 0x3d, 0x03, 0x62, 0x3a, 0x73,
+	0x10, 0x94, 0x27, 0x24, 0xc1, 0xc5, 0x9e, 0x9e, 0x58, 0x1c, 0x5a, 0x9c, 0x9a, 0x02, 0x35, 0x0c,
+	0xc6, 0x75, 0x32, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
+	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x69, 0x84,
+	0xff, 0x2b, 0x90, 0x42, 0xa0, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x7b, 0x63, 0xc0,
+	0x00, 0xd3, 0x5b, 0xf2, 0x66, 0x25, 0x01, 0x00, 0x00,
+}
+
+func (m *FeeWaiverConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeWaiverConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeWaiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigratorGasQuota != 0 {
+		i = encodeVarintFeeWaiver(dAtA, i, uint64(m.MigratorGasQuota))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockGasBudget != 0 {
+		i = encodeVarintFeeWaiver(dAtA, i, uint64(m.BlockGasBudget))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeWaiverUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeWaiverUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeWaiverUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintFeeWaiver(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeeWaiver(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeWaiver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeWaiver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeWaiverConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockGasBudget != 0 {
+		n += 1 + sovFeeWaiver(uint64(m.BlockGasBudget))
+	}
+	if m.MigratorGasQuota != 0 {
+		n += 1 + sovFeeWaiver(uint64(m.MigratorGasQuota))
+	}
+	return n
+}
+
+func (m *FeeWaiverUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeeWaiver(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeeWaiver(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovFeeWaiver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeWaiver(x uint64) (n int) {
+	return sovFeeWaiver(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeWaiverConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeWaiver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeWaiverConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeWaiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasBudget", wireType)
+			}
+			m.BlockGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeWaiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratorGasQuota", wireType)
+			}
+			m.MigratorGasQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeWaiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratorGasQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeWaiver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeWaiver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeWaiverUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeWaiver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeWaiverUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeWaiverUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeWaiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeWaiver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeWaiver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeWaiver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeWaiver(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeWaiver
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeWaiver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeWaiver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeWaiver
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeWaiver
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeWaiver
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeWaiver        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeWaiver          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeWaiver = fmt.Errorf("proto: unexpected end of group")
+)
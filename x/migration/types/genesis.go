@@ -10,10 +10,28 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		TokenMigrationList: []TokenMigration{},
-		Acl:                nil,
-		MigratorList:       []Migrator{},
-		Config:             nil,
+		TokenMigrationList:   []TokenMigration{},
+		Acl:                  nil,
+		MigratorList:         []Migrator{},
+		Config:               nil,
+		TokenSunsetList:      []TokenSunset{},
+		SourceChainList:      []SourceChain{},
+		SourceChainStatsList: []SourceChainStats{},
+		MigrationFeeList:     []MigrationFee{},
+		LockupTierList:       []LockupTier{},
+		RatioScheduleList:    []RatioSchedule{},
+		WithdrawalList:       []Withdrawal{},
+		WithdrawalConfig:     nil,
+		MigrationArchiveList: []MigrationArchive{},
+		ArchivedMsgHashList:  []string{},
+		MigrationTotals:      nil,
+		TokenStatsList:       []TokenStats{},
+		MigratorStatsList:    []MigratorStats{},
+		DailyStatsList:       []DailyStats{},
+
+		MigrationDestinationList: []MigrationDestination{},
+		FeeWaiverConfig:          nil,
+		FeeAllowanceConfig:       nil,
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -26,7 +44,7 @@ func (gs GenesisState) Validate() error {
 	tokenMigrationIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.TokenMigrationList {
-		index := string(TokenMigrationKey(elem.MsgHash))
+		index := string(TokenMigrationKey(MsgHashBytes(elem.MsgHash)))
 		if _, ok := tokenMigrationIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for tokenMigration")
 		}
@@ -42,6 +60,149 @@ func (gs GenesisState) Validate() error {
 		}
 		migratorIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in tokenSunset
+	tokenSunsetIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TokenSunsetList {
+		index := string(TokenSunsetKey(elem.Token))
+		if _, ok := tokenSunsetIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for tokenSunset")
+		}
+		tokenSunsetIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in sourceChain
+	sourceChainIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.SourceChainList {
+		index := string(SourceChainKey(elem.ChainId))
+		if _, ok := sourceChainIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for sourceChain")
+		}
+		sourceChainIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in sourceChainStats
+	sourceChainStatsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.SourceChainStatsList {
+		index := string(SourceChainKey(elem.ChainId))
+		if _, ok := sourceChainStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for sourceChainStats")
+		}
+		sourceChainStatsIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in migrationFee
+	migrationFeeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.MigrationFeeList {
+		index := string(MigrationFeeKey(elem.Token))
+		if _, ok := migrationFeeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for migrationFee")
+		}
+		migrationFeeIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in lockupTier
+	lockupTierIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.LockupTierList {
+		index := string(LockupTierKey(elem.Tier))
+		if _, ok := lockupTierIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for lockupTier")
+		}
+		lockupTierIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in ratioSchedule
+	ratioScheduleIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RatioScheduleList {
+		index := string(RatioScheduleKey(elem.Token))
+		if _, ok := ratioScheduleIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for ratioSchedule")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		ratioScheduleIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in withdrawal
+	withdrawalIdMap := make(map[uint64]bool)
+	withdrawalCount := gs.GetWithdrawalCount()
+	for _, elem := range gs.WithdrawalList {
+		if _, ok := withdrawalIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for withdrawal")
+		}
+		if elem.Id >= withdrawalCount {
+			return fmt.Errorf("withdrawal id should be lower or equal than the last id")
+		}
+		withdrawalIdMap[elem.Id] = true
+	}
+	// Check for archived migrations still holding a record
+	for _, msgHash := range gs.ArchivedMsgHashList {
+		index := string(TokenMigrationKey(MsgHashBytes(msgHash)))
+		if _, ok := tokenMigrationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for archived tokenMigration")
+		}
+		tokenMigrationIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in migrationArchive
+	migrationArchiveIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.MigrationArchiveList {
+		index := string(MigrationArchiveKey(elem.Token, elem.SourceChainId, elem.Bucket))
+		if _, ok := migrationArchiveIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for migrationArchive")
+		}
+		migrationArchiveIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in tokenStats
+	tokenStatsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TokenStatsList {
+		index := string(TokenStatsKey(elem.Token))
+		if _, ok := tokenStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for tokenStats")
+		}
+		tokenStatsIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in migratorStats
+	migratorStatsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.MigratorStatsList {
+		index := string(MigratorStatsKey(elem.Migrator))
+		if _, ok := migratorStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for migratorStats")
+		}
+		migratorStatsIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in dailyStats
+	dailyStatsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DailyStatsList {
+		index := string(DailyStatsKey(elem.Day))
+		if _, ok := dailyStatsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dailyStats")
+		}
+		dailyStatsIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in migrationDestination
+	migrationDestinationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.MigrationDestinationList {
+		index := string(MigrationDestinationKey(elem.Address))
+		if _, ok := migrationDestinationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for migrationDestination")
+		}
+		migrationDestinationIndexMap[index] = struct{}{}
+	}
+	if gs.FeeWaiverConfig != nil {
+		if err := gs.FeeWaiverConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	if gs.FeeAllowanceConfig != nil {
+		if err := gs.FeeAllowanceConfig.Validate(); err != nil {
+			return err
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
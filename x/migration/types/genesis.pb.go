@@ -25,11 +25,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the migration module's genesis state.
 type GenesisState struct {
-	Params             Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	TokenMigrationList []TokenMigration `protobuf:"bytes,2,rep,name=tokenMigrationList,proto3" json:"tokenMigrationList"`
-	Acl                *Acl             `protobuf:"bytes,3,opt,name=acl,proto3" json:"acl,omitempty"`
-	MigratorList       []Migrator       `protobuf:"bytes,4,rep,name=migratorList,proto3" json:"migratorList"`
-	Config             *Config          `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	Params               Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TokenMigrationList   []TokenMigration   `protobuf:"bytes,2,rep,name=tokenMigrationList,proto3" json:"tokenMigrationList"`
+	Acl                  *Acl               `protobuf:"bytes,3,opt,name=acl,proto3" json:"acl,omitempty"`
+	MigratorList         []Migrator         `protobuf:"bytes,4,rep,name=migratorList,proto3" json:"migratorList"`
+	Config               *Config            `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	TokenSunsetList      []TokenSunset      `protobuf:"bytes,6,rep,name=tokenSunsetList,proto3" json:"tokenSunsetList"`
+	SourceChainList      []SourceChain      `protobuf:"bytes,7,rep,name=sourceChainList,proto3" json:"sourceChainList"`
+	SourceChainStatsList []SourceChainStats `protobuf:"bytes,8,rep,name=sourceChainStatsList,proto3" json:"sourceChainStatsList"`
+	MigrationFeeList     []MigrationFee     `protobuf:"bytes,9,rep,name=migrationFeeList,proto3" json:"migrationFeeList"`
+	LockupTierList       []LockupTier       `protobuf:"bytes,11,rep,name=lockupTierList,proto3" json:"lockupTierList"`
+	RatioScheduleList    []RatioSchedule    `protobuf:"bytes,12,rep,name=ratioScheduleList,proto3" json:"ratioScheduleList"`
+	WithdrawalList       []Withdrawal       `protobuf:"bytes,13,rep,name=withdrawalList,proto3" json:"withdrawalList"`
+	WithdrawalCount      uint64             `protobuf:"varint,14,opt,name=withdrawalCount,proto3" json:"withdrawalCount,omitempty"`
+	WithdrawalConfig     *WithdrawalConfig  `protobuf:"bytes,15,opt,name=withdrawalConfig,proto3" json:"withdrawalConfig,omitempty"`
+	MigrationArchiveList []MigrationArchive `protobuf:"bytes,16,rep,name=migrationArchiveList,proto3" json:"migrationArchiveList"`
+	// hashes of the archived migrations, kept to reject replays
+	ArchivedMsgHashList      []string               `protobuf:"bytes,17,rep,name=archivedMsgHashList,proto3" json:"archivedMsgHashList,omitempty"`
+	MigrationTotals          *MigrationTotals       `protobuf:"bytes,18,opt,name=migrationTotals,proto3" json:"migrationTotals,omitempty"`
+	TokenStatsList           []TokenStats           `protobuf:"bytes,19,rep,name=tokenStatsList,proto3" json:"tokenStatsList"`
+	MigratorStatsList        []MigratorStats        `protobuf:"bytes,20,rep,name=migratorStatsList,proto3" json:"migratorStatsList"`
+	DailyStatsList           []DailyStats           `protobuf:"bytes,21,rep,name=dailyStatsList,proto3" json:"dailyStatsList"`
+	MigrationDestinationList []MigrationDestination `protobuf:"bytes,22,rep,name=migrationDestinationList,proto3" json:"migrationDestinationList"`
+	FeeWaiverConfig          *FeeWaiverConfig       `protobuf:"bytes,23,opt,name=feeWaiverConfig,proto3" json:"feeWaiverConfig,omitempty"`
+	FeeAllowanceConfig       *FeeAllowanceConfig    `protobuf:"bytes,24,opt,name=feeAllowanceConfig,proto3" json:"feeAllowanceConfig,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +119,132 @@ func (m *GenesisState) GetConfig() *Config {
 	return nil
 }
 
+func (m *GenesisState) GetTokenSunsetList() []TokenSunset {
+	if m != nil {
+		return m.TokenSunsetList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSourceChainList() []SourceChain {
+	if m != nil {
+		return m.SourceChainList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSourceChainStatsList() []SourceChainStats {
+	if m != nil {
+		return m.SourceChainStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMigrationFeeList() []MigrationFee {
+	if m != nil {
+		return m.MigrationFeeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockupTierList() []LockupTier {
+	if m != nil {
+		return m.LockupTierList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRatioScheduleList() []RatioSchedule {
+	if m != nil {
+		return m.RatioScheduleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawalList() []Withdrawal {
+	if m != nil {
+		return m.WithdrawalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawalCount() uint64 {
+	if m != nil {
+		return m.WithdrawalCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetWithdrawalConfig() *WithdrawalConfig {
+	if m != nil {
+		return m.WithdrawalConfig
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMigrationArchiveList() []MigrationArchive {
+	if m != nil {
+		return m.MigrationArchiveList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedMsgHashList() []string {
+	if m != nil {
+		return m.ArchivedMsgHashList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMigrationTotals() *MigrationTotals {
+	if m != nil {
+		return m.MigrationTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenStatsList() []TokenStats {
+	if m != nil {
+		return m.TokenStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMigratorStatsList() []MigratorStats {
+	if m != nil {
+		return m.MigratorStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDailyStatsList() []DailyStats {
+	if m != nil {
+		return m.DailyStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMigrationDestinationList() []MigrationDestination {
+	if m != nil {
+		return m.MigrationDestinationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeWaiverConfig() *FeeWaiverConfig {
+	if m != nil {
+		return m.FeeWaiverConfig
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeAllowanceConfig() *FeeAllowanceConfig {
+	if m != nil {
+		return m.FeeAllowanceConfig
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "selfchain.migration.GenesisState")
 }
@@ -107,27 +252,56 @@ func init() {
 func init() { proto.RegisterFile("selfchain/migration/genesis.proto", fileDescriptor_bcdb41b18a9cc546) }
 
 var fileDescriptor_bcdb41b18a9cc546 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x4e, 0xcd, 0x49,
-	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x2b, 0xd1, 0x83, 0x2b, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58, 0x10,
-	0xa5, 0x52, 0x0a, 0xd8, 0x4c, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x1a, 0x26, 0xa5, 0x89, 0x4d,
-	0x45, 0x49, 0x7e, 0x76, 0x6a, 0x5e, 0x3c, 0x9c, 0x0f, 0x55, 0x2a, 0x8b, 0x4d, 0x69, 0x62, 0x72,
-	0x0e, 0x54, 0x5a, 0x09, 0x9b, 0x34, 0x84, 0x95, 0x5f, 0x84, 0xcf, 0x3d, 0xc9, 0xf9, 0x79, 0x69,
-	0x99, 0xe9, 0x10, 0x15, 0x4a, 0xe7, 0x98, 0xb8, 0x78, 0xdc, 0x21, 0xde, 0x0d, 0x2e, 0x49, 0x2c,
-	0x49, 0x15, 0xb2, 0xe4, 0x62, 0x83, 0x38, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x5a,
-	0x0f, 0x8b, 0xf7, 0xf5, 0x02, 0xc0, 0x4a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a,
-	0x10, 0x8a, 0xe4, 0x12, 0x02, 0xfb, 0xc4, 0x17, 0xa6, 0xcc, 0x27, 0xb3, 0xb8, 0x44, 0x82, 0x49,
-	0x81, 0x59, 0x83, 0xdb, 0x48, 0x19, 0xab, 0x31, 0x21, 0x28, 0xca, 0xa1, 0xc6, 0x61, 0x31, 0x44,
-	0x48, 0x8b, 0x8b, 0x39, 0x31, 0x39, 0x47, 0x82, 0x19, 0xec, 0x24, 0x09, 0xac, 0x66, 0x39, 0x26,
-	0xe7, 0x04, 0x81, 0x14, 0x09, 0xb9, 0x73, 0xf1, 0xc0, 0x82, 0x01, 0xec, 0x00, 0x16, 0xb0, 0x03,
-	0x64, 0xb1, 0x6a, 0xf2, 0x85, 0x2a, 0x84, 0x5a, 0x8d, 0xa2, 0x51, 0xc8, 0x98, 0x8b, 0x0d, 0x12,
-	0x56, 0x12, 0xac, 0x78, 0x82, 0xc2, 0x19, 0xac, 0x24, 0x08, 0xaa, 0xd4, 0xc9, 0xf4, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xa4, 0x11, 0x91, 0x51, 0x81, 0x1c, 0xf9, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xe8, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x16, 0x92,
-	0x55, 0x9c, 0x90, 0x02, 0x00, 0x00,
+	// 775 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0x87, 0x93, 0x1b, 0x6e, 0xee, 0x65, 0xe0, 0x12, 0x18, 0xb8, 0xad, 0x05, 0x22, 0x04, 0x0a,
+	0x25, 0x50, 0x29, 0x54, 0xa0, 0x2e, 0xba, 0xe4, 0x8f, 0xa0, 0x0b, 0x52, 0x51, 0x27, 0x6a, 0xd4,
+	0x6e, 0xa2, 0xa9, 0x33, 0x49, 0x46, 0x18, 0x4f, 0xe4, 0x71, 0x48, 0x79, 0x85, 0xae, 0xfa, 0x58,
+	0x2c, 0x59, 0x76, 0x55, 0x55, 0xf0, 0x22, 0x95, 0xcf, 0xcc, 0x38, 0x89, 0x33, 0x99, 0xae, 0x9a,
+	0xda, 0xdf, 0xf9, 0xfc, 0xf3, 0x9c, 0x33, 0x63, 0xd0, 0xa6, 0xa0, 0x7e, 0xdb, 0xeb, 0x12, 0x16,
+	0x1c, 0xdc, 0xb0, 0x4e, 0x48, 0x22, 0xc6, 0x83, 0x83, 0x0e, 0x0d, 0xa8, 0x60, 0xa2, 0xd2, 0x0b,
+	0x79, 0xc4, 0xf1, 0x72, 0x82, 0x54, 0x12, 0x64, 0x75, 0xa5, 0xc3, 0x3b, 0x1c, 0xee, 0x1f, 0xc4,
+	0xbf, 0x24, 0xba, 0x5a, 0x32, 0xd9, 0x7a, 0x24, 0x24, 0x37, 0x4a, 0xb6, 0xba, 0x67, 0x22, 0x22,
+	0x7e, 0x4d, 0x83, 0x66, 0xf2, 0x7f, 0x85, 0xae, 0x9b, 0x50, 0xe2, 0xf9, 0xea, 0xf6, 0x96, 0xe9,
+	0xb6, 0xfc, 0xc5, 0x43, 0x5b, 0x1e, 0x8f, 0x07, 0x6d, 0xd6, 0x51, 0xc4, 0xcb, 0xe9, 0x79, 0x44,
+	0x3f, 0x10, 0x34, 0xb2, 0x71, 0x82, 0xf7, 0x43, 0x8f, 0x36, 0xe5, 0xda, 0x48, 0x6e, 0x77, 0x7a,
+	0x2a, 0xc6, 0x83, 0x66, 0x9b, 0x52, 0x05, 0xee, 0x98, 0x40, 0x9f, 0x7b, 0xd7, 0xfd, 0x5e, 0x33,
+	0x62, 0x54, 0xbf, 0x41, 0xd9, 0x84, 0xc1, 0x3f, 0x4d, 0xe1, 0x75, 0x69, 0xab, 0xef, 0x6b, 0xe1,
+	0xb6, 0x89, 0x1c, 0xb0, 0xa8, 0xdb, 0x0a, 0xc9, 0x80, 0xe8, 0x55, 0x7b, 0x65, 0xcf, 0x47, 0x42,
+	0xaf, 0xcb, 0x6e, 0xb5, 0x72, 0xdf, 0x0e, 0x47, 0x3c, 0x22, 0xbe, 0xb0, 0x3d, 0xbe, 0x4d, 0x69,
+	0x73, 0x40, 0xd8, 0x2d, 0x0d, 0x6d, 0xcb, 0x13, 0x53, 0xc4, 0xf7, 0xf9, 0x80, 0x04, 0x9e, 0x7a,
+	0xf4, 0xd6, 0xb7, 0x05, 0x34, 0x7f, 0x21, 0xc7, 0xb0, 0x16, 0x91, 0x88, 0xe2, 0xb7, 0x28, 0x2f,
+	0x07, 0xc9, 0xc9, 0x96, 0xb2, 0xe5, 0xb9, 0xc3, 0xb5, 0x8a, 0x61, 0x2c, 0x2b, 0x57, 0x80, 0x9c,
+	0xcc, 0xdc, 0xff, 0xdc, 0xc8, 0xb8, 0xaa, 0x00, 0x7f, 0x42, 0x18, 0x3a, 0x5a, 0xd5, 0xd8, 0x25,
+	0x13, 0x91, 0xf3, 0x57, 0x29, 0x57, 0x9e, 0x3b, 0x7c, 0x61, 0xd4, 0xd4, 0xc7, 0x70, 0xa5, 0x33,
+	0x48, 0xf0, 0x3e, 0xca, 0x11, 0xcf, 0x77, 0x72, 0x10, 0xc9, 0x31, 0xba, 0x8e, 0x3d, 0xdf, 0x8d,
+	0x21, 0x7c, 0x81, 0xe6, 0xf5, 0x78, 0x42, 0x80, 0x19, 0x08, 0xb0, 0x6e, 0x2c, 0xaa, 0x2a, 0x50,
+	0x3d, 0x7a, 0xac, 0x10, 0x1f, 0xa1, 0xbc, 0x9c, 0x61, 0xe7, 0x6f, 0xcb, 0x52, 0x9c, 0x02, 0xe2,
+	0x2a, 0x14, 0x5f, 0xa1, 0x02, 0xe4, 0xaf, 0xc1, 0x54, 0x43, 0x80, 0x3c, 0x04, 0x28, 0x4d, 0x5f,
+	0x01, 0xc9, 0xaa, 0x0c, 0xe9, 0xf2, 0xd8, 0x28, 0x37, 0xc0, 0x69, 0x5c, 0x0b, 0xc6, 0x7f, 0x2c,
+	0xc6, 0xda, 0x90, 0xd5, 0xc6, 0x54, 0x39, 0x6e, 0xa2, 0x95, 0x91, 0x4b, 0x71, 0xdf, 0x05, 0x68,
+	0xff, 0x05, 0xed, 0xce, 0x9f, 0xb4, 0x50, 0xa0, 0xdc, 0x46, 0x11, 0xae, 0xa1, 0xc5, 0xa4, 0xf2,
+	0x9c, 0x52, 0x90, 0xcf, 0x82, 0x7c, 0xd3, 0xd2, 0x06, 0x09, 0x2b, 0xf1, 0x84, 0x00, 0x57, 0xd1,
+	0x82, 0xdc, 0xb7, 0x75, 0x46, 0x65, 0x67, 0xe7, 0x40, 0xb9, 0x61, 0x54, 0x5e, 0x26, 0xa8, 0x12,
+	0xa6, 0x8a, 0xf1, 0x47, 0xb4, 0x04, 0x68, 0x4d, 0x6d, 0x6f, 0x30, 0xce, 0x83, 0x71, 0xcb, 0x68,
+	0x74, 0x47, 0x69, 0x25, 0x9d, 0x54, 0xc4, 0x31, 0x87, 0xa7, 0x01, 0x48, 0xff, 0xb3, 0xc4, 0x6c,
+	0x24, 0xa8, 0x8e, 0x39, 0x5e, 0x8c, 0xcb, 0xa8, 0x30, 0xbc, 0x72, 0xca, 0xfb, 0x41, 0xe4, 0x2c,
+	0x94, 0xb2, 0xe5, 0x19, 0x37, 0x7d, 0x19, 0x7f, 0x40, 0x8b, 0xa3, 0x97, 0x60, 0x70, 0x0b, 0xa5,
+	0xec, 0xd4, 0x8e, 0x36, 0x52, 0xb0, 0x3b, 0x51, 0x1e, 0x0f, 0x4a, 0xc2, 0x1f, 0xcb, 0x23, 0x0b,
+	0xde, 0x68, 0xd1, 0x32, 0x28, 0xd5, 0x54, 0x81, 0x1e, 0x14, 0x93, 0x08, 0xbf, 0x46, 0xcb, 0xea,
+	0x28, 0x6c, 0x55, 0x45, 0xe7, 0x1d, 0x11, 0x5d, 0xf0, 0x2f, 0x95, 0x72, 0xe5, 0x59, 0xd7, 0x74,
+	0x0b, 0xbf, 0x47, 0x85, 0xc4, 0x54, 0x87, 0x83, 0xd1, 0xc1, 0xf0, 0x92, 0xdb, 0xf6, 0x34, 0x92,
+	0x75, 0xd3, 0xc5, 0x71, 0xbb, 0xe4, 0x86, 0x4b, 0x76, 0xc1, 0xb2, 0xa5, 0x5d, 0xf5, 0x04, 0xd5,
+	0xed, 0x1a, 0x2f, 0x8e, 0xa7, 0x4a, 0x9f, 0x21, 0x43, 0xe3, 0x8a, 0x65, 0xaa, 0xaa, 0xa3, 0xb4,
+	0x9e, 0xaa, 0x09, 0x45, 0x1c, 0xb3, 0x45, 0x98, 0x7f, 0x37, 0x94, 0xfe, 0x6f, 0x89, 0x79, 0x96,
+	0xa0, 0x3a, 0xe6, 0x78, 0x31, 0xbe, 0x46, 0x4e, 0x42, 0x9f, 0x51, 0x11, 0xb1, 0x60, 0x78, 0x60,
+	0x3f, 0x03, 0xf1, 0x9e, 0x7d, 0x39, 0x47, 0x8a, 0xd4, 0x23, 0xa6, 0x0a, 0xe3, 0x96, 0xb5, 0x29,
+	0x6d, 0xc0, 0xf7, 0x49, 0xcd, 0xe5, 0x73, 0x4b, 0xcb, 0xce, 0xc7, 0x59, 0x37, 0x5d, 0x8c, 0x1b,
+	0x08, 0xb7, 0x29, 0x3d, 0xd6, 0x5f, 0x32, 0xa5, 0x74, 0x40, 0xb9, 0x3b, 0x4d, 0x99, 0xc2, 0x5d,
+	0x83, 0xe2, 0xe4, 0xcd, 0xfd, 0x63, 0x31, 0xfb, 0xf0, 0x58, 0xcc, 0xfe, 0x7a, 0x2c, 0x66, 0xbf,
+	0x3f, 0x15, 0x33, 0x0f, 0x4f, 0xc5, 0xcc, 0x8f, 0xa7, 0x62, 0xe6, 0xf3, 0xda, 0xf0, 0x7b, 0xfa,
+	0x75, 0xf4, 0x0f, 0x98, 0xbb, 0x1e, 0x15, 0x5f, 0xf2, 0xf0, 0x29, 0x3d, 0xfa, 0x3d, 0x00, 0x3b,
+	0x4b, 0xd5, 0xbc, 0xe4, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -150,151 +324,1064 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Config != nil {
+	if m.FeeAllowanceConfig != nil {
 		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FeeAllowanceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.FeeWaiverConfig != nil {
+		{
+			size, err := m.FeeWaiverConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.MigrationDestinationList) > 0 {
+		for iNdEx := len(m.MigrationDestinationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigrationDestinationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.DailyStatsList) > 0 {
+		for iNdEx := len(m.DailyStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.MigratorStatsList) > 0 {
+		for iNdEx := len(m.MigratorStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigratorStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.TokenStatsList) > 0 {
+		for iNdEx := len(m.TokenStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.MigrationTotals != nil {
+		{
+			size, err := m.MigrationTotals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ArchivedMsgHashList) > 0 {
+		for iNdEx := len(m.ArchivedMsgHashList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ArchivedMsgHashList[iNdEx])
+			copy(dAtA[i:], m.ArchivedMsgHashList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ArchivedMsgHashList[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MigrationArchiveList) > 0 {
+		for iNdEx := len(m.MigrationArchiveList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigrationArchiveList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.WithdrawalConfig != nil {
+		{
+			size, err := m.WithdrawalConfig.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.MigratorList) > 0 {
-		for iNdEx := len(m.MigratorList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MigratorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.WithdrawalCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WithdrawalCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.WithdrawalList) > 0 {
+		for iNdEx := len(m.WithdrawalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RatioScheduleList) > 0 {
+		for iNdEx := len(m.RatioScheduleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RatioScheduleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.LockupTierList) > 0 {
+		for iNdEx := len(m.LockupTierList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupTierList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MigrationFeeList) > 0 {
+		for iNdEx := len(m.MigrationFeeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigrationFeeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SourceChainStatsList) > 0 {
+		for iNdEx := len(m.SourceChainStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceChainStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SourceChainList) > 0 {
+		for iNdEx := len(m.SourceChainList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceChainList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TokenSunsetList) > 0 {
+		for iNdEx := len(m.TokenSunsetList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenSunsetList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MigratorList) > 0 {
+		for iNdEx := len(m.MigratorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigratorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Acl != nil {
+		{
+			size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenMigrationList) > 0 {
+		for iNdEx := len(m.TokenMigrationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenMigrationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TokenMigrationList) > 0 {
+		for _, e := range m.TokenMigrationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MigratorList) > 0 {
+		for _, e := range m.MigratorList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TokenSunsetList) > 0 {
+		for _, e := range m.TokenSunsetList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceChainList) > 0 {
+		for _, e := range m.SourceChainList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceChainStatsList) > 0 {
+		for _, e := range m.SourceChainStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MigrationFeeList) > 0 {
+		for _, e := range m.MigrationFeeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockupTierList) > 0 {
+		for _, e := range m.LockupTierList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RatioScheduleList) > 0 {
+		for _, e := range m.RatioScheduleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawalList) > 0 {
+		for _, e := range m.WithdrawalList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.WithdrawalCount != 0 {
+		n += 1 + sovGenesis(uint64(m.WithdrawalCount))
+	}
+	if m.WithdrawalConfig != nil {
+		l = m.WithdrawalConfig.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MigrationArchiveList) > 0 {
+		for _, e := range m.MigrationArchiveList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedMsgHashList) > 0 {
+		for _, s := range m.ArchivedMsgHashList {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MigrationTotals != nil {
+		l = m.MigrationTotals.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TokenStatsList) > 0 {
+		for _, e := range m.TokenStatsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MigratorStatsList) > 0 {
+		for _, e := range m.MigratorStatsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DailyStatsList) > 0 {
+		for _, e := range m.DailyStatsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MigrationDestinationList) > 0 {
+		for _, e := range m.MigrationDestinationList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.FeeWaiverConfig != nil {
+		l = m.FeeWaiverConfig.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.FeeAllowanceConfig != nil {
+		l = m.FeeAllowanceConfig.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMigrationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenMigrationList = append(m.TokenMigrationList, TokenMigration{})
+			if err := m.TokenMigrationList[len(m.TokenMigrationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &Acl{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratorList = append(m.MigratorList, Migrator{})
+			if err := m.MigratorList[len(m.MigratorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &Config{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSunsetList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSunsetList = append(m.TokenSunsetList, TokenSunset{})
+			if err := m.TokenSunsetList[len(m.TokenSunsetList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChainList = append(m.SourceChainList, SourceChain{})
+			if err := m.SourceChainList[len(m.SourceChainList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChainStatsList = append(m.SourceChainStatsList, SourceChainStats{})
+			if err := m.SourceChainStatsList[len(m.SourceChainStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationFeeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationFeeList = append(m.MigrationFeeList, MigrationFee{})
+			if err := m.MigrationFeeList[len(m.MigrationFeeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTierList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupTierList = append(m.LockupTierList, LockupTier{})
+			if err := m.LockupTierList[len(m.LockupTierList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatioScheduleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RatioScheduleList = append(m.RatioScheduleList, RatioSchedule{})
+			if err := m.RatioScheduleList[len(m.RatioScheduleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalList = append(m.WithdrawalList, Withdrawal{})
+			if err := m.WithdrawalList[len(m.WithdrawalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCount", wireType)
+			}
+			m.WithdrawalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithdrawalConfig == nil {
+				m.WithdrawalConfig = &WithdrawalConfig{}
+			}
+			if err := m.WithdrawalConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationArchiveList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Acl != nil {
-		{
-			size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TokenMigrationList) > 0 {
-		for iNdEx := len(m.TokenMigrationList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokenMigrationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationArchiveList = append(m.MigrationArchiveList, MigrationArchive{})
+			if err := m.MigrationArchiveList[len(m.MigrationArchiveList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedMsgHashList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.TokenMigrationList) > 0 {
-		for _, e := range m.TokenMigrationList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Acl != nil {
-		l = m.Acl.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MigratorList) > 0 {
-		for _, e := range m.MigratorList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ArchivedMsgHashList = append(m.ArchivedMsgHashList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationTotals", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationTotals == nil {
+				m.MigrationTotals = &MigrationTotals{}
+			}
+			if err := m.MigrationTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenStatsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -321,13 +1408,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenStatsList = append(m.TokenStatsList, TokenStats{})
+			if err := m.TokenStatsList[len(m.TokenStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenMigrationList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratorStatsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -354,14 +1442,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenMigrationList = append(m.TokenMigrationList, TokenMigration{})
-			if err := m.TokenMigrationList[len(m.TokenMigrationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MigratorStatsList = append(m.MigratorStatsList, MigratorStats{})
+			if err := m.MigratorStatsList[len(m.MigratorStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyStatsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -388,16 +1476,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Acl == nil {
-				m.Acl = &Acl{}
+			m.DailyStatsList = append(m.DailyStatsList, DailyStats{})
+			if err := m.DailyStatsList[len(m.DailyStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationDestinationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationDestinationList = append(m.MigrationDestinationList, MigrationDestination{})
+			if err := m.MigrationDestinationList[len(m.MigrationDestinationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigratorList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeWaiverConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -424,14 +1544,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MigratorList = append(m.MigratorList, Migrator{})
-			if err := m.MigratorList[len(m.MigratorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FeeWaiverConfig == nil {
+				m.FeeWaiverConfig = &FeeWaiverConfig{}
+			}
+			if err := m.FeeWaiverConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -458,10 +1580,10 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &Config{}
+			if m.FeeAllowanceConfig == nil {
+				m.FeeAllowanceConfig = &FeeAllowanceConfig{}
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeAllowanceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
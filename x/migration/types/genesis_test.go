@@ -49,6 +49,122 @@ func TestGenesisState_Validate(t *testing.T) {
 					VestingCliff:       91,
 					MinMigrationAmount: 80,
 				},
+				TokenSunsetList: []types.TokenSunset{
+					{
+						Token: 0,
+					},
+					{
+						Token: 1,
+					},
+				},
+				SourceChainList: []types.SourceChain{
+					{
+						ChainId: 1,
+					},
+					{
+						ChainId: 56,
+					},
+				},
+				SourceChainStatsList: []types.SourceChainStats{
+					{
+						ChainId: 1,
+					},
+					{
+						ChainId: 56,
+					},
+				},
+				MigrationFeeList: []types.MigrationFee{
+					{
+						Token: 0,
+					},
+					{
+						Token: 1,
+					},
+				},
+				LockupTierList: []types.LockupTier{
+					{
+						Tier: 1,
+					},
+					{
+						Tier: 2,
+					},
+				},
+				RatioScheduleList: []types.RatioSchedule{
+					{
+						Token:  0,
+						Points: []types.RatioPoint{{Time: 0, Ratio: 100}},
+					},
+					{
+						Token:  1,
+						Points: []types.RatioPoint{{Time: 0, Ratio: 100}},
+					},
+				},
+				WithdrawalList: []types.Withdrawal{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				WithdrawalCount: 2,
+				WithdrawalConfig: &types.WithdrawalConfig{
+					SignatureThreshold: 2,
+				},
+				MigrationArchiveList: []types.MigrationArchive{
+					{
+						Token:  0,
+						Bucket: 0,
+					},
+					{
+						Token:  0,
+						Bucket: 1,
+					},
+				},
+				ArchivedMsgHashList: []string{"2"},
+				MigrationTotals: &types.MigrationTotals{
+					MigrationCount: 1,
+				},
+				TokenStatsList: []types.TokenStats{
+					{
+						Token: 0,
+					},
+					{
+						Token: 1,
+					},
+				},
+				MigratorStatsList: []types.MigratorStats{
+					{
+						Migrator: "0",
+					},
+					{
+						Migrator: "1",
+					},
+				},
+				DailyStatsList: []types.DailyStats{
+					{
+						Day: 0,
+					},
+					{
+						Day: 1,
+					},
+				},
+				MigrationDestinationList: []types.MigrationDestination{
+					{
+						Address: "0",
+					},
+					{
+						Address: "1",
+					},
+				},
+				FeeWaiverConfig: &types.FeeWaiverConfig{
+					BlockGasBudget:   500000,
+					MigratorGasQuota: 300000,
+				},
+				FeeAllowanceConfig: &types.FeeAllowanceConfig{
+					SpendLimit: "500000",
+					Expiration: 2592000,
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -83,6 +199,234 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated tokenSunset",
+			genState: &types.GenesisState{
+				TokenSunsetList: []types.TokenSunset{
+					{
+						Token: 0,
+					},
+					{
+						Token: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated sourceChain",
+			genState: &types.GenesisState{
+				SourceChainList: []types.SourceChain{
+					{
+						ChainId: 56,
+					},
+					{
+						ChainId: 56,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated sourceChainStats",
+			genState: &types.GenesisState{
+				SourceChainStatsList: []types.SourceChainStats{
+					{
+						ChainId: 56,
+					},
+					{
+						ChainId: 56,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated migrationFee",
+			genState: &types.GenesisState{
+				MigrationFeeList: []types.MigrationFee{
+					{
+						Token: 1,
+					},
+					{
+						Token: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated lockupTier",
+			genState: &types.GenesisState{
+				LockupTierList: []types.LockupTier{
+					{
+						Tier: 1,
+					},
+					{
+						Tier: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ratioSchedule",
+			genState: &types.GenesisState{
+				RatioScheduleList: []types.RatioSchedule{
+					{
+						Token:  1,
+						Points: []types.RatioPoint{{Time: 0, Ratio: 100}},
+					},
+					{
+						Token:  1,
+						Points: []types.RatioPoint{{Time: 0, Ratio: 100}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid ratioSchedule",
+			genState: &types.GenesisState{
+				RatioScheduleList: []types.RatioSchedule{
+					{
+						Token: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated withdrawal",
+			genState: &types.GenesisState{
+				WithdrawalList: []types.Withdrawal{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				WithdrawalCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid withdrawal count",
+			genState: &types.GenesisState{
+				WithdrawalList: []types.Withdrawal{
+					{
+						Id: 1,
+					},
+				},
+				WithdrawalCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "archived tokenMigration still stored",
+			genState: &types.GenesisState{
+				TokenMigrationList: []types.TokenMigration{
+					{
+						MsgHash: "0",
+					},
+				},
+				ArchivedMsgHashList: []string{"0"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated migrationArchive",
+			genState: &types.GenesisState{
+				MigrationArchiveList: []types.MigrationArchive{
+					{
+						Token:  0,
+						Bucket: 1,
+					},
+					{
+						Token:  0,
+						Bucket: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated tokenStats",
+			genState: &types.GenesisState{
+				TokenStatsList: []types.TokenStats{
+					{
+						Token: 0,
+					},
+					{
+						Token: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated migratorStats",
+			genState: &types.GenesisState{
+				MigratorStatsList: []types.MigratorStats{
+					{
+						Migrator: "0",
+					},
+					{
+						Migrator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated dailyStats",
+			genState: &types.GenesisState{
+				DailyStatsList: []types.DailyStats{
+					{
+						Day: 0,
+					},
+					{
+						Day: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated migrationDestination",
+			genState: &types.GenesisState{
+				MigrationDestinationList: []types.MigrationDestination{
+					{
+						Address: "0",
+					},
+					{
+						Address: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "migrator gas quota exceeding the block gas budget",
+			genState: &types.GenesisState{
+				FeeWaiverConfig: &types.FeeWaiverConfig{
+					BlockGasBudget:   300000,
+					MigratorGasQuota: 500000,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "fee allowance exceeding the instantly released amount",
+			genState: &types.GenesisState{
+				FeeAllowanceConfig: &types.FeeAllowanceConfig{
+					SpendLimit: "2000000",
+					Expiration: 2592000,
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,24 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// LockupTierKeyPrefix is the prefix to retrieve all LockupTier
+	LockupTierKeyPrefix = "LockupTier/value/"
+)
+
+// LockupTierKey returns the store key to retrieve a LockupTier from the index fields
+func LockupTierKey(
+	tier uint64,
+) []byte {
+	var key []byte
+
+	tierBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tierBytes, tier)
+	key = append(key, tierBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,36 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// MigrationArchiveKeyPrefix is the prefix to retrieve all MigrationArchive
+	MigrationArchiveKeyPrefix = "MigrationArchive/value/"
+)
+
+// MigrationArchiveKey returns the store key to retrieve a MigrationArchive from the index fields
+func MigrationArchiveKey(
+	token uint64,
+	sourceChainId uint64,
+	bucket uint64,
+) []byte {
+	var key []byte
+
+	tokenBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tokenBytes, token)
+	key = append(key, tokenBytes...)
+	key = append(key, []byte("/")...)
+
+	sourceChainIdBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sourceChainIdBytes, sourceChainId)
+	key = append(key, sourceChainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	bucketBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(bucketBytes, bucket)
+	key = append(key, bucketBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
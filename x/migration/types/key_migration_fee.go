@@ -0,0 +1,24 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// MigrationFeeKeyPrefix is the prefix to retrieve all MigrationFee
+	MigrationFeeKeyPrefix = "MigrationFee/value/"
+)
+
+// MigrationFeeKey returns the store key to retrieve a MigrationFee from the index fields
+func MigrationFeeKey(
+	token uint64,
+) []byte {
+	var key []byte
+
+	tokenBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tokenBytes, token)
+	key = append(key, tokenBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
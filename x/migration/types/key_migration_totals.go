@@ -0,0 +1,73 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// TokenStatsKeyPrefix is the prefix to retrieve all TokenStats
+	TokenStatsKeyPrefix = "TokenStats/value/"
+
+	// MigratorStatsKeyPrefix is the prefix to retrieve all MigratorStats
+	MigratorStatsKeyPrefix = "MigratorStats/value/"
+
+	// DailyStatsKeyPrefix is the prefix to retrieve all DailyStats
+	DailyStatsKeyPrefix = "DailyStats/value/"
+
+	// MigrationDestinationKeyPrefix is the prefix to retrieve all MigrationDestination
+	MigrationDestinationKeyPrefix = "MigrationDestination/value/"
+)
+
+// TokenStatsKey returns the store key to retrieve a TokenStats from the index fields
+func TokenStatsKey(
+	token uint64,
+) []byte {
+	var key []byte
+
+	tokenBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tokenBytes, token)
+	key = append(key, tokenBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// MigratorStatsKey returns the store key to retrieve a MigratorStats from the index fields
+func MigratorStatsKey(
+	migrator string,
+) []byte {
+	var key []byte
+
+	migratorBytes := []byte(migrator)
+	key = append(key, migratorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DailyStatsKey returns the store key to retrieve a DailyStats from the index fields
+func DailyStatsKey(
+	day uint64,
+) []byte {
+	var key []byte
+
+	dayBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(dayBytes, day)
+	key = append(key, dayBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// MigrationDestinationKey returns the store key to retrieve a MigrationDestination from the index fields
+func MigrationDestinationKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
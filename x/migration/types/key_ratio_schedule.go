@@ -0,0 +1,24 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// RatioScheduleKeyPrefix is the prefix to retrieve all RatioSchedule
+	RatioScheduleKeyPrefix = "RatioSchedule/value/"
+)
+
+// RatioScheduleKey returns the store key to retrieve a RatioSchedule from the index fields
+func RatioScheduleKey(
+	token uint64,
+) []byte {
+	var key []byte
+
+	tokenBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tokenBytes, token)
+	key = append(key, tokenBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
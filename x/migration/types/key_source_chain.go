@@ -0,0 +1,27 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// SourceChainKeyPrefix is the prefix to retrieve all SourceChain
+	SourceChainKeyPrefix = "SourceChain/value/"
+
+	// SourceChainStatsKeyPrefix is the prefix to retrieve all SourceChainStats
+	SourceChainStatsKeyPrefix = "SourceChainStats/value/"
+)
+
+// SourceChainKey returns the store key to retrieve a SourceChain or its SourceChainStats from the index fields
+func SourceChainKey(
+	chainId uint64,
+) []byte {
+	var key []byte
+
+	chainIdBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(chainIdBytes, chainId)
+	key = append(key, chainIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
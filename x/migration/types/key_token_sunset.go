@@ -0,0 +1,24 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// TokenSunsetKeyPrefix is the prefix to retrieve all TokenSunset
+	TokenSunsetKeyPrefix = "TokenSunset/value/"
+)
+
+// TokenSunsetKey returns the store key to retrieve a TokenSunset from the index fields
+func TokenSunsetKey(
+	token uint64,
+) []byte {
+	var key []byte
+
+	tokenBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tokenBytes, token)
+	key = append(key, tokenBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,29 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// WithdrawalQueueKeyPrefix is the prefix to retrieve the open withdrawals by refund time
+	WithdrawalQueueKeyPrefix = "WithdrawalQueue/value/"
+)
+
+// WithdrawalQueueKey returns the store key of an open withdrawal in the refund queue
+func WithdrawalQueueKey(
+	refundAt uint64,
+	id uint64,
+) []byte {
+	var key []byte
+
+	refundAtBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(refundAtBytes, refundAt)
+	key = append(key, refundAtBytes...)
+
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	key = append(key, idBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -25,3 +25,35 @@ const (
 const (
 	ConfigKey = "Config/value/"
 )
+
+const (
+	MigrationTotalsKey = "MigrationTotals/value/"
+)
+
+const (
+	WithdrawalKey      = "Withdrawal/value/"
+	WithdrawalCountKey = "Withdrawal/count/"
+)
+
+const (
+	WithdrawalConfigKey = "WithdrawalConfig/value/"
+)
+
+const (
+	FeeAllowanceConfigKey = "FeeAllowanceConfig/value/"
+)
+
+const (
+	FeeWaiverConfigKey = "FeeWaiverConfig/value/"
+
+	// FeeWaiverUsageKey holds the gas of the fee-free transactions of the current block and
+	// MigratorFeeWaiverUsageKeyPrefix the one of each migrator
+	FeeWaiverUsageKey               = "FeeWaiverUsage/value/"
+	MigratorFeeWaiverUsageKeyPrefix = "FeeWaiverUsage/migrator/"
+)
+
+const (
+	// PendingMigrationKeyPrefix holds the migrations accepted into the mempool. It is only written while
+	// checking transactions so it is never committed
+	PendingMigrationKeyPrefix = "PendingMigration/value/"
+)
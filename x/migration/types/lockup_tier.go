@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// NewDefaultLockupTier returns the tier applied when the holder keeps the configured vesting lockup
+func NewDefaultLockupTier() LockupTier {
+	return LockupTier{
+		Tier:               DefaultLockupTier,
+		DurationMultiplier: 100,
+		BonusRatio:         100,
+	}
+}
+
+// ApplyBonus returns the amount of uslf minted for the given amount once the bonus of the tier is added
+func (t LockupTier) ApplyBonus(amount sdkmath.Uint) sdkmath.Uint {
+	return amount.MulUint64(t.BonusRatio).QuoUint64(100)
+}
+
+// EffectiveRatio returns the ratio, in percent, migrations are converted at once the bonus of the tier is
+// added to the given token ratio. It is rounded up.
+func (t LockupTier) EffectiveRatio(ratio uint64) uint64 {
+	return (ratio*t.BonusRatio + 99) / 100
+}
+
+// VestingDuration returns the vesting duration of the tier given the configured one
+func (t LockupTier) VestingDuration(duration uint64) uint64 {
+	return duration * t.DurationMultiplier / 100
+}
@@ -0,0 +1,376 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: selfchain/migration/lockup_tier.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LockupTier trades a longer vesting lockup for a better conversion ratio. Tier 0 is the default lockup
+// and can't be configured.
+type LockupTier struct {
+	Tier uint64 `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	// vesting duration in percent of the configured vesting duration e.g. 200 doubles it
+	DurationMultiplier uint64 `protobuf:"varint,2,opt,name=durationMultiplier,proto3" json:"durationMultiplier,omitempty"`
+	// ratio applied on top of the token ratio in percent e.g. 110 mints 10% more
+	BonusRatio uint64 `protobuf:"varint,3,opt,name=bonusRatio,proto3" json:"bonusRatio,omitempty"`
+}
+
+func (m *LockupTier) Reset()         { *m = LockupTier{} }
+func (m *LockupTier) String() string { return proto.CompactTextString(m) }
+func (*LockupTier) ProtoMessage()    {}
+func (*LockupTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f21b79453a7a231c, []int{0}
+}
+func (m *LockupTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockupTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockupTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockupTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockupTier.Merge(m, src)
+}
+func (m *LockupTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockupTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockupTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockupTier proto.InternalMessageInfo
+
+func (m *LockupTier) GetTier() uint64 {
+	if m != nil {
+		return m.Tier
+	}
+	return 0
+}
+
+func (m *LockupTier) GetDurationMultiplier() uint64 {
+	if m != nil {
+		return m.DurationMultiplier
+	}
+	return 0
+}
+
+func (m *LockupTier) GetBonusRatio() uint64 {
+	if m != nil {
+		return m.BonusRatio
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*LockupTier)(nil), "selfchain.migration.LockupTier")
+}
+
+func init() {
+	proto.RegisterFile("selfchain/migration/lockup_tier.proto", fileDescriptor_f21b79453a7a231c)
+}
+
+var fileDescriptor_f21b79453a7a231c = []byte{
+	// 178 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4e, 0xcd, 0x49,
+	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
+	0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0x88, 0x2f, 0xc9, 0x4c, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x12, 0x86, 0x2b, 0xd3, 0x83, 0x2b, 0x53, 0x2a, 0xe0, 0xe2, 0xf2, 0x01, 0xab, 0x0c,
+	0xc9, 0x4c, 0x2d, 0x12, 0x12, 0xe2, 0x62, 0x01, 0x69, 0x90, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09,
+	0x02, 0xb3, 0x85, 0xf4, 0xb8, 0x84, 0x52, 0x4a, 0x21, 0xaa, 0x7d, 0x4b, 0x73, 0x4a, 0x32, 0x0b,
+	0x72, 0x40, 0x2a, 0x98, 0xc0, 0x2a, 0xb0, 0xc8, 0x08, 0xc9, 0x71, 0x71, 0x25, 0xe5, 0xe7, 0x95,
+	0x16, 0x07, 0x81, 0x24, 0x24, 0x98, 0xc1, 0xea, 0x90, 0x44, 0x9c, 0x4c, 0x4f, 0x3c, 0x92, 0x63,
+	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
+	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x1a, 0xe1, 0x8f, 0x0a, 0x24, 0x9f, 0x94, 0x54, 0x16,
+	0xa4, 0x16, 0x27, 0xb1, 0x81, 0x3d, 0x61, 0x0c, 0x18, 0x00, 0xe3, 0x39, 0x04, 0x24, 0xed, 0x00,
+	0x00, 0x00,
+}
+
+func (m *LockupTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockupTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockupTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BonusRatio != 0 {
+		i = encodeVarintLockupTier(dAtA, i, uint64(m.BonusRatio))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DurationMultiplier != 0 {
+		i = encodeVarintLockupTier(dAtA, i, uint64(m.DurationMultiplier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tier != 0 {
+		i = encodeVarintLockupTier(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLockupTier(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLockupTier(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LockupTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tier != 0 {
+		n += 1 + sovLockupTier(uint64(m.Tier))
+	}
+	if m.DurationMultiplier != 0 {
+		n += 1 + sovLockupTier(uint64(m.DurationMultiplier))
+	}
+	if m.BonusRatio != 0 {
+		n += 1 + sovLockupTier(uint64(m.BonusRatio))
+	}
+	return n
+}
+
+func sovLockupTier(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLockupTier(x uint64) (n int) {
+	return sovLockupTier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LockupTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockupTier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockupTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockupTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockupTier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMultiplier", wireType)
+			}
+			m.DurationMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockupTier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusRatio", wireType)
+			}
+			m.BonusRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockupTier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BonusRatio |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockupTier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockupTier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLockupTier(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLockupTier
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLockupTier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLockupTier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLockupTier
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLockupTier
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLockupTier
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLockupTier        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLockupTier          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLockupTier = fmt.Errorf("proto: unexpected end of group")
+)
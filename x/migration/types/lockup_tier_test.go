@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockupTier_ApplyBonus(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		tier   LockupTier
+		amount uint64
+		want   uint64
+	}{
+		{
+			desc:   "default tier",
+			tier:   NewDefaultLockupTier(),
+			amount: 1000000,
+			want:   1000000,
+		},
+		{
+			desc:   "bonus",
+			tier:   LockupTier{Tier: 1, DurationMultiplier: 200, BonusRatio: 110},
+			amount: 1000000,
+			want:   1100000,
+		},
+		{
+			desc:   "rounds down",
+			tier:   LockupTier{Tier: 1, DurationMultiplier: 200, BonusRatio: 115},
+			amount: 9,
+			want:   10,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, sdkmath.NewUint(tc.want), tc.tier.ApplyBonus(sdkmath.NewUint(tc.amount)))
+		})
+	}
+}
+
+func TestLockupTier_VestingDuration(t *testing.T) {
+	require.Equal(t, uint64(1000), NewDefaultLockupTier().VestingDuration(1000))
+	require.Equal(t, uint64(2500), LockupTier{DurationMultiplier: 250, BonusRatio: 120}.VestingDuration(1000))
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgArchiveMigrations = "archive_migrations"
+
+var _ sdk.Msg = &MsgArchiveMigrations{}
+
+func NewMsgArchiveMigrations(creator string, token uint64, beforeBlock uint64, sourceChainId uint64) *MsgArchiveMigrations {
+	return &MsgArchiveMigrations{
+		Creator:       creator,
+		Token:         token,
+		BeforeBlock:   beforeBlock,
+		SourceChainId: sourceChainId,
+	}
+}
+
+func (msg *MsgArchiveMigrations) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgArchiveMigrations) Type() string {
+	return TypeMsgArchiveMigrations
+}
+
+func (msg *MsgArchiveMigrations) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgArchiveMigrations) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgArchiveMigrations) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.BeforeBlock < MigrationBucketSize {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "no bucket ends before block %d", msg.BeforeBlock)
+	}
+
+	return nil
+}
+
+// ChainId returns the EVM chain id of the network the archived blocks belong to
+func (msg *MsgArchiveMigrations) ChainId() uint64 {
+	if msg.SourceChainId == 0 {
+		return EthereumChainId
+	}
+
+	return msg.SourceChainId
+}
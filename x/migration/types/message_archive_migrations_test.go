@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgArchiveMigrations_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgArchiveMigrations
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgArchiveMigrations{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "no bucket ends before the block",
+			msg: MsgArchiveMigrations{
+				Creator:     sample.AccAddress(),
+				BeforeBlock: MigrationBucketSize - 1,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgArchiveMigrations{
+				Creator:     sample.AccAddress(),
+				BeforeBlock: MigrationBucketSize,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
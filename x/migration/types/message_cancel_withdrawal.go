@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelWithdrawal = "cancel_withdrawal"
+
+var _ sdk.Msg = &MsgCancelWithdrawal{}
+
+func NewMsgCancelWithdrawal(creator string, id uint64) *MsgCancelWithdrawal {
+	return &MsgCancelWithdrawal{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelWithdrawal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelWithdrawal) Type() string {
+	return TypeMsgCancelWithdrawal
+}
+
+func (msg *MsgCancelWithdrawal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelWithdrawal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
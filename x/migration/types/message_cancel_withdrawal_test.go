@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgCancelWithdrawal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelWithdrawal
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCancelWithdrawal{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgCancelWithdrawal{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
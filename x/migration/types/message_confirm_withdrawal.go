@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgConfirmWithdrawal = "confirm_withdrawal"
+
+var _ sdk.Msg = &MsgConfirmWithdrawal{}
+
+func NewMsgConfirmWithdrawal(creator string, id uint64, ethTxHash string) *MsgConfirmWithdrawal {
+	return &MsgConfirmWithdrawal{
+		Creator:   creator,
+		Id:        id,
+		EthTxHash: ethTxHash,
+	}
+}
+
+func (msg *MsgConfirmWithdrawal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgConfirmWithdrawal) Type() string {
+	return TypeMsgConfirmWithdrawal
+}
+
+func (msg *MsgConfirmWithdrawal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgConfirmWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgConfirmWithdrawal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.EthTxHash == "" {
+		return ErrEmptyStringValue
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgConfirmWithdrawal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgConfirmWithdrawal
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgConfirmWithdrawal{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "no eth tx hash",
+			msg: MsgConfirmWithdrawal{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrEmptyStringValue,
+		}, {
+			name: "valid confirmation",
+			msg: MsgConfirmWithdrawal{
+				Creator:   sample.AccAddress(),
+				EthTxHash: "0x2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,6 +22,11 @@ func NewMsgMigrate(
 	amount string,
 	token uint64,
 	logIndex uint64,
+	sourceChainId uint64,
+	lockupTier uint64,
+	sourceBlock uint64,
+	contractAddress string,
+	callbackMsg string,
 ) *MsgMigrate {
 	return &MsgMigrate{
 		Creator:     creator,
@@ -27,6 +36,13 @@ func NewMsgMigrate(
 		Amount:      amount,
 		Token:       token,
 		LogIndex:    logIndex,
+
+		SourceChainId: sourceChainId,
+		LockupTier:    lockupTier,
+		SourceBlock:   sourceBlock,
+
+		ContractAddress: contractAddress,
+		CallbackMsg:     callbackMsg,
 	}
 }
 
@@ -70,5 +86,44 @@ func (msg *MsgMigrate) ValidateBasic() error {
 		return ErrEmptyStringValue
 	}
 
+	// The callback is executed on the contract, both come together
+	if msg.ContractAddress != "" || msg.CallbackMsg != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidCallback, "invalid contract address (%s)", err)
+		}
+		if !json.Valid([]byte(msg.CallbackMsg)) {
+			return sdkerrors.Wrap(ErrInvalidCallback, "callback message is not valid JSON")
+		}
+	}
+
 	return nil
 }
+
+// ChainId returns the EVM chain id of the network the tokens have been deposited on
+func (msg *MsgMigrate) ChainId() uint64 {
+	if msg.SourceChainId == 0 {
+		return EthereumChainId
+	}
+
+	return msg.SourceChainId
+}
+
+// Hash returns the replay key of the migration. Migrations from Ethereum mainnet keep the original
+// encoding so that the ones processed before other chains were supported can't be replayed.
+func (msg *MsgMigrate) Hash() string {
+	encodedMsg := fmt.Sprintf(
+		"%s|%s|%s|%d|%s|%d",
+		msg.EthAddress,
+		msg.DestAddress,
+		msg.Amount,
+		msg.Token,
+		msg.TxHash,
+		msg.LogIndex,
+	)
+
+	if chainId := msg.ChainId(); chainId != EthereumChainId {
+		encodedMsg = fmt.Sprintf("%d|%s", chainId, encodedMsg)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(encodedMsg)))
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveLockupTier = "remove_lockup_tier"
+
+var _ sdk.Msg = &MsgRemoveLockupTier{}
+
+func NewMsgRemoveLockupTier(creator string, tier uint64) *MsgRemoveLockupTier {
+	return &MsgRemoveLockupTier{
+		Creator: creator,
+		Tier:    tier,
+	}
+}
+
+func (msg *MsgRemoveLockupTier) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveLockupTier) Type() string {
+	return TypeMsgRemoveLockupTier
+}
+
+func (msg *MsgRemoveLockupTier) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveLockupTier) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveLockupTier) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Tier == DefaultLockupTier {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "the default lockup tier can't be removed")
+	}
+
+	return nil
+}
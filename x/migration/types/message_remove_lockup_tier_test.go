@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgRemoveLockupTier_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRemoveLockupTier
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRemoveLockupTier{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "default tier",
+			msg: MsgRemoveLockupTier{
+				Creator: sample.AccAddress(),
+				Tier:    DefaultLockupTier,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgRemoveLockupTier{
+				Creator: sample.AccAddress(),
+				Tier:    1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveRatioSchedule = "remove_ratio_schedule"
+
+var _ sdk.Msg = &MsgRemoveRatioSchedule{}
+
+func NewMsgRemoveRatioSchedule(creator string, token uint64) *MsgRemoveRatioSchedule {
+	return &MsgRemoveRatioSchedule{
+		Creator: creator,
+		Token:   token,
+	}
+}
+
+func (msg *MsgRemoveRatioSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveRatioSchedule) Type() string {
+	return TypeMsgRemoveRatioSchedule
+}
+
+func (msg *MsgRemoveRatioSchedule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveRatioSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveRatioSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// check that token is supported
+	if msg.Token != uint64(Front) && msg.Token != uint64(Hotcross) {
+		return ErrTokenNotSupported
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgRemoveRatioSchedule_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRemoveRatioSchedule
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRemoveRatioSchedule{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "unsupported token",
+			msg: MsgRemoveRatioSchedule{
+				Creator: sample.AccAddress(),
+				Token:   2,
+			},
+			err: ErrTokenNotSupported,
+		}, {
+			name: "valid address",
+			msg: MsgRemoveRatioSchedule{
+				Creator: sample.AccAddress(),
+				Token:   uint64(Hotcross),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestWithdrawal = "request_withdrawal"
+
+var _ sdk.Msg = &MsgRequestWithdrawal{}
+
+func NewMsgRequestWithdrawal(
+	creator string,
+	ethRecipient string,
+	amount string,
+	token uint64,
+	sourceChainId uint64,
+) *MsgRequestWithdrawal {
+	return &MsgRequestWithdrawal{
+		Creator:       creator,
+		EthRecipient:  ethRecipient,
+		Amount:        amount,
+		Token:         token,
+		SourceChainId: sourceChainId,
+	}
+}
+
+func (msg *MsgRequestWithdrawal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestWithdrawal) Type() string {
+	return TypeMsgRequestWithdrawal
+}
+
+func (msg *MsgRequestWithdrawal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRequestWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestWithdrawal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := ParseEthAddress(msg.EthRecipient); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid eth recipient (%s)", err)
+	}
+
+	amount, err := sdkmath.ParseUint(msg.Amount)
+	if err != nil || amount.IsZero() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid amount %s", msg.Amount)
+	}
+
+	// check that token is supported
+	if msg.Token != uint64(Front) && msg.Token != uint64(Hotcross) {
+		return ErrTokenNotSupported
+	}
+
+	return nil
+}
+
+// ChainId returns the EVM chain id of the network of the vault
+func (msg *MsgRequestWithdrawal) ChainId() uint64 {
+	if msg.SourceChainId == 0 {
+		return EthereumChainId
+	}
+
+	return msg.SourceChainId
+}
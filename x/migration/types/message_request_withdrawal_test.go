@@ -0,0 +1,75 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgRequestWithdrawal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRequestWithdrawal
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRequestWithdrawal{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid eth recipient",
+			msg: MsgRequestWithdrawal{
+				Creator:      sample.AccAddress(),
+				EthRecipient: "0x1234",
+				Amount:       "1000000",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgRequestWithdrawal{
+				Creator:      sample.AccAddress(),
+				EthRecipient: "0xbaf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+				Amount:       "0",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "invalid amount",
+			msg: MsgRequestWithdrawal{
+				Creator:      sample.AccAddress(),
+				EthRecipient: "0xbaf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+				Amount:       "-1",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "unsupported token",
+			msg: MsgRequestWithdrawal{
+				Creator:      sample.AccAddress(),
+				EthRecipient: "0xbaf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+				Amount:       "1000000",
+				Token:        2,
+			},
+			err: ErrTokenNotSupported,
+		}, {
+			name: "valid withdrawal",
+			msg: MsgRequestWithdrawal{
+				Creator:      sample.AccAddress(),
+				EthRecipient: "0xbaf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+				Amount:       "1000000",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRevertMigration = "revert_migration"
+
+var _ sdk.Msg = &MsgRevertMigration{}
+
+func NewMsgRevertMigration(authority string, msgHash string) *MsgRevertMigration {
+	return &MsgRevertMigration{
+		Authority: authority,
+		MsgHash:   msgHash,
+	}
+}
+
+func (msg *MsgRevertMigration) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevertMigration) Type() string {
+	return TypeMsgRevertMigration
+}
+
+func (msg *MsgRevertMigration) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRevertMigration) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevertMigration) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.MsgHash == "" {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "msg hash cannot be empty")
+	}
+
+	return nil
+}
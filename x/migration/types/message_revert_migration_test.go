@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgRevertMigration_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevertMigration
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevertMigration{
+				Authority: "invalid_address",
+				MsgHash:   "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "empty msg hash",
+			msg: MsgRevertMigration{
+				Authority: sample.AccAddress(),
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgRevertMigration{
+				Authority: sample.AccAddress(),
+				MsgHash:   "2683f98e2bc2fb5a36c4064d561121fb5087451e70df03b8593dc427ef228c86",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
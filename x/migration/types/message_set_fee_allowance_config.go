@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetFeeAllowanceConfig = "set_fee_allowance_config"
+
+var _ sdk.Msg = &MsgSetFeeAllowanceConfig{}
+
+func NewMsgSetFeeAllowanceConfig(creator string, spendLimit string, expiration uint64) *MsgSetFeeAllowanceConfig {
+	return &MsgSetFeeAllowanceConfig{
+		Creator:    creator,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+func (msg *MsgSetFeeAllowanceConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetFeeAllowanceConfig) Type() string {
+	return TypeMsgSetFeeAllowanceConfig
+}
+
+func (msg *MsgSetFeeAllowanceConfig) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetFeeAllowanceConfig) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetFeeAllowanceConfig) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// An empty spend limit turns the fee allowance off
+	return FeeAllowanceConfig{
+		SpendLimit: msg.SpendLimit,
+		Expiration: msg.Expiration,
+	}.Validate()
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSetFeeAllowanceConfig_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetFeeAllowanceConfig
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetFeeAllowanceConfig{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid spend limit",
+			msg: MsgSetFeeAllowanceConfig{
+				Creator:    sample.AccAddress(),
+				SpendLimit: "-1",
+				Expiration: 2592000,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "spend limit exceeding the instantly released amount",
+			msg: MsgSetFeeAllowanceConfig{
+				Creator:    sample.AccAddress(),
+				SpendLimit: "1000001",
+				Expiration: 2592000,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "no expiration",
+			msg: MsgSetFeeAllowanceConfig{
+				Creator:    sample.AccAddress(),
+				SpendLimit: "500000",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "disabled",
+			msg: MsgSetFeeAllowanceConfig{
+				Creator: sample.AccAddress(),
+			},
+		}, {
+			name: "valid config",
+			msg: MsgSetFeeAllowanceConfig{
+				Creator:    sample.AccAddress(),
+				SpendLimit: "500000",
+				Expiration: 2592000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
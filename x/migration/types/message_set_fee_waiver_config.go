@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetFeeWaiverConfig = "set_fee_waiver_config"
+
+var _ sdk.Msg = &MsgSetFeeWaiverConfig{}
+
+func NewMsgSetFeeWaiverConfig(creator string, blockGasBudget uint64, migratorGasQuota uint64) *MsgSetFeeWaiverConfig {
+	return &MsgSetFeeWaiverConfig{
+		Creator:          creator,
+		BlockGasBudget:   blockGasBudget,
+		MigratorGasQuota: migratorGasQuota,
+	}
+}
+
+func (msg *MsgSetFeeWaiverConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetFeeWaiverConfig) Type() string {
+	return TypeMsgSetFeeWaiverConfig
+}
+
+func (msg *MsgSetFeeWaiverConfig) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetFeeWaiverConfig) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetFeeWaiverConfig) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// A zero budget turns the fee-free transactions off
+	return FeeWaiverConfig{
+		BlockGasBudget:   msg.BlockGasBudget,
+		MigratorGasQuota: msg.MigratorGasQuota,
+	}.Validate()
+}
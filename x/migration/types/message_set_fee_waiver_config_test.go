@@ -0,0 +1,55 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSetFeeWaiverConfig_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetFeeWaiverConfig
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetFeeWaiverConfig{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "quota exceeding the budget",
+			msg: MsgSetFeeWaiverConfig{
+				Creator:          sample.AccAddress(),
+				BlockGasBudget:   1000000,
+				MigratorGasQuota: 2000000,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "disabled",
+			msg: MsgSetFeeWaiverConfig{
+				Creator: sample.AccAddress(),
+			},
+		}, {
+			name: "valid config",
+			msg: MsgSetFeeWaiverConfig{
+				Creator:          sample.AccAddress(),
+				BlockGasBudget:   10000000,
+				MigratorGasQuota: 2000000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
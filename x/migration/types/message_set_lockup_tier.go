@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetLockupTier = "set_lockup_tier"
+
+var _ sdk.Msg = &MsgSetLockupTier{}
+
+func NewMsgSetLockupTier(creator string, tier uint64, durationMultiplier uint64, bonusRatio uint64) *MsgSetLockupTier {
+	return &MsgSetLockupTier{
+		Creator:            creator,
+		Tier:               tier,
+		DurationMultiplier: durationMultiplier,
+		BonusRatio:         bonusRatio,
+	}
+}
+
+func (msg *MsgSetLockupTier) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetLockupTier) Type() string {
+	return TypeMsgSetLockupTier
+}
+
+func (msg *MsgSetLockupTier) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetLockupTier) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetLockupTier) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Tier == DefaultLockupTier {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "the default lockup tier can't be configured")
+	}
+
+	// A tier can only lengthen the lockup and improve the ratio
+	if msg.DurationMultiplier < 100 || msg.DurationMultiplier > MaxLockupDurationMultiplier {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "duration multiplier must be between 100 and %d", MaxLockupDurationMultiplier)
+	}
+
+	if msg.BonusRatio < 100 || msg.BonusRatio > MaxLockupBonusRatio {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "bonus ratio must be between 100 and %d", MaxLockupBonusRatio)
+	}
+
+	return nil
+}
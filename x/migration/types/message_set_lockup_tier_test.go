@@ -0,0 +1,88 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSetLockupTier_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetLockupTier
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetLockupTier{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "default tier",
+			msg: MsgSetLockupTier{
+				Creator:            sample.AccAddress(),
+				Tier:               DefaultLockupTier,
+				DurationMultiplier: 200,
+				BonusRatio:         110,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "shorter lockup",
+			msg: MsgSetLockupTier{
+				Creator:            sample.AccAddress(),
+				Tier:               1,
+				DurationMultiplier: 50,
+				BonusRatio:         110,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "lockup too long",
+			msg: MsgSetLockupTier{
+				Creator:            sample.AccAddress(),
+				Tier:               1,
+				DurationMultiplier: MaxLockupDurationMultiplier + 1,
+				BonusRatio:         110,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "malus",
+			msg: MsgSetLockupTier{
+				Creator:            sample.AccAddress(),
+				Tier:               1,
+				DurationMultiplier: 200,
+				BonusRatio:         90,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "bonus too high",
+			msg: MsgSetLockupTier{
+				Creator:            sample.AccAddress(),
+				Tier:               1,
+				DurationMultiplier: 200,
+				BonusRatio:         MaxLockupBonusRatio + 1,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid tier",
+			msg: MsgSetLockupTier{
+				Creator:            sample.AccAddress(),
+				Tier:               1,
+				DurationMultiplier: 200,
+				BonusRatio:         110,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetMigrationFee = "set_migration_fee"
+
+var _ sdk.Msg = &MsgSetMigrationFee{}
+
+func NewMsgSetMigrationFee(
+	creator string,
+	token uint64,
+	basisPoints uint64,
+	waiveBelow string,
+	waiveAbove string,
+	recipient FeeRecipient,
+	treasury string,
+) *MsgSetMigrationFee {
+	return &MsgSetMigrationFee{
+		Creator:     creator,
+		Token:       token,
+		BasisPoints: basisPoints,
+		WaiveBelow:  waiveBelow,
+		WaiveAbove:  waiveAbove,
+		Recipient:   recipient,
+		Treasury:    treasury,
+	}
+}
+
+func (msg *MsgSetMigrationFee) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetMigrationFee) Type() string {
+	return TypeMsgSetMigrationFee
+}
+
+func (msg *MsgSetMigrationFee) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetMigrationFee) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetMigrationFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// check that token is supported
+	if msg.Token != uint64(Front) && msg.Token != uint64(Hotcross) {
+		return ErrTokenNotSupported
+	}
+
+	if msg.BasisPoints > MaxFeeBasisPoints {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "fee cannot exceed %d basis points", MaxFeeBasisPoints)
+	}
+
+	for _, threshold := range []string{msg.WaiveBelow, msg.WaiveAbove} {
+		if threshold == "" {
+			continue
+		}
+		if _, err := sdkmath.ParseUint(threshold); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid waiver threshold (%s)", err)
+		}
+	}
+
+	if _, ok := FeeRecipient_name[int32(msg.Recipient)]; !ok {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "unknown fee recipient %d", msg.Recipient)
+	}
+
+	if msg.Recipient == FeeRecipient_FEE_RECIPIENT_TREASURY {
+		_, err = sdk.AccAddressFromBech32(msg.Treasury)
+		if err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid treasury address (%s)", err)
+		}
+	} else if msg.Treasury != "" {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "treasury can only be set when fees are sent to the treasury")
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSetMigrationFee_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetMigrationFee
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetMigrationFee{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "unsupported token",
+			msg: MsgSetMigrationFee{
+				Creator: sample.AccAddress(),
+				Token:   2,
+			},
+			err: ErrTokenNotSupported,
+		}, {
+			name: "fee above 100%",
+			msg: MsgSetMigrationFee{
+				Creator:     sample.AccAddress(),
+				BasisPoints: 10001,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "invalid threshold",
+			msg: MsgSetMigrationFee{
+				Creator:     sample.AccAddress(),
+				BasisPoints: 100,
+				WaiveAbove:  "abc",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "treasury recipient without treasury",
+			msg: MsgSetMigrationFee{
+				Creator:     sample.AccAddress(),
+				BasisPoints: 100,
+				Recipient:   FeeRecipient_FEE_RECIPIENT_TREASURY,
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "treasury with community pool recipient",
+			msg: MsgSetMigrationFee{
+				Creator:     sample.AccAddress(),
+				BasisPoints: 100,
+				Treasury:    sample.AccAddress(),
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgSetMigrationFee{
+				Creator:     sample.AccAddress(),
+				Token:       uint64(Hotcross),
+				BasisPoints: 100,
+				WaiveBelow:  "1000000",
+				Recipient:   FeeRecipient_FEE_RECIPIENT_TREASURY,
+				Treasury:    sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
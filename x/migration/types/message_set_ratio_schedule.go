@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRatioSchedule = "set_ratio_schedule"
+
+var _ sdk.Msg = &MsgSetRatioSchedule{}
+
+func NewMsgSetRatioSchedule(
+	creator string,
+	token uint64,
+	interpolation RatioInterpolation,
+	points []RatioPoint,
+) *MsgSetRatioSchedule {
+	return &MsgSetRatioSchedule{
+		Creator:       creator,
+		Token:         token,
+		Interpolation: interpolation,
+		Points:        points,
+	}
+}
+
+func (msg *MsgSetRatioSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRatioSchedule) Type() string {
+	return TypeMsgSetRatioSchedule
+}
+
+func (msg *MsgSetRatioSchedule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetRatioSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRatioSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// check that token is supported
+	if msg.Token != uint64(Front) && msg.Token != uint64(Hotcross) {
+		return ErrTokenNotSupported
+	}
+
+	return msg.Schedule().Validate()
+}
+
+// Schedule returns the ratio schedule set by the message
+func (msg *MsgSetRatioSchedule) Schedule() RatioSchedule {
+	return RatioSchedule{
+		Token:         msg.Token,
+		Interpolation: msg.Interpolation,
+		Points:        msg.Points,
+	}
+}
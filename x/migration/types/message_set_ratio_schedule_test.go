@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSetRatioSchedule_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetRatioSchedule
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetRatioSchedule{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "unsupported token",
+			msg: MsgSetRatioSchedule{
+				Creator: sample.AccAddress(),
+				Token:   2,
+			},
+			err: ErrTokenNotSupported,
+		}, {
+			name: "invalid schedule",
+			msg: MsgSetRatioSchedule{
+				Creator: sample.AccAddress(),
+				Token:   uint64(Hotcross),
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid schedule",
+			msg: MsgSetRatioSchedule{
+				Creator:       sample.AccAddress(),
+				Token:         uint64(Hotcross),
+				Interpolation: RatioInterpolation_RATIO_INTERPOLATION_LINEAR,
+				Points:        []RatioPoint{{Time: 0, Ratio: 100}, {Time: 100, Ratio: 50}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetSourceChain = "set_source_chain"
+
+var _ sdk.Msg = &MsgSetSourceChain{}
+
+func NewMsgSetSourceChain(
+	creator string,
+	chainId uint64,
+	name string,
+	confirmations uint64,
+	tokens []uint64,
+	enabled bool,
+) *MsgSetSourceChain {
+	return &MsgSetSourceChain{
+		Creator:       creator,
+		ChainId:       chainId,
+		Name:          name,
+		Confirmations: confirmations,
+		Tokens:        tokens,
+		Enabled:       enabled,
+	}
+}
+
+func (msg *MsgSetSourceChain) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetSourceChain) Type() string {
+	return TypeMsgSetSourceChain
+}
+
+func (msg *MsgSetSourceChain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetSourceChain) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetSourceChain) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.ChainId == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "chain id cannot be zero")
+	}
+
+	if msg.Name == "" {
+		return ErrEmptyStringValue
+	}
+
+	// check that tokens are supported
+	for _, token := range msg.Tokens {
+		if token != uint64(Front) && token != uint64(Hotcross) {
+			return ErrTokenNotSupported
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSetSourceChain_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetSourceChain
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetSourceChain{
+				Creator: "invalid_address",
+				ChainId: 56,
+				Name:    "BSC",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "zero chain id",
+			msg: MsgSetSourceChain{
+				Creator: sample.AccAddress(),
+				Name:    "BSC",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "empty name",
+			msg: MsgSetSourceChain{
+				Creator: sample.AccAddress(),
+				ChainId: 56,
+			},
+			err: ErrEmptyStringValue,
+		}, {
+			name: "unsupported token",
+			msg: MsgSetSourceChain{
+				Creator: sample.AccAddress(),
+				ChainId: 56,
+				Name:    "BSC",
+				Tokens:  []uint64{uint64(Front), 2},
+			},
+			err: ErrTokenNotSupported,
+		}, {
+			name: "valid message",
+			msg: MsgSetSourceChain{
+				Creator:       sample.AccAddress(),
+				ChainId:       56,
+				Name:          "BSC",
+				Confirmations: 15,
+				Tokens:        []uint64{uint64(Front), uint64(Hotcross)},
+				Enabled:       true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
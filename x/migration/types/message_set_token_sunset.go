@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetTokenSunset = "set_token_sunset"
+
+var _ sdk.Msg = &MsgSetTokenSunset{}
+
+func NewMsgSetTokenSunset(
+	creator string,
+	token uint64,
+	endTime uint64,
+	cap string,
+	sweepMode SweepMode,
+	treasury string,
+	legacyMinted string,
+) *MsgSetTokenSunset {
+	return &MsgSetTokenSunset{
+		Creator:   creator,
+		Token:     token,
+		EndTime:   endTime,
+		Cap:       cap,
+		SweepMode: sweepMode,
+		Treasury:  treasury,
+
+		LegacyMinted: legacyMinted,
+	}
+}
+
+func (msg *MsgSetTokenSunset) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetTokenSunset) Type() string {
+	return TypeMsgSetTokenSunset
+}
+
+func (msg *MsgSetTokenSunset) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetTokenSunset) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetTokenSunset) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// check that token is supported
+	if msg.Token != uint64(Front) && msg.Token != uint64(Hotcross) {
+		return ErrTokenNotSupported
+	}
+
+	if msg.Cap != "" {
+		if _, err := sdkmath.ParseUint(msg.Cap); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid cap (%s)", err)
+		}
+	}
+
+	// Migrations processed before minted amounts were tracked would otherwise not count against the cap
+	if msg.Cap != "" && msg.LegacyMinted == "" {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "a cap requires the amount minted before minted amounts were tracked")
+	}
+
+	if msg.LegacyMinted != "" {
+		if _, err := sdkmath.ParseUint(msg.LegacyMinted); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid legacy minted amount (%s)", err)
+		}
+	}
+
+	if _, ok := SweepMode_name[int32(msg.SweepMode)]; !ok {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "unknown sweep mode %d", msg.SweepMode)
+	}
+
+	// Sweeping needs to know both when and how much
+	if msg.SweepMode != SweepMode_SWEEP_MODE_NONE && (msg.EndTime == 0 || msg.Cap == "") {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "sweeping requires an end time and a cap")
+	}
+
+	if msg.SweepMode == SweepMode_SWEEP_MODE_TREASURY {
+		_, err = sdk.AccAddressFromBech32(msg.Treasury)
+		if err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid treasury address (%s)", err)
+		}
+	} else if msg.Treasury != "" {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "treasury can only be set when sweeping to the treasury")
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSetTokenSunset_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetTokenSunset
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetTokenSunset{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "unsupported token",
+			msg: MsgSetTokenSunset{
+				Creator: sample.AccAddress(),
+				Token:   2,
+			},
+			err: ErrTokenNotSupported,
+		}, {
+			name: "invalid cap",
+			msg: MsgSetTokenSunset{
+				Creator: sample.AccAddress(),
+				Cap:     "-1",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "cap without legacy minted amount",
+			msg: MsgSetTokenSunset{
+				Creator: sample.AccAddress(),
+				Cap:     "1000",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "invalid legacy minted amount",
+			msg: MsgSetTokenSunset{
+				Creator:      sample.AccAddress(),
+				Cap:          "1000",
+				LegacyMinted: "-1",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "sweep without end time",
+			msg: MsgSetTokenSunset{
+				Creator:      sample.AccAddress(),
+				Cap:          "1000",
+				LegacyMinted: "0",
+				SweepMode:    SweepMode_SWEEP_MODE_BURN,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "sweep to treasury without treasury",
+			msg: MsgSetTokenSunset{
+				Creator:      sample.AccAddress(),
+				EndTime:      1700000000,
+				Cap:          "1000",
+				LegacyMinted: "0",
+				SweepMode:    SweepMode_SWEEP_MODE_TREASURY,
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "treasury without sweeping to it",
+			msg: MsgSetTokenSunset{
+				Creator:      sample.AccAddress(),
+				EndTime:      1700000000,
+				Cap:          "1000",
+				LegacyMinted: "0",
+				SweepMode:    SweepMode_SWEEP_MODE_COMMUNITY_POOL,
+				Treasury:     sample.AccAddress(),
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "deadline only",
+			msg: MsgSetTokenSunset{
+				Creator: sample.AccAddress(),
+				EndTime: 1700000000,
+			},
+		}, {
+			name: "sweep to treasury",
+			msg: MsgSetTokenSunset{
+				Creator:      sample.AccAddress(),
+				Token:        uint64(Hotcross),
+				EndTime:      1700000000,
+				Cap:          "1000",
+				LegacyMinted: "0",
+				SweepMode:    SweepMode_SWEEP_MODE_TREASURY,
+				Treasury:     sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
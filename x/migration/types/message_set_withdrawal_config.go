@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetWithdrawalConfig = "set_withdrawal_config"
+
+var _ sdk.Msg = &MsgSetWithdrawalConfig{}
+
+func NewMsgSetWithdrawalConfig(creator string, signatureThreshold uint64, expiry uint64, refundDelay uint64) *MsgSetWithdrawalConfig {
+	return &MsgSetWithdrawalConfig{
+		Creator:            creator,
+		SignatureThreshold: signatureThreshold,
+		Expiry:             expiry,
+		RefundDelay:        refundDelay,
+	}
+}
+
+func (msg *MsgSetWithdrawalConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetWithdrawalConfig) Type() string {
+	return TypeMsgSetWithdrawalConfig
+}
+
+func (msg *MsgSetWithdrawalConfig) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetWithdrawalConfig) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetWithdrawalConfig) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.SignatureThreshold == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "signature threshold must be positive")
+	}
+
+	if msg.Expiry == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "expiry must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSetWithdrawalConfig_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetWithdrawalConfig
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetWithdrawalConfig{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "no threshold",
+			msg: MsgSetWithdrawalConfig{
+				Creator: sample.AccAddress(),
+				Expiry:  86400,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "no expiry",
+			msg: MsgSetWithdrawalConfig{
+				Creator:            sample.AccAddress(),
+				SignatureThreshold: 2,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid config",
+			msg: MsgSetWithdrawalConfig{
+				Creator:            sample.AccAddress(),
+				SignatureThreshold: 2,
+				Expiry:             86400,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
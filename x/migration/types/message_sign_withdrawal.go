@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSignWithdrawal = "sign_withdrawal"
+
+var _ sdk.Msg = &MsgSignWithdrawal{}
+
+func NewMsgSignWithdrawal(creator string, id uint64, signature string) *MsgSignWithdrawal {
+	return &MsgSignWithdrawal{
+		Creator:   creator,
+		Id:        id,
+		Signature: signature,
+	}
+}
+
+func (msg *MsgSignWithdrawal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSignWithdrawal) Type() string {
+	return TypeMsgSignWithdrawal
+}
+
+func (msg *MsgSignWithdrawal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSignWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSignWithdrawal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(msg.Signature, "0x"))
+	if err != nil || len(signature) != WithdrawalSignatureLength {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "signature must be %d hex encoded bytes", WithdrawalSignatureLength)
+	}
+
+	return nil
+}
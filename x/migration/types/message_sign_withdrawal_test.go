@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSignWithdrawal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSignWithdrawal
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSignWithdrawal{
+				Creator: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "not hex",
+			msg: MsgSignWithdrawal{
+				Creator:   sample.AccAddress(),
+				Signature: "signature",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "wrong length",
+			msg: MsgSignWithdrawal{
+				Creator:   sample.AccAddress(),
+				Signature: "0x" + strings.Repeat("ab", 64),
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid signature",
+			msg: MsgSignWithdrawal{
+				Creator:   sample.AccAddress(),
+				Signature: "0x" + strings.Repeat("ab", 65),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
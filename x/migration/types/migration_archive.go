@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// NewMigrationArchive returns the empty summary of a bucket of migrations
+func NewMigrationArchive(token uint64, sourceChainId uint64, bucket uint64) MigrationArchive {
+	return MigrationArchive{
+		Token:         token,
+		SourceChainId: sourceChainId,
+		Bucket:        bucket,
+		FromBlock:     bucket * MigrationBucketSize,
+		ToBlock:       (bucket+1)*MigrationBucketSize - 1,
+		Amount:        "0",
+		MintedAmount:  "0",
+	}
+}
+
+// Add adds a migration to the summary
+func (a *MigrationArchive) Add(migration TokenMigration) {
+	a.Count++
+	if migration.Reverted {
+		a.RevertedCount++
+		return
+	}
+
+	a.Amount = addUint(a.Amount, migration.Amount)
+	a.MintedAmount = addUint(a.MintedAmount, migration.MintedAmount)
+}
+
+// addUint adds two decimal amounts, ignoring the ones that aren't set
+func addUint(a string, b string) string {
+	sum := sdkmath.ZeroUint()
+	for _, amount := range []string{a, b} {
+		if value, err := sdkmath.ParseUint(amount); err == nil {
+			sum = sum.Add(value)
+		}
+	}
+
+	return sum.String()
+}
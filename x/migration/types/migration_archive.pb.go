@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: selfchain/migration/migration_archive.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MigrationArchive summarises the archived migrations of a token deposited on a source chain within a
+// range of its blocks. The hashes of the archived migrations are kept so that they can't be replayed.
+type MigrationArchive struct {
+	Token  uint64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	Bucket uint64 `protobuf:"varint,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// source block range of the bucket, inclusive
+	FromBlock     uint64 `protobuf:"varint,3,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	ToBlock       uint64 `protobuf:"varint,4,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	Count         uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	RevertedCount uint64 `protobuf:"varint,6,opt,name=revertedCount,proto3" json:"revertedCount,omitempty"`
+	// sums over the migrations that haven't been reverted, amount in wei and minted amount in uslf
+	Amount       string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	MintedAmount string `protobuf:"bytes,8,opt,name=mintedAmount,proto3" json:"mintedAmount,omitempty"`
+	// EVM chain id of the network the migrations have been deposited on
+	SourceChainId uint64 `protobuf:"varint,9,opt,name=sourceChainId,proto3" json:"sourceChainId,omitempty"`
+}
+
+func (m *MigrationArchive) Reset()         { *m = MigrationArchive{} }
+func (m *MigrationArchive) String() string { return proto.CompactTextString(m) }
+func (*MigrationArchive) ProtoMessage()    {}
+func (*MigrationArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c27f1c83320a04b, []int{0}
+}
+func (m *MigrationArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationArchive.Merge(m, src)
+}
+func (m *MigrationArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationArchive proto.InternalMessageInfo
+
+func (m *MigrationArchive) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *MigrationArchive) GetBucket() uint64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func (m *MigrationArchive) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *MigrationArchive) GetToBlock() uint64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+func (m *MigrationArchive) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MigrationArchive) GetRevertedCount() uint64 {
+	if m != nil {
+		return m.RevertedCount
+	}
+	return 0
+}
+
+func (m *MigrationArchive) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *MigrationArchive) GetMintedAmount() string {
+	if m != nil {
+		return m.MintedAmount
+	}
+	return ""
+}
+
+func (m *MigrationArchive) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MigrationArchive)(nil), "selfchain.migration.MigrationArchive")
+}
+
+func init() {
+	proto.RegisterFile("selfchain/migration/migration_archive.proto", fileDescriptor_8c27f1c83320a04b)
+}
+
+var fileDescriptor_8c27f1c83320a04b = []byte{
+	// 269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0x4e, 0xcd, 0x49,
+	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9, 0xcc, 0x47, 0x62,
+	0xc5, 0x27, 0x16, 0x25, 0x67, 0x64, 0x96, 0xa5, 0xea, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x09,
+	0xc3, 0x15, 0xeb, 0xc1, 0x95, 0x28, 0x4d, 0x63, 0xe2, 0x12, 0xf0, 0x85, 0xf1, 0x1c, 0x21, 0xea,
+	0x85, 0x44, 0xb8, 0x58, 0x4b, 0xf2, 0xb3, 0x53, 0xf3, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82,
+	0x20, 0x1c, 0x21, 0x31, 0x2e, 0xb6, 0xa4, 0xd2, 0xe4, 0xec, 0xd4, 0x12, 0x09, 0x26, 0xb0, 0x30,
+	0x94, 0x27, 0x24, 0xc3, 0xc5, 0x99, 0x56, 0x94, 0x9f, 0xeb, 0x94, 0x93, 0x9f, 0x9c, 0x2d, 0xc1,
+	0x0c, 0x96, 0x42, 0x08, 0x08, 0x49, 0x70, 0xb1, 0x97, 0xe4, 0x43, 0xe4, 0x58, 0xc0, 0x72, 0x30,
+	0x2e, 0xc8, 0x96, 0xe4, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x56, 0x88, 0x2d, 0x60, 0x8e, 0x90, 0x0a,
+	0x17, 0x6f, 0x51, 0x6a, 0x59, 0x6a, 0x51, 0x49, 0x6a, 0x8a, 0x33, 0x58, 0x96, 0x0d, 0x2c, 0x8b,
+	0x2a, 0x08, 0x72, 0x4b, 0x62, 0x2e, 0x58, 0x9a, 0x5d, 0x81, 0x51, 0x83, 0x33, 0x08, 0xca, 0x13,
+	0x52, 0xe2, 0xe2, 0xc9, 0xcd, 0xcc, 0x2b, 0x49, 0x4d, 0x71, 0x84, 0xc8, 0x72, 0x80, 0x65, 0x51,
+	0xc4, 0x40, 0x36, 0x14, 0xe7, 0x97, 0x16, 0x25, 0xa7, 0x3a, 0x83, 0xc2, 0xc2, 0x33, 0x45, 0x82,
+	0x13, 0x62, 0x03, 0x8a, 0xa0, 0x93, 0xe9, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
+	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
+	0x44, 0x49, 0x23, 0x02, 0xbd, 0x02, 0x29, 0xd8, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0,
+	0x61, 0x6d, 0x0c, 0x18, 0x00, 0x66, 0x7e, 0x3b, 0xf1, 0x9a, 0x01, 0x00, 0x00,
+}
+
+func (m *MigrationArchive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationArchive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceChainId != 0 {
+		i = encodeVarintMigrationArchive(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MintedAmount) > 0 {
+		i -= len(m.MintedAmount)
+		copy(dAtA[i:], m.MintedAmount)
+		i = encodeVarintMigrationArchive(dAtA, i, uint64(len(m.MintedAmount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMigrationArchive(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RevertedCount != 0 {
+		i = encodeVarintMigrationArchive(dAtA, i, uint64(m.RevertedCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Count != 0 {
+		i = encodeVarintMigrationArchive(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ToBlock != 0 {
+		i = encodeVarintMigrationArchive(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromBlock != 0 {
+		i = encodeVarintMigrationArchive(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintMigrationArchive(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Token != 0 {
+		i = encodeVarintMigrationArchive(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMigrationArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMigrationArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MigrationArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovMigrationArchive(uint64(m.Token))
+	}
+	if m.Bucket != 0 {
+		n += 1 + sovMigrationArchive(uint64(m.Bucket))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovMigrationArchive(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovMigrationArchive(uint64(m.ToBlock))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMigrationArchive(uint64(m.Count))
+	}
+	if m.RevertedCount != 0 {
+		n += 1 + sovMigrationArchive(uint64(m.RevertedCount))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMigrationArchive(uint64(l))
+	}
+	l = len(m.MintedAmount)
+	if l > 0 {
+		n += 1 + l + sovMigrationArchive(uint64(l))
+	}
+	if m.SourceChainId != 0 {
+		n += 1 + sovMigrationArchive(uint64(m.SourceChainId))
+	}
+	return n
+}
+
+func sovMigrationArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMigrationArchive(x uint64) (n int) {
+	return sovMigrationArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MigrationArchive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigrationArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationArchive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationArchive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			}
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedCount", wireType)
+			}
+			m.RevertedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevertedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigrationArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMigrationArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMigrationArchive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMigrationArchive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigrationArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMigrationArchive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMigrationArchive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMigrationArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMigrationArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMigrationArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMigrationArchive = fmt.Errorf("proto: unexpected end of group")
+)
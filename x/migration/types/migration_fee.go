@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// Compute returns the fee taken from a migration minting the given amount of uslf
+func (f MigrationFee) Compute(minted sdkmath.Uint) sdkmath.Uint {
+	if f.BasisPoints == 0 {
+		return sdkmath.ZeroUint()
+	}
+
+	if f.WaiveBelow != "" && minted.LT(sdkmath.NewUintFromString(f.WaiveBelow)) {
+		return sdkmath.ZeroUint()
+	}
+
+	if f.WaiveAbove != "" && minted.GT(sdkmath.NewUintFromString(f.WaiveAbove)) {
+		return sdkmath.ZeroUint()
+	}
+
+	return minted.MulUint64(f.BasisPoints).QuoUint64(MaxFeeBasisPoints)
+}
@@ -0,0 +1,563 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: selfchain/migration/migration_fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeRecipient defines where migration fees are sent to
+type FeeRecipient int32
+
+const (
+	FeeRecipient_FEE_RECIPIENT_COMMUNITY_POOL FeeRecipient = 0
+	FeeRecipient_FEE_RECIPIENT_TREASURY       FeeRecipient = 1
+)
+
+var FeeRecipient_name = map[int32]string{
+	0: "FEE_RECIPIENT_COMMUNITY_POOL",
+	1: "FEE_RECIPIENT_TREASURY",
+}
+
+var FeeRecipient_value = map[string]int32{
+	"FEE_RECIPIENT_COMMUNITY_POOL": 0,
+	"FEE_RECIPIENT_TREASURY":       1,
+}
+
+func (x FeeRecipient) String() string {
+	return proto.EnumName(FeeRecipient_name, int32(x))
+}
+
+func (FeeRecipient) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9c9c0230964f58b9, []int{0}
+}
+
+// MigrationFee holds the protocol fee taken from the minted amount of a token's migrations
+type MigrationFee struct {
+	Token uint64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	// fee in basis points of the minted amount
+	BasisPoints uint64 `protobuf:"varint,2,opt,name=basisPoints,proto3" json:"basisPoints,omitempty"`
+	// migrations minting less than this amount of uslf are free. Empty means no lower threshold
+	WaiveBelow string `protobuf:"bytes,3,opt,name=waiveBelow,proto3" json:"waiveBelow,omitempty"`
+	// migrations minting more than this amount of uslf are free. Empty means no upper threshold
+	WaiveAbove string       `protobuf:"bytes,4,opt,name=waiveAbove,proto3" json:"waiveAbove,omitempty"`
+	Recipient  FeeRecipient `protobuf:"varint,5,opt,name=recipient,proto3,enum=selfchain.migration.FeeRecipient" json:"recipient,omitempty"`
+	Treasury   string       `protobuf:"bytes,6,opt,name=treasury,proto3" json:"treasury,omitempty"`
+}
+
+func (m *MigrationFee) Reset()         { *m = MigrationFee{} }
+func (m *MigrationFee) String() string { return proto.CompactTextString(m) }
+func (*MigrationFee) ProtoMessage()    {}
+func (*MigrationFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c9c0230964f58b9, []int{0}
+}
+func (m *MigrationFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationFee.Merge(m, src)
+}
+func (m *MigrationFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationFee proto.InternalMessageInfo
+
+func (m *MigrationFee) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *MigrationFee) GetBasisPoints() uint64 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func (m *MigrationFee) GetWaiveBelow() string {
+	if m != nil {
+		return m.WaiveBelow
+	}
+	return ""
+}
+
+func (m *MigrationFee) GetWaiveAbove() string {
+	if m != nil {
+		return m.WaiveAbove
+	}
+	return ""
+}
+
+func (m *MigrationFee) GetRecipient() FeeRecipient {
+	if m != nil {
+		return m.Recipient
+	}
+	return FeeRecipient_FEE_RECIPIENT_COMMUNITY_POOL
+}
+
+func (m *MigrationFee) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("selfchain.migration.FeeRecipient", FeeRecipient_name, FeeRecipient_value)
+	proto.RegisterType((*MigrationFee)(nil), "selfchain.migration.MigrationFee")
+}
+
+func init() {
+	proto.RegisterFile("selfchain/migration/migration_fee.proto", fileDescriptor_9c9c0230964f58b9)
+}
+
+var fileDescriptor_9c9c0230964f58b9 = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4f, 0x4b, 0xf3, 0x40,
+	0x18, 0xc4, 0xb3, 0xef, 0xdb, 0x16, 0xbb, 0x16, 0x29, 0xab, 0xc8, 0x52, 0x65, 0x89, 0x5e, 0x2c,
+	0x1e, 0x22, 0x28, 0x9e, 0xa5, 0x2d, 0x29, 0x14, 0xfa, 0x8f, 0x35, 0x3d, 0xd4, 0x4b, 0x48, 0xca,
+	0x53, 0x5d, 0xac, 0xd9, 0x90, 0x5d, 0x5b, 0xfb, 0x2d, 0xfc, 0x58, 0x1e, 0x7b, 0xf4, 0x28, 0xc9,
+	0x17, 0x11, 0x22, 0x4d, 0x57, 0xf0, 0xb6, 0x33, 0xbf, 0x99, 0xe5, 0x81, 0xc1, 0x17, 0x0a, 0x16,
+	0xf3, 0xd9, 0x53, 0x20, 0xa2, 0xab, 0x17, 0xf1, 0x98, 0x04, 0x5a, 0x48, 0xe3, 0xe5, 0xcf, 0x01,
+	0x9c, 0x38, 0x91, 0x5a, 0x92, 0xc3, 0x22, 0xe8, 0x14, 0xf8, 0x3c, 0x43, 0xb8, 0x36, 0xd8, 0xaa,
+	0x2e, 0x00, 0x39, 0xc2, 0x65, 0x2d, 0x9f, 0x21, 0xa2, 0xc8, 0x46, 0xcd, 0x12, 0xff, 0x11, 0xc4,
+	0xc6, 0xfb, 0x61, 0xa0, 0x84, 0x1a, 0x4b, 0x11, 0x69, 0x45, 0xff, 0xe5, 0xcc, 0xb4, 0x08, 0xc3,
+	0x78, 0x15, 0x88, 0x25, 0xb4, 0x61, 0x21, 0x57, 0xf4, 0xbf, 0x8d, 0x9a, 0x55, 0x6e, 0x38, 0x05,
+	0x6f, 0x85, 0x72, 0x09, 0xb4, 0x64, 0xf0, 0xdc, 0x21, 0x77, 0xb8, 0x9a, 0xc0, 0x4c, 0xc4, 0x02,
+	0x22, 0x4d, 0xcb, 0x36, 0x6a, 0x1e, 0x5c, 0x9f, 0x39, 0x7f, 0x5c, 0xec, 0x74, 0x01, 0xf8, 0x36,
+	0xc8, 0x77, 0x1d, 0xd2, 0xc0, 0x7b, 0x3a, 0x81, 0x40, 0xbd, 0x26, 0x6b, 0x5a, 0xc9, 0xbf, 0x2f,
+	0xf4, 0x65, 0x1f, 0xd7, 0xcc, 0x1a, 0xb1, 0xf1, 0x69, 0xd7, 0x75, 0x7d, 0xee, 0x76, 0x7a, 0xe3,
+	0x9e, 0x3b, 0xf4, 0xfc, 0xce, 0x68, 0x30, 0x98, 0x0c, 0x7b, 0xde, 0xd4, 0x1f, 0x8f, 0x46, 0xfd,
+	0xba, 0x45, 0x1a, 0xf8, 0xf8, 0x77, 0xc2, 0xe3, 0x6e, 0xeb, 0x7e, 0xc2, 0xa7, 0x75, 0xd4, 0xbe,
+	0xfd, 0x48, 0x19, 0xda, 0xa4, 0x0c, 0x7d, 0xa5, 0x0c, 0xbd, 0x67, 0xcc, 0xda, 0x64, 0xcc, 0xfa,
+	0xcc, 0x98, 0xf5, 0x70, 0xb2, 0xdb, 0xe2, 0xcd, 0x58, 0x43, 0xaf, 0x63, 0x50, 0x61, 0x25, 0x9f,
+	0xe1, 0xe6, 0x7b, 0x00, 0x30, 0x1c, 0xe3, 0x4d, 0xb1, 0x01, 0x00, 0x00,
+}
+
+func (m *MigrationFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintMigrationFee(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Recipient != 0 {
+		i = encodeVarintMigrationFee(dAtA, i, uint64(m.Recipient))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WaiveAbove) > 0 {
+		i -= len(m.WaiveAbove)
+		copy(dAtA[i:], m.WaiveAbove)
+		i = encodeVarintMigrationFee(dAtA, i, uint64(len(m.WaiveAbove)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WaiveBelow) > 0 {
+		i -= len(m.WaiveBelow)
+		copy(dAtA[i:], m.WaiveBelow)
+		i = encodeVarintMigrationFee(dAtA, i, uint64(len(m.WaiveBelow)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BasisPoints != 0 {
+		i = encodeVarintMigrationFee(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Token != 0 {
+		i = encodeVarintMigrationFee(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMigrationFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMigrationFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MigrationFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovMigrationFee(uint64(m.Token))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovMigrationFee(uint64(m.BasisPoints))
+	}
+	l = len(m.WaiveBelow)
+	if l > 0 {
+		n += 1 + l + sovMigrationFee(uint64(l))
+	}
+	l = len(m.WaiveAbove)
+	if l > 0 {
+		n += 1 + l + sovMigrationFee(uint64(l))
+	}
+	if m.Recipient != 0 {
+		n += 1 + sovMigrationFee(uint64(m.Recipient))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovMigrationFee(uint64(l))
+	}
+	return n
+}
+
+func sovMigrationFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMigrationFee(x uint64) (n int) {
+	return sovMigrationFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MigrationFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigrationFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaiveBelow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WaiveBelow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaiveAbove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WaiveAbove = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			m.Recipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recipient |= FeeRecipient(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigrationFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMigrationFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMigrationFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMigrationFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigrationFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigrationFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMigrationFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMigrationFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMigrationFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMigrationFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMigrationFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMigrationFee = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationFee_Compute(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		fee    MigrationFee
+		minted uint64
+		want   uint64
+	}{
+		{
+			desc:   "no fee",
+			fee:    MigrationFee{},
+			minted: 1000000,
+			want:   0,
+		},
+		{
+			desc:   "fee",
+			fee:    MigrationFee{BasisPoints: 250},
+			minted: 1000000,
+			want:   25000,
+		},
+		{
+			desc:   "rounds down",
+			fee:    MigrationFee{BasisPoints: 1},
+			minted: 9999,
+			want:   0,
+		},
+		{
+			desc:   "waived below threshold",
+			fee:    MigrationFee{BasisPoints: 250, WaiveBelow: "1000001"},
+			minted: 1000000,
+			want:   0,
+		},
+		{
+			desc:   "at lower threshold",
+			fee:    MigrationFee{BasisPoints: 250, WaiveBelow: "1000000"},
+			minted: 1000000,
+			want:   25000,
+		},
+		{
+			desc:   "waived above threshold",
+			fee:    MigrationFee{BasisPoints: 250, WaiveAbove: "999999"},
+			minted: 1000000,
+			want:   0,
+		},
+		{
+			desc:   "at upper threshold",
+			fee:    MigrationFee{BasisPoints: 250, WaiveAbove: "1000000"},
+			minted: 1000000,
+			want:   25000,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, sdkmath.NewUint(tc.want), tc.fee.Compute(sdkmath.NewUint(tc.minted)))
+		})
+	}
+}
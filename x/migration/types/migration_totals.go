@@ -0,0 +1,101 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// SecondsPerDay is the length of the buckets of the daily statistics
+const SecondsPerDay uint64 = 86400
+
+// DayOf returns the number of days between the unix epoch and the given time
+func DayOf(t time.Time) uint64 {
+	return uint64(t.Unix()) / SecondsPerDay
+}
+
+// Add adds a processed migration to the totals. newDestination tells whether its destination has never
+// received a migration counted in the totals.
+func (t *MigrationTotals) Add(migration TokenMigration, newDestination bool) {
+	t.MigrationCount++
+	if newDestination {
+		t.UniqueDestinations++
+	}
+
+	t.SourceAmount = addUint(t.SourceAmount, migration.Amount)
+	t.MintedAmount = addUint(t.MintedAmount, migration.MintedAmount)
+	t.InstantlyReleased = addUint(t.InstantlyReleased, migration.InstantlyReleased)
+	t.VestedAmount = addUint(t.VestedAmount, migration.VestedAmount)
+	t.FeesCollected = addUint(t.FeesCollected, migration.Fee)
+}
+
+// Revert takes the amounts of a reverted migration out of the totals. The destination did receive the
+// migration so it stays counted, and so does the fee since it isn't given back.
+func (t *MigrationTotals) Revert(migration TokenMigration) {
+	t.RevertedCount++
+
+	t.SourceAmount = subUint(t.SourceAmount, migration.Amount)
+	t.MintedAmount = subUint(t.MintedAmount, migration.MintedAmount)
+	t.InstantlyReleased = subUint(t.InstantlyReleased, migration.InstantlyReleased)
+	t.VestedAmount = subUint(t.VestedAmount, migration.VestedAmount)
+}
+
+// Withdraw takes the uslf burnt by a withdrawal out of the minted amount
+func (t *MigrationTotals) Withdraw(amount string) {
+	t.MintedAmount = subUint(t.MintedAmount, amount)
+	t.WithdrawnAmount = addUint(t.WithdrawnAmount, amount)
+}
+
+// RefundWithdrawal puts the uslf of a refunded withdrawal back into the minted amount
+func (t *MigrationTotals) RefundWithdrawal(amount string) {
+	t.MintedAmount = addUint(t.MintedAmount, amount)
+	t.WithdrawnAmount = subUint(t.WithdrawnAmount, amount)
+}
+
+// subUint subtracts two decimal amounts without going below zero, ignoring the ones that aren't set
+func subUint(a string, b string) string {
+	value, err := sdkmath.ParseUint(a)
+	if err != nil {
+		return sdkmath.ZeroUint().String()
+	}
+
+	sub, err := sdkmath.ParseUint(b)
+	if err != nil {
+		return value.String()
+	}
+
+	return value.Sub(sdkmath.MinUint(value, sub)).String()
+}
+
+// HasToken returns whether the address has received a migration of the token
+func (d MigrationDestination) HasToken(token uint64) bool {
+	for _, t := range d.Tokens {
+		if t == token {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasChain returns whether the address has received a migration from the source chain
+func (d MigrationDestination) HasChain(chainId uint64) bool {
+	for _, c := range d.Chains {
+		if c == chainId {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasMigrator returns whether the address has received a migration submitted by the migrator
+func (d MigrationDestination) HasMigrator(migrator string) bool {
+	for _, m := range d.Migrators {
+		if m == migrator {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,1791 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: selfchain/migration/migration_totals.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MigrationTotals holds running totals over migrations. Reverted migrations are counted but their amounts
+// are taken out of the totals.
+type MigrationTotals struct {
+	MigrationCount uint64 `protobuf:"varint,1,opt,name=migrationCount,proto3" json:"migrationCount,omitempty"`
+	RevertedCount  uint64 `protobuf:"varint,2,opt,name=revertedCount,proto3" json:"revertedCount,omitempty"`
+	// deposited amount in wei
+	SourceAmount string `protobuf:"bytes,3,opt,name=sourceAmount,proto3" json:"sourceAmount,omitempty"`
+	// amounts in uslf
+	MintedAmount      string `protobuf:"bytes,4,opt,name=mintedAmount,proto3" json:"mintedAmount,omitempty"`
+	InstantlyReleased string `protobuf:"bytes,5,opt,name=instantlyReleased,proto3" json:"instantlyReleased,omitempty"`
+	VestedAmount      string `protobuf:"bytes,6,opt,name=vestedAmount,proto3" json:"vestedAmount,omitempty"`
+	// number of addresses that have received a migration
+	UniqueDestinations uint64 `protobuf:"varint,7,opt,name=uniqueDestinations,proto3" json:"uniqueDestinations,omitempty"`
+	// migration fees collected in uslf. They are kept when a migration is reverted.
+	FeesCollected string `protobuf:"bytes,8,opt,name=feesCollected,proto3" json:"feesCollected,omitempty"`
+	// uslf burnt by withdrawals that have not been refunded. It is taken out of the minted amount so that
+	// withdrawn tokens migrated again aren't counted twice.
+	WithdrawnAmount string `protobuf:"bytes,9,opt,name=withdrawnAmount,proto3" json:"withdrawnAmount,omitempty"`
+}
+
+func (m *MigrationTotals) Reset()         { *m = MigrationTotals{} }
+func (m *MigrationTotals) String() string { return proto.CompactTextString(m) }
+func (*MigrationTotals) ProtoMessage()    {}
+func (*MigrationTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbe80352941e9716, []int{0}
+}
+func (m *MigrationTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationTotals.Merge(m, src)
+}
+func (m *MigrationTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationTotals proto.InternalMessageInfo
+
+func (m *MigrationTotals) GetMigrationCount() uint64 {
+	if m != nil {
+		return m.MigrationCount
+	}
+	return 0
+}
+
+func (m *MigrationTotals) GetRevertedCount() uint64 {
+	if m != nil {
+		return m.RevertedCount
+	}
+	return 0
+}
+
+func (m *MigrationTotals) GetSourceAmount() string {
+	if m != nil {
+		return m.SourceAmount
+	}
+	return ""
+}
+
+func (m *MigrationTotals) GetMintedAmount() string {
+	if m != nil {
+		return m.MintedAmount
+	}
+	return ""
+}
+
+func (m *MigrationTotals) GetInstantlyReleased() string {
+	if m != nil {
+		return m.InstantlyReleased
+	}
+	return ""
+}
+
+func (m *MigrationTotals) GetVestedAmount() string {
+	if m != nil {
+		return m.VestedAmount
+	}
+	return ""
+}
+
+func (m *MigrationTotals) GetUniqueDestinations() uint64 {
+	if m != nil {
+		return m.UniqueDestinations
+	}
+	return 0
+}
+
+func (m *MigrationTotals) GetFeesCollected() string {
+	if m != nil {
+		return m.FeesCollected
+	}
+	return ""
+}
+
+func (m *MigrationTotals) GetWithdrawnAmount() string {
+	if m != nil {
+		return m.WithdrawnAmount
+	}
+	return ""
+}
+
+// TokenStats holds the totals of the migrations of a token
+type TokenStats struct {
+	Token  uint64          `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	Totals MigrationTotals `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals"`
+}
+
+func (m *TokenStats) Reset()         { *m = TokenStats{} }
+func (m *TokenStats) String() string { return proto.CompactTextString(m) }
+func (*TokenStats) ProtoMessage()    {}
+func (*TokenStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbe80352941e9716, []int{1}
+}
+func (m *TokenStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenStats.Merge(m, src)
+}
+func (m *TokenStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenStats proto.InternalMessageInfo
+
+func (m *TokenStats) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *TokenStats) GetTotals() MigrationTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return MigrationTotals{}
+}
+
+// MigratorStats holds the totals of the migrations submitted by a migrator
+type MigratorStats struct {
+	Migrator string          `protobuf:"bytes,1,opt,name=migrator,proto3" json:"migrator,omitempty"`
+	Totals   MigrationTotals `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals"`
+}
+
+func (m *MigratorStats) Reset()         { *m = MigratorStats{} }
+func (m *MigratorStats) String() string { return proto.CompactTextString(m) }
+func (*MigratorStats) ProtoMessage()    {}
+func (*MigratorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbe80352941e9716, []int{2}
+}
+func (m *MigratorStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigratorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigratorStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigratorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigratorStats.Merge(m, src)
+}
+func (m *MigratorStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigratorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigratorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigratorStats proto.InternalMessageInfo
+
+func (m *MigratorStats) GetMigrator() string {
+	if m != nil {
+		return m.Migrator
+	}
+	return ""
+}
+
+func (m *MigratorStats) GetTotals() MigrationTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return MigrationTotals{}
+}
+
+// DailyStats holds the migrations processed during a UTC day. Reverts are counted on the day they
+// happen and leave the amounts of the day untouched. Unique destinations aren't tracked per day.
+type DailyStats struct {
+	// days since the unix epoch
+	Day    uint64          `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Totals MigrationTotals `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals"`
+}
+
+func (m *DailyStats) Reset()         { *m = DailyStats{} }
+func (m *DailyStats) String() string { return proto.CompactTextString(m) }
+func (*DailyStats) ProtoMessage()    {}
+func (*DailyStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbe80352941e9716, []int{3}
+}
+func (m *DailyStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DailyStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DailyStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DailyStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyStats.Merge(m, src)
+}
+func (m *DailyStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DailyStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailyStats proto.InternalMessageInfo
+
+func (m *DailyStats) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *DailyStats) GetTotals() MigrationTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return MigrationTotals{}
+}
+
+// MigrationDestination tracks what an address has received migrations from so that unique destinations
+// can be counted
+type MigrationDestination struct {
+	Address   string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tokens    []uint64 `protobuf:"varint,2,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Migrators []string `protobuf:"bytes,3,rep,name=migrators,proto3" json:"migrators,omitempty"`
+	Chains    []uint64 `protobuf:"varint,4,rep,packed,name=chains,proto3" json:"chains,omitempty"`
+}
+
+func (m *MigrationDestination) Reset()         { *m = MigrationDestination{} }
+func (m *MigrationDestination) String() string { return proto.CompactTextString(m) }
+func (*MigrationDestination) ProtoMessage()    {}
+func (*MigrationDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbe80352941e9716, []int{4}
+}
+func (m *MigrationDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationDestination.Merge(m, src)
+}
+func (m *MigrationDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationDestination proto.InternalMessageInfo
+
+func (m *MigrationDestination) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MigrationDestination) GetTokens() []uint64 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *MigrationDestination) GetMigrators() []string {
+	if m != nil {
+		return m.Migrators
+	}
+	return nil
+}
+
+func (m *MigrationDestination) GetChains() []uint64 {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MigrationTotals)(nil), "selfchain.migration.MigrationTotals")
+	proto.RegisterType((*TokenStats)(nil), "selfchain.migration.TokenStats")
+	proto.RegisterType((*MigratorStats)(nil), "selfchain.migration.MigratorStats")
+	proto.RegisterType((*DailyStats)(nil), "selfchain.migration.DailyStats")
+	proto.RegisterType((*MigrationDestination)(nil), "selfchain.migration.MigrationDestination")
+}
+
+func init() {
+	proto.RegisterFile("selfchain/migration/migration_totals.proto", fileDescriptor_fbe80352941e9716)
+}
+
+var fileDescriptor_fbe80352941e9716 = []byte{
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xda, 0x4d, 0xeb, 0x81, 0x52, 0x58, 0x22, 0x64, 0x15, 0x64, 0xa2, 0xa8, 0x42,
+	0x16, 0x42, 0xae, 0x04, 0xe2, 0x01, 0x48, 0x7b, 0xe5, 0x62, 0x7a, 0xe2, 0x82, 0xb6, 0xf1, 0x24,
+	0x5d, 0xd5, 0xd9, 0x2d, 0xde, 0x49, 0x8b, 0x2f, 0x3c, 0x03, 0x8f, 0xd5, 0x63, 0x8f, 0x9c, 0x10,
+	0x4a, 0x9e, 0x82, 0x1b, 0xda, 0x5d, 0xc7, 0x71, 0xd2, 0x1e, 0x7b, 0xdb, 0xf9, 0xf7, 0x9b, 0xf9,
+	0xfd, 0x7b, 0xb4, 0xf0, 0x56, 0x63, 0x31, 0x1e, 0x9d, 0x73, 0x21, 0x8f, 0xa6, 0x62, 0x52, 0x72,
+	0x12, 0xaa, 0x75, 0xfa, 0x46, 0x8a, 0x78, 0xa1, 0xd3, 0xcb, 0x52, 0x91, 0x62, 0xcf, 0x1b, 0x36,
+	0x6d, 0x88, 0x83, 0xde, 0x44, 0x4d, 0x94, 0xbd, 0x3f, 0x32, 0x27, 0x87, 0x0e, 0xfe, 0x6d, 0xc1,
+	0xfe, 0xe7, 0x25, 0x73, 0x6a, 0x87, 0xb0, 0x37, 0xf0, 0xa4, 0x69, 0x3b, 0x56, 0x33, 0x49, 0x91,
+	0xd7, 0xf7, 0x92, 0x20, 0xdb, 0x50, 0xd9, 0x21, 0xec, 0x95, 0x78, 0x85, 0x25, 0x61, 0xee, 0xb0,
+	0x2d, 0x8b, 0xad, 0x8b, 0x6c, 0x00, 0x8f, 0xb5, 0x9a, 0x95, 0x23, 0xfc, 0x34, 0xb5, 0x90, 0xdf,
+	0xf7, 0x92, 0x30, 0x5b, 0xd3, 0x0c, 0x33, 0x15, 0x92, 0x30, 0xaf, 0x99, 0xc0, 0x31, 0x6d, 0x8d,
+	0xbd, 0x83, 0x67, 0x42, 0x6a, 0xe2, 0x92, 0x8a, 0x2a, 0xc3, 0x02, 0xb9, 0xc6, 0x3c, 0xda, 0xb6,
+	0xe0, 0xdd, 0x0b, 0x33, 0xf1, 0x0a, 0xf5, 0x6a, 0x62, 0xd7, 0x4d, 0x6c, 0x6b, 0x2c, 0x05, 0x36,
+	0x93, 0xe2, 0xfb, 0x0c, 0x4f, 0x50, 0x93, 0x90, 0x36, 0x99, 0x8e, 0x76, 0x6c, 0x88, 0x7b, 0x6e,
+	0x4c, 0xde, 0x31, 0xa2, 0x3e, 0x56, 0x45, 0x81, 0x23, 0xc2, 0x3c, 0xda, 0xb5, 0x43, 0xd7, 0x45,
+	0x96, 0xc0, 0xfe, 0xb5, 0xa0, 0xf3, 0xbc, 0xe4, 0xd7, 0xb2, 0x36, 0x0f, 0x2d, 0xb7, 0x29, 0x0f,
+	0xc6, 0x00, 0xa7, 0xea, 0x02, 0xe5, 0x17, 0xe2, 0xa4, 0x59, 0x0f, 0xb6, 0xc9, 0x54, 0xf5, 0xcf,
+	0x76, 0x05, 0x1b, 0x42, 0xd7, 0xad, 0xd6, 0xfe, 0xdc, 0x47, 0xef, 0x0f, 0xd3, 0x7b, 0x76, 0x9b,
+	0x6e, 0x6c, 0x70, 0x18, 0xdc, 0xfc, 0x79, 0xdd, 0xc9, 0xea, 0xce, 0x81, 0x82, 0x3d, 0x07, 0xa8,
+	0xd2, 0x59, 0x1d, 0xc0, 0xee, 0xb4, 0x16, 0xac, 0x5b, 0x98, 0x35, 0xf5, 0x83, 0x18, 0x9e, 0x01,
+	0x9c, 0x70, 0x51, 0x54, 0xce, 0xed, 0x29, 0xf8, 0x39, 0xaf, 0xea, 0x58, 0xe6, 0xf8, 0x20, 0x1e,
+	0x3f, 0xa1, 0xd7, 0x00, 0xad, 0x2d, 0xb1, 0x08, 0x76, 0x78, 0x9e, 0x97, 0xa8, 0x75, 0x1d, 0x6d,
+	0x59, 0xb2, 0x17, 0xc6, 0xf5, 0x02, 0xa5, 0x71, 0xf5, 0x93, 0x20, 0xab, 0x2b, 0xf6, 0x0a, 0xc2,
+	0x65, 0x7a, 0x1d, 0xf9, 0x7d, 0x3f, 0x09, 0xb3, 0x95, 0x60, 0xba, 0xec, 0x87, 0xe9, 0x28, 0x70,
+	0x5d, 0xae, 0x1a, 0x7e, 0xbc, 0x99, 0xc7, 0xde, 0xed, 0x3c, 0xf6, 0xfe, 0xce, 0x63, 0xef, 0xd7,
+	0x22, 0xee, 0xdc, 0x2e, 0xe2, 0xce, 0xef, 0x45, 0xdc, 0xf9, 0xfa, 0x72, 0xf5, 0x52, 0x7f, 0xb4,
+	0xde, 0x2a, 0x55, 0x97, 0xa8, 0xcf, 0xba, 0xf6, 0xd9, 0x7d, 0xf8, 0x3f, 0x00, 0xb7, 0x3c, 0xc9,
+	0x75, 0xcf, 0x03, 0x00, 0x00,
+}
+
+func (m *MigrationTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnAmount) > 0 {
+		i -= len(m.WithdrawnAmount)
+		copy(dAtA[i:], m.WithdrawnAmount)
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(len(m.WithdrawnAmount)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.FeesCollected) > 0 {
+		i -= len(m.FeesCollected)
+		copy(dAtA[i:], m.FeesCollected)
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(len(m.FeesCollected)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.UniqueDestinations != 0 {
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(m.UniqueDestinations))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.VestedAmount) > 0 {
+		i -= len(m.VestedAmount)
+		copy(dAtA[i:], m.VestedAmount)
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(len(m.VestedAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InstantlyReleased) > 0 {
+		i -= len(m.InstantlyReleased)
+		copy(dAtA[i:], m.InstantlyReleased)
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(len(m.InstantlyReleased)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MintedAmount) > 0 {
+		i -= len(m.MintedAmount)
+		copy(dAtA[i:], m.MintedAmount)
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(len(m.MintedAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceAmount) > 0 {
+		i -= len(m.SourceAmount)
+		copy(dAtA[i:], m.SourceAmount)
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(len(m.SourceAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RevertedCount != 0 {
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(m.RevertedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MigrationCount != 0 {
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(m.MigrationCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Token != 0 {
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigratorStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigratorStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigratorStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Migrator) > 0 {
+		i -= len(m.Migrator)
+		copy(dAtA[i:], m.Migrator)
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(len(m.Migrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DailyStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DailyStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DailyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Day != 0 {
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrationDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		dAtA5 := make([]byte, len(m.Chains)*10)
+		var j4 int
+		for _, num := range m.Chains {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Migrators) > 0 {
+		for iNdEx := len(m.Migrators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Migrators[iNdEx])
+			copy(dAtA[i:], m.Migrators[iNdEx])
+			i = encodeVarintMigrationTotals(dAtA, i, uint64(len(m.Migrators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Tokens) > 0 {
+		dAtA7 := make([]byte, len(m.Tokens)*10)
+		var j6 int
+		for _, num := range m.Tokens {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMigrationTotals(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMigrationTotals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMigrationTotals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MigrationTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationCount != 0 {
+		n += 1 + sovMigrationTotals(uint64(m.MigrationCount))
+	}
+	if m.RevertedCount != 0 {
+		n += 1 + sovMigrationTotals(uint64(m.RevertedCount))
+	}
+	l = len(m.SourceAmount)
+	if l > 0 {
+		n += 1 + l + sovMigrationTotals(uint64(l))
+	}
+	l = len(m.MintedAmount)
+	if l > 0 {
+		n += 1 + l + sovMigrationTotals(uint64(l))
+	}
+	l = len(m.InstantlyReleased)
+	if l > 0 {
+		n += 1 + l + sovMigrationTotals(uint64(l))
+	}
+	l = len(m.VestedAmount)
+	if l > 0 {
+		n += 1 + l + sovMigrationTotals(uint64(l))
+	}
+	if m.UniqueDestinations != 0 {
+		n += 1 + sovMigrationTotals(uint64(m.UniqueDestinations))
+	}
+	l = len(m.FeesCollected)
+	if l > 0 {
+		n += 1 + l + sovMigrationTotals(uint64(l))
+	}
+	l = len(m.WithdrawnAmount)
+	if l > 0 {
+		n += 1 + l + sovMigrationTotals(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovMigrationTotals(uint64(m.Token))
+	}
+	l = m.Totals.Size()
+	n += 1 + l + sovMigrationTotals(uint64(l))
+	return n
+}
+
+func (m *MigratorStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Migrator)
+	if l > 0 {
+		n += 1 + l + sovMigrationTotals(uint64(l))
+	}
+	l = m.Totals.Size()
+	n += 1 + l + sovMigrationTotals(uint64(l))
+	return n
+}
+
+func (m *DailyStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Day != 0 {
+		n += 1 + sovMigrationTotals(uint64(m.Day))
+	}
+	l = m.Totals.Size()
+	n += 1 + l + sovMigrationTotals(uint64(l))
+	return n
+}
+
+func (m *MigrationDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMigrationTotals(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		l = 0
+		for _, e := range m.Tokens {
+			l += sovMigrationTotals(uint64(e))
+		}
+		n += 1 + sovMigrationTotals(uint64(l)) + l
+	}
+	if len(m.Migrators) > 0 {
+		for _, s := range m.Migrators {
+			l = len(s)
+			n += 1 + l + sovMigrationTotals(uint64(l))
+		}
+	}
+	if len(m.Chains) > 0 {
+		l = 0
+		for _, e := range m.Chains {
+			l += sovMigrationTotals(uint64(e))
+		}
+		n += 1 + sovMigrationTotals(uint64(l)) + l
+	}
+	return n
+}
+
+func sovMigrationTotals(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMigrationTotals(x uint64) (n int) {
+	return sovMigrationTotals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MigrationTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigrationTotals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationCount", wireType)
+			}
+			m.MigrationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigrationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedCount", wireType)
+			}
+			m.RevertedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevertedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantlyReleased", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstantlyReleased = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueDestinations", wireType)
+			}
+			m.UniqueDestinations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueDestinations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesCollected = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigrationTotals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigrationTotals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigrationTotals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigratorStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigrationTotals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigratorStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigratorStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigrationTotals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DailyStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigrationTotals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DailyStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DailyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigrationTotals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigrationDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigrationTotals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMigrationTotals
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Tokens = append(m.Tokens, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMigrationTotals
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMigrationTotals
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMigrationTotals
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Tokens) == 0 {
+					m.Tokens = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMigrationTotals
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Tokens = append(m.Tokens, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrators = append(m.Migrators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMigrationTotals
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Chains = append(m.Chains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMigrationTotals
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMigrationTotals
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMigrationTotals
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Chains) == 0 {
+					m.Chains = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMigrationTotals
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Chains = append(m.Chains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigrationTotals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMigrationTotals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMigrationTotals(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMigrationTotals
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigrationTotals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMigrationTotals
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMigrationTotals
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMigrationTotals
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMigrationTotals        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMigrationTotals          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMigrationTotals = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"selfchain/x/migration/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationTotals(t *testing.T) {
+	migration := types.TokenMigration{
+		Amount:            "2000000000000000000",
+		MintedAmount:      "2000000",
+		InstantlyReleased: "1000000",
+		VestedAmount:      "1000000",
+		Fee:               "20000",
+	}
+
+	var totals types.MigrationTotals
+	totals.Add(migration, true)
+	totals.Add(migration, false)
+	require.Equal(t, types.MigrationTotals{
+		MigrationCount:     2,
+		SourceAmount:       "4000000000000000000",
+		MintedAmount:       "4000000",
+		InstantlyReleased:  "2000000",
+		VestedAmount:       "2000000",
+		UniqueDestinations: 1,
+		FeesCollected:      "40000",
+	}, totals)
+
+	// The fee isn't given back when a migration is reverted
+	totals.Revert(migration)
+	require.Equal(t, types.MigrationTotals{
+		MigrationCount:     2,
+		RevertedCount:      1,
+		SourceAmount:       "2000000000000000000",
+		MintedAmount:       "2000000",
+		InstantlyReleased:  "1000000",
+		VestedAmount:       "1000000",
+		UniqueDestinations: 1,
+		FeesCollected:      "40000",
+	}, totals)
+
+	// Totals never go below zero
+	totals.Revert(migration)
+	totals.Revert(migration)
+	require.Equal(t, "0", totals.MintedAmount)
+	require.Equal(t, uint64(3), totals.RevertedCount)
+}
+
+func TestDayOf(t *testing.T) {
+	require.Equal(t, uint64(0), types.DayOf(time.Unix(0, 0)))
+	require.Equal(t, uint64(0), types.DayOf(time.Unix(86399, 0)))
+	require.Equal(t, uint64(19675), types.DayOf(time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)))
+}
+
+func TestMigrationDestination(t *testing.T) {
+	destination := types.MigrationDestination{
+		Tokens:    []uint64{1},
+		Migrators: []string{"migrator"},
+		Chains:    []uint64{56},
+	}
+
+	require.True(t, destination.HasToken(1))
+	require.False(t, destination.HasToken(0))
+	require.True(t, destination.HasMigrator("migrator"))
+	require.False(t, destination.HasMigrator("other"))
+	require.True(t, destination.HasChain(56))
+	require.False(t, destination.HasChain(1))
+}
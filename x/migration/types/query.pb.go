@@ -641,1035 +641,11826 @@ func (m *QueryGetConfigResponse) GetConfig() Config {
 	return Config{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "selfchain.migration.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "selfchain.migration.QueryParamsResponse")
-	proto.RegisterType((*QueryGetTokenMigrationRequest)(nil), "selfchain.migration.QueryGetTokenMigrationRequest")
-	proto.RegisterType((*QueryGetTokenMigrationResponse)(nil), "selfchain.migration.QueryGetTokenMigrationResponse")
-	proto.RegisterType((*QueryAllTokenMigrationRequest)(nil), "selfchain.migration.QueryAllTokenMigrationRequest")
-	proto.RegisterType((*QueryAllTokenMigrationResponse)(nil), "selfchain.migration.QueryAllTokenMigrationResponse")
-	proto.RegisterType((*QueryGetAclRequest)(nil), "selfchain.migration.QueryGetAclRequest")
-	proto.RegisterType((*QueryGetAclResponse)(nil), "selfchain.migration.QueryGetAclResponse")
-	proto.RegisterType((*QueryGetMigratorRequest)(nil), "selfchain.migration.QueryGetMigratorRequest")
-	proto.RegisterType((*QueryGetMigratorResponse)(nil), "selfchain.migration.QueryGetMigratorResponse")
-	proto.RegisterType((*QueryAllMigratorRequest)(nil), "selfchain.migration.QueryAllMigratorRequest")
-	proto.RegisterType((*QueryAllMigratorResponse)(nil), "selfchain.migration.QueryAllMigratorResponse")
-	proto.RegisterType((*QueryGetConfigRequest)(nil), "selfchain.migration.QueryGetConfigRequest")
-	proto.RegisterType((*QueryGetConfigResponse)(nil), "selfchain.migration.QueryGetConfigResponse")
+type QueryGetTokenSunsetRequest struct {
+	Token uint64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func init() { proto.RegisterFile("selfchain/migration/query.proto", fileDescriptor_c711775a55f886d1) }
-
-var fileDescriptor_c711775a55f886d1 = []byte{
-	// 748 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xc7, 0xb7, 0xa0, 0x88, 0x43, 0x42, 0xe2, 0x80, 0xb2, 0x29, 0x6e, 0x21, 0x83, 0x02, 0x22,
-	0x76, 0x60, 0x09, 0x26, 0x9c, 0xcc, 0x62, 0xe2, 0x7a, 0x21, 0x81, 0xd5, 0x93, 0x1e, 0xcc, 0xd0,
-	0x0c, 0x65, 0x63, 0xb7, 0xb3, 0x6c, 0x8b, 0x11, 0x09, 0x89, 0xf1, 0xe0, 0x59, 0xe3, 0xc9, 0x9b,
-	0x07, 0x4f, 0xde, 0xbc, 0xfb, 0x01, 0x38, 0x92, 0x78, 0xf1, 0x64, 0x0c, 0xf8, 0x41, 0xcc, 0xce,
-	0xbc, 0xd9, 0xa5, 0xcb, 0xb4, 0xec, 0x46, 0x6e, 0x6d, 0xe7, 0xff, 0xde, 0xfc, 0x5e, 0xdf, 0x9b,
-	0x7f, 0x8b, 0x26, 0x22, 0x1e, 0x6c, 0x79, 0xdb, 0xac, 0x1a, 0xd2, 0x5a, 0xd5, 0x6f, 0xb0, 0xb8,
-	0x2a, 0x42, 0xba, 0xb3, 0xcb, 0x1b, 0x7b, 0x6e, 0xbd, 0x21, 0x62, 0x81, 0x47, 0x5a, 0x02, 0xb7,
-	0x25, 0xb0, 0x47, 0x7d, 0xe1, 0x0b, 0xb9, 0x4e, 0x9b, 0x57, 0x4a, 0x6a, 0xdf, 0xf4, 0x85, 0xf0,
-	0x03, 0x4e, 0x59, 0xbd, 0x4a, 0x59, 0x18, 0x8a, 0x58, 0x8a, 0x23, 0x58, 0x9d, 0xf3, 0x44, 0x54,
-	0x13, 0x11, 0xdd, 0x64, 0x11, 0x57, 0x3b, 0xd0, 0x57, 0x8b, 0x9b, 0x3c, 0x66, 0x8b, 0xb4, 0xce,
-	0xfc, 0x6a, 0x28, 0xc5, 0xa0, 0x9d, 0x34, 0x51, 0xd5, 0x59, 0x83, 0xd5, 0x74, 0xb6, 0x3b, 0x26,
-	0x45, 0x2c, 0x5e, 0xf2, 0xf0, 0x45, 0xeb, 0x1e, 0xa4, 0x05, 0x93, 0x94, 0x79, 0x01, 0x2c, 0x13,
-	0xd3, 0xb2, 0xba, 0x12, 0x8d, 0x2c, 0x1e, 0x4f, 0x84, 0x5b, 0x55, 0x5f, 0x29, 0xc8, 0x28, 0xc2,
-	0x1b, 0xcd, 0x9a, 0xd6, 0x25, 0x64, 0x85, 0xef, 0xec, 0xf2, 0x28, 0x26, 0xeb, 0x68, 0x24, 0xf1,
-	0x34, 0xaa, 0x8b, 0x30, 0xe2, 0x78, 0x05, 0x0d, 0xa8, 0x62, 0xf2, 0xd6, 0xa4, 0x35, 0x3b, 0x54,
-	0x1c, 0x77, 0x0d, 0x2f, 0xd9, 0x55, 0x41, 0xab, 0x97, 0x0e, 0x7f, 0x4f, 0xe4, 0x2a, 0x10, 0x40,
-	0x56, 0x50, 0x41, 0x66, 0x2c, 0xf3, 0xf8, 0x69, 0xb3, 0xda, 0x35, 0x2d, 0x87, 0x2d, 0x71, 0x1e,
-	0x5d, 0xa9, 0x45, 0xfe, 0x63, 0x16, 0x6d, 0xcb, 0xe4, 0x57, 0x2b, 0xfa, 0x96, 0x44, 0xc8, 0x49,
-	0x0b, 0x05, 0xae, 0x0d, 0x34, 0x1c, 0x27, 0x56, 0x80, 0x6f, 0xca, 0xc8, 0x97, 0x4c, 0x02, 0x9c,
-	0x1d, 0x09, 0x88, 0x0f, 0xbc, 0xa5, 0x20, 0x30, 0xf3, 0x3e, 0x42, 0xa8, 0xdd, 0x7e, 0xd8, 0x6f,
-	0xda, 0x55, 0xb3, 0xe2, 0x36, 0x67, 0xc5, 0x55, 0xd3, 0x08, 0xb3, 0xe2, 0xae, 0x33, 0x9f, 0x43,
-	0x6c, 0xe5, 0x54, 0x24, 0xf9, 0x61, 0x41, 0x79, 0x86, 0x9d, 0x32, 0xca, 0xeb, 0xff, 0xaf, 0xf2,
-	0x70, 0x39, 0x41, 0xdf, 0x27, 0xe9, 0x67, 0xce, 0xa5, 0x57, 0x3c, 0x09, 0x7c, 0x3d, 0x3f, 0x65,
-	0x1e, 0x97, 0xbc, 0x40, 0xcf, 0x4f, 0x19, 0xe6, 0x47, 0x3f, 0x85, 0x42, 0x16, 0x50, 0x7f, 0xc9,
-	0x0b, 0xe0, 0x65, 0xe5, 0x8d, 0xf4, 0x25, 0x2f, 0x00, 0xe4, 0xa6, 0x94, 0x2c, 0xa3, 0x31, 0x9d,
-	0x68, 0x0d, 0x46, 0x5b, 0x37, 0xc0, 0x46, 0x83, 0x7a, 0xda, 0x61, 0x62, 0x5a, 0xf7, 0xe4, 0x39,
-	0xca, 0x9f, 0x0d, 0x03, 0x88, 0x07, 0x1d, 0x71, 0x43, 0xc5, 0x82, 0x91, 0x44, 0x07, 0x02, 0x4e,
-	0x3b, 0x39, 0x03, 0xa6, 0x52, 0x10, 0x74, 0x32, 0x5d, 0xd4, 0x50, 0x7c, 0xb5, 0xa0, 0x80, 0xc4,
-	0x1e, 0xc6, 0x02, 0xfa, 0x7b, 0x2e, 0xe0, 0xe2, 0x9a, 0x3f, 0x86, 0xae, 0xeb, 0xd7, 0xfc, 0x50,
-	0x9a, 0x8a, 0xee, 0xff, 0x13, 0x74, 0xa3, 0x73, 0xa1, 0x6d, 0x21, 0xea, 0x49, 0xa6, 0x85, 0x28,
-	0x89, 0xb6, 0x10, 0x75, 0x57, 0xfc, 0x32, 0x88, 0x2e, 0xcb, 0xac, 0xf8, 0xad, 0x85, 0x06, 0x94,
-	0xcb, 0xe0, 0x19, 0x63, 0xfc, 0x59, 0x4b, 0xb3, 0x67, 0xcf, 0x17, 0x2a, 0x44, 0x32, 0xf5, 0xee,
-	0xe7, 0xdf, 0x4f, 0x7d, 0x05, 0x3c, 0x4e, 0xd3, 0xdd, 0x1c, 0x7f, 0xb7, 0xd0, 0x70, 0xf2, 0xa4,
-	0xe1, 0x62, 0xfa, 0x0e, 0x69, 0xae, 0x67, 0x2f, 0xf5, 0x14, 0x03, 0x80, 0xf7, 0x25, 0xe0, 0x02,
-	0x76, 0x69, 0x17, 0x1f, 0x13, 0xba, 0x0f, 0x3e, 0x7a, 0x80, 0xbf, 0x59, 0xe8, 0x5a, 0x32, 0x65,
-	0x29, 0x08, 0xb2, 0xb0, 0xd3, 0xcc, 0x2f, 0x0b, 0x3b, 0xd5, 0xc6, 0xc8, 0xbc, 0xc4, 0x9e, 0xc6,
-	0xb7, 0xba, 0xc1, 0xc6, 0x6f, 0xa4, 0x57, 0x64, 0xf5, 0x37, 0x61, 0x39, 0x59, 0xfd, 0x4d, 0xba,
-	0x10, 0x99, 0x94, 0x1c, 0x36, 0xce, 0xd3, 0x94, 0x0f, 0x2c, 0xfe, 0x6c, 0xa1, 0x41, 0x7d, 0x7a,
-	0xf0, 0x7c, 0x66, 0xe2, 0x0e, 0x07, 0xb0, 0xef, 0x75, 0xa9, 0x06, 0x96, 0x05, 0xc9, 0x32, 0x87,
-	0x67, 0x69, 0xd6, 0xd7, 0x9c, 0xee, 0xeb, 0xab, 0x03, 0xfc, 0xd1, 0x42, 0x43, 0x3a, 0x4d, 0xb3,
-	0x7d, 0xf3, 0x99, 0xad, 0xe8, 0x01, 0xcf, 0x60, 0x35, 0xe4, 0xb6, 0xc4, 0x9b, 0xc0, 0x85, 0x4c,
-	0x3c, 0xfc, 0xde, 0xd2, 0xa7, 0x1a, 0xcf, 0x65, 0xd6, 0x9f, 0x70, 0x09, 0xfb, 0x6e, 0x57, 0xda,
-	0xae, 0x4e, 0xa5, 0xfa, 0xa7, 0x59, 0x5d, 0x3e, 0x3c, 0x76, 0xac, 0xa3, 0x63, 0xc7, 0xfa, 0x73,
-	0xec, 0x58, 0x1f, 0x4e, 0x9c, 0xdc, 0xd1, 0x89, 0x93, 0xfb, 0x75, 0xe2, 0xe4, 0x9e, 0x8d, 0xb7,
-	0xa3, 0x5e, 0x9f, 0x9e, 0xba, 0xbd, 0x3a, 0x8f, 0x36, 0x07, 0xe4, 0xbf, 0xd0, 0xd2, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x5d, 0x1a, 0xe5, 0xfd, 0x55, 0x0a, 0x00, 0x00,
+func (m *QueryGetTokenSunsetRequest) Reset()         { *m = QueryGetTokenSunsetRequest{} }
+func (m *QueryGetTokenSunsetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTokenSunsetRequest) ProtoMessage()    {}
+func (*QueryGetTokenSunsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{14}
+}
+func (m *QueryGetTokenSunsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTokenSunsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTokenSunsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTokenSunsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTokenSunsetRequest.Merge(m, src)
+}
+func (m *QueryGetTokenSunsetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTokenSunsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTokenSunsetRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetTokenSunsetRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of TokenMigration items.
-	TokenMigration(ctx context.Context, in *QueryGetTokenMigrationRequest, opts ...grpc.CallOption) (*QueryGetTokenMigrationResponse, error)
-	TokenMigrationAll(ctx context.Context, in *QueryAllTokenMigrationRequest, opts ...grpc.CallOption) (*QueryAllTokenMigrationResponse, error)
-	// Queries a Acl by index.
-	Acl(ctx context.Context, in *QueryGetAclRequest, opts ...grpc.CallOption) (*QueryGetAclResponse, error)
-	// Queries a list of Migrator items.
-	Migrator(ctx context.Context, in *QueryGetMigratorRequest, opts ...grpc.CallOption) (*QueryGetMigratorResponse, error)
-	MigratorAll(ctx context.Context, in *QueryAllMigratorRequest, opts ...grpc.CallOption) (*QueryAllMigratorResponse, error)
-	// Queries a Config by index.
-	Config(ctx context.Context, in *QueryGetConfigRequest, opts ...grpc.CallOption) (*QueryGetConfigResponse, error)
+func (m *QueryGetTokenSunsetRequest) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetTokenSunsetResponse struct {
+	TokenSunset TokenSunset `protobuf:"bytes,1,opt,name=tokenSunset,proto3" json:"tokenSunset"`
+	// seconds left until the migration of the token ends
+	TimeRemaining uint64 `protobuf:"varint,2,opt,name=timeRemaining,proto3" json:"timeRemaining,omitempty"`
+	// amount of uslf that can still be minted before the cap is reached
+	Remaining string `protobuf:"bytes,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetTokenSunsetResponse) Reset()         { *m = QueryGetTokenSunsetResponse{} }
+func (m *QueryGetTokenSunsetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTokenSunsetResponse) ProtoMessage()    {}
+func (*QueryGetTokenSunsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{15}
+}
+func (m *QueryGetTokenSunsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTokenSunsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTokenSunsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTokenSunsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTokenSunsetResponse.Merge(m, src)
+}
+func (m *QueryGetTokenSunsetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTokenSunsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTokenSunsetResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetTokenSunsetResponse proto.InternalMessageInfo
+
+func (m *QueryGetTokenSunsetResponse) GetTokenSunset() TokenSunset {
+	if m != nil {
+		return m.TokenSunset
 	}
-	return out, nil
+	return TokenSunset{}
 }
 
-func (c *queryClient) TokenMigration(ctx context.Context, in *QueryGetTokenMigrationRequest, opts ...grpc.CallOption) (*QueryGetTokenMigrationResponse, error) {
-	out := new(QueryGetTokenMigrationResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/TokenMigration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetTokenSunsetResponse) GetTimeRemaining() uint64 {
+	if m != nil {
+		return m.TimeRemaining
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) TokenMigrationAll(ctx context.Context, in *QueryAllTokenMigrationRequest, opts ...grpc.CallOption) (*QueryAllTokenMigrationResponse, error) {
-	out := new(QueryAllTokenMigrationResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/TokenMigrationAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetTokenSunsetResponse) GetRemaining() string {
+	if m != nil {
+		return m.Remaining
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Acl(ctx context.Context, in *QueryGetAclRequest, opts ...grpc.CallOption) (*QueryGetAclResponse, error) {
-	out := new(QueryGetAclResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/Acl", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryAllTokenSunsetRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTokenSunsetRequest) Reset()         { *m = QueryAllTokenSunsetRequest{} }
+func (m *QueryAllTokenSunsetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTokenSunsetRequest) ProtoMessage()    {}
+func (*QueryAllTokenSunsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{16}
+}
+func (m *QueryAllTokenSunsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTokenSunsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTokenSunsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllTokenSunsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTokenSunsetRequest.Merge(m, src)
+}
+func (m *QueryAllTokenSunsetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTokenSunsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTokenSunsetRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Migrator(ctx context.Context, in *QueryGetMigratorRequest, opts ...grpc.CallOption) (*QueryGetMigratorResponse, error) {
-	out := new(QueryGetMigratorResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/Migrator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllTokenSunsetRequest proto.InternalMessageInfo
+
+func (m *QueryAllTokenSunsetRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) MigratorAll(ctx context.Context, in *QueryAllMigratorRequest, opts ...grpc.CallOption) (*QueryAllMigratorResponse, error) {
-	out := new(QueryAllMigratorResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/MigratorAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryAllTokenSunsetResponse struct {
+	TokenSunset []TokenSunset       `protobuf:"bytes,1,rep,name=tokenSunset,proto3" json:"tokenSunset"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTokenSunsetResponse) Reset()         { *m = QueryAllTokenSunsetResponse{} }
+func (m *QueryAllTokenSunsetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTokenSunsetResponse) ProtoMessage()    {}
+func (*QueryAllTokenSunsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{17}
+}
+func (m *QueryAllTokenSunsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTokenSunsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTokenSunsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllTokenSunsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTokenSunsetResponse.Merge(m, src)
+}
+func (m *QueryAllTokenSunsetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTokenSunsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTokenSunsetResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Config(ctx context.Context, in *QueryGetConfigRequest, opts ...grpc.CallOption) (*QueryGetConfigResponse, error) {
-	out := new(QueryGetConfigResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/Config", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllTokenSunsetResponse proto.InternalMessageInfo
+
+func (m *QueryAllTokenSunsetResponse) GetTokenSunset() []TokenSunset {
+	if m != nil {
+		return m.TokenSunset
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of TokenMigration items.
-	TokenMigration(context.Context, *QueryGetTokenMigrationRequest) (*QueryGetTokenMigrationResponse, error)
-	TokenMigrationAll(context.Context, *QueryAllTokenMigrationRequest) (*QueryAllTokenMigrationResponse, error)
-	// Queries a Acl by index.
-	Acl(context.Context, *QueryGetAclRequest) (*QueryGetAclResponse, error)
-	// Queries a list of Migrator items.
-	Migrator(context.Context, *QueryGetMigratorRequest) (*QueryGetMigratorResponse, error)
-	MigratorAll(context.Context, *QueryAllMigratorRequest) (*QueryAllMigratorResponse, error)
-	// Queries a Config by index.
-	Config(context.Context, *QueryGetConfigRequest) (*QueryGetConfigResponse, error)
+func (m *QueryAllTokenSunsetResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryGetSourceChainRequest struct {
+	ChainId uint64 `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetSourceChainRequest) Reset()         { *m = QueryGetSourceChainRequest{} }
+func (m *QueryGetSourceChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSourceChainRequest) ProtoMessage()    {}
+func (*QueryGetSourceChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{18}
 }
-func (*UnimplementedQueryServer) TokenMigration(ctx context.Context, req *QueryGetTokenMigrationRequest) (*QueryGetTokenMigrationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenMigration not implemented")
+func (m *QueryGetSourceChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TokenMigrationAll(ctx context.Context, req *QueryAllTokenMigrationRequest) (*QueryAllTokenMigrationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenMigrationAll not implemented")
+func (m *QueryGetSourceChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSourceChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Acl(ctx context.Context, req *QueryGetAclRequest) (*QueryGetAclResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Acl not implemented")
+func (m *QueryGetSourceChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSourceChainRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Migrator(ctx context.Context, req *QueryGetMigratorRequest) (*QueryGetMigratorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Migrator not implemented")
+func (m *QueryGetSourceChainRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) MigratorAll(ctx context.Context, req *QueryAllMigratorRequest) (*QueryAllMigratorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MigratorAll not implemented")
+func (m *QueryGetSourceChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSourceChainRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryGetConfigRequest) (*QueryGetConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+
+var xxx_messageInfo_QueryGetSourceChainRequest proto.InternalMessageInfo
+
+func (m *QueryGetSourceChainRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryGetSourceChainResponse struct {
+	SourceChain SourceChain `protobuf:"bytes,1,opt,name=sourceChain,proto3" json:"sourceChain"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryGetSourceChainResponse) Reset()         { *m = QueryGetSourceChainResponse{} }
+func (m *QueryGetSourceChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSourceChainResponse) ProtoMessage()    {}
+func (*QueryGetSourceChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{19}
+}
+func (m *QueryGetSourceChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSourceChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSourceChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetSourceChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSourceChainResponse.Merge(m, src)
+}
+func (m *QueryGetSourceChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSourceChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSourceChainResponse.DiscardUnknown(m)
 }
 
-func _Query_TokenMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTokenMigrationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSourceChainResponse proto.InternalMessageInfo
+
+func (m *QueryGetSourceChainResponse) GetSourceChain() SourceChain {
+	if m != nil {
+		return m.SourceChain
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenMigration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Query/TokenMigration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenMigration(ctx, req.(*QueryGetTokenMigrationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return SourceChain{}
 }
 
-func _Query_TokenMigrationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllTokenMigrationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenMigrationAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Query/TokenMigrationAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenMigrationAll(ctx, req.(*QueryAllTokenMigrationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllSourceChainRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Acl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAclRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Acl(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Query/Acl",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Acl(ctx, req.(*QueryGetAclRequest))
+func (m *QueryAllSourceChainRequest) Reset()         { *m = QueryAllSourceChainRequest{} }
+func (m *QueryAllSourceChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSourceChainRequest) ProtoMessage()    {}
+func (*QueryAllSourceChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{20}
+}
+func (m *QueryAllSourceChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSourceChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSourceChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllSourceChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSourceChainRequest.Merge(m, src)
+}
+func (m *QueryAllSourceChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSourceChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSourceChainRequest.DiscardUnknown(m)
 }
 
-func _Query_Migrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMigratorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Migrator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Query/Migrator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Migrator(ctx, req.(*QueryGetMigratorRequest))
+var xxx_messageInfo_QueryAllSourceChainRequest proto.InternalMessageInfo
+
+func (m *QueryAllSourceChainRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_MigratorAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMigratorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MigratorAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Query/MigratorAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MigratorAll(ctx, req.(*QueryAllMigratorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllSourceChainResponse struct {
+	SourceChain []SourceChain       `protobuf:"bytes,1,rep,name=sourceChain,proto3" json:"sourceChain"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Config(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Query/Config",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Config(ctx, req.(*QueryGetConfigRequest))
+func (m *QueryAllSourceChainResponse) Reset()         { *m = QueryAllSourceChainResponse{} }
+func (m *QueryAllSourceChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSourceChainResponse) ProtoMessage()    {}
+func (*QueryAllSourceChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{21}
+}
+func (m *QueryAllSourceChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSourceChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSourceChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllSourceChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSourceChainResponse.Merge(m, src)
+}
+func (m *QueryAllSourceChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSourceChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSourceChainResponse.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "selfchain.migration.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "TokenMigration",
-			Handler:    _Query_TokenMigration_Handler,
-		},
-		{
-			MethodName: "TokenMigrationAll",
-			Handler:    _Query_TokenMigrationAll_Handler,
-		},
-		{
-			MethodName: "Acl",
-			Handler:    _Query_Acl_Handler,
-		},
-		{
-			MethodName: "Migrator",
-			Handler:    _Query_Migrator_Handler,
-		},
-		{
-			MethodName: "MigratorAll",
-			Handler:    _Query_MigratorAll_Handler,
-		},
-		{
-			MethodName: "Config",
-			Handler:    _Query_Config_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "selfchain/migration/query.proto",
+var xxx_messageInfo_QueryAllSourceChainResponse proto.InternalMessageInfo
+
+func (m *QueryAllSourceChainResponse) GetSourceChain() []SourceChain {
+	if m != nil {
+		return m.SourceChain
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllSourceChainResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetSourceChainStatsRequest struct {
+	ChainId uint64 `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetSourceChainStatsRequest) Reset()         { *m = QueryGetSourceChainStatsRequest{} }
+func (m *QueryGetSourceChainStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSourceChainStatsRequest) ProtoMessage()    {}
+func (*QueryGetSourceChainStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{22}
+}
+func (m *QueryGetSourceChainStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSourceChainStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSourceChainStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSourceChainStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSourceChainStatsRequest.Merge(m, src)
+}
+func (m *QueryGetSourceChainStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSourceChainStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSourceChainStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSourceChainStatsRequest proto.InternalMessageInfo
+
+func (m *QueryGetSourceChainStatsRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetSourceChainStatsResponse struct {
+	SourceChainStats SourceChainStats `protobuf:"bytes,1,opt,name=sourceChainStats,proto3" json:"sourceChainStats"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetSourceChainStatsResponse) Reset()         { *m = QueryGetSourceChainStatsResponse{} }
+func (m *QueryGetSourceChainStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSourceChainStatsResponse) ProtoMessage()    {}
+func (*QueryGetSourceChainStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{23}
+}
+func (m *QueryGetSourceChainStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSourceChainStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSourceChainStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetSourceChainStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSourceChainStatsResponse.Merge(m, src)
+}
+func (m *QueryGetSourceChainStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSourceChainStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSourceChainStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetTokenMigrationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSourceChainStatsResponse proto.InternalMessageInfo
+
+func (m *QueryGetSourceChainStatsResponse) GetSourceChainStats() SourceChainStats {
+	if m != nil {
+		return m.SourceChainStats
 	}
-	return dAtA[:n], nil
+	return SourceChainStats{}
 }
 
-func (m *QueryGetTokenMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllSourceChainStatsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetTokenMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MsgHash) > 0 {
-		i -= len(m.MsgHash)
-		copy(dAtA[i:], m.MsgHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgHash)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllSourceChainStatsRequest) Reset()         { *m = QueryAllSourceChainStatsRequest{} }
+func (m *QueryAllSourceChainStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSourceChainStatsRequest) ProtoMessage()    {}
+func (*QueryAllSourceChainStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{24}
+}
+func (m *QueryAllSourceChainStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSourceChainStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSourceChainStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllSourceChainStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSourceChainStatsRequest.Merge(m, src)
+}
+func (m *QueryAllSourceChainStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSourceChainStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSourceChainStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTokenMigrationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllSourceChainStatsRequest proto.InternalMessageInfo
+
+func (m *QueryAllSourceChainStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetTokenMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllSourceChainStatsResponse struct {
+	SourceChainStats []SourceChainStats  `protobuf:"bytes,1,rep,name=sourceChainStats,proto3" json:"sourceChainStats"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetTokenMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenMigration.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllSourceChainStatsResponse) Reset()         { *m = QueryAllSourceChainStatsResponse{} }
+func (m *QueryAllSourceChainStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSourceChainStatsResponse) ProtoMessage()    {}
+func (*QueryAllSourceChainStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{25}
+}
+func (m *QueryAllSourceChainStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSourceChainStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSourceChainStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllTokenMigrationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllSourceChainStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSourceChainStatsResponse.Merge(m, src)
 }
-
-func (m *QueryAllTokenMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllSourceChainStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSourceChainStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSourceChainStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllTokenMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllSourceChainStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAllSourceChainStatsResponse) GetSourceChainStats() []SourceChainStats {
+	if m != nil {
+		return m.SourceChainStats
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllTokenMigrationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllSourceChainStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllTokenMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetMigrationFeeRequest struct {
+	Token uint64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (m *QueryAllTokenMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TokenMigration) > 0 {
-		for iNdEx := len(m.TokenMigration) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokenMigration[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetMigrationFeeRequest) Reset()         { *m = QueryGetMigrationFeeRequest{} }
+func (m *QueryGetMigrationFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMigrationFeeRequest) ProtoMessage()    {}
+func (*QueryGetMigrationFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{26}
+}
+func (m *QueryGetMigrationFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMigrationFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMigrationFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetAclRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetMigrationFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMigrationFeeRequest.Merge(m, src)
 }
-
-func (m *QueryGetAclRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetMigrationFeeRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetAclRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetMigrationFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMigrationFeeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetAclResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetMigrationFeeRequest proto.InternalMessageInfo
+
+func (m *QueryGetMigrationFeeRequest) GetToken() uint64 {
+	if m != nil {
+		return m.Token
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetAclResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetMigrationFeeResponse struct {
+	MigrationFee MigrationFee `protobuf:"bytes,1,opt,name=migrationFee,proto3" json:"migrationFee"`
 }
 
-func (m *QueryGetAclResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetMigrationFeeResponse) Reset()         { *m = QueryGetMigrationFeeResponse{} }
+func (m *QueryGetMigrationFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMigrationFeeResponse) ProtoMessage()    {}
+func (*QueryGetMigrationFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{27}
+}
+func (m *QueryGetMigrationFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMigrationFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMigrationFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetMigrationFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMigrationFeeResponse.Merge(m, src)
+}
+func (m *QueryGetMigrationFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMigrationFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMigrationFeeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMigratorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetMigrationFeeResponse proto.InternalMessageInfo
+
+func (m *QueryGetMigrationFeeResponse) GetMigrationFee() MigrationFee {
+	if m != nil {
+		return m.MigrationFee
 	}
-	return dAtA[:n], nil
+	return MigrationFee{}
 }
 
-func (m *QueryGetMigratorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllMigrationFeeRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMigratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Migrator) > 0 {
-		i -= len(m.Migrator)
-		copy(dAtA[i:], m.Migrator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Migrator)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllMigrationFeeRequest) Reset()         { *m = QueryAllMigrationFeeRequest{} }
+func (m *QueryAllMigrationFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMigrationFeeRequest) ProtoMessage()    {}
+func (*QueryAllMigrationFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{28}
+}
+func (m *QueryAllMigrationFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMigrationFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMigrationFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllMigrationFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMigrationFeeRequest.Merge(m, src)
+}
+func (m *QueryAllMigrationFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMigrationFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMigrationFeeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMigratorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllMigrationFeeRequest proto.InternalMessageInfo
+
+func (m *QueryAllMigrationFeeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetMigratorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllMigrationFeeResponse struct {
+	MigrationFee []MigrationFee      `protobuf:"bytes,1,rep,name=migrationFee,proto3" json:"migrationFee"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMigratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Migrator.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllMigrationFeeResponse) Reset()         { *m = QueryAllMigrationFeeResponse{} }
+func (m *QueryAllMigrationFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMigrationFeeResponse) ProtoMessage()    {}
+func (*QueryAllMigrationFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{29}
+}
+func (m *QueryAllMigrationFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMigrationFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMigrationFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllMigrationFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMigrationFeeResponse.Merge(m, src)
+}
+func (m *QueryAllMigrationFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMigrationFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMigrationFeeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMigratorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllMigrationFeeResponse proto.InternalMessageInfo
+
+func (m *QueryAllMigrationFeeResponse) GetMigrationFee() []MigrationFee {
+	if m != nil {
+		return m.MigrationFee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMigratorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllMigrationFeeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllMigratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryGetLockupTierRequest struct {
+	Tier uint64 `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+}
+
+func (m *QueryGetLockupTierRequest) Reset()         { *m = QueryGetLockupTierRequest{} }
+func (m *QueryGetLockupTierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLockupTierRequest) ProtoMessage()    {}
+func (*QueryGetLockupTierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{30}
+}
+func (m *QueryGetLockupTierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetLockupTierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLockupTierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetLockupTierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLockupTierRequest.Merge(m, src)
+}
+func (m *QueryGetLockupTierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetLockupTierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLockupTierRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMigratorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetLockupTierRequest proto.InternalMessageInfo
+
+func (m *QueryGetLockupTierRequest) GetTier() uint64 {
+	if m != nil {
+		return m.Tier
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllMigratorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetLockupTierResponse struct {
+	LockupTier LockupTier `protobuf:"bytes,1,opt,name=lockupTier,proto3" json:"lockupTier"`
 }
 
-func (m *QueryAllMigratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Migrator) > 0 {
-		for iNdEx := len(m.Migrator) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Migrator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetLockupTierResponse) Reset()         { *m = QueryGetLockupTierResponse{} }
+func (m *QueryGetLockupTierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLockupTierResponse) ProtoMessage()    {}
+func (*QueryGetLockupTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{31}
+}
+func (m *QueryGetLockupTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetLockupTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLockupTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetLockupTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLockupTierResponse.Merge(m, src)
+}
+func (m *QueryGetLockupTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetLockupTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLockupTierResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetLockupTierResponse proto.InternalMessageInfo
+
+func (m *QueryGetLockupTierResponse) GetLockupTier() LockupTier {
+	if m != nil {
+		return m.LockupTier
 	}
-	return dAtA[:n], nil
+	return LockupTier{}
 }
 
-func (m *QueryGetConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllLockupTierRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllLockupTierRequest) Reset()         { *m = QueryAllLockupTierRequest{} }
+func (m *QueryAllLockupTierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllLockupTierRequest) ProtoMessage()    {}
+func (*QueryAllLockupTierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{32}
+}
+func (m *QueryAllLockupTierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllLockupTierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllLockupTierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllLockupTierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllLockupTierRequest.Merge(m, src)
+}
+func (m *QueryAllLockupTierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllLockupTierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllLockupTierRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllLockupTierRequest proto.InternalMessageInfo
+
+func (m *QueryAllLockupTierRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllLockupTierResponse struct {
+	LockupTier []LockupTier        `protobuf:"bytes,1,rep,name=lockupTier,proto3" json:"lockupTier"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllLockupTierResponse) Reset()         { *m = QueryAllLockupTierResponse{} }
+func (m *QueryAllLockupTierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllLockupTierResponse) ProtoMessage()    {}
+func (*QueryAllLockupTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{33}
+}
+func (m *QueryAllLockupTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllLockupTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllLockupTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryAllLockupTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllLockupTierResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllLockupTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllLockupTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllLockupTierResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllLockupTierResponse proto.InternalMessageInfo
+
+func (m *QueryAllLockupTierResponse) GetLockupTier() []LockupTier {
+	if m != nil {
+		return m.LockupTier
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetTokenMigrationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MsgHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllLockupTierResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetTokenMigrationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenMigration.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryGetRatioScheduleRequest struct {
+	Token uint64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (m *QueryAllTokenMigrationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetRatioScheduleRequest) Reset()         { *m = QueryGetRatioScheduleRequest{} }
+func (m *QueryGetRatioScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRatioScheduleRequest) ProtoMessage()    {}
+func (*QueryGetRatioScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{34}
+}
+func (m *QueryGetRatioScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRatioScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRatioScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryGetRatioScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRatioScheduleRequest.Merge(m, src)
+}
+func (m *QueryGetRatioScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRatioScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRatioScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRatioScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryGetRatioScheduleRequest) GetToken() uint64 {
+	if m != nil {
+		return m.Token
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllTokenMigrationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryGetRatioScheduleResponse struct {
+	RatioSchedule RatioSchedule `protobuf:"bytes,1,opt,name=ratioSchedule,proto3" json:"ratioSchedule"`
+}
+
+func (m *QueryGetRatioScheduleResponse) Reset()         { *m = QueryGetRatioScheduleResponse{} }
+func (m *QueryGetRatioScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRatioScheduleResponse) ProtoMessage()    {}
+func (*QueryGetRatioScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{35}
+}
+func (m *QueryGetRatioScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRatioScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRatioScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.TokenMigration) > 0 {
-		for _, e := range m.TokenMigration {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryGetRatioScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRatioScheduleResponse.Merge(m, src)
+}
+func (m *QueryGetRatioScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRatioScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRatioScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRatioScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryGetRatioScheduleResponse) GetRatioSchedule() RatioSchedule {
+	if m != nil {
+		return m.RatioSchedule
+	}
+	return RatioSchedule{}
+}
+
+type QueryAllRatioScheduleRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRatioScheduleRequest) Reset()         { *m = QueryAllRatioScheduleRequest{} }
+func (m *QueryAllRatioScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRatioScheduleRequest) ProtoMessage()    {}
+func (*QueryAllRatioScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{36}
+}
+func (m *QueryAllRatioScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRatioScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRatioScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAllRatioScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRatioScheduleRequest.Merge(m, src)
+}
+func (m *QueryAllRatioScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRatioScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRatioScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRatioScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryAllRatioScheduleRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetAclRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryAllRatioScheduleResponse struct {
+	RatioSchedule []RatioSchedule     `protobuf:"bytes,1,rep,name=ratioSchedule,proto3" json:"ratioSchedule"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRatioScheduleResponse) Reset()         { *m = QueryAllRatioScheduleResponse{} }
+func (m *QueryAllRatioScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRatioScheduleResponse) ProtoMessage()    {}
+func (*QueryAllRatioScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{37}
+}
+func (m *QueryAllRatioScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRatioScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRatioScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryAllRatioScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRatioScheduleResponse.Merge(m, src)
+}
+func (m *QueryAllRatioScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRatioScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRatioScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetAclResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllRatioScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryAllRatioScheduleResponse) GetRatioSchedule() []RatioSchedule {
+	if m != nil {
+		return m.RatioSchedule
 	}
-	var l int
-	_ = l
-	l = m.Acl.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetMigratorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllRatioScheduleResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.Migrator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryCurrentRatioRequest struct {
+	Token uint64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryCurrentRatioRequest) Reset()         { *m = QueryCurrentRatioRequest{} }
+func (m *QueryCurrentRatioRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentRatioRequest) ProtoMessage()    {}
+func (*QueryCurrentRatioRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{38}
+}
+func (m *QueryCurrentRatioRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentRatioRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentRatioRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCurrentRatioRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentRatioRequest.Merge(m, src)
+}
+func (m *QueryCurrentRatioRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentRatioRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentRatioRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMigratorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCurrentRatioRequest proto.InternalMessageInfo
+
+func (m *QueryCurrentRatioRequest) GetToken() uint64 {
+	if m != nil {
+		return m.Token
 	}
-	var l int
-	_ = l
-	l = m.Migrator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAllMigratorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryCurrentRatioResponse struct {
+	// ratio in percent
+	Ratio uint64 `protobuf:"varint,1,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// whether the ratio comes from the schedule of the token
+	Scheduled bool `protobuf:"varint,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+}
+
+func (m *QueryCurrentRatioResponse) Reset()         { *m = QueryCurrentRatioResponse{} }
+func (m *QueryCurrentRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentRatioResponse) ProtoMessage()    {}
+func (*QueryCurrentRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{39}
+}
+func (m *QueryCurrentRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryCurrentRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentRatioResponse.Merge(m, src)
+}
+func (m *QueryCurrentRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentRatioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentRatioResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentRatioResponse) GetRatio() uint64 {
+	if m != nil {
+		return m.Ratio
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllMigratorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCurrentRatioResponse) GetScheduled() bool {
+	if m != nil {
+		return m.Scheduled
 	}
-	var l int
-	_ = l
-	if len(m.Migrator) > 0 {
-		for _, e := range m.Migrator {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+type QueryGetWithdrawalRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetWithdrawalRequest) Reset()         { *m = QueryGetWithdrawalRequest{} }
+func (m *QueryGetWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWithdrawalRequest) ProtoMessage()    {}
+func (*QueryGetWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{40}
+}
+func (m *QueryGetWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWithdrawalRequest.Merge(m, src)
+}
+func (m *QueryGetWithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWithdrawalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWithdrawalRequest proto.InternalMessageInfo
+
+func (m *QueryGetWithdrawalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetWithdrawalResponse struct {
+	Withdrawal Withdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal"`
+}
+
+func (m *QueryGetWithdrawalResponse) Reset()         { *m = QueryGetWithdrawalResponse{} }
+func (m *QueryGetWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWithdrawalResponse) ProtoMessage()    {}
+func (*QueryGetWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{41}
+}
+func (m *QueryGetWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWithdrawalResponse.Merge(m, src)
+}
+func (m *QueryGetWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWithdrawalResponse proto.InternalMessageInfo
+
+func (m *QueryGetWithdrawalResponse) GetWithdrawal() Withdrawal {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return Withdrawal{}
+}
+
+type QueryAllWithdrawalRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllWithdrawalRequest) Reset()         { *m = QueryAllWithdrawalRequest{} }
+func (m *QueryAllWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllWithdrawalRequest) ProtoMessage()    {}
+func (*QueryAllWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{42}
+}
+func (m *QueryAllWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllWithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllWithdrawalRequest.Merge(m, src)
+}
+func (m *QueryAllWithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllWithdrawalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllWithdrawalRequest proto.InternalMessageInfo
+
+func (m *QueryAllWithdrawalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllWithdrawalResponse struct {
+	Withdrawal []Withdrawal        `protobuf:"bytes,1,rep,name=withdrawal,proto3" json:"withdrawal"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllWithdrawalResponse) Reset()         { *m = QueryAllWithdrawalResponse{} }
+func (m *QueryAllWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllWithdrawalResponse) ProtoMessage()    {}
+func (*QueryAllWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{43}
+}
+func (m *QueryAllWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllWithdrawalResponse.Merge(m, src)
+}
+func (m *QueryAllWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllWithdrawalResponse proto.InternalMessageInfo
+
+func (m *QueryAllWithdrawalResponse) GetWithdrawal() []Withdrawal {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return nil
+}
+
+func (m *QueryAllWithdrawalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetWithdrawalConfigRequest struct {
+}
+
+func (m *QueryGetWithdrawalConfigRequest) Reset()         { *m = QueryGetWithdrawalConfigRequest{} }
+func (m *QueryGetWithdrawalConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWithdrawalConfigRequest) ProtoMessage()    {}
+func (*QueryGetWithdrawalConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{44}
+}
+func (m *QueryGetWithdrawalConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWithdrawalConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWithdrawalConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWithdrawalConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWithdrawalConfigRequest.Merge(m, src)
+}
+func (m *QueryGetWithdrawalConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWithdrawalConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWithdrawalConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWithdrawalConfigRequest proto.InternalMessageInfo
+
+type QueryGetWithdrawalConfigResponse struct {
+	WithdrawalConfig WithdrawalConfig `protobuf:"bytes,1,opt,name=withdrawalConfig,proto3" json:"withdrawalConfig"`
+}
+
+func (m *QueryGetWithdrawalConfigResponse) Reset()         { *m = QueryGetWithdrawalConfigResponse{} }
+func (m *QueryGetWithdrawalConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWithdrawalConfigResponse) ProtoMessage()    {}
+func (*QueryGetWithdrawalConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{45}
+}
+func (m *QueryGetWithdrawalConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWithdrawalConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWithdrawalConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWithdrawalConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWithdrawalConfigResponse.Merge(m, src)
+}
+func (m *QueryGetWithdrawalConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWithdrawalConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWithdrawalConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWithdrawalConfigResponse proto.InternalMessageInfo
+
+func (m *QueryGetWithdrawalConfigResponse) GetWithdrawalConfig() WithdrawalConfig {
+	if m != nil {
+		return m.WithdrawalConfig
+	}
+	return WithdrawalConfig{}
+}
+
+type QueryGetMigrationArchiveRequest struct {
+	Token         uint64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	Bucket        uint64 `protobuf:"varint,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	SourceChainId uint64 `protobuf:"varint,3,opt,name=sourceChainId,proto3" json:"sourceChainId,omitempty"`
+}
+
+func (m *QueryGetMigrationArchiveRequest) Reset()         { *m = QueryGetMigrationArchiveRequest{} }
+func (m *QueryGetMigrationArchiveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMigrationArchiveRequest) ProtoMessage()    {}
+func (*QueryGetMigrationArchiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{46}
+}
+func (m *QueryGetMigrationArchiveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMigrationArchiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMigrationArchiveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMigrationArchiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMigrationArchiveRequest.Merge(m, src)
+}
+func (m *QueryGetMigrationArchiveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMigrationArchiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMigrationArchiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMigrationArchiveRequest proto.InternalMessageInfo
+
+func (m *QueryGetMigrationArchiveRequest) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *QueryGetMigrationArchiveRequest) GetBucket() uint64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func (m *QueryGetMigrationArchiveRequest) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+type QueryGetMigrationArchiveResponse struct {
+	MigrationArchive MigrationArchive `protobuf:"bytes,1,opt,name=migrationArchive,proto3" json:"migrationArchive"`
+}
+
+func (m *QueryGetMigrationArchiveResponse) Reset()         { *m = QueryGetMigrationArchiveResponse{} }
+func (m *QueryGetMigrationArchiveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMigrationArchiveResponse) ProtoMessage()    {}
+func (*QueryGetMigrationArchiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{47}
+}
+func (m *QueryGetMigrationArchiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMigrationArchiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMigrationArchiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMigrationArchiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMigrationArchiveResponse.Merge(m, src)
+}
+func (m *QueryGetMigrationArchiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMigrationArchiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMigrationArchiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMigrationArchiveResponse proto.InternalMessageInfo
+
+func (m *QueryGetMigrationArchiveResponse) GetMigrationArchive() MigrationArchive {
+	if m != nil {
+		return m.MigrationArchive
+	}
+	return MigrationArchive{}
+}
+
+type QueryAllMigrationArchiveRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMigrationArchiveRequest) Reset()         { *m = QueryAllMigrationArchiveRequest{} }
+func (m *QueryAllMigrationArchiveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMigrationArchiveRequest) ProtoMessage()    {}
+func (*QueryAllMigrationArchiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{48}
+}
+func (m *QueryAllMigrationArchiveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMigrationArchiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMigrationArchiveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMigrationArchiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMigrationArchiveRequest.Merge(m, src)
+}
+func (m *QueryAllMigrationArchiveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMigrationArchiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMigrationArchiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMigrationArchiveRequest proto.InternalMessageInfo
+
+func (m *QueryAllMigrationArchiveRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllMigrationArchiveResponse struct {
+	MigrationArchive []MigrationArchive  `protobuf:"bytes,1,rep,name=migrationArchive,proto3" json:"migrationArchive"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMigrationArchiveResponse) Reset()         { *m = QueryAllMigrationArchiveResponse{} }
+func (m *QueryAllMigrationArchiveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMigrationArchiveResponse) ProtoMessage()    {}
+func (*QueryAllMigrationArchiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{49}
+}
+func (m *QueryAllMigrationArchiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMigrationArchiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMigrationArchiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMigrationArchiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMigrationArchiveResponse.Merge(m, src)
+}
+func (m *QueryAllMigrationArchiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMigrationArchiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMigrationArchiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMigrationArchiveResponse proto.InternalMessageInfo
+
+func (m *QueryAllMigrationArchiveResponse) GetMigrationArchive() []MigrationArchive {
+	if m != nil {
+		return m.MigrationArchive
+	}
+	return nil
+}
+
+func (m *QueryAllMigrationArchiveResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetFeeWaiverConfigRequest struct {
+}
+
+func (m *QueryGetFeeWaiverConfigRequest) Reset()         { *m = QueryGetFeeWaiverConfigRequest{} }
+func (m *QueryGetFeeWaiverConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFeeWaiverConfigRequest) ProtoMessage()    {}
+func (*QueryGetFeeWaiverConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{50}
+}
+func (m *QueryGetFeeWaiverConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFeeWaiverConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFeeWaiverConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFeeWaiverConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFeeWaiverConfigRequest.Merge(m, src)
+}
+func (m *QueryGetFeeWaiverConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFeeWaiverConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFeeWaiverConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFeeWaiverConfigRequest proto.InternalMessageInfo
+
+type QueryGetFeeWaiverConfigResponse struct {
+	FeeWaiverConfig FeeWaiverConfig `protobuf:"bytes,1,opt,name=feeWaiverConfig,proto3" json:"feeWaiverConfig"`
+}
+
+func (m *QueryGetFeeWaiverConfigResponse) Reset()         { *m = QueryGetFeeWaiverConfigResponse{} }
+func (m *QueryGetFeeWaiverConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFeeWaiverConfigResponse) ProtoMessage()    {}
+func (*QueryGetFeeWaiverConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{51}
+}
+func (m *QueryGetFeeWaiverConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFeeWaiverConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFeeWaiverConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFeeWaiverConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFeeWaiverConfigResponse.Merge(m, src)
+}
+func (m *QueryGetFeeWaiverConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFeeWaiverConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFeeWaiverConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFeeWaiverConfigResponse proto.InternalMessageInfo
+
+func (m *QueryGetFeeWaiverConfigResponse) GetFeeWaiverConfig() FeeWaiverConfig {
+	if m != nil {
+		return m.FeeWaiverConfig
+	}
+	return FeeWaiverConfig{}
+}
+
+type QueryGetFeeAllowanceConfigRequest struct {
+}
+
+func (m *QueryGetFeeAllowanceConfigRequest) Reset()         { *m = QueryGetFeeAllowanceConfigRequest{} }
+func (m *QueryGetFeeAllowanceConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFeeAllowanceConfigRequest) ProtoMessage()    {}
+func (*QueryGetFeeAllowanceConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{52}
+}
+func (m *QueryGetFeeAllowanceConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFeeAllowanceConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFeeAllowanceConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFeeAllowanceConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFeeAllowanceConfigRequest.Merge(m, src)
+}
+func (m *QueryGetFeeAllowanceConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFeeAllowanceConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFeeAllowanceConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFeeAllowanceConfigRequest proto.InternalMessageInfo
+
+type QueryGetFeeAllowanceConfigResponse struct {
+	FeeAllowanceConfig FeeAllowanceConfig `protobuf:"bytes,1,opt,name=feeAllowanceConfig,proto3" json:"feeAllowanceConfig"`
+}
+
+func (m *QueryGetFeeAllowanceConfigResponse) Reset()         { *m = QueryGetFeeAllowanceConfigResponse{} }
+func (m *QueryGetFeeAllowanceConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFeeAllowanceConfigResponse) ProtoMessage()    {}
+func (*QueryGetFeeAllowanceConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{53}
+}
+func (m *QueryGetFeeAllowanceConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFeeAllowanceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFeeAllowanceConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFeeAllowanceConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFeeAllowanceConfigResponse.Merge(m, src)
+}
+func (m *QueryGetFeeAllowanceConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFeeAllowanceConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFeeAllowanceConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFeeAllowanceConfigResponse proto.InternalMessageInfo
+
+func (m *QueryGetFeeAllowanceConfigResponse) GetFeeAllowanceConfig() FeeAllowanceConfig {
+	if m != nil {
+		return m.FeeAllowanceConfig
+	}
+	return FeeAllowanceConfig{}
+}
+
+type QueryGetMigrationTotalsRequest struct {
+}
+
+func (m *QueryGetMigrationTotalsRequest) Reset()         { *m = QueryGetMigrationTotalsRequest{} }
+func (m *QueryGetMigrationTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMigrationTotalsRequest) ProtoMessage()    {}
+func (*QueryGetMigrationTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{54}
+}
+func (m *QueryGetMigrationTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMigrationTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMigrationTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMigrationTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMigrationTotalsRequest.Merge(m, src)
+}
+func (m *QueryGetMigrationTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMigrationTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMigrationTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMigrationTotalsRequest proto.InternalMessageInfo
+
+type QueryGetMigrationTotalsResponse struct {
+	MigrationTotals MigrationTotals `protobuf:"bytes,1,opt,name=migrationTotals,proto3" json:"migrationTotals"`
+}
+
+func (m *QueryGetMigrationTotalsResponse) Reset()         { *m = QueryGetMigrationTotalsResponse{} }
+func (m *QueryGetMigrationTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMigrationTotalsResponse) ProtoMessage()    {}
+func (*QueryGetMigrationTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{55}
+}
+func (m *QueryGetMigrationTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMigrationTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMigrationTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMigrationTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMigrationTotalsResponse.Merge(m, src)
+}
+func (m *QueryGetMigrationTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMigrationTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMigrationTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMigrationTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryGetMigrationTotalsResponse) GetMigrationTotals() MigrationTotals {
+	if m != nil {
+		return m.MigrationTotals
+	}
+	return MigrationTotals{}
+}
+
+type QueryGetTokenStatsRequest struct {
+	Token uint64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryGetTokenStatsRequest) Reset()         { *m = QueryGetTokenStatsRequest{} }
+func (m *QueryGetTokenStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTokenStatsRequest) ProtoMessage()    {}
+func (*QueryGetTokenStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{56}
+}
+func (m *QueryGetTokenStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTokenStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTokenStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTokenStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTokenStatsRequest.Merge(m, src)
+}
+func (m *QueryGetTokenStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTokenStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTokenStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTokenStatsRequest proto.InternalMessageInfo
+
+func (m *QueryGetTokenStatsRequest) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+type QueryGetTokenStatsResponse struct {
+	TokenStats TokenStats `protobuf:"bytes,1,opt,name=tokenStats,proto3" json:"tokenStats"`
+}
+
+func (m *QueryGetTokenStatsResponse) Reset()         { *m = QueryGetTokenStatsResponse{} }
+func (m *QueryGetTokenStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTokenStatsResponse) ProtoMessage()    {}
+func (*QueryGetTokenStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{57}
+}
+func (m *QueryGetTokenStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTokenStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTokenStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTokenStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTokenStatsResponse.Merge(m, src)
+}
+func (m *QueryGetTokenStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTokenStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTokenStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTokenStatsResponse proto.InternalMessageInfo
+
+func (m *QueryGetTokenStatsResponse) GetTokenStats() TokenStats {
+	if m != nil {
+		return m.TokenStats
+	}
+	return TokenStats{}
+}
+
+type QueryAllTokenStatsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTokenStatsRequest) Reset()         { *m = QueryAllTokenStatsRequest{} }
+func (m *QueryAllTokenStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTokenStatsRequest) ProtoMessage()    {}
+func (*QueryAllTokenStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{58}
+}
+func (m *QueryAllTokenStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTokenStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTokenStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTokenStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTokenStatsRequest.Merge(m, src)
+}
+func (m *QueryAllTokenStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTokenStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTokenStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTokenStatsRequest proto.InternalMessageInfo
+
+func (m *QueryAllTokenStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllTokenStatsResponse struct {
+	TokenStats []TokenStats        `protobuf:"bytes,1,rep,name=tokenStats,proto3" json:"tokenStats"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTokenStatsResponse) Reset()         { *m = QueryAllTokenStatsResponse{} }
+func (m *QueryAllTokenStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTokenStatsResponse) ProtoMessage()    {}
+func (*QueryAllTokenStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{59}
+}
+func (m *QueryAllTokenStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTokenStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTokenStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTokenStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTokenStatsResponse.Merge(m, src)
+}
+func (m *QueryAllTokenStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTokenStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTokenStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTokenStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAllTokenStatsResponse) GetTokenStats() []TokenStats {
+	if m != nil {
+		return m.TokenStats
+	}
+	return nil
+}
+
+func (m *QueryAllTokenStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetMigratorStatsRequest struct {
+	Migrator string `protobuf:"bytes,1,opt,name=migrator,proto3" json:"migrator,omitempty"`
+}
+
+func (m *QueryGetMigratorStatsRequest) Reset()         { *m = QueryGetMigratorStatsRequest{} }
+func (m *QueryGetMigratorStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMigratorStatsRequest) ProtoMessage()    {}
+func (*QueryGetMigratorStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{60}
+}
+func (m *QueryGetMigratorStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMigratorStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMigratorStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMigratorStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMigratorStatsRequest.Merge(m, src)
+}
+func (m *QueryGetMigratorStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMigratorStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMigratorStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMigratorStatsRequest proto.InternalMessageInfo
+
+func (m *QueryGetMigratorStatsRequest) GetMigrator() string {
+	if m != nil {
+		return m.Migrator
+	}
+	return ""
+}
+
+type QueryGetMigratorStatsResponse struct {
+	MigratorStats MigratorStats `protobuf:"bytes,1,opt,name=migratorStats,proto3" json:"migratorStats"`
+}
+
+func (m *QueryGetMigratorStatsResponse) Reset()         { *m = QueryGetMigratorStatsResponse{} }
+func (m *QueryGetMigratorStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMigratorStatsResponse) ProtoMessage()    {}
+func (*QueryGetMigratorStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{61}
+}
+func (m *QueryGetMigratorStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMigratorStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMigratorStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMigratorStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMigratorStatsResponse.Merge(m, src)
+}
+func (m *QueryGetMigratorStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMigratorStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMigratorStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMigratorStatsResponse proto.InternalMessageInfo
+
+func (m *QueryGetMigratorStatsResponse) GetMigratorStats() MigratorStats {
+	if m != nil {
+		return m.MigratorStats
+	}
+	return MigratorStats{}
+}
+
+type QueryAllMigratorStatsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMigratorStatsRequest) Reset()         { *m = QueryAllMigratorStatsRequest{} }
+func (m *QueryAllMigratorStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMigratorStatsRequest) ProtoMessage()    {}
+func (*QueryAllMigratorStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{62}
+}
+func (m *QueryAllMigratorStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMigratorStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMigratorStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMigratorStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMigratorStatsRequest.Merge(m, src)
+}
+func (m *QueryAllMigratorStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMigratorStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMigratorStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMigratorStatsRequest proto.InternalMessageInfo
+
+func (m *QueryAllMigratorStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllMigratorStatsResponse struct {
+	MigratorStats []MigratorStats     `protobuf:"bytes,1,rep,name=migratorStats,proto3" json:"migratorStats"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMigratorStatsResponse) Reset()         { *m = QueryAllMigratorStatsResponse{} }
+func (m *QueryAllMigratorStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMigratorStatsResponse) ProtoMessage()    {}
+func (*QueryAllMigratorStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{63}
+}
+func (m *QueryAllMigratorStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMigratorStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMigratorStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMigratorStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMigratorStatsResponse.Merge(m, src)
+}
+func (m *QueryAllMigratorStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMigratorStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMigratorStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMigratorStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAllMigratorStatsResponse) GetMigratorStats() []MigratorStats {
+	if m != nil {
+		return m.MigratorStats
+	}
+	return nil
+}
+
+func (m *QueryAllMigratorStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetDailyStatsRequest struct {
+	Day uint64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+}
+
+func (m *QueryGetDailyStatsRequest) Reset()         { *m = QueryGetDailyStatsRequest{} }
+func (m *QueryGetDailyStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDailyStatsRequest) ProtoMessage()    {}
+func (*QueryGetDailyStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{64}
+}
+func (m *QueryGetDailyStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDailyStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDailyStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDailyStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDailyStatsRequest.Merge(m, src)
+}
+func (m *QueryGetDailyStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDailyStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDailyStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDailyStatsRequest proto.InternalMessageInfo
+
+func (m *QueryGetDailyStatsRequest) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+type QueryGetDailyStatsResponse struct {
+	DailyStats DailyStats `protobuf:"bytes,1,opt,name=dailyStats,proto3" json:"dailyStats"`
+}
+
+func (m *QueryGetDailyStatsResponse) Reset()         { *m = QueryGetDailyStatsResponse{} }
+func (m *QueryGetDailyStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDailyStatsResponse) ProtoMessage()    {}
+func (*QueryGetDailyStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{65}
+}
+func (m *QueryGetDailyStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDailyStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDailyStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDailyStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDailyStatsResponse.Merge(m, src)
+}
+func (m *QueryGetDailyStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDailyStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDailyStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDailyStatsResponse proto.InternalMessageInfo
+
+func (m *QueryGetDailyStatsResponse) GetDailyStats() DailyStats {
+	if m != nil {
+		return m.DailyStats
+	}
+	return DailyStats{}
+}
+
+type QueryAllDailyStatsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDailyStatsRequest) Reset()         { *m = QueryAllDailyStatsRequest{} }
+func (m *QueryAllDailyStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDailyStatsRequest) ProtoMessage()    {}
+func (*QueryAllDailyStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{66}
+}
+func (m *QueryAllDailyStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDailyStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDailyStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDailyStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDailyStatsRequest.Merge(m, src)
+}
+func (m *QueryAllDailyStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDailyStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDailyStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDailyStatsRequest proto.InternalMessageInfo
+
+func (m *QueryAllDailyStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllDailyStatsResponse struct {
+	DailyStats []DailyStats        `protobuf:"bytes,1,rep,name=dailyStats,proto3" json:"dailyStats"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDailyStatsResponse) Reset()         { *m = QueryAllDailyStatsResponse{} }
+func (m *QueryAllDailyStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDailyStatsResponse) ProtoMessage()    {}
+func (*QueryAllDailyStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c711775a55f886d1, []int{67}
+}
+func (m *QueryAllDailyStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDailyStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDailyStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDailyStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDailyStatsResponse.Merge(m, src)
+}
+func (m *QueryAllDailyStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDailyStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDailyStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDailyStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAllDailyStatsResponse) GetDailyStats() []DailyStats {
+	if m != nil {
+		return m.DailyStats
+	}
+	return nil
+}
+
+func (m *QueryAllDailyStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "selfchain.migration.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "selfchain.migration.QueryParamsResponse")
+	proto.RegisterType((*QueryGetTokenMigrationRequest)(nil), "selfchain.migration.QueryGetTokenMigrationRequest")
+	proto.RegisterType((*QueryGetTokenMigrationResponse)(nil), "selfchain.migration.QueryGetTokenMigrationResponse")
+	proto.RegisterType((*QueryAllTokenMigrationRequest)(nil), "selfchain.migration.QueryAllTokenMigrationRequest")
+	proto.RegisterType((*QueryAllTokenMigrationResponse)(nil), "selfchain.migration.QueryAllTokenMigrationResponse")
+	proto.RegisterType((*QueryGetAclRequest)(nil), "selfchain.migration.QueryGetAclRequest")
+	proto.RegisterType((*QueryGetAclResponse)(nil), "selfchain.migration.QueryGetAclResponse")
+	proto.RegisterType((*QueryGetMigratorRequest)(nil), "selfchain.migration.QueryGetMigratorRequest")
+	proto.RegisterType((*QueryGetMigratorResponse)(nil), "selfchain.migration.QueryGetMigratorResponse")
+	proto.RegisterType((*QueryAllMigratorRequest)(nil), "selfchain.migration.QueryAllMigratorRequest")
+	proto.RegisterType((*QueryAllMigratorResponse)(nil), "selfchain.migration.QueryAllMigratorResponse")
+	proto.RegisterType((*QueryGetConfigRequest)(nil), "selfchain.migration.QueryGetConfigRequest")
+	proto.RegisterType((*QueryGetConfigResponse)(nil), "selfchain.migration.QueryGetConfigResponse")
+	proto.RegisterType((*QueryGetTokenSunsetRequest)(nil), "selfchain.migration.QueryGetTokenSunsetRequest")
+	proto.RegisterType((*QueryGetTokenSunsetResponse)(nil), "selfchain.migration.QueryGetTokenSunsetResponse")
+	proto.RegisterType((*QueryAllTokenSunsetRequest)(nil), "selfchain.migration.QueryAllTokenSunsetRequest")
+	proto.RegisterType((*QueryAllTokenSunsetResponse)(nil), "selfchain.migration.QueryAllTokenSunsetResponse")
+	proto.RegisterType((*QueryGetSourceChainRequest)(nil), "selfchain.migration.QueryGetSourceChainRequest")
+	proto.RegisterType((*QueryGetSourceChainResponse)(nil), "selfchain.migration.QueryGetSourceChainResponse")
+	proto.RegisterType((*QueryAllSourceChainRequest)(nil), "selfchain.migration.QueryAllSourceChainRequest")
+	proto.RegisterType((*QueryAllSourceChainResponse)(nil), "selfchain.migration.QueryAllSourceChainResponse")
+	proto.RegisterType((*QueryGetSourceChainStatsRequest)(nil), "selfchain.migration.QueryGetSourceChainStatsRequest")
+	proto.RegisterType((*QueryGetSourceChainStatsResponse)(nil), "selfchain.migration.QueryGetSourceChainStatsResponse")
+	proto.RegisterType((*QueryAllSourceChainStatsRequest)(nil), "selfchain.migration.QueryAllSourceChainStatsRequest")
+	proto.RegisterType((*QueryAllSourceChainStatsResponse)(nil), "selfchain.migration.QueryAllSourceChainStatsResponse")
+	proto.RegisterType((*QueryGetMigrationFeeRequest)(nil), "selfchain.migration.QueryGetMigrationFeeRequest")
+	proto.RegisterType((*QueryGetMigrationFeeResponse)(nil), "selfchain.migration.QueryGetMigrationFeeResponse")
+	proto.RegisterType((*QueryAllMigrationFeeRequest)(nil), "selfchain.migration.QueryAllMigrationFeeRequest")
+	proto.RegisterType((*QueryAllMigrationFeeResponse)(nil), "selfchain.migration.QueryAllMigrationFeeResponse")
+	proto.RegisterType((*QueryGetLockupTierRequest)(nil), "selfchain.migration.QueryGetLockupTierRequest")
+	proto.RegisterType((*QueryGetLockupTierResponse)(nil), "selfchain.migration.QueryGetLockupTierResponse")
+	proto.RegisterType((*QueryAllLockupTierRequest)(nil), "selfchain.migration.QueryAllLockupTierRequest")
+	proto.RegisterType((*QueryAllLockupTierResponse)(nil), "selfchain.migration.QueryAllLockupTierResponse")
+	proto.RegisterType((*QueryGetRatioScheduleRequest)(nil), "selfchain.migration.QueryGetRatioScheduleRequest")
+	proto.RegisterType((*QueryGetRatioScheduleResponse)(nil), "selfchain.migration.QueryGetRatioScheduleResponse")
+	proto.RegisterType((*QueryAllRatioScheduleRequest)(nil), "selfchain.migration.QueryAllRatioScheduleRequest")
+	proto.RegisterType((*QueryAllRatioScheduleResponse)(nil), "selfchain.migration.QueryAllRatioScheduleResponse")
+	proto.RegisterType((*QueryCurrentRatioRequest)(nil), "selfchain.migration.QueryCurrentRatioRequest")
+	proto.RegisterType((*QueryCurrentRatioResponse)(nil), "selfchain.migration.QueryCurrentRatioResponse")
+	proto.RegisterType((*QueryGetWithdrawalRequest)(nil), "selfchain.migration.QueryGetWithdrawalRequest")
+	proto.RegisterType((*QueryGetWithdrawalResponse)(nil), "selfchain.migration.QueryGetWithdrawalResponse")
+	proto.RegisterType((*QueryAllWithdrawalRequest)(nil), "selfchain.migration.QueryAllWithdrawalRequest")
+	proto.RegisterType((*QueryAllWithdrawalResponse)(nil), "selfchain.migration.QueryAllWithdrawalResponse")
+	proto.RegisterType((*QueryGetWithdrawalConfigRequest)(nil), "selfchain.migration.QueryGetWithdrawalConfigRequest")
+	proto.RegisterType((*QueryGetWithdrawalConfigResponse)(nil), "selfchain.migration.QueryGetWithdrawalConfigResponse")
+	proto.RegisterType((*QueryGetMigrationArchiveRequest)(nil), "selfchain.migration.QueryGetMigrationArchiveRequest")
+	proto.RegisterType((*QueryGetMigrationArchiveResponse)(nil), "selfchain.migration.QueryGetMigrationArchiveResponse")
+	proto.RegisterType((*QueryAllMigrationArchiveRequest)(nil), "selfchain.migration.QueryAllMigrationArchiveRequest")
+	proto.RegisterType((*QueryAllMigrationArchiveResponse)(nil), "selfchain.migration.QueryAllMigrationArchiveResponse")
+	proto.RegisterType((*QueryGetFeeWaiverConfigRequest)(nil), "selfchain.migration.QueryGetFeeWaiverConfigRequest")
+	proto.RegisterType((*QueryGetFeeWaiverConfigResponse)(nil), "selfchain.migration.QueryGetFeeWaiverConfigResponse")
+	proto.RegisterType((*QueryGetFeeAllowanceConfigRequest)(nil), "selfchain.migration.QueryGetFeeAllowanceConfigRequest")
+	proto.RegisterType((*QueryGetFeeAllowanceConfigResponse)(nil), "selfchain.migration.QueryGetFeeAllowanceConfigResponse")
+	proto.RegisterType((*QueryGetMigrationTotalsRequest)(nil), "selfchain.migration.QueryGetMigrationTotalsRequest")
+	proto.RegisterType((*QueryGetMigrationTotalsResponse)(nil), "selfchain.migration.QueryGetMigrationTotalsResponse")
+	proto.RegisterType((*QueryGetTokenStatsRequest)(nil), "selfchain.migration.QueryGetTokenStatsRequest")
+	proto.RegisterType((*QueryGetTokenStatsResponse)(nil), "selfchain.migration.QueryGetTokenStatsResponse")
+	proto.RegisterType((*QueryAllTokenStatsRequest)(nil), "selfchain.migration.QueryAllTokenStatsRequest")
+	proto.RegisterType((*QueryAllTokenStatsResponse)(nil), "selfchain.migration.QueryAllTokenStatsResponse")
+	proto.RegisterType((*QueryGetMigratorStatsRequest)(nil), "selfchain.migration.QueryGetMigratorStatsRequest")
+	proto.RegisterType((*QueryGetMigratorStatsResponse)(nil), "selfchain.migration.QueryGetMigratorStatsResponse")
+	proto.RegisterType((*QueryAllMigratorStatsRequest)(nil), "selfchain.migration.QueryAllMigratorStatsRequest")
+	proto.RegisterType((*QueryAllMigratorStatsResponse)(nil), "selfchain.migration.QueryAllMigratorStatsResponse")
+	proto.RegisterType((*QueryGetDailyStatsRequest)(nil), "selfchain.migration.QueryGetDailyStatsRequest")
+	proto.RegisterType((*QueryGetDailyStatsResponse)(nil), "selfchain.migration.QueryGetDailyStatsResponse")
+	proto.RegisterType((*QueryAllDailyStatsRequest)(nil), "selfchain.migration.QueryAllDailyStatsRequest")
+	proto.RegisterType((*QueryAllDailyStatsResponse)(nil), "selfchain.migration.QueryAllDailyStatsResponse")
+}
+
+func init() { proto.RegisterFile("selfchain/migration/query.proto", fileDescriptor_c711775a55f886d1) }
+
+var fileDescriptor_c711775a55f886d1 = []byte{
+	// 2412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xdd, 0x6f, 0x1c, 0x49,
+	0x11, 0x4f, 0x67, 0x73, 0x21, 0x57, 0x8e, 0x13, 0xd3, 0x09, 0x77, 0xbe, 0x71, 0xfc, 0x35, 0xb6,
+	0x63, 0xc7, 0x76, 0x76, 0xfc, 0x19, 0x14, 0x78, 0x40, 0xbe, 0x40, 0x7c, 0x11, 0x1c, 0x97, 0x5b,
+	0x47, 0x8a, 0x04, 0x42, 0xd6, 0x64, 0x77, 0xbc, 0x1e, 0x65, 0x76, 0xc7, 0xb7, 0x33, 0x8e, 0x31,
+	0x96, 0x25, 0x04, 0x12, 0x6f, 0x48, 0x20, 0x9e, 0x00, 0x01, 0xe2, 0xeb, 0x90, 0xc8, 0x89, 0xef,
+	0x87, 0x13, 0xe2, 0x91, 0x87, 0x7b, 0x8c, 0xc4, 0x0b, 0x4f, 0x08, 0x25, 0xfc, 0x21, 0x68, 0x7a,
+	0xba, 0xb7, 0xbb, 0x67, 0x7b, 0x7a, 0x7a, 0xec, 0xf5, 0x4b, 0xb2, 0xd3, 0x5d, 0x55, 0xfd, 0xab,
+	0xaa, 0xae, 0xee, 0x9a, 0xaa, 0x31, 0x8c, 0x47, 0x5e, 0xb0, 0x53, 0xdf, 0x75, 0xfd, 0xb6, 0xd3,
+	0xf2, 0x9b, 0x1d, 0x37, 0xf6, 0xc3, 0xb6, 0xf3, 0xc1, 0xbe, 0xd7, 0x39, 0xac, 0xee, 0x75, 0xc2,
+	0x38, 0xc4, 0xd7, 0xba, 0x04, 0xd5, 0x2e, 0x81, 0x75, 0xbd, 0x19, 0x36, 0x43, 0x32, 0xef, 0x24,
+	0xbf, 0x52, 0x52, 0xeb, 0x46, 0x33, 0x0c, 0x9b, 0x81, 0xe7, 0xb8, 0x7b, 0xbe, 0xe3, 0xb6, 0xdb,
+	0x61, 0x4c, 0x88, 0x23, 0x3a, 0x3b, 0x5f, 0x0f, 0xa3, 0x56, 0x18, 0x39, 0x4f, 0xdc, 0xc8, 0x4b,
+	0x57, 0x70, 0x9e, 0x2d, 0x3f, 0xf1, 0x62, 0x77, 0xd9, 0xd9, 0x73, 0x9b, 0x7e, 0x9b, 0x10, 0x53,
+	0xda, 0x09, 0x15, 0xaa, 0x3d, 0xb7, 0xe3, 0xb6, 0x98, 0xb4, 0x5b, 0x2a, 0x8a, 0x38, 0x7c, 0xea,
+	0xb5, 0xb7, 0xbb, 0xcf, 0x94, 0x74, 0x54, 0x45, 0xea, 0xd6, 0x03, 0x3a, 0x6d, 0xab, 0xa6, 0xd3,
+	0x5f, 0x61, 0x47, 0x87, 0xa7, 0x1e, 0xb6, 0x77, 0xfc, 0x26, 0xa5, 0xb8, 0x99, 0x8f, 0x27, 0xda,
+	0x6f, 0x47, 0x5e, 0xac, 0xa3, 0x8b, 0xc2, 0xfd, 0x4e, 0xdd, 0xdb, 0x4e, 0xad, 0x9c, 0xd2, 0xcd,
+	0xe6, 0xa3, 0xf2, 0xc3, 0xf6, 0xf6, 0x8e, 0xe7, 0x51, 0xc2, 0x19, 0x15, 0x61, 0x10, 0xd6, 0x9f,
+	0xee, 0xef, 0x6d, 0xc7, 0xbe, 0xc7, 0x34, 0x98, 0x53, 0x91, 0x91, 0xff, 0xb6, 0xa3, 0xfa, 0xae,
+	0xd7, 0xd8, 0x0f, 0x98, 0xc0, 0x69, 0x15, 0xe5, 0x81, 0x1f, 0xef, 0x36, 0x3a, 0xee, 0x81, 0xcb,
+	0xac, 0xb6, 0xa0, 0xc7, 0xe7, 0x76, 0xea, 0xbb, 0xfe, 0x33, 0x26, 0x72, 0x5e, 0x4f, 0x1c, 0x87,
+	0xb1, 0x1b, 0x44, 0xba, 0xe5, 0x77, 0x3c, 0x6f, 0xfb, 0xc0, 0xf5, 0x9f, 0x79, 0x1d, 0x9d, 0x79,
+	0x12, 0x2a, 0x37, 0x08, 0xc2, 0x03, 0xb7, 0x5d, 0xa7, 0x4b, 0xdb, 0xd7, 0x01, 0xbf, 0x9f, 0xec,
+	0xb5, 0x87, 0x64, 0xf3, 0xd4, 0xbc, 0x0f, 0xf6, 0xbd, 0x28, 0xb6, 0x1f, 0xc2, 0x35, 0x69, 0x34,
+	0xda, 0x0b, 0xdb, 0x91, 0x87, 0xef, 0xc2, 0xc5, 0x74, 0x93, 0x0d, 0xa3, 0x09, 0x34, 0x37, 0xb0,
+	0x32, 0x52, 0x55, 0x6c, 0xfe, 0x6a, 0xca, 0xf4, 0xf6, 0x85, 0x4f, 0xfe, 0x33, 0x7e, 0xae, 0x46,
+	0x19, 0xec, 0xbb, 0x30, 0x4a, 0x24, 0x6e, 0x7a, 0xf1, 0xa3, 0xc4, 0xeb, 0xef, 0x32, 0x72, 0xba,
+	0x24, 0x1e, 0x86, 0x4f, 0xb5, 0xa2, 0xe6, 0x3b, 0x6e, 0xb4, 0x4b, 0x84, 0xbf, 0x5e, 0x63, 0x8f,
+	0x76, 0x04, 0x63, 0x79, 0xac, 0x14, 0xd7, 0xfb, 0x70, 0x25, 0x96, 0x66, 0x28, 0xbe, 0x29, 0x25,
+	0x3e, 0x59, 0x08, 0xc5, 0x99, 0x11, 0x60, 0x37, 0x29, 0xde, 0x8d, 0x20, 0x50, 0xe3, 0xbd, 0x0f,
+	0xc0, 0xc3, 0x92, 0xae, 0x77, 0xb3, 0x9a, 0xc6, 0x70, 0x35, 0x89, 0xe1, 0x6a, 0x7a, 0x4a, 0xd0,
+	0x18, 0xae, 0x3e, 0x74, 0x9b, 0x1e, 0xe5, 0xad, 0x09, 0x9c, 0xf6, 0x3f, 0x10, 0x8c, 0xe5, 0xad,
+	0xa4, 0x51, 0xaf, 0x72, 0x2a, 0xf5, 0xf0, 0xa6, 0x84, 0xfe, 0x3c, 0x41, 0x3f, 0x5b, 0x88, 0x3e,
+	0xc5, 0x23, 0xc1, 0x67, 0xfb, 0x67, 0xd3, 0x8b, 0x37, 0xea, 0x01, 0xdb, 0x3f, 0x9b, 0x70, 0x4d,
+	0x1a, 0xa5, 0x8a, 0x2c, 0x41, 0x65, 0xa3, 0x1e, 0x50, 0x63, 0x0d, 0x2b, 0xd1, 0x6f, 0xd4, 0x03,
+	0x0a, 0x39, 0x21, 0xb5, 0xd7, 0xe1, 0x4d, 0x26, 0xe8, 0x5d, 0x7a, 0xe4, 0x30, 0x07, 0x58, 0x70,
+	0x89, 0x9d, 0x42, 0x74, 0xc7, 0x74, 0x9f, 0xed, 0xaf, 0xc3, 0x70, 0x2f, 0x1b, 0x05, 0xf1, 0x85,
+	0x0c, 0xdf, 0xc0, 0xca, 0xa8, 0x12, 0x09, 0x63, 0xa4, 0x70, 0xb8, 0x70, 0x97, 0x62, 0xda, 0x08,
+	0x82, 0x2c, 0xa6, 0x7e, 0x6d, 0x8a, 0xdf, 0x20, 0x18, 0xee, 0x5d, 0x43, 0xa9, 0x40, 0xa5, 0xb4,
+	0x02, 0xfd, 0x73, 0xfe, 0x9b, 0xf0, 0x19, 0x66, 0xe6, 0x7b, 0xe4, 0xb0, 0x67, 0xfe, 0xdf, 0x82,
+	0x37, 0xb2, 0x13, 0xfc, 0x08, 0x49, 0x47, 0xb4, 0x47, 0x48, 0x4a, 0xc2, 0x8e, 0x90, 0xf4, 0xc9,
+	0x5e, 0x01, 0x4b, 0x3a, 0x07, 0xb6, 0xc8, 0xbd, 0xc1, 0x4c, 0x7f, 0x1d, 0x5e, 0x23, 0x7b, 0x9c,
+	0xc8, 0xbd, 0x50, 0x4b, 0x1f, 0xec, 0xe7, 0x08, 0x46, 0x94, 0x4c, 0x14, 0xce, 0x3b, 0x30, 0x10,
+	0xf3, 0x61, 0x8a, 0x69, 0x22, 0x3f, 0xae, 0x52, 0x3a, 0x0a, 0x4c, 0x64, 0xc5, 0xd3, 0x30, 0x18,
+	0xfb, 0x2d, 0xaf, 0xe6, 0xb5, 0x5c, 0xbf, 0xed, 0xb7, 0x9b, 0xc4, 0xae, 0x17, 0x6a, 0xf2, 0x20,
+	0xbe, 0x01, 0xaf, 0x77, 0xba, 0x14, 0x15, 0xb2, 0x6b, 0xf9, 0x80, 0xdd, 0x00, 0x4b, 0x3a, 0x0a,
+	0x64, 0x0d, 0xfb, 0xb5, 0xb9, 0xfe, 0xc4, 0x6c, 0x92, 0x5d, 0x26, 0xcf, 0x26, 0x95, 0x93, 0xda,
+	0xa4, 0x6f, 0x1b, 0xed, 0x0e, 0x77, 0xfd, 0x16, 0xc9, 0x05, 0xee, 0x25, 0x40, 0x84, 0xab, 0x83,
+	0x00, 0x7b, 0xd0, 0xa0, 0xce, 0x67, 0x8f, 0x76, 0x13, 0x46, 0x94, 0x7c, 0x5c, 0xd3, 0x88, 0x0f,
+	0x6b, 0xbd, 0x2f, 0xb0, 0x33, 0x4d, 0x05, 0x56, 0xd1, 0x73, 0x0a, 0x80, 0x67, 0xe1, 0x39, 0x23,
+	0x7d, 0x2a, 0x27, 0xd4, 0xa7, 0x7f, 0x9e, 0xfb, 0x3c, 0x8c, 0x2b, 0x3c, 0xb0, 0x15, 0xbb, 0x71,
+	0x54, 0xec, 0xbe, 0x23, 0x98, 0xc8, 0x67, 0xa6, 0x3a, 0x3f, 0x86, 0xa1, 0x28, 0x33, 0x47, 0x2d,
+	0x3c, 0x53, 0xa4, 0x38, 0x21, 0xa6, 0xda, 0xf7, 0x08, 0xb1, 0x7d, 0x18, 0x57, 0xd8, 0x5a, 0x42,
+	0xde, 0x2f, 0xbf, 0xfe, 0x13, 0xc1, 0x44, 0xfe, 0x5a, 0x5a, 0x45, 0x2b, 0xa7, 0x56, 0xb4, 0x7f,
+	0xbe, 0x5e, 0xe5, 0xd1, 0xd6, 0xcd, 0x34, 0xee, 0x7b, 0x9e, 0xfe, 0x84, 0x7e, 0x0a, 0x37, 0xd4,
+	0x4c, 0x54, 0xed, 0x2f, 0xc3, 0xe5, 0x96, 0x30, 0x4e, 0xad, 0x3c, 0xa9, 0xb9, 0xf1, 0x52, 0x42,
+	0xaa, 0xae, 0xc4, 0x6c, 0x7b, 0x3c, 0x7e, 0x54, 0x08, 0xfb, 0xe5, 0xcf, 0xbf, 0x21, 0xb8, 0xa1,
+	0x5e, 0x27, 0x57, 0xa9, 0xca, 0x89, 0x95, 0xea, 0x9f, 0xff, 0x1c, 0x78, 0x8b, 0xb9, 0xe2, 0x2b,
+	0xe4, 0x05, 0xe9, 0x91, 0xef, 0x75, 0x53, 0x1b, 0x0c, 0x17, 0x62, 0xdf, 0xeb, 0x50, 0xe7, 0x91,
+	0xdf, 0x76, 0x1d, 0x2c, 0x15, 0x03, 0x55, 0xf2, 0x4b, 0x00, 0x41, 0x77, 0x94, 0x5a, 0x73, 0x5c,
+	0xa9, 0x22, 0x67, 0xa6, 0x0a, 0x0a, 0x8c, 0x76, 0x9d, 0xa2, 0xda, 0x08, 0x82, 0x5e, 0x54, 0xfd,
+	0xf2, 0xd8, 0x47, 0x08, 0x2c, 0xd5, 0x2a, 0x39, 0xaa, 0x54, 0x4e, 0xa4, 0x4a, 0xff, 0x3c, 0xb5,
+	0xc6, 0x83, 0xa6, 0x96, 0x8c, 0x6c, 0xd1, 0x57, 0x54, 0x7d, 0xa8, 0x85, 0x30, 0x9a, 0xc3, 0x45,
+	0xd5, 0xfc, 0x2a, 0x0c, 0x76, 0xc4, 0x09, 0x6a, 0x50, 0x5b, 0xa9, 0xa9, 0x24, 0x82, 0x2a, 0x2b,
+	0xb3, 0xdb, 0x3b, 0x3c, 0x0c, 0x94, 0x30, 0xfb, 0xe5, 0xbd, 0x8f, 0x11, 0x8c, 0xe6, 0x2c, 0x94,
+	0xaf, 0x59, 0xe5, 0x14, 0x9a, 0xf5, 0xcf, 0x93, 0x4b, 0x34, 0xd1, 0xbf, 0xb7, 0xdf, 0xe9, 0x78,
+	0xed, 0xd4, 0x2f, 0x7a, 0x2f, 0xbe, 0x07, 0x6f, 0x29, 0x38, 0xa8, 0x9e, 0xd7, 0xe1, 0x35, 0x02,
+	0x94, 0xb1, 0x90, 0x87, 0x24, 0xeb, 0x64, 0x45, 0x8c, 0x06, 0x01, 0x7b, 0xa9, 0xc6, 0x07, 0xec,
+	0x05, 0x1e, 0xf6, 0x8f, 0xbb, 0x65, 0x0c, 0x86, 0xe1, 0x0a, 0x9c, 0xf7, 0xd9, 0xbd, 0x7c, 0xde,
+	0x6f, 0x88, 0x21, 0x2f, 0x12, 0xf3, 0x38, 0xe1, 0x95, 0x10, 0x6d, 0xc8, 0x73, 0x66, 0x16, 0x27,
+	0x9c, 0x51, 0x0c, 0xf9, 0x5e, 0x44, 0x67, 0x11, 0xf2, 0x06, 0xaa, 0x54, 0x4e, 0xa4, 0x4a, 0xff,
+	0x36, 0xca, 0x24, 0x4f, 0xa4, 0xf8, 0x82, 0xf2, 0x5b, 0x97, 0x90, 0x2e, 0xf5, 0x92, 0xf0, 0x2c,
+	0xe2, 0x20, 0x33, 0xa7, 0x4d, 0x97, 0xb2, 0x82, 0x58, 0x16, 0x91, 0x15, 0x62, 0xef, 0x73, 0x7c,
+	0xdd, 0x1b, 0x6b, 0x23, 0xad, 0x72, 0x69, 0xf7, 0x33, 0x7e, 0x03, 0x2e, 0x3e, 0xd9, 0xaf, 0x3f,
+	0xf5, 0x62, 0xfa, 0xc6, 0x44, 0x9f, 0x92, 0x17, 0x2a, 0x21, 0x55, 0x79, 0xd0, 0x20, 0xaf, 0x4b,
+	0x17, 0x6a, 0xf2, 0xa0, 0xa8, 0x73, 0xef, 0xb2, 0x5c, 0xe7, 0x56, 0x66, 0x4e, 0xab, 0x73, 0x56,
+	0x10, 0xd3, 0x39, 0x2b, 0x44, 0x4c, 0x11, 0xf3, 0x74, 0x3e, 0x8b, 0x14, 0xb1, 0xa4, 0xa2, 0x95,
+	0x53, 0x2b, 0xda, 0xbf, 0x5d, 0x3c, 0xc1, 0x6b, 0x79, 0xf7, 0x3d, 0xef, 0x31, 0x29, 0x59, 0xca,
+	0x9b, 0xf8, 0x00, 0xc6, 0x73, 0x29, 0xa8, 0x9a, 0x8f, 0xe0, 0xea, 0x8e, 0x3c, 0x45, 0x0d, 0x3b,
+	0xad, 0xd4, 0x32, 0x23, 0x86, 0x2a, 0x99, 0x15, 0x61, 0x4f, 0xc1, 0xa4, 0xb0, 0xf0, 0x06, 0xab,
+	0x93, 0xca, 0xe8, 0xbe, 0x8b, 0xc0, 0xd6, 0x51, 0x51, 0x84, 0xdf, 0x00, 0xbc, 0xd3, 0x33, 0x4b,
+	0x41, 0xce, 0xe6, 0x81, 0xcc, 0x90, 0x53, 0x9c, 0x0a, 0x41, 0xa2, 0x15, 0xbb, 0x2e, 0x7c, 0x44,
+	0x8a, 0xc4, 0x0a, 0x2b, 0xf6, 0x50, 0x70, 0x2b, 0xb6, 0xe4, 0x29, 0xad, 0x15, 0x33, 0x62, 0x98,
+	0x15, 0x33, 0x22, 0xec, 0x65, 0x7e, 0x99, 0xa4, 0xc5, 0x01, 0xf1, 0x7d, 0x49, 0x7d, 0xa1, 0xd5,
+	0xc1, 0x52, 0xb1, 0xf0, 0x73, 0x38, 0xee, 0x8e, 0x6a, 0xaf, 0x14, 0xce, 0xcc, 0xce, 0x61, 0xce,
+	0x28, 0x5e, 0x29, 0xbd, 0xb8, 0xce, 0xe2, 0x4a, 0x31, 0x50, 0xa5, 0x72, 0x22, 0x55, 0xfa, 0x17,
+	0x8c, 0x9f, 0xcb, 0xbe, 0x7a, 0x85, 0x1d, 0xc9, 0x2c, 0xba, 0x0a, 0xab, 0x90, 0x4b, 0x66, 0x78,
+	0x79, 0xc6, 0xd5, 0x12, 0x27, 0xb4, 0xb9, 0xa4, 0x24, 0x82, 0x65, 0x5c, 0x12, 0xbb, 0x98, 0x4b,
+	0x2a, 0xc1, 0x9e, 0x45, 0x2e, 0x69, 0xac, 0x59, 0xe5, 0x14, 0x9a, 0xf5, 0xcf, 0x9f, 0xb7, 0x79,
+	0xec, 0x7d, 0xd1, 0xf5, 0x83, 0x43, 0xc9, 0x3e, 0x43, 0x50, 0x69, 0xb8, 0x87, 0x34, 0xf2, 0x92,
+	0x9f, 0x62, 0xdc, 0x89, 0xe4, 0x7c, 0xb3, 0x36, 0xba, 0xa3, 0xda, 0xb8, 0xe3, 0xcc, 0x6c, 0xb3,
+	0x72, 0x46, 0x31, 0xee, 0x7a, 0x31, 0x9d, 0x45, 0xdc, 0x19, 0xa8, 0x52, 0x39, 0x91, 0x2a, 0x7d,
+	0xf3, 0xd3, 0xca, 0xf7, 0x17, 0xe1, 0x35, 0x02, 0x17, 0x7f, 0x1b, 0xc1, 0xc5, 0xb4, 0x5d, 0x86,
+	0xd5, 0xd7, 0x42, 0x6f, 0x6f, 0xce, 0x9a, 0x2b, 0x26, 0x4c, 0xd7, 0xb4, 0xa7, 0xbe, 0xf3, 0xaf,
+	0xff, 0xfd, 0xe8, 0xfc, 0x28, 0x1e, 0x71, 0xf2, 0xdb, 0xc5, 0xf8, 0xcf, 0x08, 0xae, 0xc8, 0x2d,
+	0x23, 0xbc, 0x92, 0xbf, 0x42, 0x5e, 0xfb, 0xce, 0x5a, 0x2d, 0xc5, 0x43, 0x01, 0xde, 0x21, 0x00,
+	0x97, 0x70, 0xd5, 0x31, 0xe8, 0x56, 0x3b, 0x47, 0xb4, 0x21, 0x78, 0x8c, 0x7f, 0x8f, 0xe0, 0xd3,
+	0xb2, 0xc8, 0x8d, 0x20, 0xd0, 0xc1, 0xce, 0xeb, 0xe2, 0x59, 0xab, 0xa5, 0x78, 0x28, 0xec, 0x45,
+	0x02, 0xfb, 0x26, 0x9e, 0x36, 0x81, 0x8d, 0xbf, 0x45, 0x9a, 0x5e, 0x3a, 0xff, 0x4a, 0xbd, 0x33,
+	0x6b, 0xae, 0x98, 0x90, 0xe2, 0x98, 0x20, 0x38, 0x2c, 0x3c, 0xec, 0xe4, 0x74, 0xf0, 0xf1, 0x8f,
+	0x11, 0x5c, 0x62, 0x27, 0x10, 0x5e, 0xd4, 0x0a, 0xce, 0xb4, 0xb2, 0xac, 0xdb, 0x86, 0xd4, 0x14,
+	0xcb, 0x12, 0xc1, 0x32, 0x8f, 0xe7, 0x1c, 0xdd, 0xe7, 0x02, 0xce, 0x11, 0xfb, 0x75, 0x8c, 0x7f,
+	0x88, 0x60, 0x80, 0x89, 0x49, 0xdc, 0xb7, 0xa8, 0x75, 0x45, 0x09, 0x78, 0x8a, 0x9e, 0x99, 0x3d,
+	0x43, 0xe0, 0x8d, 0xe3, 0x51, 0x2d, 0x3c, 0xfc, 0x3d, 0xc4, 0xda, 0x53, 0x78, 0x5e, 0xab, 0xbf,
+	0x94, 0x15, 0x5a, 0x0b, 0x46, 0xb4, 0x46, 0x51, 0x99, 0x7e, 0x34, 0x81, 0x7f, 0x8d, 0x60, 0x40,
+	0x68, 0xae, 0x60, 0xa7, 0x38, 0xbc, 0xa4, 0x66, 0x91, 0xb5, 0x64, 0xce, 0x40, 0x71, 0x2d, 0x13,
+	0x5c, 0x0b, 0xf8, 0x96, 0x53, 0xf4, 0xa9, 0x86, 0x73, 0x44, 0x9e, 0x8e, 0xf1, 0xcf, 0xd9, 0xd9,
+	0x91, 0x8a, 0x4a, 0xbc, 0xe8, 0x14, 0x07, 0x94, 0x31, 0x50, 0x75, 0x7f, 0xca, 0xbe, 0x45, 0x80,
+	0x4e, 0xe1, 0xc9, 0x42, 0xa0, 0xf8, 0xb7, 0x08, 0x06, 0x84, 0x3a, 0x78, 0x81, 0x19, 0x7b, 0x3b,
+	0x37, 0xd6, 0x92, 0x39, 0x03, 0x45, 0xb7, 0x4a, 0xd0, 0xdd, 0xc6, 0x0b, 0x4e, 0xd1, 0x97, 0x2c,
+	0xce, 0x11, 0xed, 0x73, 0xa4, 0x86, 0x14, 0x84, 0x15, 0x1b, 0xb2, 0x1c, 0x54, 0x75, 0xbb, 0xa8,
+	0xc0, 0x90, 0x22, 0x54, 0xfc, 0x77, 0x04, 0x43, 0xd9, 0x86, 0x02, 0x5e, 0x33, 0x35, 0x8e, 0x78,
+	0xe9, 0x5b, 0xeb, 0x25, 0xb9, 0x28, 0xd8, 0xbb, 0x04, 0xec, 0x2a, 0x5e, 0x2e, 0x04, 0xbb, 0x1d,
+	0x25, 0x8c, 0x82, 0x75, 0xff, 0x8a, 0xe0, 0x5a, 0x56, 0x6e, 0x62, 0xe2, 0x35, 0x53, 0x8b, 0x99,
+	0xe2, 0xd7, 0xb4, 0x6f, 0x6c, 0x87, 0xe0, 0xbf, 0x85, 0x67, 0x0d, 0xf1, 0xe3, 0xdf, 0x21, 0xb8,
+	0x2c, 0xd6, 0xfe, 0xf1, 0x92, 0xc1, 0x89, 0x2c, 0xf5, 0x33, 0xac, 0xe5, 0x12, 0x1c, 0x14, 0xe6,
+	0x0a, 0x81, 0xb9, 0x88, 0xe7, 0x9d, 0xc2, 0x0f, 0xac, 0xba, 0xc7, 0xc0, 0xaf, 0x10, 0x5c, 0x15,
+	0x85, 0x25, 0xb6, 0x5d, 0x32, 0x38, 0x9f, 0x8d, 0xc1, 0xe6, 0xb4, 0x51, 0xec, 0x79, 0x02, 0x76,
+	0x1a, 0xdb, 0xc5, 0x60, 0x93, 0x10, 0x03, 0x5e, 0x9c, 0xc7, 0x55, 0xad, 0x69, 0x7a, 0x1a, 0x0d,
+	0x96, 0x63, 0x4c, 0x6f, 0xe4, 0x6f, 0xe1, 0x03, 0x34, 0xe7, 0x28, 0xf9, 0xf7, 0x18, 0xff, 0x04,
+	0xc1, 0x20, 0x97, 0x93, 0xd8, 0xb0, 0xaa, 0xb5, 0x48, 0x29, 0x8c, 0xca, 0xb6, 0x86, 0x3d, 0x47,
+	0x30, 0xda, 0x78, 0xa2, 0x08, 0x23, 0x7e, 0x8e, 0x60, 0x50, 0x2a, 0x8b, 0x63, 0xfd, 0xde, 0x52,
+	0x95, 0xfb, 0xad, 0x95, 0x32, 0x2c, 0x46, 0xc7, 0xa9, 0xfc, 0x81, 0x5e, 0x77, 0x43, 0x7e, 0x88,
+	0x60, 0x48, 0x12, 0x97, 0x58, 0x53, 0xbf, 0xbf, 0xca, 0x02, 0xce, 0xeb, 0x34, 0xd8, 0x0b, 0x04,
+	0xf0, 0x0c, 0x9e, 0x32, 0x00, 0x8c, 0x7f, 0x89, 0xe0, 0xb2, 0x58, 0xc7, 0xc7, 0x9a, 0xb4, 0x46,
+	0xd1, 0x21, 0xb0, 0xaa, 0xa6, 0xe4, 0x46, 0xd1, 0x5d, 0x4f, 0x59, 0xb6, 0xc9, 0x63, 0xd7, 0x98,
+	0x3f, 0x45, 0x00, 0xbc, 0x0a, 0x5c, 0x10, 0x38, 0x3d, 0xe5, 0x7a, 0xcb, 0x31, 0xa6, 0x37, 0xca,
+	0xae, 0x79, 0xdd, 0xd9, 0x39, 0xf2, 0x1b, 0xc7, 0x49, 0x86, 0x3b, 0xc8, 0x85, 0x14, 0x47, 0x4d,
+	0x29, 0x80, 0xca, 0xce, 0x80, 0x3d, 0x4b, 0x00, 0x4e, 0xe2, 0xf1, 0x02, 0x80, 0xf8, 0x8f, 0x08,
+	0x86, 0xb2, 0xf5, 0xf3, 0x82, 0x4b, 0x33, 0xa7, 0xb4, 0x6f, 0xad, 0x97, 0xe4, 0xa2, 0x50, 0xab,
+	0x04, 0xea, 0x1c, 0xbe, 0x59, 0x00, 0x75, 0x9b, 0xa6, 0x9d, 0x2f, 0x10, 0x0c, 0x65, 0x8b, 0xc2,
+	0x05, 0x88, 0x73, 0x0a, 0xdf, 0xd6, 0x7a, 0x49, 0x2e, 0x8a, 0xf8, 0x3d, 0x82, 0xf8, 0x01, 0xde,
+	0x74, 0x8c, 0x3e, 0xa0, 0x65, 0xbb, 0xd4, 0x39, 0x92, 0xda, 0x02, 0xc7, 0xce, 0x51, 0xda, 0x45,
+	0x38, 0xc6, 0x7f, 0x41, 0x70, 0x2d, 0xbb, 0x5a, 0xf1, 0xe5, 0x7f, 0x02, 0xad, 0x34, 0x85, 0xf9,
+	0x02, 0x3f, 0xf4, 0x68, 0x85, 0x3f, 0x42, 0x70, 0x35, 0x53, 0xb6, 0xc6, 0xfa, 0x37, 0x6c, 0x75,
+	0x35, 0xdd, 0x5a, 0x2b, 0xc7, 0x64, 0x04, 0x97, 0x7f, 0x6c, 0xcc, 0xb6, 0xcd, 0xc7, 0x08, 0x70,
+	0x6f, 0x01, 0x1b, 0xdf, 0x29, 0x5a, 0x5c, 0x5d, 0x64, 0xb7, 0x3e, 0x5b, 0x9a, 0xcf, 0xe8, 0x15,
+	0x46, 0xfa, 0xfc, 0x99, 0x41, 0x7f, 0x2e, 0xe6, 0x2e, 0x69, 0x0d, 0xbb, 0xc0, 0xd2, 0xea, 0x8a,
+	0xbb, 0xb5, 0x56, 0x8e, 0x89, 0x22, 0xbe, 0x4d, 0x10, 0xcf, 0xe2, 0x19, 0xc7, 0xe4, 0x13, 0x70,
+	0xfc, 0x0b, 0x04, 0xc0, 0x6b, 0xc3, 0x05, 0x67, 0x71, 0x4f, 0x9d, 0xdb, 0x72, 0x8c, 0xe9, 0x8d,
+	0xde, 0xea, 0xe9, 0xab, 0x56, 0x9a, 0x6d, 0xd3, 0xdb, 0x22, 0xc9, 0x62, 0xb8, 0xa0, 0xe2, 0xf3,
+	0xb8, 0x14, 0x48, 0x65, 0x59, 0xbd, 0x20, 0x8b, 0x11, 0x40, 0xe2, 0x3f, 0x20, 0x18, 0x94, 0x0a,
+	0xb2, 0x78, 0xd9, 0xa8, 0xca, 0x21, 0xe1, 0x5b, 0x29, 0xc3, 0x62, 0x54, 0xe8, 0x6a, 0x51, 0x1e,
+	0x66, 0x4a, 0x5e, 0x23, 0xf9, 0xb0, 0x7b, 0x1e, 0x87, 0x9d, 0xae, 0x41, 0x97, 0x8d, 0x4a, 0x1f,
+	0xa6, 0x98, 0xf3, 0xca, 0xdc, 0x05, 0x89, 0x8c, 0x8c, 0x19, 0xff, 0x0c, 0x01, 0xf0, 0xe2, 0x69,
+	0xc1, 0xc6, 0xec, 0x29, 0x04, 0x5b, 0x8e, 0x31, 0xbd, 0xd1, 0x09, 0x45, 0x8a, 0xb6, 0xcc, 0x9a,
+	0x0d, 0xf7, 0x30, 0xdd, 0x96, 0x5c, 0x4c, 0xf1, 0xb6, 0x2c, 0x05, 0x51, 0x59, 0x75, 0x2e, 0xd8,
+	0x96, 0x02, 0xc4, 0xb7, 0xd7, 0x3f, 0x79, 0x39, 0x86, 0x5e, 0xbc, 0x1c, 0x43, 0xff, 0x7d, 0x39,
+	0x86, 0x7e, 0xf0, 0x6a, 0xec, 0xdc, 0x8b, 0x57, 0x63, 0xe7, 0xfe, 0xfd, 0x6a, 0xec, 0xdc, 0xd7,
+	0x46, 0x38, 0xeb, 0x37, 0x05, 0xe6, 0xf8, 0x70, 0xcf, 0x8b, 0x9e, 0x5c, 0x24, 0x7f, 0xc1, 0xb1,
+	0xfa, 0xff, 0x01, 0x00, 0xe2, 0x94, 0x7e, 0x35, 0xa3, 0x34, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of TokenMigration items.
+	TokenMigration(ctx context.Context, in *QueryGetTokenMigrationRequest, opts ...grpc.CallOption) (*QueryGetTokenMigrationResponse, error)
+	TokenMigrationAll(ctx context.Context, in *QueryAllTokenMigrationRequest, opts ...grpc.CallOption) (*QueryAllTokenMigrationResponse, error)
+	// Queries a Acl by index.
+	Acl(ctx context.Context, in *QueryGetAclRequest, opts ...grpc.CallOption) (*QueryGetAclResponse, error)
+	// Queries a list of Migrator items.
+	Migrator(ctx context.Context, in *QueryGetMigratorRequest, opts ...grpc.CallOption) (*QueryGetMigratorResponse, error)
+	MigratorAll(ctx context.Context, in *QueryAllMigratorRequest, opts ...grpc.CallOption) (*QueryAllMigratorResponse, error)
+	// Queries a Config by index.
+	Config(ctx context.Context, in *QueryGetConfigRequest, opts ...grpc.CallOption) (*QueryGetConfigResponse, error)
+	// Queries the migration deadline and swept allocation of a token.
+	TokenSunset(ctx context.Context, in *QueryGetTokenSunsetRequest, opts ...grpc.CallOption) (*QueryGetTokenSunsetResponse, error)
+	TokenSunsetAll(ctx context.Context, in *QueryAllTokenSunsetRequest, opts ...grpc.CallOption) (*QueryAllTokenSunsetResponse, error)
+	// Queries a list of SourceChain items.
+	SourceChain(ctx context.Context, in *QueryGetSourceChainRequest, opts ...grpc.CallOption) (*QueryGetSourceChainResponse, error)
+	SourceChainAll(ctx context.Context, in *QueryAllSourceChainRequest, opts ...grpc.CallOption) (*QueryAllSourceChainResponse, error)
+	// Queries the migration statistics of source chains.
+	SourceChainStats(ctx context.Context, in *QueryGetSourceChainStatsRequest, opts ...grpc.CallOption) (*QueryGetSourceChainStatsResponse, error)
+	SourceChainStatsAll(ctx context.Context, in *QueryAllSourceChainStatsRequest, opts ...grpc.CallOption) (*QueryAllSourceChainStatsResponse, error)
+	// Queries a list of MigrationFee items.
+	MigrationFee(ctx context.Context, in *QueryGetMigrationFeeRequest, opts ...grpc.CallOption) (*QueryGetMigrationFeeResponse, error)
+	MigrationFeeAll(ctx context.Context, in *QueryAllMigrationFeeRequest, opts ...grpc.CallOption) (*QueryAllMigrationFeeResponse, error)
+	// Queries a list of LockupTier items.
+	LockupTier(ctx context.Context, in *QueryGetLockupTierRequest, opts ...grpc.CallOption) (*QueryGetLockupTierResponse, error)
+	LockupTierAll(ctx context.Context, in *QueryAllLockupTierRequest, opts ...grpc.CallOption) (*QueryAllLockupTierResponse, error)
+	// Queries a list of RatioSchedule items.
+	RatioSchedule(ctx context.Context, in *QueryGetRatioScheduleRequest, opts ...grpc.CallOption) (*QueryGetRatioScheduleResponse, error)
+	RatioScheduleAll(ctx context.Context, in *QueryAllRatioScheduleRequest, opts ...grpc.CallOption) (*QueryAllRatioScheduleResponse, error)
+	// Queries the ratio a migration of the token gets at the current block time.
+	CurrentRatio(ctx context.Context, in *QueryCurrentRatioRequest, opts ...grpc.CallOption) (*QueryCurrentRatioResponse, error)
+	// Queries a list of Withdrawal items. A withdrawal holds the signatures to submit to the vault.
+	Withdrawal(ctx context.Context, in *QueryGetWithdrawalRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalResponse, error)
+	WithdrawalAll(ctx context.Context, in *QueryAllWithdrawalRequest, opts ...grpc.CallOption) (*QueryAllWithdrawalResponse, error)
+	// Queries the configuration of the withdrawals.
+	WithdrawalConfig(ctx context.Context, in *QueryGetWithdrawalConfigRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalConfigResponse, error)
+	// Queries a list of MigrationArchive items.
+	MigrationArchive(ctx context.Context, in *QueryGetMigrationArchiveRequest, opts ...grpc.CallOption) (*QueryGetMigrationArchiveResponse, error)
+	MigrationArchiveAll(ctx context.Context, in *QueryAllMigrationArchiveRequest, opts ...grpc.CallOption) (*QueryAllMigrationArchiveResponse, error)
+	// Queries the configuration of the fee-free migrator transactions.
+	FeeWaiverConfig(ctx context.Context, in *QueryGetFeeWaiverConfigRequest, opts ...grpc.CallOption) (*QueryGetFeeWaiverConfigResponse, error)
+	// Queries the fee allowance granted to the beneficiaries of new vesting positions.
+	FeeAllowanceConfig(ctx context.Context, in *QueryGetFeeAllowanceConfigRequest, opts ...grpc.CallOption) (*QueryGetFeeAllowanceConfigResponse, error)
+	// Queries the totals of all the migrations.
+	MigrationTotals(ctx context.Context, in *QueryGetMigrationTotalsRequest, opts ...grpc.CallOption) (*QueryGetMigrationTotalsResponse, error)
+	// Queries a list of TokenStats items.
+	TokenStats(ctx context.Context, in *QueryGetTokenStatsRequest, opts ...grpc.CallOption) (*QueryGetTokenStatsResponse, error)
+	TokenStatsAll(ctx context.Context, in *QueryAllTokenStatsRequest, opts ...grpc.CallOption) (*QueryAllTokenStatsResponse, error)
+	// Queries a list of MigratorStats items.
+	MigratorStats(ctx context.Context, in *QueryGetMigratorStatsRequest, opts ...grpc.CallOption) (*QueryGetMigratorStatsResponse, error)
+	MigratorStatsAll(ctx context.Context, in *QueryAllMigratorStatsRequest, opts ...grpc.CallOption) (*QueryAllMigratorStatsResponse, error)
+	// Queries a list of DailyStats items, oldest first unless the pagination is reversed.
+	DailyStats(ctx context.Context, in *QueryGetDailyStatsRequest, opts ...grpc.CallOption) (*QueryGetDailyStatsResponse, error)
+	DailyStatsAll(ctx context.Context, in *QueryAllDailyStatsRequest, opts ...grpc.CallOption) (*QueryAllDailyStatsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenMigration(ctx context.Context, in *QueryGetTokenMigrationRequest, opts ...grpc.CallOption) (*QueryGetTokenMigrationResponse, error) {
+	out := new(QueryGetTokenMigrationResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/TokenMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenMigrationAll(ctx context.Context, in *QueryAllTokenMigrationRequest, opts ...grpc.CallOption) (*QueryAllTokenMigrationResponse, error) {
+	out := new(QueryAllTokenMigrationResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/TokenMigrationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Acl(ctx context.Context, in *QueryGetAclRequest, opts ...grpc.CallOption) (*QueryGetAclResponse, error) {
+	out := new(QueryGetAclResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/Acl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Migrator(ctx context.Context, in *QueryGetMigratorRequest, opts ...grpc.CallOption) (*QueryGetMigratorResponse, error) {
+	out := new(QueryGetMigratorResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/Migrator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigratorAll(ctx context.Context, in *QueryAllMigratorRequest, opts ...grpc.CallOption) (*QueryAllMigratorResponse, error) {
+	out := new(QueryAllMigratorResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/MigratorAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Config(ctx context.Context, in *QueryGetConfigRequest, opts ...grpc.CallOption) (*QueryGetConfigResponse, error) {
+	out := new(QueryGetConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/Config", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenSunset(ctx context.Context, in *QueryGetTokenSunsetRequest, opts ...grpc.CallOption) (*QueryGetTokenSunsetResponse, error) {
+	out := new(QueryGetTokenSunsetResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/TokenSunset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenSunsetAll(ctx context.Context, in *QueryAllTokenSunsetRequest, opts ...grpc.CallOption) (*QueryAllTokenSunsetResponse, error) {
+	out := new(QueryAllTokenSunsetResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/TokenSunsetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SourceChain(ctx context.Context, in *QueryGetSourceChainRequest, opts ...grpc.CallOption) (*QueryGetSourceChainResponse, error) {
+	out := new(QueryGetSourceChainResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/SourceChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SourceChainAll(ctx context.Context, in *QueryAllSourceChainRequest, opts ...grpc.CallOption) (*QueryAllSourceChainResponse, error) {
+	out := new(QueryAllSourceChainResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/SourceChainAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SourceChainStats(ctx context.Context, in *QueryGetSourceChainStatsRequest, opts ...grpc.CallOption) (*QueryGetSourceChainStatsResponse, error) {
+	out := new(QueryGetSourceChainStatsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/SourceChainStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SourceChainStatsAll(ctx context.Context, in *QueryAllSourceChainStatsRequest, opts ...grpc.CallOption) (*QueryAllSourceChainStatsResponse, error) {
+	out := new(QueryAllSourceChainStatsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/SourceChainStatsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigrationFee(ctx context.Context, in *QueryGetMigrationFeeRequest, opts ...grpc.CallOption) (*QueryGetMigrationFeeResponse, error) {
+	out := new(QueryGetMigrationFeeResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/MigrationFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigrationFeeAll(ctx context.Context, in *QueryAllMigrationFeeRequest, opts ...grpc.CallOption) (*QueryAllMigrationFeeResponse, error) {
+	out := new(QueryAllMigrationFeeResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/MigrationFeeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockupTier(ctx context.Context, in *QueryGetLockupTierRequest, opts ...grpc.CallOption) (*QueryGetLockupTierResponse, error) {
+	out := new(QueryGetLockupTierResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/LockupTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockupTierAll(ctx context.Context, in *QueryAllLockupTierRequest, opts ...grpc.CallOption) (*QueryAllLockupTierResponse, error) {
+	out := new(QueryAllLockupTierResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/LockupTierAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RatioSchedule(ctx context.Context, in *QueryGetRatioScheduleRequest, opts ...grpc.CallOption) (*QueryGetRatioScheduleResponse, error) {
+	out := new(QueryGetRatioScheduleResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/RatioSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RatioScheduleAll(ctx context.Context, in *QueryAllRatioScheduleRequest, opts ...grpc.CallOption) (*QueryAllRatioScheduleResponse, error) {
+	out := new(QueryAllRatioScheduleResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/RatioScheduleAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentRatio(ctx context.Context, in *QueryCurrentRatioRequest, opts ...grpc.CallOption) (*QueryCurrentRatioResponse, error) {
+	out := new(QueryCurrentRatioResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/CurrentRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Withdrawal(ctx context.Context, in *QueryGetWithdrawalRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalResponse, error) {
+	out := new(QueryGetWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/Withdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawalAll(ctx context.Context, in *QueryAllWithdrawalRequest, opts ...grpc.CallOption) (*QueryAllWithdrawalResponse, error) {
+	out := new(QueryAllWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/WithdrawalAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawalConfig(ctx context.Context, in *QueryGetWithdrawalConfigRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalConfigResponse, error) {
+	out := new(QueryGetWithdrawalConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/WithdrawalConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigrationArchive(ctx context.Context, in *QueryGetMigrationArchiveRequest, opts ...grpc.CallOption) (*QueryGetMigrationArchiveResponse, error) {
+	out := new(QueryGetMigrationArchiveResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/MigrationArchive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigrationArchiveAll(ctx context.Context, in *QueryAllMigrationArchiveRequest, opts ...grpc.CallOption) (*QueryAllMigrationArchiveResponse, error) {
+	out := new(QueryAllMigrationArchiveResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/MigrationArchiveAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeWaiverConfig(ctx context.Context, in *QueryGetFeeWaiverConfigRequest, opts ...grpc.CallOption) (*QueryGetFeeWaiverConfigResponse, error) {
+	out := new(QueryGetFeeWaiverConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/FeeWaiverConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeAllowanceConfig(ctx context.Context, in *QueryGetFeeAllowanceConfigRequest, opts ...grpc.CallOption) (*QueryGetFeeAllowanceConfigResponse, error) {
+	out := new(QueryGetFeeAllowanceConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/FeeAllowanceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigrationTotals(ctx context.Context, in *QueryGetMigrationTotalsRequest, opts ...grpc.CallOption) (*QueryGetMigrationTotalsResponse, error) {
+	out := new(QueryGetMigrationTotalsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/MigrationTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenStats(ctx context.Context, in *QueryGetTokenStatsRequest, opts ...grpc.CallOption) (*QueryGetTokenStatsResponse, error) {
+	out := new(QueryGetTokenStatsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/TokenStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenStatsAll(ctx context.Context, in *QueryAllTokenStatsRequest, opts ...grpc.CallOption) (*QueryAllTokenStatsResponse, error) {
+	out := new(QueryAllTokenStatsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/TokenStatsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigratorStats(ctx context.Context, in *QueryGetMigratorStatsRequest, opts ...grpc.CallOption) (*QueryGetMigratorStatsResponse, error) {
+	out := new(QueryGetMigratorStatsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/MigratorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigratorStatsAll(ctx context.Context, in *QueryAllMigratorStatsRequest, opts ...grpc.CallOption) (*QueryAllMigratorStatsResponse, error) {
+	out := new(QueryAllMigratorStatsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/MigratorStatsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DailyStats(ctx context.Context, in *QueryGetDailyStatsRequest, opts ...grpc.CallOption) (*QueryGetDailyStatsResponse, error) {
+	out := new(QueryGetDailyStatsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/DailyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DailyStatsAll(ctx context.Context, in *QueryAllDailyStatsRequest, opts ...grpc.CallOption) (*QueryAllDailyStatsResponse, error) {
+	out := new(QueryAllDailyStatsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Query/DailyStatsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of TokenMigration items.
+	TokenMigration(context.Context, *QueryGetTokenMigrationRequest) (*QueryGetTokenMigrationResponse, error)
+	TokenMigrationAll(context.Context, *QueryAllTokenMigrationRequest) (*QueryAllTokenMigrationResponse, error)
+	// Queries a Acl by index.
+	Acl(context.Context, *QueryGetAclRequest) (*QueryGetAclResponse, error)
+	// Queries a list of Migrator items.
+	Migrator(context.Context, *QueryGetMigratorRequest) (*QueryGetMigratorResponse, error)
+	MigratorAll(context.Context, *QueryAllMigratorRequest) (*QueryAllMigratorResponse, error)
+	// Queries a Config by index.
+	Config(context.Context, *QueryGetConfigRequest) (*QueryGetConfigResponse, error)
+	// Queries the migration deadline and swept allocation of a token.
+	TokenSunset(context.Context, *QueryGetTokenSunsetRequest) (*QueryGetTokenSunsetResponse, error)
+	TokenSunsetAll(context.Context, *QueryAllTokenSunsetRequest) (*QueryAllTokenSunsetResponse, error)
+	// Queries a list of SourceChain items.
+	SourceChain(context.Context, *QueryGetSourceChainRequest) (*QueryGetSourceChainResponse, error)
+	SourceChainAll(context.Context, *QueryAllSourceChainRequest) (*QueryAllSourceChainResponse, error)
+	// Queries the migration statistics of source chains.
+	SourceChainStats(context.Context, *QueryGetSourceChainStatsRequest) (*QueryGetSourceChainStatsResponse, error)
+	SourceChainStatsAll(context.Context, *QueryAllSourceChainStatsRequest) (*QueryAllSourceChainStatsResponse, error)
+	// Queries a list of MigrationFee items.
+	MigrationFee(context.Context, *QueryGetMigrationFeeRequest) (*QueryGetMigrationFeeResponse, error)
+	MigrationFeeAll(context.Context, *QueryAllMigrationFeeRequest) (*QueryAllMigrationFeeResponse, error)
+	// Queries a list of LockupTier items.
+	LockupTier(context.Context, *QueryGetLockupTierRequest) (*QueryGetLockupTierResponse, error)
+	LockupTierAll(context.Context, *QueryAllLockupTierRequest) (*QueryAllLockupTierResponse, error)
+	// Queries a list of RatioSchedule items.
+	RatioSchedule(context.Context, *QueryGetRatioScheduleRequest) (*QueryGetRatioScheduleResponse, error)
+	RatioScheduleAll(context.Context, *QueryAllRatioScheduleRequest) (*QueryAllRatioScheduleResponse, error)
+	// Queries the ratio a migration of the token gets at the current block time.
+	CurrentRatio(context.Context, *QueryCurrentRatioRequest) (*QueryCurrentRatioResponse, error)
+	// Queries a list of Withdrawal items. A withdrawal holds the signatures to submit to the vault.
+	Withdrawal(context.Context, *QueryGetWithdrawalRequest) (*QueryGetWithdrawalResponse, error)
+	WithdrawalAll(context.Context, *QueryAllWithdrawalRequest) (*QueryAllWithdrawalResponse, error)
+	// Queries the configuration of the withdrawals.
+	WithdrawalConfig(context.Context, *QueryGetWithdrawalConfigRequest) (*QueryGetWithdrawalConfigResponse, error)
+	// Queries a list of MigrationArchive items.
+	MigrationArchive(context.Context, *QueryGetMigrationArchiveRequest) (*QueryGetMigrationArchiveResponse, error)
+	MigrationArchiveAll(context.Context, *QueryAllMigrationArchiveRequest) (*QueryAllMigrationArchiveResponse, error)
+	// Queries the configuration of the fee-free migrator transactions.
+	FeeWaiverConfig(context.Context, *QueryGetFeeWaiverConfigRequest) (*QueryGetFeeWaiverConfigResponse, error)
+	// Queries the fee allowance granted to the beneficiaries of new vesting positions.
+	FeeAllowanceConfig(context.Context, *QueryGetFeeAllowanceConfigRequest) (*QueryGetFeeAllowanceConfigResponse, error)
+	// Queries the totals of all the migrations.
+	MigrationTotals(context.Context, *QueryGetMigrationTotalsRequest) (*QueryGetMigrationTotalsResponse, error)
+	// Queries a list of TokenStats items.
+	TokenStats(context.Context, *QueryGetTokenStatsRequest) (*QueryGetTokenStatsResponse, error)
+	TokenStatsAll(context.Context, *QueryAllTokenStatsRequest) (*QueryAllTokenStatsResponse, error)
+	// Queries a list of MigratorStats items.
+	MigratorStats(context.Context, *QueryGetMigratorStatsRequest) (*QueryGetMigratorStatsResponse, error)
+	MigratorStatsAll(context.Context, *QueryAllMigratorStatsRequest) (*QueryAllMigratorStatsResponse, error)
+	// Queries a list of DailyStats items, oldest first unless the pagination is reversed.
+	DailyStats(context.Context, *QueryGetDailyStatsRequest) (*QueryGetDailyStatsResponse, error)
+	DailyStatsAll(context.Context, *QueryAllDailyStatsRequest) (*QueryAllDailyStatsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) TokenMigration(ctx context.Context, req *QueryGetTokenMigrationRequest) (*QueryGetTokenMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenMigration not implemented")
+}
+func (*UnimplementedQueryServer) TokenMigrationAll(ctx context.Context, req *QueryAllTokenMigrationRequest) (*QueryAllTokenMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenMigrationAll not implemented")
+}
+func (*UnimplementedQueryServer) Acl(ctx context.Context, req *QueryGetAclRequest) (*QueryGetAclResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acl not implemented")
+}
+func (*UnimplementedQueryServer) Migrator(ctx context.Context, req *QueryGetMigratorRequest) (*QueryGetMigratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrator not implemented")
+}
+func (*UnimplementedQueryServer) MigratorAll(ctx context.Context, req *QueryAllMigratorRequest) (*QueryAllMigratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigratorAll not implemented")
+}
+func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryGetConfigRequest) (*QueryGetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+}
+func (*UnimplementedQueryServer) TokenSunset(ctx context.Context, req *QueryGetTokenSunsetRequest) (*QueryGetTokenSunsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenSunset not implemented")
+}
+func (*UnimplementedQueryServer) TokenSunsetAll(ctx context.Context, req *QueryAllTokenSunsetRequest) (*QueryAllTokenSunsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenSunsetAll not implemented")
+}
+func (*UnimplementedQueryServer) SourceChain(ctx context.Context, req *QueryGetSourceChainRequest) (*QueryGetSourceChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceChain not implemented")
+}
+func (*UnimplementedQueryServer) SourceChainAll(ctx context.Context, req *QueryAllSourceChainRequest) (*QueryAllSourceChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceChainAll not implemented")
+}
+func (*UnimplementedQueryServer) SourceChainStats(ctx context.Context, req *QueryGetSourceChainStatsRequest) (*QueryGetSourceChainStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceChainStats not implemented")
+}
+func (*UnimplementedQueryServer) SourceChainStatsAll(ctx context.Context, req *QueryAllSourceChainStatsRequest) (*QueryAllSourceChainStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceChainStatsAll not implemented")
+}
+func (*UnimplementedQueryServer) MigrationFee(ctx context.Context, req *QueryGetMigrationFeeRequest) (*QueryGetMigrationFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationFee not implemented")
+}
+func (*UnimplementedQueryServer) MigrationFeeAll(ctx context.Context, req *QueryAllMigrationFeeRequest) (*QueryAllMigrationFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationFeeAll not implemented")
+}
+func (*UnimplementedQueryServer) LockupTier(ctx context.Context, req *QueryGetLockupTierRequest) (*QueryGetLockupTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockupTier not implemented")
+}
+func (*UnimplementedQueryServer) LockupTierAll(ctx context.Context, req *QueryAllLockupTierRequest) (*QueryAllLockupTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockupTierAll not implemented")
+}
+func (*UnimplementedQueryServer) RatioSchedule(ctx context.Context, req *QueryGetRatioScheduleRequest) (*QueryGetRatioScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RatioSchedule not implemented")
+}
+func (*UnimplementedQueryServer) RatioScheduleAll(ctx context.Context, req *QueryAllRatioScheduleRequest) (*QueryAllRatioScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RatioScheduleAll not implemented")
+}
+func (*UnimplementedQueryServer) CurrentRatio(ctx context.Context, req *QueryCurrentRatioRequest) (*QueryCurrentRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentRatio not implemented")
+}
+func (*UnimplementedQueryServer) Withdrawal(ctx context.Context, req *QueryGetWithdrawalRequest) (*QueryGetWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawalAll(ctx context.Context, req *QueryAllWithdrawalRequest) (*QueryAllWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalAll not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawalConfig(ctx context.Context, req *QueryGetWithdrawalConfigRequest) (*QueryGetWithdrawalConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalConfig not implemented")
+}
+func (*UnimplementedQueryServer) MigrationArchive(ctx context.Context, req *QueryGetMigrationArchiveRequest) (*QueryGetMigrationArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationArchive not implemented")
+}
+func (*UnimplementedQueryServer) MigrationArchiveAll(ctx context.Context, req *QueryAllMigrationArchiveRequest) (*QueryAllMigrationArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationArchiveAll not implemented")
+}
+func (*UnimplementedQueryServer) FeeWaiverConfig(ctx context.Context, req *QueryGetFeeWaiverConfigRequest) (*QueryGetFeeWaiverConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeWaiverConfig not implemented")
+}
+func (*UnimplementedQueryServer) FeeAllowanceConfig(ctx context.Context, req *QueryGetFeeAllowanceConfigRequest) (*QueryGetFeeAllowanceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeAllowanceConfig not implemented")
+}
+func (*UnimplementedQueryServer) MigrationTotals(ctx context.Context, req *QueryGetMigrationTotalsRequest) (*QueryGetMigrationTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationTotals not implemented")
+}
+func (*UnimplementedQueryServer) TokenStats(ctx context.Context, req *QueryGetTokenStatsRequest) (*QueryGetTokenStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenStats not implemented")
+}
+func (*UnimplementedQueryServer) TokenStatsAll(ctx context.Context, req *QueryAllTokenStatsRequest) (*QueryAllTokenStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenStatsAll not implemented")
+}
+func (*UnimplementedQueryServer) MigratorStats(ctx context.Context, req *QueryGetMigratorStatsRequest) (*QueryGetMigratorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigratorStats not implemented")
+}
+func (*UnimplementedQueryServer) MigratorStatsAll(ctx context.Context, req *QueryAllMigratorStatsRequest) (*QueryAllMigratorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigratorStatsAll not implemented")
+}
+func (*UnimplementedQueryServer) DailyStats(ctx context.Context, req *QueryGetDailyStatsRequest) (*QueryGetDailyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DailyStats not implemented")
+}
+func (*UnimplementedQueryServer) DailyStatsAll(ctx context.Context, req *QueryAllDailyStatsRequest) (*QueryAllDailyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DailyStatsAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTokenMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/TokenMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenMigration(ctx, req.(*QueryGetTokenMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenMigrationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTokenMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenMigrationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/TokenMigrationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenMigrationAll(ctx, req.(*QueryAllTokenMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Acl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAclRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Acl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/Acl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Acl(ctx, req.(*QueryGetAclRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Migrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMigratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Migrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/Migrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Migrator(ctx, req.(*QueryGetMigratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigratorAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMigratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigratorAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/MigratorAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigratorAll(ctx, req.(*QueryAllMigratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Config(ctx, req.(*QueryGetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenSunset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTokenSunsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenSunset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/TokenSunset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenSunset(ctx, req.(*QueryGetTokenSunsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenSunsetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTokenSunsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenSunsetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/TokenSunsetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenSunsetAll(ctx, req.(*QueryAllTokenSunsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SourceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSourceChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/SourceChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceChain(ctx, req.(*QueryGetSourceChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SourceChainAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSourceChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceChainAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/SourceChainAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceChainAll(ctx, req.(*QueryAllSourceChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SourceChainStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSourceChainStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceChainStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/SourceChainStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceChainStats(ctx, req.(*QueryGetSourceChainStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SourceChainStatsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSourceChainStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceChainStatsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/SourceChainStatsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceChainStatsAll(ctx, req.(*QueryAllSourceChainStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigrationFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMigrationFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/MigrationFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationFee(ctx, req.(*QueryGetMigrationFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigrationFeeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMigrationFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationFeeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/MigrationFeeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationFeeAll(ctx, req.(*QueryAllMigrationFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockupTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLockupTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockupTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/LockupTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockupTier(ctx, req.(*QueryGetLockupTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockupTierAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLockupTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockupTierAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/LockupTierAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockupTierAll(ctx, req.(*QueryAllLockupTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RatioSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRatioScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RatioSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/RatioSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RatioSchedule(ctx, req.(*QueryGetRatioScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RatioScheduleAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRatioScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RatioScheduleAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/RatioScheduleAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RatioScheduleAll(ctx, req.(*QueryAllRatioScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentRatioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/CurrentRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentRatio(ctx, req.(*QueryCurrentRatioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Withdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/Withdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Withdrawal(ctx, req.(*QueryGetWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawalAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/WithdrawalAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalAll(ctx, req.(*QueryAllWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWithdrawalConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/WithdrawalConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalConfig(ctx, req.(*QueryGetWithdrawalConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigrationArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMigrationArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/MigrationArchive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationArchive(ctx, req.(*QueryGetMigrationArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigrationArchiveAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMigrationArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationArchiveAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/MigrationArchiveAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationArchiveAll(ctx, req.(*QueryAllMigrationArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeWaiverConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFeeWaiverConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeWaiverConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/FeeWaiverConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeWaiverConfig(ctx, req.(*QueryGetFeeWaiverConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeAllowanceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFeeAllowanceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeAllowanceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/FeeAllowanceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeAllowanceConfig(ctx, req.(*QueryGetFeeAllowanceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigrationTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMigrationTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/MigrationTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationTotals(ctx, req.(*QueryGetMigrationTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTokenStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/TokenStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenStats(ctx, req.(*QueryGetTokenStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenStatsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTokenStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenStatsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/TokenStatsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenStatsAll(ctx, req.(*QueryAllTokenStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigratorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMigratorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigratorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/MigratorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigratorStats(ctx, req.(*QueryGetMigratorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigratorStatsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMigratorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigratorStatsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/MigratorStatsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigratorStatsAll(ctx, req.(*QueryAllMigratorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DailyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDailyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DailyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/DailyStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DailyStats(ctx, req.(*QueryGetDailyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DailyStatsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDailyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DailyStatsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Query/DailyStatsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DailyStatsAll(ctx, req.(*QueryAllDailyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "selfchain.migration.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "TokenMigration",
+			Handler:    _Query_TokenMigration_Handler,
+		},
+		{
+			MethodName: "TokenMigrationAll",
+			Handler:    _Query_TokenMigrationAll_Handler,
+		},
+		{
+			MethodName: "Acl",
+			Handler:    _Query_Acl_Handler,
+		},
+		{
+			MethodName: "Migrator",
+			Handler:    _Query_Migrator_Handler,
+		},
+		{
+			MethodName: "MigratorAll",
+			Handler:    _Query_MigratorAll_Handler,
+		},
+		{
+			MethodName: "Config",
+			Handler:    _Query_Config_Handler,
+		},
+		{
+			MethodName: "TokenSunset",
+			Handler:    _Query_TokenSunset_Handler,
+		},
+		{
+			MethodName: "TokenSunsetAll",
+			Handler:    _Query_TokenSunsetAll_Handler,
+		},
+		{
+			MethodName: "SourceChain",
+			Handler:    _Query_SourceChain_Handler,
+		},
+		{
+			MethodName: "SourceChainAll",
+			Handler:    _Query_SourceChainAll_Handler,
+		},
+		{
+			MethodName: "SourceChainStats",
+			Handler:    _Query_SourceChainStats_Handler,
+		},
+		{
+			MethodName: "SourceChainStatsAll",
+			Handler:    _Query_SourceChainStatsAll_Handler,
+		},
+		{
+			MethodName: "MigrationFee",
+			Handler:    _Query_MigrationFee_Handler,
+		},
+		{
+			MethodName: "MigrationFeeAll",
+			Handler:    _Query_MigrationFeeAll_Handler,
+		},
+		{
+			MethodName: "LockupTier",
+			Handler:    _Query_LockupTier_Handler,
+		},
+		{
+			MethodName: "LockupTierAll",
+			Handler:    _Query_LockupTierAll_Handler,
+		},
+		{
+			MethodName: "RatioSchedule",
+			Handler:    _Query_RatioSchedule_Handler,
+		},
+		{
+			MethodName: "RatioScheduleAll",
+			Handler:    _Query_RatioScheduleAll_Handler,
+		},
+		{
+			MethodName: "CurrentRatio",
+			Handler:    _Query_CurrentRatio_Handler,
+		},
+		{
+			MethodName: "Withdrawal",
+			Handler:    _Query_Withdrawal_Handler,
+		},
+		{
+			MethodName: "WithdrawalAll",
+			Handler:    _Query_WithdrawalAll_Handler,
+		},
+		{
+			MethodName: "WithdrawalConfig",
+			Handler:    _Query_WithdrawalConfig_Handler,
+		},
+		{
+			MethodName: "MigrationArchive",
+			Handler:    _Query_MigrationArchive_Handler,
+		},
+		{
+			MethodName: "MigrationArchiveAll",
+			Handler:    _Query_MigrationArchiveAll_Handler,
+		},
+		{
+			MethodName: "FeeWaiverConfig",
+			Handler:    _Query_FeeWaiverConfig_Handler,
+		},
+		{
+			MethodName: "FeeAllowanceConfig",
+			Handler:    _Query_FeeAllowanceConfig_Handler,
+		},
+		{
+			MethodName: "MigrationTotals",
+			Handler:    _Query_MigrationTotals_Handler,
+		},
+		{
+			MethodName: "TokenStats",
+			Handler:    _Query_TokenStats_Handler,
+		},
+		{
+			MethodName: "TokenStatsAll",
+			Handler:    _Query_TokenStatsAll_Handler,
+		},
+		{
+			MethodName: "MigratorStats",
+			Handler:    _Query_MigratorStats_Handler,
+		},
+		{
+			MethodName: "MigratorStatsAll",
+			Handler:    _Query_MigratorStatsAll_Handler,
+		},
+		{
+			MethodName: "DailyStats",
+			Handler:    _Query_DailyStats_Handler,
+		},
+		{
+			MethodName: "DailyStatsAll",
+			Handler:    _Query_DailyStatsAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "selfchain/migration/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTokenMigrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTokenMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTokenMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgHash) > 0 {
+		i -= len(m.MsgHash)
+		copy(dAtA[i:], m.MsgHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTokenMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTokenMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTokenMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenMigration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTokenMigrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTokenMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTokenMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTokenMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTokenMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTokenMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenMigration) > 0 {
+		for iNdEx := len(m.TokenMigration) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenMigration[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAclRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAclRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAclRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAclResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAclResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAclResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigratorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigratorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrator) > 0 {
+		i -= len(m.Migrator)
+		copy(dAtA[i:], m.Migrator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Migrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Migrator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMigratorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMigratorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMigratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMigratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMigratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMigratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Migrator) > 0 {
+		for iNdEx := len(m.Migrator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTokenSunsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTokenSunsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTokenSunsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTokenSunsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTokenSunsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTokenSunsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		i -= len(m.Remaining)
+		copy(dAtA[i:], m.Remaining)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Remaining)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TimeRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeRemaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.TokenSunset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTokenSunsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTokenSunsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTokenSunsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTokenSunsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTokenSunsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTokenSunsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenSunset) > 0 {
+		for iNdEx := len(m.TokenSunset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenSunset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSourceChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSourceChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSourceChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSourceChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SourceChain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSourceChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSourceChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSourceChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSourceChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceChain) > 0 {
+		for iNdEx := len(m.SourceChain) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceChain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSourceChainStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSourceChainStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSourceChainStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSourceChainStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSourceChainStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSourceChainStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SourceChainStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSourceChainStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSourceChainStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSourceChainStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSourceChainStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSourceChainStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSourceChainStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceChainStats) > 0 {
+		for iNdEx := len(m.SourceChainStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceChainStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigrationFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigrationFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigrationFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigrationFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigrationFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigrationFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MigrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMigrationFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMigrationFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMigrationFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMigrationFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMigrationFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMigrationFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MigrationFee) > 0 {
+		for iNdEx := len(m.MigrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLockupTierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLockupTierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLockupTierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tier != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLockupTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLockupTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLockupTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LockupTier.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllLockupTierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLockupTierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLockupTierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllLockupTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLockupTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLockupTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LockupTier) > 0 {
+		for iNdEx := len(m.LockupTier) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupTier[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRatioScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRatioScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRatioScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRatioScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRatioScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRatioScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RatioSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRatioScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRatioScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRatioScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRatioScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRatioScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRatioScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RatioSchedule) > 0 {
+		for iNdEx := len(m.RatioSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RatioSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentRatioRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentRatioRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentRatioRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheduled {
+		i--
+		if m.Scheduled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Ratio != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Ratio))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawal) > 0 {
+		for iNdEx := len(m.Withdrawal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WithdrawalConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigrationArchiveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigrationArchiveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigrationArchiveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Token != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigrationArchiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigrationArchiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigrationArchiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MigrationArchive.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMigrationArchiveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMigrationArchiveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMigrationArchiveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMigrationArchiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMigrationArchiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMigrationArchiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MigrationArchive) > 0 {
+		for iNdEx := len(m.MigrationArchive) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigrationArchive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFeeWaiverConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFeeWaiverConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFeeWaiverConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFeeWaiverConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFeeWaiverConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFeeWaiverConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeWaiverConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFeeAllowanceConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFeeAllowanceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFeeAllowanceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFeeAllowanceConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFeeAllowanceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFeeAllowanceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeAllowanceConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigrationTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigrationTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigrationTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigrationTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigrationTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigrationTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MigrationTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTokenStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTokenStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTokenStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTokenStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTokenStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTokenStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTokenStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTokenStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTokenStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTokenStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTokenStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTokenStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenStats) > 0 {
+		for iNdEx := len(m.TokenStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigratorStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigratorStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigratorStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrator) > 0 {
+		i -= len(m.Migrator)
+		copy(dAtA[i:], m.Migrator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Migrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMigratorStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMigratorStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMigratorStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MigratorStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMigratorStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMigratorStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMigratorStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMigratorStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMigratorStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMigratorStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MigratorStats) > 0 {
+		for iNdEx := len(m.MigratorStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigratorStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDailyStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDailyStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDailyStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Day != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDailyStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDailyStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDailyStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DailyStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDailyStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDailyStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDailyStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDailyStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDailyStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDailyStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DailyStats) > 0 {
+		for iNdEx := len(m.DailyStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTokenMigrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTokenMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenMigration.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTokenMigrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTokenMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenMigration) > 0 {
+		for _, e := range m.TokenMigration {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAclRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetAclResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Acl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMigratorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Migrator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMigratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Migrator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMigratorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMigratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Migrator) > 0 {
+		for _, e := range m.Migrator {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTokenSunsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovQuery(uint64(m.Token))
+	}
+	return n
+}
+
+func (m *QueryGetTokenSunsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenSunset.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TimeRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.TimeRemaining))
+	}
+	l = len(m.Remaining)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTokenSunsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTokenSunsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenSunset) > 0 {
+		for _, e := range m.TokenSunset {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSourceChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetSourceChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SourceChain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSourceChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSourceChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SourceChain) > 0 {
+		for _, e := range m.SourceChain {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSourceChainStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetSourceChainStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SourceChainStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSourceChainStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSourceChainStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SourceChainStats) > 0 {
+		for _, e := range m.SourceChainStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMigrationFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovQuery(uint64(m.Token))
+	}
+	return n
+}
+
+func (m *QueryGetMigrationFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MigrationFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMigrationFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMigrationFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MigrationFee) > 0 {
+		for _, e := range m.MigrationFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLockupTierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tier != 0 {
+		n += 1 + sovQuery(uint64(m.Tier))
+	}
+	return n
+}
+
+func (m *QueryGetLockupTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LockupTier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllLockupTierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLockupTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockupTier) > 0 {
+		for _, e := range m.LockupTier {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRatioScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovQuery(uint64(m.Token))
+	}
+	return n
+}
+
+func (m *QueryGetRatioScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RatioSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRatioScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRatioScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RatioSchedule) > 0 {
+		for _, e := range m.RatioSchedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentRatioRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovQuery(uint64(m.Token))
+	}
+	return n
+}
+
+func (m *QueryCurrentRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ratio != 0 {
+		n += 1 + sovQuery(uint64(m.Ratio))
+	}
+	if m.Scheduled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdrawal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawal) > 0 {
+		for _, e := range m.Withdrawal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWithdrawalConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetWithdrawalConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WithdrawalConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMigrationArchiveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovQuery(uint64(m.Token))
+	}
+	if m.Bucket != 0 {
+		n += 1 + sovQuery(uint64(m.Bucket))
+	}
+	if m.SourceChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SourceChainId))
+	}
+	return n
+}
+
+func (m *QueryGetMigrationArchiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MigrationArchive.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMigrationArchiveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMigrationArchiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MigrationArchive) > 0 {
+		for _, e := range m.MigrationArchive {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFeeWaiverConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetFeeWaiverConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeWaiverConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetFeeAllowanceConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetFeeAllowanceConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeAllowanceConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMigrationTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetMigrationTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MigrationTotals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTokenStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovQuery(uint64(m.Token))
+	}
+	return n
+}
+
+func (m *QueryGetTokenStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTokenStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTokenStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenStats) > 0 {
+		for _, e := range m.TokenStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMigratorStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Migrator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMigratorStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MigratorStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMigratorStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMigratorStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MigratorStats) > 0 {
+		for _, e := range m.MigratorStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDailyStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Day != 0 {
+		n += 1 + sovQuery(uint64(m.Day))
+	}
+	return n
+}
+
+func (m *QueryGetDailyStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DailyStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDailyStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDailyStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DailyStats) > 0 {
+		for _, e := range m.DailyStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTokenMigrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTokenMigrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTokenMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTokenMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTokenMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTokenMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMigration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMigration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTokenMigrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTokenMigrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTokenMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTokenMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTokenMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTokenMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMigration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenMigration = append(m.TokenMigration, TokenMigration{})
+			if err := m.TokenMigration[len(m.TokenMigration)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAclRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAclRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAclRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAclResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAclResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAclResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMigratorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMigratorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMigratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMigratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMigratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMigratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Migrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMigratorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMigratorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMigratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMigratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMigratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMigratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrator = append(m.Migrator, Migrator{})
+			if err := m.Migrator[len(m.Migrator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTokenSunsetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTokenSunsetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTokenSunsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTokenSunsetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTokenSunsetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTokenSunsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSunset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenSunset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRemaining", wireType)
+			}
+			m.TimeRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTokenSunsetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTokenSunsetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTokenSunsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTokenSunsetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTokenSunsetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTokenSunsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSunset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSunset = append(m.TokenSunset, TokenSunset{})
+			if err := m.TokenSunset[len(m.TokenSunset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSourceChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSourceChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSourceChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSourceChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSourceChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSourceChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSourceChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSourceChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSourceChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSourceChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = append(m.SourceChain, SourceChain{})
+			if err := m.SourceChain[len(m.SourceChain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSourceChainStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSourceChainStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSourceChainStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSourceChainStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSourceChainStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSourceChainStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceChainStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSourceChainStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSourceChainStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSourceChainStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSourceChainStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSourceChainStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSourceChainStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChainStats = append(m.SourceChainStats, SourceChainStats{})
+			if err := m.SourceChainStats[len(m.SourceChainStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMigrationFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMigrationFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMigrationFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMigrationFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMigrationFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMigrationFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MigrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMigrationFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMigrationFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMigrationFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMigrationFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMigrationFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMigrationFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationFee = append(m.MigrationFee, MigrationFee{})
+			if err := m.MigrationFee[len(m.MigrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLockupTierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLockupTierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLockupTierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLockupTierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLockupTierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLockupTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockupTier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLockupTierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLockupTierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLockupTierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLockupTierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLockupTierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLockupTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupTier = append(m.LockupTier, LockupTier{})
+			if err := m.LockupTier[len(m.LockupTier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRatioScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRatioScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRatioScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRatioScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRatioScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRatioScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatioSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RatioSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRatioScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRatioScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRatioScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRatioScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRatioScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRatioScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatioSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RatioSchedule = append(m.RatioSchedule, RatioSchedule{})
+			if err := m.RatioSchedule[len(m.RatioSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentRatioRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentRatioRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentRatioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentRatioResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentRatioResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			m.Ratio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ratio |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Scheduled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetWithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllWithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawal = append(m.Withdrawal, Withdrawal{})
+			if err := m.Withdrawal[len(m.Withdrawal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetWithdrawalConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWithdrawalConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWithdrawalConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetWithdrawalConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWithdrawalConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWithdrawalConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMigrationArchiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMigrationArchiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMigrationArchiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMigrationArchiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMigrationArchiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMigrationArchiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationArchive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MigrationArchive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMigrationArchiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMigrationArchiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMigrationArchiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMigrationArchiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMigrationArchiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMigrationArchiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationArchive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationArchive = append(m.MigrationArchive, MigrationArchive{})
+			if err := m.MigrationArchive[len(m.MigrationArchive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetFeeWaiverConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetFeeWaiverConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetFeeWaiverConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryGetConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryGetConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetFeeWaiverConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetFeeWaiverConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetFeeWaiverConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeWaiverConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeWaiverConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Config.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFeeAllowanceConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1692,10 +12483,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFeeAllowanceConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFeeAllowanceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1719,7 +12510,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFeeAllowanceConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,15 +12533,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFeeAllowanceConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFeeAllowanceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1777,7 +12568,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeAllowanceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1802,7 +12593,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTokenMigrationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMigrationTotalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,17 +12616,67 @@ func (m *QueryGetTokenMigrationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTokenMigrationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMigrationTotalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTokenMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMigrationTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMigrationTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMigrationTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMigrationTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationTotals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1845,23 +12686,24 @@ func (m *QueryGetTokenMigrationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgHash = string(dAtA[iNdEx:postIndex])
+			if err := m.MigrationTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1884,7 +12726,7 @@ func (m *QueryGetTokenMigrationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTokenMigrationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTokenStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1907,15 +12749,84 @@ func (m *QueryGetTokenMigrationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTokenMigrationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTokenStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTokenMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTokenStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTokenStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTokenStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTokenStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenMigration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1942,7 +12853,7 @@ func (m *QueryGetTokenMigrationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenMigration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1967,7 +12878,7 @@ func (m *QueryGetTokenMigrationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTokenMigrationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTokenStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1990,10 +12901,10 @@ func (m *QueryAllTokenMigrationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTokenMigrationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTokenStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTokenMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTokenStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2053,7 +12964,7 @@ func (m *QueryAllTokenMigrationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTokenMigrationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTokenStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2076,15 +12987,15 @@ func (m *QueryAllTokenMigrationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTokenMigrationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTokenStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTokenMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTokenStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenMigration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2111,8 +13022,8 @@ func (m *QueryAllTokenMigrationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenMigration = append(m.TokenMigration, TokenMigration{})
-			if err := m.TokenMigration[len(m.TokenMigration)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenStats = append(m.TokenStats, TokenStats{})
+			if err := m.TokenStats[len(m.TokenStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2173,7 +13084,7 @@ func (m *QueryAllTokenMigrationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAclRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMigratorStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2196,12 +13107,44 @@ func (m *QueryGetAclRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAclRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMigratorStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAclRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMigratorStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2223,7 +13166,7 @@ func (m *QueryGetAclRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAclResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMigratorStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2246,15 +13189,15 @@ func (m *QueryGetAclResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAclResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMigratorStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAclResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMigratorStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratorStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2281,7 +13224,7 @@ func (m *QueryGetAclResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MigratorStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2306,7 +13249,7 @@ func (m *QueryGetAclResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMigratorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMigratorStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2329,17 +13272,17 @@ func (m *QueryGetMigratorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMigratorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllMigratorStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMigratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllMigratorStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2349,23 +13292,27 @@ func (m *QueryGetMigratorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Migrator = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2388,7 +13335,7 @@ func (m *QueryGetMigratorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMigratorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMigratorStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2411,15 +13358,15 @@ func (m *QueryGetMigratorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMigratorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllMigratorStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMigratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllMigratorStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratorStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2446,7 +13393,44 @@ func (m *QueryGetMigratorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Migrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MigratorStats = append(m.MigratorStats, MigratorStats{})
+			if err := m.MigratorStats[len(m.MigratorStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2471,7 +13455,7 @@ func (m *QueryGetMigratorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMigratorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDailyStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2494,17 +13478,17 @@ func (m *QueryAllMigratorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMigratorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDailyStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMigratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDailyStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
 			}
-			var msglen int
+			m.Day = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2514,28 +13498,11 @@ func (m *QueryAllMigratorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Day |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2557,7 +13524,7 @@ func (m *QueryAllMigratorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMigratorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDailyStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2580,49 +13547,15 @@ func (m *QueryAllMigratorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMigratorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDailyStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMigratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDailyStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Migrator = append(m.Migrator, Migrator{})
-			if err := m.Migrator[len(m.Migrator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2649,10 +13582,7 @@ func (m *QueryAllMigratorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DailyStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2677,7 +13607,7 @@ func (m *QueryAllMigratorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDailyStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2700,12 +13630,48 @@ func (m *QueryGetConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDailyStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDailyStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2727,7 +13693,7 @@ func (m *QueryGetConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDailyStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2750,15 +13716,15 @@ func (m *QueryGetConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDailyStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDailyStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2785,7 +13751,44 @@ func (m *QueryGetConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DailyStats = append(m.DailyStats, DailyStats{})
+			if err := m.DailyStats[len(m.DailyStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
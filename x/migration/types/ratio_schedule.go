@@ -0,0 +1,58 @@
+package types
+
+import (
+	"sort"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the points of the schedule are ordered and hold a positive ratio
+func (s RatioSchedule) Validate() error {
+	if _, ok := RatioInterpolation_name[int32(s.Interpolation)]; !ok {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "unknown ratio interpolation %d", s.Interpolation)
+	}
+
+	if len(s.Points) == 0 || len(s.Points) > MaxRatioSchedulePoints {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "a ratio schedule must have between 1 and %d points", MaxRatioSchedulePoints)
+	}
+
+	for i, point := range s.Points {
+		if point.Ratio == 0 {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "ratio of point %d must be positive", i)
+		}
+
+		if i > 0 && point.Time <= s.Points[i-1].Time {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "point %d must come after point %d", i, i-1)
+		}
+	}
+
+	return nil
+}
+
+// RatioAt returns the ratio in effect at the given unix time. It returns false before the schedule starts
+func (s RatioSchedule) RatioAt(now uint64) (uint64, bool) {
+	if len(s.Points) == 0 || now < s.Points[0].Time {
+		return 0, false
+	}
+
+	// index of the last point that has started
+	i := sort.Search(len(s.Points), func(i int) bool {
+		return s.Points[i].Time > now
+	}) - 1
+
+	current := s.Points[i]
+	if s.Interpolation == RatioInterpolation_RATIO_INTERPOLATION_STEP || i == len(s.Points)-1 {
+		return current.Ratio, true
+	}
+
+	next := s.Points[i+1]
+	elapsed := now - current.Time
+	span := next.Time - current.Time
+
+	if next.Ratio >= current.Ratio {
+		return current.Ratio + (next.Ratio-current.Ratio)*elapsed/span, true
+	}
+
+	return current.Ratio - (current.Ratio-next.Ratio)*elapsed/span, true
+}
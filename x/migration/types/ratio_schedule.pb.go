@@ -0,0 +1,629 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: selfchain/migration/ratio_schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RatioInterpolation defines how the ratio evolves between two points of a schedule
+type RatioInterpolation int32
+
+const (
+	// the ratio of a point holds until the next one
+	RatioInterpolation_RATIO_INTERPOLATION_STEP RatioInterpolation = 0
+	// the ratio moves linearly from a point to the next one
+	RatioInterpolation_RATIO_INTERPOLATION_LINEAR RatioInterpolation = 1
+)
+
+var RatioInterpolation_name = map[int32]string{
+	0: "RATIO_INTERPOLATION_STEP",
+	1: "RATIO_INTERPOLATION_LINEAR",
+}
+
+var RatioInterpolation_value = map[string]int32{
+	"RATIO_INTERPOLATION_STEP":   0,
+	"RATIO_INTERPOLATION_LINEAR": 1,
+}
+
+func (x RatioInterpolation) String() string {
+	return proto.EnumName(RatioInterpolation_name, int32(x))
+}
+
+func (RatioInterpolation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_86055d15c21ca756, []int{0}
+}
+
+type RatioPoint struct {
+	// unix time from which the ratio applies
+	Time uint64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// ratio in percent
+	Ratio uint64 `protobuf:"varint,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+}
+
+func (m *RatioPoint) Reset()         { *m = RatioPoint{} }
+func (m *RatioPoint) String() string { return proto.CompactTextString(m) }
+func (*RatioPoint) ProtoMessage()    {}
+func (*RatioPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86055d15c21ca756, []int{0}
+}
+func (m *RatioPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatioPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatioPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatioPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatioPoint.Merge(m, src)
+}
+func (m *RatioPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatioPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatioPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatioPoint proto.InternalMessageInfo
+
+func (m *RatioPoint) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *RatioPoint) GetRatio() uint64 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+// RatioSchedule overrides the static ratio of a token with one varying over block time. Before the first
+// point the static ratio applies, after the last one the ratio of the last point holds.
+type RatioSchedule struct {
+	Token         uint64             `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	Interpolation RatioInterpolation `protobuf:"varint,2,opt,name=interpolation,proto3,enum=selfchain.migration.RatioInterpolation" json:"interpolation,omitempty"`
+	Points        []RatioPoint       `protobuf:"bytes,3,rep,name=points,proto3" json:"points"`
+}
+
+func (m *RatioSchedule) Reset()         { *m = RatioSchedule{} }
+func (m *RatioSchedule) String() string { return proto.CompactTextString(m) }
+func (*RatioSchedule) ProtoMessage()    {}
+func (*RatioSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86055d15c21ca756, []int{1}
+}
+func (m *RatioSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatioSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatioSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatioSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatioSchedule.Merge(m, src)
+}
+func (m *RatioSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatioSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatioSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatioSchedule proto.InternalMessageInfo
+
+func (m *RatioSchedule) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *RatioSchedule) GetInterpolation() RatioInterpolation {
+	if m != nil {
+		return m.Interpolation
+	}
+	return RatioInterpolation_RATIO_INTERPOLATION_STEP
+}
+
+func (m *RatioSchedule) GetPoints() []RatioPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("selfchain.migration.RatioInterpolation", RatioInterpolation_name, RatioInterpolation_value)
+	proto.RegisterType((*RatioPoint)(nil), "selfchain.migration.RatioPoint")
+	proto.RegisterType((*RatioSchedule)(nil), "selfchain.migration.RatioSchedule")
+}
+
+func init() {
+	proto.RegisterFile("selfchain/migration/ratio_schedule.proto", fileDescriptor_86055d15c21ca756)
+}
+
+var fileDescriptor_86055d15c21ca756 = []byte{
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0x4e, 0xcd, 0x49,
+	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
+	0x07, 0x53, 0xf1, 0xc5, 0xc9, 0x19, 0xa9, 0x29, 0xa5, 0x39, 0xa9, 0x7a, 0x05, 0x45, 0xf9, 0x25,
+	0xf9, 0x42, 0xc2, 0x70, 0x95, 0x7a, 0x70, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79,
+	0x7d, 0x10, 0x0b, 0xa2, 0x54, 0xc9, 0x8c, 0x8b, 0x2b, 0x08, 0x24, 0x1f, 0x90, 0x9f, 0x99, 0x57,
+	0x22, 0x24, 0xc4, 0xc5, 0x52, 0x92, 0x99, 0x9b, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x12, 0x04,
+	0x66, 0x0b, 0x89, 0x70, 0xb1, 0x82, 0x4d, 0x90, 0x60, 0x02, 0x0b, 0x42, 0x38, 0x4a, 0x9b, 0x19,
+	0xb9, 0x78, 0xc1, 0x1a, 0x83, 0xa1, 0x56, 0x83, 0xd4, 0x95, 0xe4, 0x67, 0xa7, 0xe6, 0x41, 0x35,
+	0x43, 0x38, 0x42, 0xbe, 0x5c, 0xbc, 0x99, 0x79, 0x25, 0xa9, 0x45, 0x05, 0xf9, 0x39, 0x60, 0x67,
+	0x80, 0x4d, 0xe1, 0x33, 0x52, 0xd7, 0xc3, 0xe2, 0x44, 0x3d, 0xb0, 0x81, 0x9e, 0xc8, 0xca, 0x83,
+	0x50, 0x75, 0x0b, 0xd9, 0x72, 0xb1, 0x15, 0x80, 0x5c, 0x5a, 0x2c, 0xc1, 0xac, 0xc0, 0xac, 0xc1,
+	0x6d, 0x24, 0x8f, 0xdb, 0x1c, 0xb0, 0x8f, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a,
+	0xd2, 0x0a, 0xe2, 0x12, 0xc2, 0xb4, 0x43, 0x48, 0x86, 0x4b, 0x22, 0xc8, 0x31, 0xc4, 0xd3, 0x3f,
+	0xde, 0xd3, 0x2f, 0xc4, 0x35, 0x28, 0xc0, 0xdf, 0x07, 0xc4, 0xf1, 0x8b, 0x0f, 0x0e, 0x71, 0x0d,
+	0x10, 0x60, 0x10, 0x92, 0xe3, 0x92, 0xc2, 0x26, 0xeb, 0xe3, 0xe9, 0xe7, 0xea, 0x18, 0x24, 0xc0,
+	0xe8, 0x64, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
+	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xd2, 0x88, 0x08,
+	0xab, 0x40, 0x8a, 0xb2, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xf8, 0x1b, 0x03, 0x06,
+	0x00, 0xee, 0x9a, 0x6d, 0x7f, 0xd6, 0x01, 0x00, 0x00,
+}
+
+func (m *RatioPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatioPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatioPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ratio != 0 {
+		i = encodeVarintRatioSchedule(dAtA, i, uint64(m.Ratio))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Time != 0 {
+		i = encodeVarintRatioSchedule(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RatioSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatioSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatioSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRatioSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Interpolation != 0 {
+		i = encodeVarintRatioSchedule(dAtA, i, uint64(m.Interpolation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Token != 0 {
+		i = encodeVarintRatioSchedule(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRatioSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRatioSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RatioPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovRatioSchedule(uint64(m.Time))
+	}
+	if m.Ratio != 0 {
+		n += 1 + sovRatioSchedule(uint64(m.Ratio))
+	}
+	return n
+}
+
+func (m *RatioSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovRatioSchedule(uint64(m.Token))
+	}
+	if m.Interpolation != 0 {
+		n += 1 + sovRatioSchedule(uint64(m.Interpolation))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovRatioSchedule(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRatioSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRatioSchedule(x uint64) (n int) {
+	return sovRatioSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RatioPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatioSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatioPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatioPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatioSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			m.Ratio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatioSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ratio |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatioSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatioSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RatioSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatioSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatioSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatioSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatioSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interpolation", wireType)
+			}
+			m.Interpolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatioSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interpolation |= RatioInterpolation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatioSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatioSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatioSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, RatioPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatioSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatioSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRatioSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRatioSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRatioSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRatioSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRatioSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRatioSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRatioSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRatioSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRatioSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRatioSchedule = fmt.Errorf("proto: unexpected end of group")
+)
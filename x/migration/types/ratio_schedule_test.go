@@ -0,0 +1,118 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// 100% in the first month then falling to 50% by the sixth one
+func earlyMigratorsSchedule(interpolation RatioInterpolation) RatioSchedule {
+	return RatioSchedule{
+		Token:         uint64(Hotcross),
+		Interpolation: interpolation,
+		Points: []RatioPoint{
+			{Time: 1000, Ratio: 100},
+			{Time: 1000 + 30*86400, Ratio: 100},
+			{Time: 1000 + 180*86400, Ratio: 50},
+		},
+	}
+}
+
+func TestRatioSchedule_RatioAt(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		schedule  RatioSchedule
+		now       uint64
+		want      uint64
+		scheduled bool
+	}{
+		{
+			desc:     "before the schedule",
+			schedule: earlyMigratorsSchedule(RatioInterpolation_RATIO_INTERPOLATION_LINEAR),
+			now:      999,
+		},
+		{
+			desc:      "first point",
+			schedule:  earlyMigratorsSchedule(RatioInterpolation_RATIO_INTERPOLATION_LINEAR),
+			now:       1000,
+			want:      100,
+			scheduled: true,
+		},
+		{
+			desc:      "linear decay",
+			schedule:  earlyMigratorsSchedule(RatioInterpolation_RATIO_INTERPOLATION_LINEAR),
+			now:       1000 + 105*86400,
+			want:      75,
+			scheduled: true,
+		},
+		{
+			desc:      "step holds the previous point",
+			schedule:  earlyMigratorsSchedule(RatioInterpolation_RATIO_INTERPOLATION_STEP),
+			now:       1000 + 105*86400,
+			want:      100,
+			scheduled: true,
+		},
+		{
+			desc:      "after the last point",
+			schedule:  earlyMigratorsSchedule(RatioInterpolation_RATIO_INTERPOLATION_LINEAR),
+			now:       1000 + 365*86400,
+			want:      50,
+			scheduled: true,
+		},
+		{
+			desc: "linear increase",
+			schedule: RatioSchedule{
+				Interpolation: RatioInterpolation_RATIO_INTERPOLATION_LINEAR,
+				Points:        []RatioPoint{{Time: 0, Ratio: 20}, {Time: 100, Ratio: 40}},
+			},
+			now:       25,
+			want:      25,
+			scheduled: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ratio, scheduled := tc.schedule.RatioAt(tc.now)
+			require.Equal(t, tc.scheduled, scheduled)
+			require.Equal(t, tc.want, ratio)
+		})
+	}
+}
+
+func TestRatioSchedule_Validate(t *testing.T) {
+	require.NoError(t, earlyMigratorsSchedule(RatioInterpolation_RATIO_INTERPOLATION_LINEAR).Validate())
+
+	for _, tc := range []struct {
+		desc     string
+		schedule RatioSchedule
+	}{
+		{
+			desc:     "no points",
+			schedule: RatioSchedule{},
+		},
+		{
+			desc: "unknown interpolation",
+			schedule: RatioSchedule{
+				Interpolation: 2,
+				Points:        []RatioPoint{{Time: 0, Ratio: 20}},
+			},
+		},
+		{
+			desc: "zero ratio",
+			schedule: RatioSchedule{
+				Points: []RatioPoint{{Time: 0, Ratio: 0}},
+			},
+		},
+		{
+			desc: "unordered points",
+			schedule: RatioSchedule{
+				Points: []RatioPoint{{Time: 10, Ratio: 20}, {Time: 10, Ratio: 30}},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.ErrorIs(t, tc.schedule.Validate(), errors.ErrInvalidRequest)
+		})
+	}
+}
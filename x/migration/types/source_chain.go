@@ -0,0 +1,12 @@
+package types
+
+// SupportsToken returns true if the token can be migrated from the chain
+func (c SourceChain) SupportsToken(token uint64) bool {
+	for _, t := range c.Tokens {
+		if t == token {
+			return true
+		}
+	}
+
+	return false
+}
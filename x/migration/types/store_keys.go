@@ -1,16 +1,53 @@
 package types
 
-import "encoding/binary"
-
-var _ binary.ByteOrder
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+)
 
 const (
-	// TokenMigrationKeyPrefix is the prefix to retrieve all TokenMigration
-	TokenMigrationKeyPrefix = "TokenMigration/value/"
+	// LegacyTokenMigrationKeyPrefix is the prefix the migrations were stored under, keyed by their hex hash
+	LegacyTokenMigrationKeyPrefix = "TokenMigration/value/"
+
+	// TokenMigrationKeyPrefix is the prefix to retrieve all TokenMigration, keyed by their binary hash. The
+	// entry of an archived migration is kept empty so that it can't be replayed.
+	TokenMigrationKeyPrefix = "TokenMigration/record/"
+
+	// TokenMigrationBucketKeyPrefix is the prefix of the index of the migrations by token, source chain and
+	// source block bucket. Its entries have no value.
+	TokenMigrationBucketKeyPrefix = "TokenMigration/bucket/"
 )
 
-// TokenMigrationKey returns the store key to retrieve a TokenMigration from the index fields
-func TokenMigrationKey(
+// MsgHashLength is the length of the binary hash of a migration
+const MsgHashLength = sha256.Size
+
+// MigrationBucketSize is the number of source blocks covered by a bucket of migrations
+const MigrationBucketSize uint64 = 100_000
+
+// MsgHashBytes returns the binary form of the hash of a migration. Hashes that aren't hex encoded
+// sha256 digests are hashed so that every key has the same length.
+func MsgHashBytes(msgHash string) []byte {
+	if hash, err := hex.DecodeString(msgHash); err == nil && len(hash) == MsgHashLength {
+		return hash
+	}
+
+	hash := sha256.Sum256([]byte(msgHash))
+	return hash[:]
+}
+
+// IsCanonicalMsgHash returns whether the hash of a migration can be rebuilt from its binary form
+func IsCanonicalMsgHash(msgHash string) bool {
+	return hex.EncodeToString(MsgHashBytes(msgHash)) == msgHash
+}
+
+// MigrationBucket returns the bucket of the migrations deposited at the given source block
+func MigrationBucket(sourceBlock uint64) uint64 {
+	return sourceBlock / MigrationBucketSize
+}
+
+// LegacyTokenMigrationKey returns the store key a TokenMigration was stored under before the compact layout
+func LegacyTokenMigrationKey(
 	msgHash string,
 ) []byte {
 	var key []byte
@@ -21,3 +58,41 @@ func TokenMigrationKey(
 
 	return key
 }
+
+// TokenMigrationBucketKey returns the store key prefix of the migrations of a token in a bucket. Block
+// numbers are only meaningful within a chain so buckets are kept per source chain.
+func TokenMigrationBucketKey(
+	token uint64,
+	sourceChainId uint64,
+	bucket uint64,
+) []byte {
+	key := make([]byte, 24)
+	binary.BigEndian.PutUint64(key, token)
+	binary.BigEndian.PutUint64(key[8:], sourceChainId)
+	binary.BigEndian.PutUint64(key[16:], bucket)
+
+	return key
+}
+
+// TokenMigrationBucketEntryKey returns the key of a migration in the index of its bucket
+func TokenMigrationBucketEntryKey(
+	token uint64,
+	sourceChainId uint64,
+	bucket uint64,
+	msgHash []byte,
+) []byte {
+	return append(TokenMigrationBucketKey(token, sourceChainId, bucket), msgHash...)
+}
+
+// TokenMigrationKey returns the store key to retrieve a TokenMigration from the index fields
+func TokenMigrationKey(
+	msgHash []byte,
+) []byte {
+	return msgHash
+}
+
+// MsgHashFromTokenMigrationKey returns the hex hash held by the store key of a TokenMigration or by the
+// key of its bucket entry
+func MsgHashFromTokenMigrationKey(key []byte) string {
+	return hex.EncodeToString(key[len(key)-MsgHashLength:])
+}
@@ -0,0 +1,11 @@
+package types
+
+// Recipient returns the account that received the migrated tokens, i.e. the contract of the migration
+// if its callback succeeded and the destination address otherwise
+func (m TokenMigration) Recipient() string {
+	if m.ContractAddress != "" {
+		return m.ContractAddress
+	}
+
+	return m.DestAddress
+}
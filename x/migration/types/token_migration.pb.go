@@ -23,8 +23,37 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type TokenMigration struct {
-	MsgHash   string `protobuf:"bytes,1,opt,name=msgHash,proto3" json:"msgHash,omitempty"`
-	Processed bool   `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
+	MsgHash     string `protobuf:"bytes,1,opt,name=msgHash,proto3" json:"msgHash,omitempty"`
+	Processed   bool   `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
+	TxHash      string `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	EthAddress  string `protobuf:"bytes,4,opt,name=ethAddress,proto3" json:"ethAddress,omitempty"`
+	DestAddress string `protobuf:"bytes,5,opt,name=destAddress,proto3" json:"destAddress,omitempty"`
+	Amount      string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Token       uint64 `protobuf:"varint,7,opt,name=token,proto3" json:"token,omitempty"`
+	LogIndex    uint64 `protobuf:"varint,8,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	// amounts in uslf
+	MintedAmount      string `protobuf:"bytes,9,opt,name=mintedAmount,proto3" json:"mintedAmount,omitempty"`
+	InstantlyReleased string `protobuf:"bytes,10,opt,name=instantlyReleased,proto3" json:"instantlyReleased,omitempty"`
+	VestedAmount      string `protobuf:"bytes,11,opt,name=vestedAmount,proto3" json:"vestedAmount,omitempty"`
+	PositionIndex     uint64 `protobuf:"varint,12,opt,name=positionIndex,proto3" json:"positionIndex,omitempty"`
+	Reverted          bool   `protobuf:"varint,13,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	RevertedBurned    string `protobuf:"bytes,14,opt,name=revertedBurned,proto3" json:"revertedBurned,omitempty"`
+	RevertedShortfall string `protobuf:"bytes,15,opt,name=revertedShortfall,proto3" json:"revertedShortfall,omitempty"`
+	SourceChainId     uint64 `protobuf:"varint,16,opt,name=sourceChainId,proto3" json:"sourceChainId,omitempty"`
+	// protocol fee in uslf taken from the minted amount
+	Fee        string `protobuf:"bytes,17,opt,name=fee,proto3" json:"fee,omitempty"`
+	LockupTier uint64 `protobuf:"varint,18,opt,name=lockupTier,proto3" json:"lockupTier,omitempty"`
+	// block number of the deposit on the source chain
+	SourceBlock uint64 `protobuf:"varint,19,opt,name=sourceBlock,proto3" json:"sourceBlock,omitempty"`
+	// migrator that submitted the migration
+	Migrator string `protobuf:"bytes,20,opt,name=migrator,proto3" json:"migrator,omitempty"`
+	// contract that received the migration, empty if it went to the destination address
+	ContractAddress string `protobuf:"bytes,21,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	// fee allowance in uslf granted to the destination address instead of the instantly released amount
+	FeeAllowance string `protobuf:"bytes,22,opt,name=feeAllowance,proto3" json:"feeAllowance,omitempty"`
+	// id of the vesting position. Migrations processed before positions had stable ids only hold the
+	// index of the position.
+	PositionId uint64 `protobuf:"varint,23,opt,name=positionId,proto3" json:"positionId,omitempty"`
 }
 
 func (m *TokenMigration) Reset()         { *m = TokenMigration{} }
@@ -74,6 +103,153 @@ func (m *TokenMigration) GetProcessed() bool {
 	return false
 }
 
+func (m *TokenMigration) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *TokenMigration) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *TokenMigration) GetMintedAmount() string {
+	if m != nil {
+		return m.MintedAmount
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetInstantlyReleased() string {
+	if m != nil {
+		return m.InstantlyReleased
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetVestedAmount() string {
+	if m != nil {
+		return m.VestedAmount
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetPositionIndex() uint64 {
+	if m != nil {
+		return m.PositionIndex
+	}
+	return 0
+}
+
+func (m *TokenMigration) GetReverted() bool {
+	if m != nil {
+		return m.Reverted
+	}
+	return false
+}
+
+func (m *TokenMigration) GetRevertedBurned() string {
+	if m != nil {
+		return m.RevertedBurned
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetRevertedShortfall() string {
+	if m != nil {
+		return m.RevertedShortfall
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+func (m *TokenMigration) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetLockupTier() uint64 {
+	if m != nil {
+		return m.LockupTier
+	}
+	return 0
+}
+
+func (m *TokenMigration) GetSourceBlock() uint64 {
+	if m != nil {
+		return m.SourceBlock
+	}
+	return 0
+}
+
+func (m *TokenMigration) GetMigrator() string {
+	if m != nil {
+		return m.Migrator
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetFeeAllowance() string {
+	if m != nil {
+		return m.FeeAllowance
+	}
+	return ""
+}
+
+func (m *TokenMigration) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TokenMigration)(nil), "selfchain.migration.TokenMigration")
 }
@@ -83,18 +259,37 @@ func init() {
 }
 
 var fileDescriptor_b4c85e2c2274004d = []byte{
-	// 163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2c, 0x4e, 0xcd, 0x49,
-	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x8b, 0x87, 0xf3, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84,
-	0xe1, 0x4a, 0xf5, 0xe0, 0x52, 0x4a, 0x1e, 0x5c, 0x7c, 0x21, 0x20, 0xd5, 0xbe, 0x30, 0x11, 0x21,
-	0x09, 0x2e, 0xf6, 0xdc, 0xe2, 0x74, 0x8f, 0xc4, 0xe2, 0x0c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce,
-	0x20, 0x18, 0x57, 0x48, 0x86, 0x8b, 0xb3, 0xa0, 0x28, 0x3f, 0x39, 0xb5, 0xb8, 0x38, 0x35, 0x45,
-	0x82, 0x49, 0x81, 0x51, 0x83, 0x23, 0x08, 0x21, 0xe0, 0x64, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
-	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xd2, 0x08, 0x37, 0x56, 0x20, 0xbb, 0xb2, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0xec, 0x38, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x12, 0x81, 0x09, 0x81, 0xc9,
-	0x00, 0x00, 0x00,
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xb3, 0xb4, 0x49, 0x13, 0xb7, 0x4d, 0x5b, 0xb7, 0x94, 0x11, 0xa0, 0x55, 0x54, 0x21,
+	0x14, 0x24, 0xd4, 0x1e, 0x10, 0x0f, 0x90, 0x70, 0xa1, 0x07, 0x2e, 0xa1, 0x27, 0x2e, 0xc8, 0xac,
+	0x27, 0xc9, 0xaa, 0x8e, 0xbd, 0xb2, 0x9d, 0x92, 0xbe, 0x05, 0x8f, 0xc5, 0xb1, 0x47, 0x8e, 0x28,
+	0x79, 0x0f, 0x84, 0x3c, 0xee, 0x6e, 0x36, 0xcd, 0x6d, 0xff, 0x6f, 0xc6, 0xff, 0xfe, 0x1e, 0xdb,
+	0xec, 0x9d, 0x43, 0x35, 0xce, 0xa6, 0x22, 0xd7, 0x57, 0xb3, 0x7c, 0x62, 0x85, 0xcf, 0x8d, 0xbe,
+	0xf2, 0xe6, 0x16, 0xf5, 0xf7, 0x4a, 0x5f, 0x16, 0xd6, 0x78, 0xc3, 0x4f, 0xab, 0xd6, 0xcb, 0xaa,
+	0x74, 0xf1, 0xaf, 0xc9, 0xba, 0x37, 0xa1, 0xfd, 0x4b, 0x89, 0x38, 0xb0, 0xbd, 0x99, 0x9b, 0x7c,
+	0x16, 0x6e, 0x0a, 0x49, 0x2f, 0xe9, 0x77, 0x46, 0xa5, 0xe4, 0xaf, 0x59, 0xa7, 0xb0, 0x26, 0x43,
+	0xe7, 0x50, 0xc2, 0xb3, 0x5e, 0xd2, 0x6f, 0x8f, 0xd6, 0x80, 0x9f, 0xb3, 0x96, 0x5f, 0xd0, 0xb2,
+	0x1d, 0x5a, 0xf6, 0xa8, 0x78, 0xca, 0x18, 0xfa, 0xe9, 0x40, 0x4a, 0x8b, 0xce, 0xc1, 0x2e, 0xd5,
+	0x6a, 0x84, 0xf7, 0xd8, 0xbe, 0x44, 0xe7, 0xcb, 0x86, 0x26, 0x35, 0xd4, 0x51, 0x70, 0x16, 0x33,
+	0x33, 0xd7, 0x1e, 0x5a, 0xd1, 0x39, 0x2a, 0x7e, 0xc6, 0x9a, 0xb4, 0x55, 0xd8, 0xeb, 0x25, 0xfd,
+	0xdd, 0x51, 0x14, 0xfc, 0x25, 0x6b, 0x2b, 0x33, 0xb9, 0xd6, 0x12, 0x17, 0xd0, 0xa6, 0x42, 0xa5,
+	0xf9, 0x05, 0x3b, 0x98, 0xe5, 0xda, 0xa3, 0x1c, 0x44, 0xbf, 0x0e, 0xf9, 0x6d, 0x30, 0xfe, 0x9e,
+	0x9d, 0xe4, 0xda, 0x79, 0xa1, 0xbd, 0xba, 0x1f, 0xa1, 0x42, 0x11, 0x76, 0xcb, 0xa8, 0x71, 0xbb,
+	0x10, 0x1c, 0xef, 0xd0, 0xad, 0x1d, 0xf7, 0xa3, 0x63, 0x9d, 0xf1, 0x37, 0xec, 0xb0, 0x30, 0x2e,
+	0x0f, 0xd3, 0x8d, 0xb1, 0x0e, 0x28, 0xd6, 0x26, 0x0c, 0xb9, 0x2d, 0xde, 0xa1, 0xf5, 0x28, 0xe1,
+	0x90, 0x86, 0x5b, 0x69, 0xfe, 0x96, 0x75, 0xcb, 0xef, 0xe1, 0xdc, 0x6a, 0x94, 0xd0, 0xa5, 0xff,
+	0x3c, 0xa1, 0x21, 0x7b, 0x49, 0xbe, 0x4e, 0x8d, 0xf5, 0x63, 0xa1, 0x14, 0x1c, 0xc5, 0xec, 0x5b,
+	0x85, 0x90, 0xcb, 0x99, 0xb9, 0xcd, 0xf0, 0x53, 0xb8, 0x15, 0xd7, 0x12, 0x8e, 0x63, 0xae, 0x0d,
+	0xc8, 0x8f, 0xd9, 0xce, 0x18, 0x11, 0x4e, 0xc8, 0x25, 0x7c, 0x86, 0x13, 0x55, 0x26, 0xbb, 0x9d,
+	0x17, 0x37, 0x39, 0x5a, 0xe0, 0xb4, 0xa8, 0x46, 0xc2, 0x89, 0x46, 0x8b, 0x61, 0x80, 0x70, 0x4a,
+	0x0d, 0x75, 0x14, 0xf6, 0x1a, 0xef, 0xa0, 0xb1, 0x70, 0x46, 0xc6, 0x95, 0xe6, 0x7d, 0x76, 0x94,
+	0x19, 0xed, 0xad, 0xc8, 0xaa, 0x3b, 0xf1, 0x9c, 0x5a, 0x9e, 0xe2, 0x30, 0xfb, 0x31, 0xe2, 0x40,
+	0x29, 0xf3, 0x53, 0xe8, 0x0c, 0xe1, 0x3c, 0xce, 0xbe, 0xce, 0x42, 0xd6, 0x6a, 0xcc, 0x12, 0x5e,
+	0xc4, 0xac, 0x6b, 0x32, 0xfc, 0xf8, 0x7b, 0x99, 0x26, 0x0f, 0xcb, 0x34, 0xf9, 0xbb, 0x4c, 0x93,
+	0x5f, 0xab, 0xb4, 0xf1, 0xb0, 0x4a, 0x1b, 0x7f, 0x56, 0x69, 0xe3, 0xdb, 0xab, 0xf5, 0xd3, 0x5a,
+	0xd4, 0x1f, 0xd7, 0x7d, 0x81, 0xee, 0x47, 0x8b, 0xde, 0xd4, 0x87, 0xff, 0x03, 0x00, 0xec, 0xea,
+	0x79, 0x72, 0x80, 0x03, 0x00, 0x00,
 }
 
 func (m *TokenMigration) Marshal() (dAtA []byte, err error) {
@@ -117,6 +312,158 @@ func (m *TokenMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTokenMigration(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.FeeAllowance) > 0 {
+		i -= len(m.FeeAllowance)
+		copy(dAtA[i:], m.FeeAllowance)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.FeeAllowance)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.Migrator) > 0 {
+		i -= len(m.Migrator)
+		copy(dAtA[i:], m.Migrator)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.Migrator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.SourceBlock != 0 {
+		i = encodeVarintTokenMigration(dAtA, i, uint64(m.SourceBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.LockupTier != 0 {
+		i = encodeVarintTokenMigration(dAtA, i, uint64(m.LockupTier))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SourceChainId != 0 {
+		i = encodeVarintTokenMigration(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RevertedShortfall) > 0 {
+		i -= len(m.RevertedShortfall)
+		copy(dAtA[i:], m.RevertedShortfall)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.RevertedShortfall)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.RevertedBurned) > 0 {
+		i -= len(m.RevertedBurned)
+		copy(dAtA[i:], m.RevertedBurned)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.RevertedBurned)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Reverted {
+		i--
+		if m.Reverted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PositionIndex != 0 {
+		i = encodeVarintTokenMigration(dAtA, i, uint64(m.PositionIndex))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.VestedAmount) > 0 {
+		i -= len(m.VestedAmount)
+		copy(dAtA[i:], m.VestedAmount)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.VestedAmount)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.InstantlyReleased) > 0 {
+		i -= len(m.InstantlyReleased)
+		copy(dAtA[i:], m.InstantlyReleased)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.InstantlyReleased)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MintedAmount) > 0 {
+		i -= len(m.MintedAmount)
+		copy(dAtA[i:], m.MintedAmount)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.MintedAmount)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintTokenMigration(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Token != 0 {
+		i = encodeVarintTokenMigration(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTokenMigration(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Processed {
 		i--
 		if m.Processed {
@@ -161,6 +508,82 @@ func (m *TokenMigration) Size() (n int) {
 	if m.Processed {
 		n += 2
 	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTokenMigration(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovTokenMigration(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTokenMigration(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTokenMigration(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovTokenMigration(uint64(m.Token))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTokenMigration(uint64(m.LogIndex))
+	}
+	l = len(m.MintedAmount)
+	if l > 0 {
+		n += 1 + l + sovTokenMigration(uint64(l))
+	}
+	l = len(m.InstantlyReleased)
+	if l > 0 {
+		n += 1 + l + sovTokenMigration(uint64(l))
+	}
+	l = len(m.VestedAmount)
+	if l > 0 {
+		n += 1 + l + sovTokenMigration(uint64(l))
+	}
+	if m.PositionIndex != 0 {
+		n += 1 + sovTokenMigration(uint64(m.PositionIndex))
+	}
+	if m.Reverted {
+		n += 2
+	}
+	l = len(m.RevertedBurned)
+	if l > 0 {
+		n += 1 + l + sovTokenMigration(uint64(l))
+	}
+	l = len(m.RevertedShortfall)
+	if l > 0 {
+		n += 1 + l + sovTokenMigration(uint64(l))
+	}
+	if m.SourceChainId != 0 {
+		n += 2 + sovTokenMigration(uint64(m.SourceChainId))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 2 + l + sovTokenMigration(uint64(l))
+	}
+	if m.LockupTier != 0 {
+		n += 2 + sovTokenMigration(uint64(m.LockupTier))
+	}
+	if m.SourceBlock != 0 {
+		n += 2 + sovTokenMigration(uint64(m.SourceBlock))
+	}
+	l = len(m.Migrator)
+	if l > 0 {
+		n += 2 + l + sovTokenMigration(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 2 + l + sovTokenMigration(uint64(l))
+	}
+	l = len(m.FeeAllowance)
+	if l > 0 {
+		n += 2 + l + sovTokenMigration(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 2 + sovTokenMigration(uint64(m.PositionId))
+	}
 	return n
 }
 
@@ -251,6 +674,575 @@ func (m *TokenMigration) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Processed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantlyReleased", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstantlyReleased = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionIndex", wireType)
+			}
+			m.PositionIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverted = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertedBurned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedShortfall", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertedShortfall = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTier", wireType)
+			}
+			m.LockupTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockupTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBlock", wireType)
+			}
+			m.SourceBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTokenMigration(dAtA[iNdEx:])
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// HasEnded returns true if the migration of the token is no longer possible at the given time
+func (s TokenSunset) HasEnded(now time.Time) bool {
+	return s.EndTime != 0 && now.Unix() >= int64(s.EndTime)
+}
+
+// MintedAmount returns the amount of uslf minted for the token so far
+func (s TokenSunset) MintedAmount() sdkmath.Uint {
+	if s.Minted == "" {
+		return sdkmath.ZeroUint()
+	}
+
+	return sdkmath.NewUintFromString(s.Minted)
+}
+
+// LegacyMintedAmount returns the amount of uslf minted for the token before minted amounts were tracked
+func (s TokenSunset) LegacyMintedAmount() sdkmath.Uint {
+	if s.LegacyMinted == "" {
+		return sdkmath.ZeroUint()
+	}
+
+	return sdkmath.NewUintFromString(s.LegacyMinted)
+}
+
+// WithdrawableAmount returns the amount of uslf that can be withdrawn back to the token, that is what has
+// been minted for it and not withdrawn yet
+func (s TokenSunset) WithdrawableAmount() sdkmath.Uint {
+	return s.MintedAmount().Add(s.LegacyMintedAmount())
+}
+
+// WithdrawalRatio returns the ratio withdrawals of the token are converted back at given the ratio its
+// migrations currently get. The highest of the two is used so that withdrawals never release more tokens
+// than were deposited for the uslf.
+func (s TokenSunset) WithdrawalRatio(current uint64) uint64 {
+	if s.PeakRatio > current {
+		return s.PeakRatio
+	}
+
+	return current
+}
+
+// RemainingAllocation returns the amount of uslf that can still be minted for the token. The second
+// return value is false if the token has no cap.
+func (s TokenSunset) RemainingAllocation() (sdkmath.Uint, bool) {
+	if s.Cap == "" {
+		return sdkmath.ZeroUint(), false
+	}
+
+	if s.Swept {
+		return sdkmath.ZeroUint(), true
+	}
+
+	capAmount := sdkmath.NewUintFromString(s.Cap)
+	minted := s.MintedAmount().Add(s.LegacyMintedAmount())
+	if minted.GTE(capAmount) {
+		return sdkmath.ZeroUint(), true
+	}
+
+	return capAmount.Sub(minted), true
+}
+
+// TimeRemaining returns the number of seconds until the migration of the token ends
+func (s TokenSunset) TimeRemaining(now time.Time) uint64 {
+	if s.EndTime == 0 || s.HasEnded(now) {
+		return 0
+	}
+
+	return s.EndTime - uint64(now.Unix())
+}
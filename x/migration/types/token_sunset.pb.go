@@ -0,0 +1,794 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: selfchain/migration/token_sunset.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SweepMode defines what happens to the unclaimed allocation of a token once its migration has ended
+type SweepMode int32
+
+const (
+	// the unclaimed allocation is left untouched
+	SweepMode_SWEEP_MODE_NONE SweepMode = 0
+	// the unclaimed allocation is minted into the community pool
+	SweepMode_SWEEP_MODE_COMMUNITY_POOL SweepMode = 1
+	// the unclaimed allocation is minted to the treasury address
+	SweepMode_SWEEP_MODE_TREASURY SweepMode = 2
+	// the unclaimed allocation is never minted
+	SweepMode_SWEEP_MODE_BURN SweepMode = 3
+)
+
+var SweepMode_name = map[int32]string{
+	0: "SWEEP_MODE_NONE",
+	1: "SWEEP_MODE_COMMUNITY_POOL",
+	2: "SWEEP_MODE_TREASURY",
+	3: "SWEEP_MODE_BURN",
+}
+
+var SweepMode_value = map[string]int32{
+	"SWEEP_MODE_NONE":           0,
+	"SWEEP_MODE_COMMUNITY_POOL": 1,
+	"SWEEP_MODE_TREASURY":       2,
+	"SWEEP_MODE_BURN":           3,
+}
+
+func (x SweepMode) String() string {
+	return proto.EnumName(SweepMode_name, int32(x))
+}
+
+func (SweepMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d07d1fdcedd2b9ad, []int{0}
+}
+
+// TokenSunset holds the migration deadline and allocation of a source token
+type TokenSunset struct {
+	Token uint64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	// unix time (in seconds) from which migrations of the token are rejected. Zero means no deadline
+	EndTime uint64 `protobuf:"varint,2,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// total amount of uslf that can be minted for the token. Empty means no cap
+	Cap       string    `protobuf:"bytes,3,opt,name=cap,proto3" json:"cap,omitempty"`
+	SweepMode SweepMode `protobuf:"varint,4,opt,name=sweepMode,proto3,enum=selfchain.migration.SweepMode" json:"sweepMode,omitempty"`
+	Treasury  string    `protobuf:"bytes,5,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// total amount of uslf minted for the token so far
+	Minted      string `protobuf:"bytes,6,opt,name=minted,proto3" json:"minted,omitempty"`
+	Swept       bool   `protobuf:"varint,7,opt,name=swept,proto3" json:"swept,omitempty"`
+	SweptAmount string `protobuf:"bytes,8,opt,name=sweptAmount,proto3" json:"sweptAmount,omitempty"`
+	SweptAt     int64  `protobuf:"varint,9,opt,name=sweptAt,proto3" json:"sweptAt,omitempty"`
+	// amount of uslf minted for the token before minted amounts were tracked. It counts against the cap
+	LegacyMinted string `protobuf:"bytes,10,opt,name=legacyMinted,proto3" json:"legacyMinted,omitempty"`
+	// highest ratio, in percent and lockup bonus included, migrations of the token have been converted at.
+	// Withdrawals are converted back at this ratio so that they never release more than was deposited.
+	PeakRatio uint64 `protobuf:"varint,11,opt,name=peakRatio,proto3" json:"peakRatio,omitempty"`
+}
+
+func (m *TokenSunset) Reset()         { *m = TokenSunset{} }
+func (m *TokenSunset) String() string { return proto.CompactTextString(m) }
+func (*TokenSunset) ProtoMessage()    {}
+func (*TokenSunset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d07d1fdcedd2b9ad, []int{0}
+}
+func (m *TokenSunset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenSunset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenSunset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenSunset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSunset.Merge(m, src)
+}
+func (m *TokenSunset) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenSunset) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSunset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSunset proto.InternalMessageInfo
+
+func (m *TokenSunset) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *TokenSunset) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *TokenSunset) GetCap() string {
+	if m != nil {
+		return m.Cap
+	}
+	return ""
+}
+
+func (m *TokenSunset) GetSweepMode() SweepMode {
+	if m != nil {
+		return m.SweepMode
+	}
+	return SweepMode_SWEEP_MODE_NONE
+}
+
+func (m *TokenSunset) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *TokenSunset) GetMinted() string {
+	if m != nil {
+		return m.Minted
+	}
+	return ""
+}
+
+func (m *TokenSunset) GetSwept() bool {
+	if m != nil {
+		return m.Swept
+	}
+	return false
+}
+
+func (m *TokenSunset) GetSweptAmount() string {
+	if m != nil {
+		return m.SweptAmount
+	}
+	return ""
+}
+
+func (m *TokenSunset) GetSweptAt() int64 {
+	if m != nil {
+		return m.SweptAt
+	}
+	return 0
+}
+
+func (m *TokenSunset) GetLegacyMinted() string {
+	if m != nil {
+		return m.LegacyMinted
+	}
+	return ""
+}
+
+func (m *TokenSunset) GetPeakRatio() uint64 {
+	if m != nil {
+		return m.PeakRatio
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("selfchain.migration.SweepMode", SweepMode_name, SweepMode_value)
+	proto.RegisterType((*TokenSunset)(nil), "selfchain.migration.TokenSunset")
+}
+
+func init() {
+	proto.RegisterFile("selfchain/migration/token_sunset.proto", fileDescriptor_d07d1fdcedd2b9ad)
+}
+
+var fileDescriptor_d07d1fdcedd2b9ad = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0xce, 0x93, 0x40,
+	0x14, 0x86, 0x99, 0xd2, 0x3f, 0x4e, 0x8d, 0x92, 0xa9, 0xd1, 0xf1, 0x8f, 0x90, 0x2e, 0x0c, 0x71,
+	0x41, 0x13, 0x8d, 0x3b, 0x37, 0xad, 0xb2, 0x30, 0x11, 0x68, 0x06, 0x1a, 0x53, 0x37, 0x04, 0xdb,
+	0xb1, 0x92, 0x96, 0x9f, 0xc0, 0x34, 0xb5, 0x77, 0xe1, 0x65, 0xb9, 0xec, 0xc6, 0xc4, 0xa5, 0x69,
+	0x6f, 0xc4, 0x30, 0x58, 0xca, 0xf7, 0xe5, 0xdb, 0xcd, 0xf3, 0x9e, 0xf7, 0x3d, 0x87, 0x43, 0x0e,
+	0xbc, 0x2c, 0xd8, 0xf6, 0xdb, 0xf2, 0x7b, 0x18, 0x25, 0xe3, 0x38, 0x5a, 0xe7, 0x21, 0x8f, 0xd2,
+	0x64, 0xcc, 0xd3, 0x0d, 0x4b, 0x82, 0x62, 0x97, 0x14, 0x8c, 0x9b, 0x59, 0x9e, 0xf2, 0x14, 0x0f,
+	0x6b, 0x9f, 0x59, 0xfb, 0x46, 0xbf, 0x5b, 0x30, 0xf0, 0x4b, 0xaf, 0x27, 0xac, 0xf8, 0x21, 0x74,
+	0x44, 0x94, 0x20, 0x1d, 0x19, 0x6d, 0x5a, 0x01, 0x26, 0xd0, 0x63, 0xc9, 0xca, 0x8f, 0x62, 0x46,
+	0x5a, 0x42, 0xbf, 0x20, 0x56, 0x41, 0x5e, 0x86, 0x19, 0x91, 0x75, 0x64, 0x28, 0xb4, 0x7c, 0xe2,
+	0x77, 0xa0, 0x14, 0x7b, 0xc6, 0x32, 0x3b, 0x5d, 0x31, 0xd2, 0xd6, 0x91, 0x71, 0xff, 0xb5, 0x66,
+	0xde, 0x31, 0xda, 0xf4, 0x2e, 0x2e, 0x7a, 0x0d, 0xe0, 0xa7, 0xd0, 0xe7, 0x39, 0x0b, 0x8b, 0x5d,
+	0x7e, 0x20, 0x1d, 0xd1, 0xb4, 0x66, 0xfc, 0x08, 0xba, 0x71, 0x94, 0x70, 0xb6, 0x22, 0x5d, 0x51,
+	0xf9, 0x4f, 0xe5, 0x37, 0x17, 0x7b, 0x96, 0x71, 0xd2, 0xd3, 0x91, 0xd1, 0xa7, 0x15, 0x60, 0x1d,
+	0x06, 0xe2, 0x31, 0x89, 0xd3, 0x5d, 0xc2, 0x49, 0x5f, 0x44, 0x9a, 0x52, 0xb9, 0x55, 0x85, 0x9c,
+	0x28, 0x3a, 0x32, 0x64, 0x7a, 0x41, 0x3c, 0x82, 0x7b, 0x5b, 0xb6, 0x0e, 0x97, 0x07, 0xbb, 0x9a,
+	0x07, 0x22, 0x7c, 0x43, 0xc3, 0xcf, 0x41, 0xc9, 0x58, 0xb8, 0xa1, 0xe5, 0x32, 0x64, 0x20, 0xfe,
+	0xca, 0x55, 0x78, 0x15, 0x83, 0x52, 0xef, 0x87, 0x87, 0xf0, 0xc0, 0xfb, 0x6c, 0x59, 0xb3, 0xc0,
+	0x76, 0x3f, 0x58, 0x81, 0xe3, 0x3a, 0x96, 0x2a, 0xe1, 0x17, 0xf0, 0xa4, 0x21, 0xbe, 0x77, 0x6d,
+	0x7b, 0xee, 0x7c, 0xf4, 0x17, 0xc1, 0xcc, 0x75, 0x3f, 0xa9, 0x08, 0x3f, 0x86, 0x61, 0xa3, 0xec,
+	0x53, 0x6b, 0xe2, 0xcd, 0xe9, 0x42, 0x6d, 0xdd, 0x6a, 0x36, 0x9d, 0x53, 0x47, 0x95, 0xa7, 0x6f,
+	0x7f, 0x9d, 0x34, 0x74, 0x3c, 0x69, 0xe8, 0xef, 0x49, 0x43, 0x3f, 0xcf, 0x9a, 0x74, 0x3c, 0x6b,
+	0xd2, 0x9f, 0xb3, 0x26, 0x7d, 0x79, 0x76, 0xbd, 0x8e, 0x1f, 0xcd, 0xfb, 0x38, 0x64, 0xac, 0xf8,
+	0xda, 0x15, 0x97, 0xf1, 0xe6, 0xdf, 0x00, 0xf9, 0x2a, 0x43, 0x46, 0x43, 0x02, 0x00, 0x00,
+}
+
+func (m *TokenSunset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenSunset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenSunset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeakRatio != 0 {
+		i = encodeVarintTokenSunset(dAtA, i, uint64(m.PeakRatio))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.LegacyMinted) > 0 {
+		i -= len(m.LegacyMinted)
+		copy(dAtA[i:], m.LegacyMinted)
+		i = encodeVarintTokenSunset(dAtA, i, uint64(len(m.LegacyMinted)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SweptAt != 0 {
+		i = encodeVarintTokenSunset(dAtA, i, uint64(m.SweptAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SweptAmount) > 0 {
+		i -= len(m.SweptAmount)
+		copy(dAtA[i:], m.SweptAmount)
+		i = encodeVarintTokenSunset(dAtA, i, uint64(len(m.SweptAmount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Swept {
+		i--
+		if m.Swept {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Minted) > 0 {
+		i -= len(m.Minted)
+		copy(dAtA[i:], m.Minted)
+		i = encodeVarintTokenSunset(dAtA, i, uint64(len(m.Minted)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintTokenSunset(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SweepMode != 0 {
+		i = encodeVarintTokenSunset(dAtA, i, uint64(m.SweepMode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cap) > 0 {
+		i -= len(m.Cap)
+		copy(dAtA[i:], m.Cap)
+		i = encodeVarintTokenSunset(dAtA, i, uint64(len(m.Cap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintTokenSunset(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Token != 0 {
+		i = encodeVarintTokenSunset(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTokenSunset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTokenSunset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenSunset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != 0 {
+		n += 1 + sovTokenSunset(uint64(m.Token))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTokenSunset(uint64(m.EndTime))
+	}
+	l = len(m.Cap)
+	if l > 0 {
+		n += 1 + l + sovTokenSunset(uint64(l))
+	}
+	if m.SweepMode != 0 {
+		n += 1 + sovTokenSunset(uint64(m.SweepMode))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovTokenSunset(uint64(l))
+	}
+	l = len(m.Minted)
+	if l > 0 {
+		n += 1 + l + sovTokenSunset(uint64(l))
+	}
+	if m.Swept {
+		n += 2
+	}
+	l = len(m.SweptAmount)
+	if l > 0 {
+		n += 1 + l + sovTokenSunset(uint64(l))
+	}
+	if m.SweptAt != 0 {
+		n += 1 + sovTokenSunset(uint64(m.SweptAt))
+	}
+	l = len(m.LegacyMinted)
+	if l > 0 {
+		n += 1 + l + sovTokenSunset(uint64(l))
+	}
+	if m.PeakRatio != 0 {
+		n += 1 + sovTokenSunset(uint64(m.PeakRatio))
+	}
+	return n
+}
+
+func sovTokenSunset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTokenSunset(x uint64) (n int) {
+	return sovTokenSunset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenSunset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenSunset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenSunset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenSunset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepMode", wireType)
+			}
+			m.SweepMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SweepMode |= SweepMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swept", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Swept = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweptAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SweptAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweptAt", wireType)
+			}
+			m.SweptAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SweptAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyMinted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakRatio", wireType)
+			}
+			m.PeakRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeakRatio |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenSunset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenSunset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTokenSunset(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTokenSunset
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenSunset
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTokenSunset
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTokenSunset
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTokenSunset
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTokenSunset        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTokenSunset          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTokenSunset = fmt.Errorf("proto: unexpected end of group")
+)
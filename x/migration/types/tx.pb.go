@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -35,6 +36,17 @@ type MsgMigrate struct {
 	Amount      string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
 	Token       uint64 `protobuf:"varint,6,opt,name=token,proto3" json:"token,omitempty"`
 	LogIndex    uint64 `protobuf:"varint,7,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	// EVM chain id of the network the tokens have been deposited on. Zero means Ethereum mainnet
+	SourceChainId uint64 `protobuf:"varint,8,opt,name=sourceChainId,proto3" json:"sourceChainId,omitempty"`
+	// lockup tier chosen by the holder. Zero is the default lockup
+	LockupTier uint64 `protobuf:"varint,9,opt,name=lockupTier,proto3" json:"lockupTier,omitempty"`
+	// block number of the deposit on the source chain, used to bucket the stored migrations
+	SourceBlock uint64 `protobuf:"varint,10,opt,name=sourceBlock,proto3" json:"sourceBlock,omitempty"`
+	// optional CosmWasm contract receiving the migrated tokens along with the JSON callback message. Only
+	// migrations released at once can be sent to a contract, the ones that would vest are rejected. The
+	// destination address is the fallback, receiving the tokens if the contract fails
+	ContractAddress string `protobuf:"bytes,11,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	CallbackMsg     string `protobuf:"bytes,12,opt,name=callbackMsg,proto3" json:"callbackMsg,omitempty"`
 }
 
 func (m *MsgMigrate) Reset()         { *m = MsgMigrate{} }
@@ -119,6 +131,41 @@ func (m *MsgMigrate) GetLogIndex() uint64 {
 	return 0
 }
 
+func (m *MsgMigrate) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+func (m *MsgMigrate) GetLockupTier() uint64 {
+	if m != nil {
+		return m.LockupTier
+	}
+	return 0
+}
+
+func (m *MsgMigrate) GetSourceBlock() uint64 {
+	if m != nil {
+		return m.SourceBlock
+	}
+	return 0
+}
+
+func (m *MsgMigrate) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgMigrate) GetCallbackMsg() string {
+	if m != nil {
+		return m.CallbackMsg
+	}
+	return ""
+}
+
 type MsgMigrateResponse struct {
 }
 
@@ -435,665 +482,8202 @@ func (m *MsgUpdateConfigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateConfigResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgMigrate)(nil), "selfchain.migration.MsgMigrate")
-	proto.RegisterType((*MsgMigrateResponse)(nil), "selfchain.migration.MsgMigrateResponse")
-	proto.RegisterType((*MsgAddMigrator)(nil), "selfchain.migration.MsgAddMigrator")
-	proto.RegisterType((*MsgAddMigratorResponse)(nil), "selfchain.migration.MsgAddMigratorResponse")
-	proto.RegisterType((*MsgRemoveMigrator)(nil), "selfchain.migration.MsgRemoveMigrator")
-	proto.RegisterType((*MsgRemoveMigratorResponse)(nil), "selfchain.migration.MsgRemoveMigratorResponse")
-	proto.RegisterType((*MsgUpdateConfig)(nil), "selfchain.migration.MsgUpdateConfig")
-	proto.RegisterType((*MsgUpdateConfigResponse)(nil), "selfchain.migration.MsgUpdateConfigResponse")
+// MsgRevertMigration reverts a processed migration. It can only be executed by
+// the governance authority.
+type MsgRevertMigration struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MsgHash   string `protobuf:"bytes,2,opt,name=msgHash,proto3" json:"msgHash,omitempty"`
 }
 
-func init() { proto.RegisterFile("selfchain/migration/tx.proto", fileDescriptor_956be144f468c705) }
+func (m *MsgRevertMigration) Reset()         { *m = MsgRevertMigration{} }
+func (m *MsgRevertMigration) String() string { return proto.CompactTextString(m) }
+func (*MsgRevertMigration) ProtoMessage()    {}
+func (*MsgRevertMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{8}
+}
+func (m *MsgRevertMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevertMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevertMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevertMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevertMigration.Merge(m, src)
+}
+func (m *MsgRevertMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevertMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevertMigration.DiscardUnknown(m)
+}
 
-var fileDescriptor_956be144f468c705 = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0xaf, 0x69, 0xd6, 0x8e, 0x6f, 0xd3, 0x26, 0xcc, 0x34, 0xbc, 0x0c, 0x85, 0x2a, 0x20, 0xa8,
-	0x04, 0xca, 0x24, 0x10, 0x0f, 0x50, 0x86, 0x10, 0x3b, 0xe4, 0x12, 0xc4, 0x85, 0x0b, 0xca, 0x16,
-	0xc7, 0x89, 0x68, 0xec, 0x28, 0xf6, 0xa6, 0xf0, 0x08, 0xdc, 0x78, 0x0d, 0xde, 0x04, 0x71, 0xda,
-	0x91, 0x23, 0x6a, 0x5f, 0x04, 0xe1, 0x24, 0x6e, 0x52, 0xd6, 0x52, 0x89, 0xe3, 0xef, 0x8f, 0xbf,
-	0x9f, 0x3f, 0xfd, 0x2c, 0xc3, 0x7d, 0x49, 0xa7, 0xf1, 0x45, 0x12, 0xa6, 0xfc, 0x24, 0x4b, 0x59,
-	0x11, 0xaa, 0x54, 0xf0, 0x13, 0x55, 0x7a, 0x79, 0x21, 0x94, 0xc0, 0x77, 0x8d, 0xea, 0x19, 0xd5,
-	0xfd, 0x81, 0x00, 0x7c, 0xc9, 0x7c, 0x4d, 0x50, 0x4c, 0x60, 0x78, 0x51, 0xd0, 0x50, 0x89, 0x82,
-	0xa0, 0x11, 0x1a, 0xdf, 0x0e, 0x1a, 0x88, 0x0f, 0x61, 0xa0, 0xca, 0xb7, 0xa1, 0x4c, 0xc8, 0x2d,
-	0x2d, 0xd4, 0x08, 0x3b, 0x00, 0x54, 0x25, 0x93, 0x28, 0x2a, 0xa8, 0x94, 0xa4, 0xaf, 0xb5, 0x16,
-	0x83, 0x47, 0xb0, 0x13, 0x51, 0xa9, 0x1a, 0x83, 0xa5, 0x0d, 0x6d, 0xea, 0xcf, 0xe4, 0x30, 0x13,
-	0x97, 0x5c, 0x91, 0xad, 0x6a, 0x72, 0x85, 0xf0, 0x01, 0x6c, 0x29, 0xf1, 0x89, 0x72, 0x32, 0x18,
-	0xa1, 0xb1, 0x15, 0x54, 0x00, 0xdb, 0xb0, 0x3d, 0x15, 0xec, 0x8c, 0x47, 0xb4, 0x24, 0x43, 0x2d,
-	0x18, 0xec, 0x1e, 0x00, 0x5e, 0xec, 0x12, 0x50, 0x99, 0x0b, 0x2e, 0xa9, 0xfb, 0x06, 0xf6, 0x7c,
-	0xc9, 0x26, 0x51, 0x54, 0x09, 0xa2, 0x58, 0xb3, 0xa5, 0x0d, 0xdb, 0x59, 0xed, 0xaa, 0xf7, 0x34,
-	0xd8, 0x25, 0x70, 0xd8, 0x9d, 0x63, 0x12, 0xce, 0xe0, 0x8e, 0x2f, 0x59, 0x40, 0x33, 0x71, 0x45,
-	0xff, 0x33, 0xe4, 0x18, 0x8e, 0xfe, 0x1a, 0x65, 0x72, 0xbe, 0x21, 0xd8, 0xf7, 0x25, 0x7b, 0x9f,
-	0x47, 0xa1, 0xa2, 0xa7, 0x82, 0xc7, 0x29, 0x5b, 0x13, 0x33, 0x86, 0xfd, 0x2b, 0x2a, 0x55, 0xca,
-	0xd9, 0xeb, 0xcb, 0xaa, 0x6d, 0x9d, 0x66, 0x05, 0xcb, 0x34, 0x76, 0x61, 0xb7, 0xa6, 0x4e, 0xa7,
-	0x69, 0x1c, 0xeb, 0x16, 0xad, 0xa0, 0xc3, 0x61, 0x0f, 0x70, 0x96, 0x72, 0xbf, 0x79, 0x38, 0x93,
-	0xaa, 0x31, 0x4b, 0x3b, 0x6f, 0x50, 0xdc, 0x23, 0xb8, 0xb7, 0x74, 0xd5, 0x66, 0x8d, 0xe7, 0x5f,
-	0xfa, 0xd0, 0xf7, 0x25, 0xc3, 0xef, 0x60, 0xd8, 0xbc, 0xbb, 0x07, 0xde, 0x0d, 0x8f, 0xd3, 0x5b,
-	0x94, 0x69, 0x3f, 0xf9, 0x87, 0xa1, 0x19, 0x8e, 0x3f, 0xc2, 0x4e, 0xbb, 0xea, 0x87, 0xab, 0xce,
-	0xb5, 0x4c, 0xf6, 0xd3, 0x0d, 0x4c, 0x26, 0x20, 0x81, 0xbd, 0xa5, 0xa6, 0x1f, 0xaf, 0x3a, 0xde,
-	0xf5, 0xd9, 0xde, 0x66, 0x3e, 0x93, 0x74, 0x0e, 0xbb, 0x9d, 0xaa, 0x1f, 0xad, 0x3a, 0xdf, 0x76,
-	0xd9, 0xcf, 0x36, 0x71, 0x35, 0x19, 0xaf, 0x5e, 0x7e, 0x9f, 0x39, 0xe8, 0x7a, 0xe6, 0xa0, 0x5f,
-	0x33, 0x07, 0x7d, 0x9d, 0x3b, 0xbd, 0xeb, 0xb9, 0xd3, 0xfb, 0x39, 0x77, 0x7a, 0x1f, 0x8e, 0x17,
-	0x9f, 0x49, 0xd9, 0xfe, 0x4e, 0x3e, 0xe7, 0x54, 0x9e, 0x0f, 0xf4, 0x97, 0xf2, 0xe2, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x14, 0x38, 0x9a, 0x8b, 0x72, 0x04, 0x00, 0x00,
+var xxx_messageInfo_MsgRevertMigration proto.InternalMessageInfo
+
+func (m *MsgRevertMigration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgRevertMigration) GetMsgHash() string {
+	if m != nil {
+		return m.MsgHash
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type MsgRevertMigrationResponse struct {
+	ClawedBack string `protobuf:"bytes,1,opt,name=clawedBack,proto3" json:"clawedBack,omitempty"`
+	Recovered  string `protobuf:"bytes,2,opt,name=recovered,proto3" json:"recovered,omitempty"`
+	Burned     string `protobuf:"bytes,3,opt,name=burned,proto3" json:"burned,omitempty"`
+	Shortfall  string `protobuf:"bytes,4,opt,name=shortfall,proto3" json:"shortfall,omitempty"`
+	// breakdown of the shortfall: released tokens the recipient no longer holds, the protocol fee paid out
+	// when migrating and the fee allowance granted to the recipient
+	ShortfallSpent        string `protobuf:"bytes,5,opt,name=shortfallSpent,proto3" json:"shortfallSpent,omitempty"`
+	ShortfallFee          string `protobuf:"bytes,6,opt,name=shortfallFee,proto3" json:"shortfallFee,omitempty"`
+	ShortfallFeeAllowance string `protobuf:"bytes,7,opt,name=shortfallFeeAllowance,proto3" json:"shortfallFeeAllowance,omitempty"`
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error)
-	AddMigrator(ctx context.Context, in *MsgAddMigrator, opts ...grpc.CallOption) (*MsgAddMigratorResponse, error)
-	RemoveMigrator(ctx context.Context, in *MsgRemoveMigrator, opts ...grpc.CallOption) (*MsgRemoveMigratorResponse, error)
-	UpdateConfig(ctx context.Context, in *MsgUpdateConfig, opts ...grpc.CallOption) (*MsgUpdateConfigResponse, error)
+func (m *MsgRevertMigrationResponse) Reset()         { *m = MsgRevertMigrationResponse{} }
+func (m *MsgRevertMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevertMigrationResponse) ProtoMessage()    {}
+func (*MsgRevertMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{9}
+}
+func (m *MsgRevertMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevertMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevertMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevertMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevertMigrationResponse.Merge(m, src)
+}
+func (m *MsgRevertMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevertMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevertMigrationResponse.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_MsgRevertMigrationResponse proto.InternalMessageInfo
+
+func (m *MsgRevertMigrationResponse) GetClawedBack() string {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRevertMigrationResponse) GetRecovered() string {
+	if m != nil {
+		return m.Recovered
+	}
+	return ""
 }
 
-func (c *msgClient) Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error) {
-	out := new(MsgMigrateResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/Migrate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevertMigrationResponse) GetBurned() string {
+	if m != nil {
+		return m.Burned
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddMigrator(ctx context.Context, in *MsgAddMigrator, opts ...grpc.CallOption) (*MsgAddMigratorResponse, error) {
-	out := new(MsgAddMigratorResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/AddMigrator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevertMigrationResponse) GetShortfall() string {
+	if m != nil {
+		return m.Shortfall
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveMigrator(ctx context.Context, in *MsgRemoveMigrator, opts ...grpc.CallOption) (*MsgRemoveMigratorResponse, error) {
-	out := new(MsgRemoveMigratorResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/RemoveMigrator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevertMigrationResponse) GetShortfallSpent() string {
+	if m != nil {
+		return m.ShortfallSpent
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateConfig(ctx context.Context, in *MsgUpdateConfig, opts ...grpc.CallOption) (*MsgUpdateConfigResponse, error) {
-	out := new(MsgUpdateConfigResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/UpdateConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevertMigrationResponse) GetShortfallFee() string {
+	if m != nil {
+		return m.ShortfallFee
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Migrate(context.Context, *MsgMigrate) (*MsgMigrateResponse, error)
-	AddMigrator(context.Context, *MsgAddMigrator) (*MsgAddMigratorResponse, error)
-	RemoveMigrator(context.Context, *MsgRemoveMigrator) (*MsgRemoveMigratorResponse, error)
-	UpdateConfig(context.Context, *MsgUpdateConfig) (*MsgUpdateConfigResponse, error)
+func (m *MsgRevertMigrationResponse) GetShortfallFeeAllowance() string {
+	if m != nil {
+		return m.ShortfallFeeAllowance
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSetTokenSunset configures the migration deadline of a token and what happens to its unclaimed
+// allocation once the deadline has passed
+type MsgSetTokenSunset struct {
+	Creator   string    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Token     uint64    `protobuf:"varint,2,opt,name=token,proto3" json:"token,omitempty"`
+	EndTime   uint64    `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Cap       string    `protobuf:"bytes,4,opt,name=cap,proto3" json:"cap,omitempty"`
+	SweepMode SweepMode `protobuf:"varint,5,opt,name=sweepMode,proto3,enum=selfchain.migration.SweepMode" json:"sweepMode,omitempty"`
+	Treasury  string    `protobuf:"bytes,6,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// amount of uslf minted for the token before minted amounts were tracked. Required with a cap
+	LegacyMinted string `protobuf:"bytes,7,opt,name=legacyMinted,proto3" json:"legacyMinted,omitempty"`
 }
 
-func (*UnimplementedMsgServer) Migrate(ctx context.Context, req *MsgMigrate) (*MsgMigrateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+func (m *MsgSetTokenSunset) Reset()         { *m = MsgSetTokenSunset{} }
+func (m *MsgSetTokenSunset) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTokenSunset) ProtoMessage()    {}
+func (*MsgSetTokenSunset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{10}
 }
-func (*UnimplementedMsgServer) AddMigrator(ctx context.Context, req *MsgAddMigrator) (*MsgAddMigratorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMigrator not implemented")
+func (m *MsgSetTokenSunset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RemoveMigrator(ctx context.Context, req *MsgRemoveMigrator) (*MsgRemoveMigratorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMigrator not implemented")
+func (m *MsgSetTokenSunset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTokenSunset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateConfig(ctx context.Context, req *MsgUpdateConfig) (*MsgUpdateConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfig not implemented")
+func (m *MsgSetTokenSunset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTokenSunset.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSetTokenSunset) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTokenSunset) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTokenSunset.DiscardUnknown(m)
 }
 
-func _Msg_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMigrate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Migrate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Msg/Migrate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Migrate(ctx, req.(*MsgMigrate))
+var xxx_messageInfo_MsgSetTokenSunset proto.InternalMessageInfo
+
+func (m *MsgSetTokenSunset) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddMigrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMigrator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMigrator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Msg/AddMigrator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMigrator(ctx, req.(*MsgAddMigrator))
+func (m *MsgSetTokenSunset) GetToken() uint64 {
+	if m != nil {
+		return m.Token
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RemoveMigrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMigrator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMigrator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Msg/RemoveMigrator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMigrator(ctx, req.(*MsgRemoveMigrator))
+func (m *MsgSetTokenSunset) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateConfig)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetTokenSunset) GetCap() string {
+	if m != nil {
+		return m.Cap
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateConfig(ctx, in)
+	return ""
+}
+
+func (m *MsgSetTokenSunset) GetSweepMode() SweepMode {
+	if m != nil {
+		return m.SweepMode
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.migration.Msg/UpdateConfig",
+	return SweepMode_SWEEP_MODE_NONE
+}
+
+func (m *MsgSetTokenSunset) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateConfig(ctx, req.(*MsgUpdateConfig))
+	return ""
+}
+
+func (m *MsgSetTokenSunset) GetLegacyMinted() string {
+	if m != nil {
+		return m.LegacyMinted
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "selfchain.migration.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Migrate",
-			Handler:    _Msg_Migrate_Handler,
-		},
-		{
-			MethodName: "AddMigrator",
-			Handler:    _Msg_AddMigrator_Handler,
-		},
-		{
-			MethodName: "RemoveMigrator",
-			Handler:    _Msg_RemoveMigrator_Handler,
-		},
-		{
-			MethodName: "UpdateConfig",
-			Handler:    _Msg_UpdateConfig_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "selfchain/migration/tx.proto",
+type MsgSetTokenSunsetResponse struct {
 }
 
-func (m *MsgMigrate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTokenSunsetResponse) Reset()         { *m = MsgSetTokenSunsetResponse{} }
+func (m *MsgSetTokenSunsetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTokenSunsetResponse) ProtoMessage()    {}
+func (*MsgSetTokenSunsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{11}
+}
+func (m *MsgSetTokenSunsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTokenSunsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTokenSunsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetTokenSunsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTokenSunsetResponse.Merge(m, src)
+}
+func (m *MsgSetTokenSunsetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTokenSunsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTokenSunsetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMigrate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetTokenSunsetResponse proto.InternalMessageInfo
+
+// MsgSetSourceChain adds or updates an EVM network tokens can be migrated from
+type MsgSetSourceChain struct {
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId       uint64   `protobuf:"varint,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Name          string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Confirmations uint64   `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Tokens        []uint64 `protobuf:"varint,5,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Enabled       bool     `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgMigrate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Token != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Token))
-		i--
-		dAtA[i] = 0x30
+func (m *MsgSetSourceChain) Reset()         { *m = MsgSetSourceChain{} }
+func (m *MsgSetSourceChain) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSourceChain) ProtoMessage()    {}
+func (*MsgSetSourceChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{12}
+}
+func (m *MsgSetSourceChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSourceChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSourceChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *MsgSetSourceChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSourceChain.Merge(m, src)
+}
+func (m *MsgSetSourceChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSourceChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSourceChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSourceChain proto.InternalMessageInfo
+
+func (m *MsgSetSourceChain) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.DestAddress) > 0 {
-		i -= len(m.DestAddress)
-		copy(dAtA[i:], m.DestAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *MsgSetSourceChain) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *MsgSetSourceChain) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgSetSourceChain) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgSetSourceChain) GetTokens() []uint64 {
+	if m != nil {
+		return m.Tokens
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgMigrateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetSourceChain) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgMigrateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetSourceChainResponse struct {
 }
 
-func (m *MsgMigrateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetSourceChainResponse) Reset()         { *m = MsgSetSourceChainResponse{} }
+func (m *MsgSetSourceChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSourceChainResponse) ProtoMessage()    {}
+func (*MsgSetSourceChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{13}
+}
+func (m *MsgSetSourceChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSourceChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSourceChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSourceChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSourceChainResponse.Merge(m, src)
+}
+func (m *MsgSetSourceChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSourceChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSourceChainResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddMigrator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetSourceChainResponse proto.InternalMessageInfo
+
+// MsgSetMigrationFee configures the protocol fee taken from the migrations of a token
+type MsgSetMigrationFee struct {
+	Creator     string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Token       uint64       `protobuf:"varint,2,opt,name=token,proto3" json:"token,omitempty"`
+	BasisPoints uint64       `protobuf:"varint,3,opt,name=basisPoints,proto3" json:"basisPoints,omitempty"`
+	WaiveBelow  string       `protobuf:"bytes,4,opt,name=waiveBelow,proto3" json:"waiveBelow,omitempty"`
+	WaiveAbove  string       `protobuf:"bytes,5,opt,name=waiveAbove,proto3" json:"waiveAbove,omitempty"`
+	Recipient   FeeRecipient `protobuf:"varint,6,opt,name=recipient,proto3,enum=selfchain.migration.FeeRecipient" json:"recipient,omitempty"`
+	Treasury    string       `protobuf:"bytes,7,opt,name=treasury,proto3" json:"treasury,omitempty"`
+}
+
+func (m *MsgSetMigrationFee) Reset()         { *m = MsgSetMigrationFee{} }
+func (m *MsgSetMigrationFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMigrationFee) ProtoMessage()    {}
+func (*MsgSetMigrationFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{14}
+}
+func (m *MsgSetMigrationFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMigrationFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMigrationFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetMigrationFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMigrationFee.Merge(m, src)
+}
+func (m *MsgSetMigrationFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMigrationFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMigrationFee.DiscardUnknown(m)
 }
 
-func (m *MsgAddMigrator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetMigrationFee proto.InternalMessageInfo
+
+func (m *MsgSetMigrationFee) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgAddMigrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Migrator) > 0 {
-		i -= len(m.Migrator)
-		copy(dAtA[i:], m.Migrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Migrator)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSetMigrationFee) GetToken() uint64 {
+	if m != nil {
+		return m.Token
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgSetMigrationFee) GetBasisPoints() uint64 {
+	if m != nil {
+		return m.BasisPoints
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgAddMigratorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMigrationFee) GetWaiveBelow() string {
+	if m != nil {
+		return m.WaiveBelow
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddMigratorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetMigrationFee) GetWaiveAbove() string {
+	if m != nil {
+		return m.WaiveAbove
+	}
+	return ""
 }
 
-func (m *MsgAddMigratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetMigrationFee) GetRecipient() FeeRecipient {
+	if m != nil {
+		return m.Recipient
+	}
+	return FeeRecipient_FEE_RECIPIENT_COMMUNITY_POOL
 }
 
-func (m *MsgRemoveMigrator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMigrationFee) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRemoveMigrator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetMigrationFeeResponse struct {
 }
 
-func (m *MsgRemoveMigrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Migrator) > 0 {
-		i -= len(m.Migrator)
-		copy(dAtA[i:], m.Migrator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Migrator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetMigrationFeeResponse) Reset()         { *m = MsgSetMigrationFeeResponse{} }
+func (m *MsgSetMigrationFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMigrationFeeResponse) ProtoMessage()    {}
+func (*MsgSetMigrationFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{15}
 }
-
-func (m *MsgRemoveMigratorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMigrationFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMigrationFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMigrationFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRemoveMigratorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetMigrationFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMigrationFeeResponse.Merge(m, src)
+}
+func (m *MsgSetMigrationFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMigrationFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMigrationFeeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveMigratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetMigrationFeeResponse proto.InternalMessageInfo
+
+type MsgSetLockupTier struct {
+	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tier               uint64 `protobuf:"varint,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	DurationMultiplier uint64 `protobuf:"varint,3,opt,name=durationMultiplier,proto3" json:"durationMultiplier,omitempty"`
+	BonusRatio         uint64 `protobuf:"varint,4,opt,name=bonusRatio,proto3" json:"bonusRatio,omitempty"`
 }
 
-func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetLockupTier) Reset()         { *m = MsgSetLockupTier{} }
+func (m *MsgSetLockupTier) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLockupTier) ProtoMessage()    {}
+func (*MsgSetLockupTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{16}
+}
+func (m *MsgSetLockupTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLockupTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLockupTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetLockupTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLockupTier.Merge(m, src)
+}
+func (m *MsgSetLockupTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLockupTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLockupTier.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinMigrationAmount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MinMigrationAmount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.VestingCliff != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VestingCliff))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.VestingDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VestingDuration))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_MsgSetLockupTier proto.InternalMessageInfo
+
+func (m *MsgSetLockupTier) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSetLockupTier) GetTier() uint64 {
+	if m != nil {
+		return m.Tier
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetLockupTier) GetDurationMultiplier() uint64 {
+	if m != nil {
+		return m.DurationMultiplier
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetLockupTier) GetBonusRatio() uint64 {
+	if m != nil {
+		return m.BonusRatio
+	}
+	return 0
 }
 
-func (m *MsgUpdateConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetLockupTierResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetLockupTierResponse) Reset()         { *m = MsgSetLockupTierResponse{} }
+func (m *MsgSetLockupTierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLockupTierResponse) ProtoMessage()    {}
+func (*MsgSetLockupTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{17}
 }
-func (m *MsgMigrate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DestAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Token != 0 {
-		n += 1 + sovTx(uint64(m.Token))
-	}
-	if m.LogIndex != 0 {
-		n += 1 + sovTx(uint64(m.LogIndex))
+func (m *MsgSetLockupTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLockupTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLockupTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetLockupTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLockupTierResponse.Merge(m, src)
+}
+func (m *MsgSetLockupTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLockupTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLockupTierResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMigrateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetLockupTierResponse proto.InternalMessageInfo
+
+type MsgRemoveLockupTier struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tier    uint64 `protobuf:"varint,2,opt,name=tier,proto3" json:"tier,omitempty"`
 }
 
-func (m *MsgAddMigrator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Migrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveLockupTier) Reset()         { *m = MsgRemoveLockupTier{} }
+func (m *MsgRemoveLockupTier) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveLockupTier) ProtoMessage()    {}
+func (*MsgRemoveLockupTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{18}
+}
+func (m *MsgRemoveLockupTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveLockupTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveLockupTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRemoveLockupTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveLockupTier.Merge(m, src)
+}
+func (m *MsgRemoveLockupTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveLockupTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveLockupTier.DiscardUnknown(m)
 }
 
-func (m *MsgAddMigratorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRemoveLockupTier proto.InternalMessageInfo
+
+func (m *MsgRemoveLockupTier) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgRemoveMigrator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Migrator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveLockupTier) GetTier() uint64 {
+	if m != nil {
+		return m.Tier
 	}
-	return n
+	return 0
 }
 
-func (m *MsgRemoveMigratorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type MsgRemoveLockupTierResponse struct {
 }
 
-func (m *MsgUpdateConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveLockupTierResponse) Reset()         { *m = MsgRemoveLockupTierResponse{} }
+func (m *MsgRemoveLockupTierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveLockupTierResponse) ProtoMessage()    {}
+func (*MsgRemoveLockupTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{19}
+}
+func (m *MsgRemoveLockupTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveLockupTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveLockupTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveLockupTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveLockupTierResponse.Merge(m, src)
+}
+func (m *MsgRemoveLockupTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveLockupTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveLockupTierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveLockupTierResponse proto.InternalMessageInfo
+
+type MsgSetRatioSchedule struct {
+	Creator       string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Token         uint64             `protobuf:"varint,2,opt,name=token,proto3" json:"token,omitempty"`
+	Interpolation RatioInterpolation `protobuf:"varint,3,opt,name=interpolation,proto3,enum=selfchain.migration.RatioInterpolation" json:"interpolation,omitempty"`
+	Points        []RatioPoint       `protobuf:"bytes,4,rep,name=points,proto3" json:"points"`
+}
+
+func (m *MsgSetRatioSchedule) Reset()         { *m = MsgSetRatioSchedule{} }
+func (m *MsgSetRatioSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRatioSchedule) ProtoMessage()    {}
+func (*MsgSetRatioSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{20}
+}
+func (m *MsgSetRatioSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRatioSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRatioSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRatioSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRatioSchedule.Merge(m, src)
+}
+func (m *MsgSetRatioSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRatioSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRatioSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRatioSchedule proto.InternalMessageInfo
+
+func (m *MsgSetRatioSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetRatioSchedule) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *MsgSetRatioSchedule) GetInterpolation() RatioInterpolation {
+	if m != nil {
+		return m.Interpolation
+	}
+	return RatioInterpolation_RATIO_INTERPOLATION_STEP
+}
+
+func (m *MsgSetRatioSchedule) GetPoints() []RatioPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type MsgSetRatioScheduleResponse struct {
+}
+
+func (m *MsgSetRatioScheduleResponse) Reset()         { *m = MsgSetRatioScheduleResponse{} }
+func (m *MsgSetRatioScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRatioScheduleResponse) ProtoMessage()    {}
+func (*MsgSetRatioScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{21}
+}
+func (m *MsgSetRatioScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRatioScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRatioScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRatioScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRatioScheduleResponse.Merge(m, src)
+}
+func (m *MsgSetRatioScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRatioScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRatioScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRatioScheduleResponse proto.InternalMessageInfo
+
+type MsgRemoveRatioSchedule struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Token   uint64 `protobuf:"varint,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *MsgRemoveRatioSchedule) Reset()         { *m = MsgRemoveRatioSchedule{} }
+func (m *MsgRemoveRatioSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRatioSchedule) ProtoMessage()    {}
+func (*MsgRemoveRatioSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{22}
+}
+func (m *MsgRemoveRatioSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRatioSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRatioSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRatioSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRatioSchedule.Merge(m, src)
+}
+func (m *MsgRemoveRatioSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRatioSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRatioSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRatioSchedule proto.InternalMessageInfo
+
+func (m *MsgRemoveRatioSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRemoveRatioSchedule) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+type MsgRemoveRatioScheduleResponse struct {
+}
+
+func (m *MsgRemoveRatioScheduleResponse) Reset()         { *m = MsgRemoveRatioScheduleResponse{} }
+func (m *MsgRemoveRatioScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRatioScheduleResponse) ProtoMessage()    {}
+func (*MsgRemoveRatioScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{23}
+}
+func (m *MsgRemoveRatioScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRatioScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRatioScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRatioScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRatioScheduleResponse.Merge(m, src)
+}
+func (m *MsgRemoveRatioScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRatioScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRatioScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRatioScheduleResponse proto.InternalMessageInfo
+
+type MsgSetWithdrawalConfig struct {
+	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SignatureThreshold uint64 `protobuf:"varint,2,opt,name=signatureThreshold,proto3" json:"signatureThreshold,omitempty"`
+	Expiry             uint64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	RefundDelay        uint64 `protobuf:"varint,4,opt,name=refundDelay,proto3" json:"refundDelay,omitempty"`
+}
+
+func (m *MsgSetWithdrawalConfig) Reset()         { *m = MsgSetWithdrawalConfig{} }
+func (m *MsgSetWithdrawalConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawalConfig) ProtoMessage()    {}
+func (*MsgSetWithdrawalConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{24}
+}
+func (m *MsgSetWithdrawalConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawalConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawalConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetWithdrawalConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawalConfig.Merge(m, src)
+}
+func (m *MsgSetWithdrawalConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawalConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawalConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawalConfig proto.InternalMessageInfo
+
+func (m *MsgSetWithdrawalConfig) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetWithdrawalConfig) GetSignatureThreshold() uint64 {
+	if m != nil {
+		return m.SignatureThreshold
+	}
+	return 0
+}
+
+func (m *MsgSetWithdrawalConfig) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *MsgSetWithdrawalConfig) GetRefundDelay() uint64 {
+	if m != nil {
+		return m.RefundDelay
+	}
+	return 0
+}
+
+type MsgSetWithdrawalConfigResponse struct {
+}
+
+func (m *MsgSetWithdrawalConfigResponse) Reset()         { *m = MsgSetWithdrawalConfigResponse{} }
+func (m *MsgSetWithdrawalConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawalConfigResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawalConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{25}
+}
+func (m *MsgSetWithdrawalConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawalConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawalConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetWithdrawalConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawalConfigResponse.Merge(m, src)
+}
+func (m *MsgSetWithdrawalConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawalConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawalConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawalConfigResponse proto.InternalMessageInfo
+
+type MsgRequestWithdrawal struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EthRecipient string `protobuf:"bytes,2,opt,name=ethRecipient,proto3" json:"ethRecipient,omitempty"`
+	// uslf to burn
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Token  uint64 `protobuf:"varint,4,opt,name=token,proto3" json:"token,omitempty"`
+	// EVM chain id of the network of the vault. Zero is Ethereum mainnet
+	SourceChainId uint64 `protobuf:"varint,5,opt,name=sourceChainId,proto3" json:"sourceChainId,omitempty"`
+}
+
+func (m *MsgRequestWithdrawal) Reset()         { *m = MsgRequestWithdrawal{} }
+func (m *MsgRequestWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestWithdrawal) ProtoMessage()    {}
+func (*MsgRequestWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{26}
+}
+func (m *MsgRequestWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestWithdrawal.Merge(m, src)
+}
+func (m *MsgRequestWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestWithdrawal proto.InternalMessageInfo
+
+func (m *MsgRequestWithdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRequestWithdrawal) GetEthRecipient() string {
+	if m != nil {
+		return m.EthRecipient
+	}
+	return ""
+}
+
+func (m *MsgRequestWithdrawal) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *MsgRequestWithdrawal) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *MsgRequestWithdrawal) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+type MsgRequestWithdrawalResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRequestWithdrawalResponse) Reset()         { *m = MsgRequestWithdrawalResponse{} }
+func (m *MsgRequestWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestWithdrawalResponse) ProtoMessage()    {}
+func (*MsgRequestWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{27}
+}
+func (m *MsgRequestWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgRequestWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestWithdrawalResponse proto.InternalMessageInfo
+
+func (m *MsgRequestWithdrawalResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgSignWithdrawal struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgSignWithdrawal) Reset()         { *m = MsgSignWithdrawal{} }
+func (m *MsgSignWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgSignWithdrawal) ProtoMessage()    {}
+func (*MsgSignWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{28}
+}
+func (m *MsgSignWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignWithdrawal.Merge(m, src)
+}
+func (m *MsgSignWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignWithdrawal proto.InternalMessageInfo
+
+func (m *MsgSignWithdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSignWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgSignWithdrawal) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type MsgSignWithdrawalResponse struct {
+}
+
+func (m *MsgSignWithdrawalResponse) Reset()         { *m = MsgSignWithdrawalResponse{} }
+func (m *MsgSignWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignWithdrawalResponse) ProtoMessage()    {}
+func (*MsgSignWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{29}
+}
+func (m *MsgSignWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgSignWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignWithdrawalResponse proto.InternalMessageInfo
+
+type MsgConfirmWithdrawal struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	EthTxHash string `protobuf:"bytes,3,opt,name=ethTxHash,proto3" json:"ethTxHash,omitempty"`
+}
+
+func (m *MsgConfirmWithdrawal) Reset()         { *m = MsgConfirmWithdrawal{} }
+func (m *MsgConfirmWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmWithdrawal) ProtoMessage()    {}
+func (*MsgConfirmWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{30}
+}
+func (m *MsgConfirmWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfirmWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmWithdrawal.Merge(m, src)
+}
+func (m *MsgConfirmWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfirmWithdrawal proto.InternalMessageInfo
+
+func (m *MsgConfirmWithdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgConfirmWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgConfirmWithdrawal) GetEthTxHash() string {
+	if m != nil {
+		return m.EthTxHash
+	}
+	return ""
+}
+
+type MsgConfirmWithdrawalResponse struct {
+}
+
+func (m *MsgConfirmWithdrawalResponse) Reset()         { *m = MsgConfirmWithdrawalResponse{} }
+func (m *MsgConfirmWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmWithdrawalResponse) ProtoMessage()    {}
+func (*MsgConfirmWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{31}
+}
+func (m *MsgConfirmWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfirmWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgConfirmWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfirmWithdrawalResponse proto.InternalMessageInfo
+
+// MsgCancelWithdrawal attests that the vault has not executed a signed withdrawal that has expired
+type MsgCancelWithdrawal struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelWithdrawal) Reset()         { *m = MsgCancelWithdrawal{} }
+func (m *MsgCancelWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdrawal) ProtoMessage()    {}
+func (*MsgCancelWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{32}
+}
+func (m *MsgCancelWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdrawal.Merge(m, src)
+}
+func (m *MsgCancelWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelWithdrawal proto.InternalMessageInfo
+
+func (m *MsgCancelWithdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelWithdrawalResponse struct {
+}
+
+func (m *MsgCancelWithdrawalResponse) Reset()         { *m = MsgCancelWithdrawalResponse{} }
+func (m *MsgCancelWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdrawalResponse) ProtoMessage()    {}
+func (*MsgCancelWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{33}
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelWithdrawalResponse proto.InternalMessageInfo
+
+type MsgArchiveMigrations struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Token   uint64 `protobuf:"varint,2,opt,name=token,proto3" json:"token,omitempty"`
+	// buckets ending before this source block are archived
+	BeforeBlock uint64 `protobuf:"varint,3,opt,name=beforeBlock,proto3" json:"beforeBlock,omitempty"`
+	// EVM chain id of the network the blocks belong to. Zero means Ethereum mainnet
+	SourceChainId uint64 `protobuf:"varint,4,opt,name=sourceChainId,proto3" json:"sourceChainId,omitempty"`
+}
+
+func (m *MsgArchiveMigrations) Reset()         { *m = MsgArchiveMigrations{} }
+func (m *MsgArchiveMigrations) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveMigrations) ProtoMessage()    {}
+func (*MsgArchiveMigrations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{34}
+}
+func (m *MsgArchiveMigrations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArchiveMigrations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveMigrations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgArchiveMigrations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveMigrations.Merge(m, src)
+}
+func (m *MsgArchiveMigrations) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveMigrations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveMigrations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgArchiveMigrations proto.InternalMessageInfo
+
+func (m *MsgArchiveMigrations) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgArchiveMigrations) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *MsgArchiveMigrations) GetBeforeBlock() uint64 {
+	if m != nil {
+		return m.BeforeBlock
+	}
+	return 0
+}
+
+func (m *MsgArchiveMigrations) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+type MsgArchiveMigrationsResponse struct {
+	Archived uint64 `protobuf:"varint,1,opt,name=archived,proto3" json:"archived,omitempty"`
+	// false when the limit per message has been reached and some migrations are left to archive
+	Done bool `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (m *MsgArchiveMigrationsResponse) Reset()         { *m = MsgArchiveMigrationsResponse{} }
+func (m *MsgArchiveMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveMigrationsResponse) ProtoMessage()    {}
+func (*MsgArchiveMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{35}
+}
+func (m *MsgArchiveMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArchiveMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveMigrationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgArchiveMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveMigrationsResponse.Merge(m, src)
+}
+func (m *MsgArchiveMigrationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveMigrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgArchiveMigrationsResponse proto.InternalMessageInfo
+
+func (m *MsgArchiveMigrationsResponse) GetArchived() uint64 {
+	if m != nil {
+		return m.Archived
+	}
+	return 0
+}
+
+func (m *MsgArchiveMigrationsResponse) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+type MsgSetFeeWaiverConfig struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	BlockGasBudget   uint64 `protobuf:"varint,2,opt,name=blockGasBudget,proto3" json:"blockGasBudget,omitempty"`
+	MigratorGasQuota uint64 `protobuf:"varint,3,opt,name=migratorGasQuota,proto3" json:"migratorGasQuota,omitempty"`
+}
+
+func (m *MsgSetFeeWaiverConfig) Reset()         { *m = MsgSetFeeWaiverConfig{} }
+func (m *MsgSetFeeWaiverConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeWaiverConfig) ProtoMessage()    {}
+func (*MsgSetFeeWaiverConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{36}
+}
+func (m *MsgSetFeeWaiverConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeWaiverConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeWaiverConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeWaiverConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeWaiverConfig.Merge(m, src)
+}
+func (m *MsgSetFeeWaiverConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeWaiverConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeWaiverConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeWaiverConfig proto.InternalMessageInfo
+
+func (m *MsgSetFeeWaiverConfig) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetFeeWaiverConfig) GetBlockGasBudget() uint64 {
+	if m != nil {
+		return m.BlockGasBudget
+	}
+	return 0
+}
+
+func (m *MsgSetFeeWaiverConfig) GetMigratorGasQuota() uint64 {
+	if m != nil {
+		return m.MigratorGasQuota
+	}
+	return 0
+}
+
+type MsgSetFeeWaiverConfigResponse struct {
+}
+
+func (m *MsgSetFeeWaiverConfigResponse) Reset()         { *m = MsgSetFeeWaiverConfigResponse{} }
+func (m *MsgSetFeeWaiverConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeWaiverConfigResponse) ProtoMessage()    {}
+func (*MsgSetFeeWaiverConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{37}
+}
+func (m *MsgSetFeeWaiverConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeWaiverConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeWaiverConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeWaiverConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeWaiverConfigResponse.Merge(m, src)
+}
+func (m *MsgSetFeeWaiverConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeWaiverConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeWaiverConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeWaiverConfigResponse proto.InternalMessageInfo
+
+type MsgSetFeeAllowanceConfig struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SpendLimit string `protobuf:"bytes,2,opt,name=spendLimit,proto3" json:"spendLimit,omitempty"`
+	Expiration uint64 `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *MsgSetFeeAllowanceConfig) Reset()         { *m = MsgSetFeeAllowanceConfig{} }
+func (m *MsgSetFeeAllowanceConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeAllowanceConfig) ProtoMessage()    {}
+func (*MsgSetFeeAllowanceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{38}
+}
+func (m *MsgSetFeeAllowanceConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeAllowanceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeAllowanceConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeAllowanceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeAllowanceConfig.Merge(m, src)
+}
+func (m *MsgSetFeeAllowanceConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeAllowanceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeAllowanceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeAllowanceConfig proto.InternalMessageInfo
+
+func (m *MsgSetFeeAllowanceConfig) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetFeeAllowanceConfig) GetSpendLimit() string {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return ""
+}
+
+func (m *MsgSetFeeAllowanceConfig) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type MsgSetFeeAllowanceConfigResponse struct {
+}
+
+func (m *MsgSetFeeAllowanceConfigResponse) Reset()         { *m = MsgSetFeeAllowanceConfigResponse{} }
+func (m *MsgSetFeeAllowanceConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeAllowanceConfigResponse) ProtoMessage()    {}
+func (*MsgSetFeeAllowanceConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_956be144f468c705, []int{39}
+}
+func (m *MsgSetFeeAllowanceConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeAllowanceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeAllowanceConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeAllowanceConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeAllowanceConfigResponse.Merge(m, src)
+}
+func (m *MsgSetFeeAllowanceConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeAllowanceConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeAllowanceConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeAllowanceConfigResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgMigrate)(nil), "selfchain.migration.MsgMigrate")
+	proto.RegisterType((*MsgMigrateResponse)(nil), "selfchain.migration.MsgMigrateResponse")
+	proto.RegisterType((*MsgAddMigrator)(nil), "selfchain.migration.MsgAddMigrator")
+	proto.RegisterType((*MsgAddMigratorResponse)(nil), "selfchain.migration.MsgAddMigratorResponse")
+	proto.RegisterType((*MsgRemoveMigrator)(nil), "selfchain.migration.MsgRemoveMigrator")
+	proto.RegisterType((*MsgRemoveMigratorResponse)(nil), "selfchain.migration.MsgRemoveMigratorResponse")
+	proto.RegisterType((*MsgUpdateConfig)(nil), "selfchain.migration.MsgUpdateConfig")
+	proto.RegisterType((*MsgUpdateConfigResponse)(nil), "selfchain.migration.MsgUpdateConfigResponse")
+	proto.RegisterType((*MsgRevertMigration)(nil), "selfchain.migration.MsgRevertMigration")
+	proto.RegisterType((*MsgRevertMigrationResponse)(nil), "selfchain.migration.MsgRevertMigrationResponse")
+	proto.RegisterType((*MsgSetTokenSunset)(nil), "selfchain.migration.MsgSetTokenSunset")
+	proto.RegisterType((*MsgSetTokenSunsetResponse)(nil), "selfchain.migration.MsgSetTokenSunsetResponse")
+	proto.RegisterType((*MsgSetSourceChain)(nil), "selfchain.migration.MsgSetSourceChain")
+	proto.RegisterType((*MsgSetSourceChainResponse)(nil), "selfchain.migration.MsgSetSourceChainResponse")
+	proto.RegisterType((*MsgSetMigrationFee)(nil), "selfchain.migration.MsgSetMigrationFee")
+	proto.RegisterType((*MsgSetMigrationFeeResponse)(nil), "selfchain.migration.MsgSetMigrationFeeResponse")
+	proto.RegisterType((*MsgSetLockupTier)(nil), "selfchain.migration.MsgSetLockupTier")
+	proto.RegisterType((*MsgSetLockupTierResponse)(nil), "selfchain.migration.MsgSetLockupTierResponse")
+	proto.RegisterType((*MsgRemoveLockupTier)(nil), "selfchain.migration.MsgRemoveLockupTier")
+	proto.RegisterType((*MsgRemoveLockupTierResponse)(nil), "selfchain.migration.MsgRemoveLockupTierResponse")
+	proto.RegisterType((*MsgSetRatioSchedule)(nil), "selfchain.migration.MsgSetRatioSchedule")
+	proto.RegisterType((*MsgSetRatioScheduleResponse)(nil), "selfchain.migration.MsgSetRatioScheduleResponse")
+	proto.RegisterType((*MsgRemoveRatioSchedule)(nil), "selfchain.migration.MsgRemoveRatioSchedule")
+	proto.RegisterType((*MsgRemoveRatioScheduleResponse)(nil), "selfchain.migration.MsgRemoveRatioScheduleResponse")
+	proto.RegisterType((*MsgSetWithdrawalConfig)(nil), "selfchain.migration.MsgSetWithdrawalConfig")
+	proto.RegisterType((*MsgSetWithdrawalConfigResponse)(nil), "selfchain.migration.MsgSetWithdrawalConfigResponse")
+	proto.RegisterType((*MsgRequestWithdrawal)(nil), "selfchain.migration.MsgRequestWithdrawal")
+	proto.RegisterType((*MsgRequestWithdrawalResponse)(nil), "selfchain.migration.MsgRequestWithdrawalResponse")
+	proto.RegisterType((*MsgSignWithdrawal)(nil), "selfchain.migration.MsgSignWithdrawal")
+	proto.RegisterType((*MsgSignWithdrawalResponse)(nil), "selfchain.migration.MsgSignWithdrawalResponse")
+	proto.RegisterType((*MsgConfirmWithdrawal)(nil), "selfchain.migration.MsgConfirmWithdrawal")
+	proto.RegisterType((*MsgConfirmWithdrawalResponse)(nil), "selfchain.migration.MsgConfirmWithdrawalResponse")
+	proto.RegisterType((*MsgCancelWithdrawal)(nil), "selfchain.migration.MsgCancelWithdrawal")
+	proto.RegisterType((*MsgCancelWithdrawalResponse)(nil), "selfchain.migration.MsgCancelWithdrawalResponse")
+	proto.RegisterType((*MsgArchiveMigrations)(nil), "selfchain.migration.MsgArchiveMigrations")
+	proto.RegisterType((*MsgArchiveMigrationsResponse)(nil), "selfchain.migration.MsgArchiveMigrationsResponse")
+	proto.RegisterType((*MsgSetFeeWaiverConfig)(nil), "selfchain.migration.MsgSetFeeWaiverConfig")
+	proto.RegisterType((*MsgSetFeeWaiverConfigResponse)(nil), "selfchain.migration.MsgSetFeeWaiverConfigResponse")
+	proto.RegisterType((*MsgSetFeeAllowanceConfig)(nil), "selfchain.migration.MsgSetFeeAllowanceConfig")
+	proto.RegisterType((*MsgSetFeeAllowanceConfigResponse)(nil), "selfchain.migration.MsgSetFeeAllowanceConfigResponse")
+}
+
+func init() { proto.RegisterFile("selfchain/migration/tx.proto", fileDescriptor_956be144f468c705) }
+
+var fileDescriptor_956be144f468c705 = []byte{
+	// 1729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x4f, 0x6f, 0xe3, 0xc6,
+	0x15, 0x5f, 0xc9, 0xf2, 0xbf, 0x67, 0xaf, 0xed, 0xe5, 0x7a, 0xb7, 0x0a, 0xd7, 0xd1, 0xaa, 0x6c,
+	0x6a, 0xab, 0xd9, 0x46, 0xde, 0xf5, 0x36, 0xb7, 0x16, 0x81, 0xed, 0xc0, 0x89, 0x81, 0x55, 0xd1,
+	0x52, 0x2e, 0x02, 0xb4, 0x40, 0x0d, 0x8a, 0x7c, 0xa2, 0x08, 0x53, 0xa4, 0xc2, 0x19, 0xfa, 0xcf,
+	0xa1, 0xa7, 0xa2, 0xd7, 0x22, 0xe8, 0xa9, 0x87, 0x7e, 0x80, 0xf6, 0xdc, 0x2f, 0x91, 0x63, 0x80,
+	0x5e, 0x7a, 0x2a, 0x8a, 0xdd, 0x73, 0xbf, 0x42, 0x11, 0xcc, 0x70, 0x38, 0x1c, 0x52, 0x94, 0x4c,
+	0x7b, 0x4f, 0xe6, 0xbc, 0xf7, 0x9b, 0xf7, 0x6f, 0xde, 0x7b, 0xf3, 0x46, 0x86, 0x1d, 0x82, 0xfe,
+	0xd0, 0x1e, 0x59, 0x5e, 0xb0, 0x3f, 0xf6, 0xdc, 0xc8, 0xa2, 0x5e, 0x18, 0xec, 0xd3, 0xeb, 0xee,
+	0x24, 0x0a, 0x69, 0xa8, 0x3d, 0x96, 0xdc, 0xae, 0xe4, 0xea, 0xdb, 0x6e, 0xe8, 0x86, 0x9c, 0xbf,
+	0xcf, 0xbe, 0x12, 0xa8, 0xbe, 0x5b, 0x2a, 0x28, 0xbc, 0xc0, 0xe0, 0x9c, 0xc4, 0x01, 0x41, 0x2a,
+	0x70, 0x7b, 0x65, 0x38, 0xf9, 0x75, 0x3e, 0x44, 0x14, 0xc0, 0x4e, 0x19, 0x90, 0xff, 0x39, 0x27,
+	0xf6, 0x08, 0x9d, 0xd8, 0x17, 0x48, 0xe3, 0xff, 0x75, 0x80, 0x1e, 0x71, 0x7b, 0x1c, 0x85, 0x5a,
+	0x13, 0x96, 0xed, 0x08, 0x2d, 0x1a, 0x46, 0xcd, 0x5a, 0xbb, 0xd6, 0x59, 0x35, 0xd3, 0xa5, 0xf6,
+	0x14, 0x96, 0xe8, 0xf5, 0x97, 0x16, 0x19, 0x35, 0xeb, 0x9c, 0x21, 0x56, 0x5a, 0x0b, 0x00, 0xe9,
+	0xe8, 0xd0, 0x71, 0x22, 0x24, 0xa4, 0xb9, 0xc0, 0x79, 0x0a, 0x45, 0x6b, 0xc3, 0x9a, 0x83, 0x84,
+	0xa6, 0x80, 0x06, 0x07, 0xa8, 0x24, 0x26, 0xd9, 0x1a, 0x87, 0x71, 0x40, 0x9b, 0x8b, 0x89, 0xe4,
+	0x64, 0xa5, 0x6d, 0xc3, 0x22, 0x8f, 0x41, 0x73, 0xa9, 0x5d, 0xeb, 0x34, 0xcc, 0x64, 0xa1, 0xe9,
+	0xb0, 0xe2, 0x87, 0xee, 0x69, 0xe0, 0xe0, 0x75, 0x73, 0x99, 0x33, 0xe4, 0x5a, 0xfb, 0x08, 0x1e,
+	0x92, 0x30, 0x8e, 0x6c, 0x3c, 0x66, 0xae, 0x9f, 0x3a, 0xcd, 0x15, 0x0e, 0xc8, 0x13, 0x99, 0xc5,
+	0x7e, 0x68, 0x5f, 0xc4, 0x93, 0x33, 0x0f, 0xa3, 0xe6, 0x2a, 0x87, 0x28, 0x14, 0x66, 0x71, 0xb2,
+	0xe1, 0x88, 0x11, 0x9b, 0xc0, 0x01, 0x2a, 0x49, 0xeb, 0xc0, 0xa6, 0x1d, 0x06, 0x34, 0xb2, 0x6c,
+	0xe9, 0xd7, 0x1a, 0x37, 0xbd, 0x48, 0x66, 0xb2, 0x6c, 0xcb, 0xf7, 0x07, 0x96, 0x7d, 0xd1, 0x23,
+	0x6e, 0x73, 0x3d, 0xf1, 0x5e, 0x21, 0x19, 0xdb, 0xa0, 0x65, 0xf1, 0x37, 0x91, 0x4c, 0xc2, 0x80,
+	0xa0, 0x71, 0x02, 0x1b, 0x3d, 0xe2, 0x1e, 0x3a, 0x4e, 0xc2, 0x08, 0xa3, 0x39, 0x27, 0xa3, 0xc3,
+	0xca, 0x58, 0xa0, 0xc4, 0xd9, 0xc8, 0xb5, 0xd1, 0x84, 0xa7, 0x79, 0x39, 0x52, 0xc3, 0x29, 0x3c,
+	0xea, 0x11, 0xd7, 0xc4, 0x71, 0x78, 0x89, 0xef, 0xa9, 0xe4, 0x19, 0x7c, 0x30, 0x25, 0x4a, 0xea,
+	0xf9, 0x47, 0x0d, 0x36, 0x7b, 0xc4, 0xfd, 0xcd, 0xc4, 0xb1, 0x28, 0x1e, 0x87, 0xc1, 0xd0, 0x73,
+	0xe7, 0xa8, 0xe9, 0xc0, 0xe6, 0x25, 0x12, 0xea, 0x05, 0xee, 0xe7, 0x71, 0x92, 0xb6, 0x5c, 0x5b,
+	0xc3, 0x2c, 0x92, 0x35, 0x03, 0xd6, 0x05, 0xe9, 0xd8, 0xf7, 0x86, 0x43, 0x9e, 0x79, 0x0d, 0x33,
+	0x47, 0xd3, 0xba, 0xa0, 0x8d, 0xbd, 0xa0, 0x97, 0x56, 0xc0, 0x61, 0x92, 0x65, 0x0d, 0x8e, 0x2c,
+	0xe1, 0x18, 0x1f, 0xc0, 0x0f, 0x0a, 0xa6, 0x4a, 0x37, 0xde, 0xf0, 0x63, 0x32, 0xf1, 0x12, 0x23,
+	0x2a, 0xb7, 0x69, 0x3b, 0xb0, 0x6a, 0xc5, 0x74, 0x14, 0x46, 0x1e, 0xbd, 0x11, 0xae, 0x64, 0x04,
+	0xe6, 0xe6, 0x98, 0xb8, 0x4a, 0xcd, 0xa4, 0x4b, 0xe3, 0x2f, 0x75, 0xd0, 0xa7, 0xc5, 0xa5, 0xca,
+	0x58, 0x86, 0xda, 0xbe, 0x75, 0x85, 0xce, 0x91, 0x65, 0x5f, 0x08, 0xb9, 0x0a, 0x85, 0xa9, 0x8d,
+	0xd0, 0x0e, 0x2f, 0x31, 0x42, 0x47, 0x88, 0xce, 0x08, 0xac, 0x9e, 0x06, 0x71, 0x14, 0xa0, 0x23,
+	0xaa, 0x51, 0xac, 0xd8, 0x2e, 0x32, 0x0a, 0x23, 0x3a, 0xb4, 0x7c, 0x5f, 0xd4, 0x61, 0x46, 0xd0,
+	0x76, 0x61, 0x43, 0x2e, 0xfa, 0x13, 0x94, 0xd5, 0x58, 0xa0, 0xb2, 0xb8, 0x4b, 0xca, 0x09, 0x22,
+	0x2f, 0xce, 0x55, 0x33, 0x47, 0xd3, 0x7e, 0x06, 0x4f, 0xd4, 0xf5, 0xa1, 0xef, 0x87, 0x57, 0x56,
+	0x60, 0x23, 0x2f, 0xd8, 0x55, 0xb3, 0x9c, 0x69, 0xfc, 0xaf, 0xc6, 0x53, 0xb2, 0x8f, 0xf4, 0x8c,
+	0x55, 0x7a, 0x9f, 0x77, 0xbe, 0x39, 0xb9, 0x22, 0xfb, 0x43, 0x5d, 0xed, 0x0f, 0x4d, 0x58, 0xc6,
+	0xc0, 0x39, 0xf3, 0xc6, 0x28, 0x52, 0x22, 0x5d, 0x6a, 0x5b, 0xb0, 0x60, 0x5b, 0x13, 0xe1, 0x39,
+	0xfb, 0xd4, 0x7e, 0x0e, 0xab, 0xe4, 0x0a, 0x71, 0xd2, 0x0b, 0x1d, 0xe4, 0xee, 0x6e, 0x1c, 0xb4,
+	0xba, 0x25, 0x6d, 0xbb, 0xdb, 0x4f, 0x51, 0x66, 0xb6, 0x81, 0x95, 0x04, 0x8d, 0xd0, 0x22, 0x71,
+	0x74, 0x23, 0xa2, 0x20, 0xd7, 0x2c, 0x4a, 0x3e, 0xba, 0x96, 0x7d, 0xd3, 0xf3, 0x02, 0x8a, 0x8e,
+	0x70, 0x3c, 0x47, 0x13, 0x65, 0x93, 0x77, 0x57, 0xe6, 0xdb, 0x3f, 0x65, 0x30, 0xfa, 0x59, 0xf3,
+	0x9a, 0x13, 0x0c, 0xc6, 0x11, 0x4d, 0x2f, 0x09, 0x47, 0xba, 0xd4, 0x34, 0x68, 0x04, 0x96, 0x88,
+	0xc6, 0xaa, 0xc9, 0xbf, 0x59, 0xa3, 0xb4, 0x59, 0x7e, 0x47, 0x63, 0xee, 0x1f, 0x11, 0x35, 0x91,
+	0x27, 0xf2, 0x96, 0xcf, 0x4c, 0x23, 0xcd, 0xc5, 0xf6, 0x42, 0xa7, 0x61, 0x8a, 0x55, 0x12, 0x62,
+	0x6b, 0xe0, 0xa3, 0xc3, 0xfd, 0x5e, 0x31, 0xd3, 0x65, 0xe6, 0x92, 0x62, 0xb4, 0x74, 0xe9, 0x8f,
+	0x75, 0x5e, 0x43, 0x7d, 0xcc, 0x32, 0x9e, 0x25, 0xcb, 0x5d, 0x0f, 0xb8, 0x0d, 0x6b, 0x03, 0x8b,
+	0x78, 0xe4, 0x57, 0xa1, 0x17, 0x50, 0x22, 0x0e, 0x59, 0x25, 0xb1, 0xf2, 0xb9, 0xb2, 0xbc, 0x4b,
+	0x3c, 0x42, 0x3f, 0xbc, 0x12, 0xe7, 0xad, 0x50, 0x24, 0xff, 0x70, 0x10, 0x5e, 0xa2, 0x48, 0x73,
+	0x85, 0xa2, 0x7d, 0xc6, 0xcb, 0xcb, 0x9b, 0x78, 0xac, 0x0a, 0x96, 0x78, 0x5a, 0xfc, 0xb0, 0x34,
+	0x2d, 0x4e, 0x10, 0xcd, 0x14, 0x68, 0x66, 0x7b, 0x72, 0x99, 0xb1, 0x9c, 0xcf, 0x0c, 0x63, 0x07,
+	0xf4, 0xe9, 0x20, 0xc8, 0x18, 0x7d, 0x53, 0x83, 0xad, 0x84, 0xfd, 0x26, 0xbb, 0x90, 0x66, 0x47,
+	0x48, 0x83, 0x06, 0x65, 0x97, 0x58, 0x12, 0x20, 0xfe, 0xcd, 0x9a, 0x9e, 0x23, 0x9a, 0x64, 0x2f,
+	0xf6, 0xa9, 0x37, 0xf1, 0x19, 0x22, 0x09, 0x53, 0x09, 0x87, 0x45, 0x63, 0x10, 0x06, 0x31, 0x31,
+	0x19, 0x43, 0x24, 0x82, 0x42, 0x31, 0x74, 0x68, 0x16, 0x2d, 0x92, 0xe6, 0x1e, 0xc3, 0x63, 0xd9,
+	0xf9, 0xef, 0x6b, 0xb0, 0xf1, 0x21, 0x3c, 0x2b, 0x11, 0x22, 0x75, 0xfc, 0xab, 0xc6, 0x95, 0xf4,
+	0x91, 0x72, 0x7b, 0xfa, 0x62, 0x7e, 0xb9, 0x73, 0xde, 0xf4, 0xe0, 0x21, 0xab, 0xbb, 0x68, 0x12,
+	0xfa, 0xc9, 0xc5, 0xb2, 0xc0, 0x4f, 0x76, 0xaf, 0xf4, 0x64, 0xb9, 0xaa, 0x53, 0x15, 0x6e, 0xe6,
+	0x77, 0x6b, 0xbf, 0x80, 0xa5, 0x49, 0x92, 0x81, 0x8d, 0xf6, 0x42, 0x67, 0xed, 0xe0, 0xf9, 0x6c,
+	0x39, 0x3c, 0x2d, 0x8f, 0x1a, 0xdf, 0xfe, 0xe7, 0xf9, 0x03, 0x53, 0x6c, 0x12, 0x4e, 0x17, 0x9d,
+	0x92, 0x4e, 0x7f, 0x09, 0x4f, 0x65, 0x4c, 0xde, 0xcb, 0x6d, 0xa3, 0x0d, 0xad, 0x72, 0x49, 0x52,
+	0xd7, 0xdf, 0x6a, 0x5c, 0x59, 0x1f, 0xe9, 0x57, 0x1e, 0x1d, 0x39, 0x91, 0x75, 0x65, 0xf9, 0xb7,
+	0x5e, 0xd4, 0x5d, 0xd0, 0x88, 0xe7, 0x06, 0x16, 0x8d, 0x23, 0x3c, 0x1b, 0x45, 0x48, 0x46, 0xa1,
+	0x9f, 0xb6, 0x9e, 0x12, 0x0e, 0xeb, 0x25, 0x78, 0x3d, 0xf1, 0xa2, 0x1b, 0x91, 0x89, 0x62, 0xc5,
+	0xaa, 0x39, 0xc2, 0x61, 0x1c, 0x38, 0x9f, 0xa3, 0x6f, 0xdd, 0x88, 0xf4, 0x53, 0x49, 0xc2, 0x81,
+	0x12, 0xeb, 0xa4, 0x03, 0x7f, 0xaf, 0xc1, 0x36, 0xf7, 0xf1, 0xeb, 0x18, 0x89, 0x02, 0x9b, 0x63,
+	0xbe, 0x01, 0xeb, 0x48, 0x47, 0xb2, 0x78, 0xc5, 0x25, 0x9a, 0xa3, 0x29, 0x73, 0xe9, 0x42, 0xf9,
+	0x5c, 0xda, 0x50, 0xd3, 0x6b, 0x6a, 0xf6, 0x5c, 0x2c, 0x99, 0x3d, 0x8d, 0x2e, 0xec, 0x94, 0x59,
+	0x2a, 0x6f, 0xfe, 0x0d, 0xa8, 0x7b, 0x0e, 0x37, 0xb6, 0x61, 0xd6, 0x3d, 0xc7, 0xf8, 0x5d, 0x72,
+	0x0b, 0x78, 0x6e, 0x50, 0xc9, 0xad, 0x64, 0x7b, 0x3d, 0xdd, 0xce, 0xaf, 0xfc, 0xf4, 0x2c, 0x84,
+	0x17, 0x19, 0x21, 0xed, 0xd6, 0x39, 0xe1, 0x32, 0xa8, 0xbf, 0xe7, 0x31, 0x3d, 0x4e, 0x2e, 0x84,
+	0xfb, 0x2a, 0x47, 0x3a, 0x3a, 0x4b, 0x1e, 0x0d, 0x42, 0xb9, 0x24, 0x18, 0x2d, 0xd8, 0x29, 0x93,
+	0x2f, 0xf5, 0x7f, 0xc6, 0xab, 0xfe, 0xd8, 0x0a, 0x6c, 0xf4, 0xef, 0xa3, 0x5e, 0x54, 0x58, 0x51,
+	0x80, 0x94, 0xff, 0xe7, 0x24, 0x69, 0x0e, 0x23, 0x7b, 0xe4, 0xa5, 0x63, 0x2b, 0xbf, 0xf5, 0xee,
+	0x73, 0x1f, 0xe1, 0x30, 0x8c, 0xc4, 0x73, 0x21, 0xbd, 0x8f, 0x32, 0xd2, 0x74, 0x6a, 0x34, 0xca,
+	0x52, 0xe3, 0x97, 0xb0, 0x53, 0x66, 0x8f, 0x4c, 0x0d, 0x1d, 0x56, 0xac, 0x84, 0x99, 0x26, 0x88,
+	0x5c, 0xb3, 0xb6, 0xea, 0x84, 0x01, 0x72, 0xc3, 0x56, 0x4c, 0xfe, 0x6d, 0xfc, 0xa9, 0x06, 0x4f,
+	0x92, 0xc2, 0x39, 0x41, 0xfc, 0x8a, 0xdd, 0x6e, 0xd1, 0xad, 0x55, 0xbd, 0x0b, 0x1b, 0x03, 0x66,
+	0xf2, 0x17, 0x16, 0x39, 0x8a, 0x1d, 0x17, 0xa9, 0x70, 0xb5, 0x40, 0xd5, 0x3e, 0x86, 0xad, 0x74,
+	0xfa, 0xff, 0xc2, 0x22, 0xbf, 0x8e, 0x43, 0x6a, 0x09, 0xc7, 0xa7, 0xe8, 0xc6, 0x73, 0xf8, 0xb0,
+	0xd4, 0x0c, 0x79, 0x12, 0x34, 0xbd, 0x60, 0xd4, 0x69, 0xf0, 0x56, 0x53, 0x5b, 0x00, 0x64, 0x82,
+	0x81, 0xf3, 0xc6, 0x1b, 0x7b, 0x69, 0xfd, 0x2a, 0x14, 0xc6, 0xe7, 0x2d, 0x26, 0xeb, 0xf5, 0x0d,
+	0x53, 0xa1, 0x18, 0x06, 0xb4, 0x67, 0x69, 0x4d, 0x2d, 0x3b, 0xf8, 0xeb, 0x23, 0x58, 0xe8, 0x11,
+	0x57, 0xeb, 0xc3, 0x72, 0xfa, 0x40, 0x2e, 0x6f, 0xf3, 0xd9, 0x0b, 0x4e, 0xdf, 0xbb, 0x05, 0x20,
+	0xcf, 0xf3, 0x1c, 0xd6, 0xd4, 0xf7, 0xdd, 0x8f, 0x66, 0xed, 0x53, 0x40, 0xfa, 0x8b, 0x0a, 0x20,
+	0xa9, 0x60, 0x04, 0x1b, 0x85, 0xe7, 0xdd, 0xee, 0xac, 0xed, 0x79, 0x9c, 0xde, 0xad, 0x86, 0x93,
+	0x9a, 0x06, 0xb0, 0x9e, 0x7b, 0xdf, 0x7d, 0x34, 0x6b, 0xbf, 0x8a, 0xd2, 0x7f, 0x5a, 0x05, 0x25,
+	0x75, 0x5c, 0xc0, 0x66, 0xf1, 0xf5, 0xb5, 0x37, 0xdb, 0xcc, 0x1c, 0x50, 0xdf, 0xaf, 0x08, 0x54,
+	0x43, 0x57, 0x78, 0x86, 0xcc, 0x0c, 0x5d, 0x1e, 0xa7, 0x77, 0xab, 0xe1, 0x0a, 0x9a, 0xd4, 0x19,
+	0x7f, 0x9e, 0x26, 0x05, 0xa7, 0x77, 0xab, 0xe1, 0xd4, 0x00, 0x16, 0x47, 0xef, 0xbd, 0x39, 0x22,
+	0x54, 0xa0, 0xbe, 0x5f, 0x11, 0x28, 0x95, 0x21, 0x3c, 0xcc, 0xcf, 0xb0, 0x3f, 0x9e, 0x23, 0x21,
+	0x83, 0xe9, 0x9f, 0x54, 0x82, 0x49, 0x35, 0x01, 0x6c, 0x4d, 0x0d, 0x9f, 0x9d, 0xf9, 0xc9, 0xab,
+	0x28, 0x7b, 0x59, 0x15, 0xa9, 0xea, 0x9b, 0x9a, 0x43, 0x3b, 0x73, 0x4c, 0xce, 0x21, 0xf5, 0x97,
+	0x55, 0x91, 0x52, 0xdf, 0x15, 0x3c, 0x2e, 0x9b, 0x01, 0x5f, 0xcc, 0x37, 0x3c, 0xaf, 0xf5, 0xf5,
+	0x1d, 0xc0, 0xaa, 0xe2, 0xb2, 0x79, 0xf0, 0xc5, 0x1c, 0x0f, 0x8a, 0x60, 0xfd, 0xf5, 0x1d, 0xc0,
+	0x52, 0xf1, 0xd7, 0xf0, 0x68, 0x7a, 0x8e, 0xfb, 0xc9, 0x6c, 0x17, 0x0a, 0x50, 0xfd, 0x55, 0x65,
+	0x68, 0xae, 0x04, 0xf3, 0x03, 0xd6, 0xec, 0x12, 0xcc, 0xe1, 0xf4, 0x6e, 0x35, 0x9c, 0xea, 0xdc,
+	0xf4, 0x40, 0x35, 0xd3, 0xb9, 0x29, 0xa8, 0xfe, 0xaa, 0x32, 0x54, 0xcd, 0xd8, 0xa9, 0x19, 0x6a,
+	0x66, 0xc6, 0x16, 0x91, 0xfa, 0xcb, 0xaa, 0x48, 0xd5, 0xc5, 0xe9, 0x91, 0x6a, 0xa6, 0x8b, 0x53,
+	0x50, 0xfd, 0x55, 0x65, 0xa8, 0x54, 0x49, 0x41, 0x2b, 0x19, 0x72, 0x3e, 0x9e, 0x93, 0x7d, 0x05,
+	0xac, 0x7e, 0x50, 0x1d, 0x2b, 0xb5, 0xfe, 0x01, 0x9e, 0x94, 0x8f, 0x2c, 0x9f, 0xcc, 0x17, 0x56,
+	0x80, 0xeb, 0x9f, 0xde, 0x09, 0x9e, 0xaa, 0x3f, 0xfa, 0xf4, 0xdb, 0xb7, 0xad, 0xda, 0x77, 0x6f,
+	0x5b, 0xb5, 0xff, 0xbe, 0x6d, 0xd5, 0xbe, 0x79, 0xd7, 0x7a, 0xf0, 0xdd, 0xbb, 0xd6, 0x83, 0x7f,
+	0xbf, 0x6b, 0x3d, 0xf8, 0xed, 0xb3, 0xec, 0xb7, 0xff, 0x6b, 0xf5, 0xdf, 0x09, 0x37, 0x13, 0x24,
+	0x83, 0x25, 0xfe, 0xab, 0xff, 0xeb, 0xef, 0x07, 0x00, 0xe0, 0x8d, 0x7d, 0x1d, 0xbb, 0x18, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error)
+	AddMigrator(ctx context.Context, in *MsgAddMigrator, opts ...grpc.CallOption) (*MsgAddMigratorResponse, error)
+	RemoveMigrator(ctx context.Context, in *MsgRemoveMigrator, opts ...grpc.CallOption) (*MsgRemoveMigratorResponse, error)
+	UpdateConfig(ctx context.Context, in *MsgUpdateConfig, opts ...grpc.CallOption) (*MsgUpdateConfigResponse, error)
+	RevertMigration(ctx context.Context, in *MsgRevertMigration, opts ...grpc.CallOption) (*MsgRevertMigrationResponse, error)
+	SetTokenSunset(ctx context.Context, in *MsgSetTokenSunset, opts ...grpc.CallOption) (*MsgSetTokenSunsetResponse, error)
+	SetSourceChain(ctx context.Context, in *MsgSetSourceChain, opts ...grpc.CallOption) (*MsgSetSourceChainResponse, error)
+	SetMigrationFee(ctx context.Context, in *MsgSetMigrationFee, opts ...grpc.CallOption) (*MsgSetMigrationFeeResponse, error)
+	SetLockupTier(ctx context.Context, in *MsgSetLockupTier, opts ...grpc.CallOption) (*MsgSetLockupTierResponse, error)
+	RemoveLockupTier(ctx context.Context, in *MsgRemoveLockupTier, opts ...grpc.CallOption) (*MsgRemoveLockupTierResponse, error)
+	SetRatioSchedule(ctx context.Context, in *MsgSetRatioSchedule, opts ...grpc.CallOption) (*MsgSetRatioScheduleResponse, error)
+	RemoveRatioSchedule(ctx context.Context, in *MsgRemoveRatioSchedule, opts ...grpc.CallOption) (*MsgRemoveRatioScheduleResponse, error)
+	SetWithdrawalConfig(ctx context.Context, in *MsgSetWithdrawalConfig, opts ...grpc.CallOption) (*MsgSetWithdrawalConfigResponse, error)
+	RequestWithdrawal(ctx context.Context, in *MsgRequestWithdrawal, opts ...grpc.CallOption) (*MsgRequestWithdrawalResponse, error)
+	SignWithdrawal(ctx context.Context, in *MsgSignWithdrawal, opts ...grpc.CallOption) (*MsgSignWithdrawalResponse, error)
+	ConfirmWithdrawal(ctx context.Context, in *MsgConfirmWithdrawal, opts ...grpc.CallOption) (*MsgConfirmWithdrawalResponse, error)
+	CancelWithdrawal(ctx context.Context, in *MsgCancelWithdrawal, opts ...grpc.CallOption) (*MsgCancelWithdrawalResponse, error)
+	ArchiveMigrations(ctx context.Context, in *MsgArchiveMigrations, opts ...grpc.CallOption) (*MsgArchiveMigrationsResponse, error)
+	SetFeeWaiverConfig(ctx context.Context, in *MsgSetFeeWaiverConfig, opts ...grpc.CallOption) (*MsgSetFeeWaiverConfigResponse, error)
+	SetFeeAllowanceConfig(ctx context.Context, in *MsgSetFeeAllowanceConfig, opts ...grpc.CallOption) (*MsgSetFeeAllowanceConfigResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error) {
+	out := new(MsgMigrateResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddMigrator(ctx context.Context, in *MsgAddMigrator, opts ...grpc.CallOption) (*MsgAddMigratorResponse, error) {
+	out := new(MsgAddMigratorResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/AddMigrator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMigrator(ctx context.Context, in *MsgRemoveMigrator, opts ...grpc.CallOption) (*MsgRemoveMigratorResponse, error) {
+	out := new(MsgRemoveMigratorResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/RemoveMigrator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateConfig(ctx context.Context, in *MsgUpdateConfig, opts ...grpc.CallOption) (*MsgUpdateConfigResponse, error) {
+	out := new(MsgUpdateConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/UpdateConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevertMigration(ctx context.Context, in *MsgRevertMigration, opts ...grpc.CallOption) (*MsgRevertMigrationResponse, error) {
+	out := new(MsgRevertMigrationResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/RevertMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTokenSunset(ctx context.Context, in *MsgSetTokenSunset, opts ...grpc.CallOption) (*MsgSetTokenSunsetResponse, error) {
+	out := new(MsgSetTokenSunsetResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/SetTokenSunset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSourceChain(ctx context.Context, in *MsgSetSourceChain, opts ...grpc.CallOption) (*MsgSetSourceChainResponse, error) {
+	out := new(MsgSetSourceChainResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/SetSourceChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMigrationFee(ctx context.Context, in *MsgSetMigrationFee, opts ...grpc.CallOption) (*MsgSetMigrationFeeResponse, error) {
+	out := new(MsgSetMigrationFeeResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/SetMigrationFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLockupTier(ctx context.Context, in *MsgSetLockupTier, opts ...grpc.CallOption) (*MsgSetLockupTierResponse, error) {
+	out := new(MsgSetLockupTierResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/SetLockupTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveLockupTier(ctx context.Context, in *MsgRemoveLockupTier, opts ...grpc.CallOption) (*MsgRemoveLockupTierResponse, error) {
+	out := new(MsgRemoveLockupTierResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/RemoveLockupTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRatioSchedule(ctx context.Context, in *MsgSetRatioSchedule, opts ...grpc.CallOption) (*MsgSetRatioScheduleResponse, error) {
+	out := new(MsgSetRatioScheduleResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/SetRatioSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRatioSchedule(ctx context.Context, in *MsgRemoveRatioSchedule, opts ...grpc.CallOption) (*MsgRemoveRatioScheduleResponse, error) {
+	out := new(MsgRemoveRatioScheduleResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/RemoveRatioSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWithdrawalConfig(ctx context.Context, in *MsgSetWithdrawalConfig, opts ...grpc.CallOption) (*MsgSetWithdrawalConfigResponse, error) {
+	out := new(MsgSetWithdrawalConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/SetWithdrawalConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestWithdrawal(ctx context.Context, in *MsgRequestWithdrawal, opts ...grpc.CallOption) (*MsgRequestWithdrawalResponse, error) {
+	out := new(MsgRequestWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/RequestWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SignWithdrawal(ctx context.Context, in *MsgSignWithdrawal, opts ...grpc.CallOption) (*MsgSignWithdrawalResponse, error) {
+	out := new(MsgSignWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/SignWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfirmWithdrawal(ctx context.Context, in *MsgConfirmWithdrawal, opts ...grpc.CallOption) (*MsgConfirmWithdrawalResponse, error) {
+	out := new(MsgConfirmWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/ConfirmWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelWithdrawal(ctx context.Context, in *MsgCancelWithdrawal, opts ...grpc.CallOption) (*MsgCancelWithdrawalResponse, error) {
+	out := new(MsgCancelWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/CancelWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ArchiveMigrations(ctx context.Context, in *MsgArchiveMigrations, opts ...grpc.CallOption) (*MsgArchiveMigrationsResponse, error) {
+	out := new(MsgArchiveMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/ArchiveMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeWaiverConfig(ctx context.Context, in *MsgSetFeeWaiverConfig, opts ...grpc.CallOption) (*MsgSetFeeWaiverConfigResponse, error) {
+	out := new(MsgSetFeeWaiverConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/SetFeeWaiverConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeAllowanceConfig(ctx context.Context, in *MsgSetFeeAllowanceConfig, opts ...grpc.CallOption) (*MsgSetFeeAllowanceConfigResponse, error) {
+	out := new(MsgSetFeeAllowanceConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.migration.Msg/SetFeeAllowanceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Migrate(context.Context, *MsgMigrate) (*MsgMigrateResponse, error)
+	AddMigrator(context.Context, *MsgAddMigrator) (*MsgAddMigratorResponse, error)
+	RemoveMigrator(context.Context, *MsgRemoveMigrator) (*MsgRemoveMigratorResponse, error)
+	UpdateConfig(context.Context, *MsgUpdateConfig) (*MsgUpdateConfigResponse, error)
+	RevertMigration(context.Context, *MsgRevertMigration) (*MsgRevertMigrationResponse, error)
+	SetTokenSunset(context.Context, *MsgSetTokenSunset) (*MsgSetTokenSunsetResponse, error)
+	SetSourceChain(context.Context, *MsgSetSourceChain) (*MsgSetSourceChainResponse, error)
+	SetMigrationFee(context.Context, *MsgSetMigrationFee) (*MsgSetMigrationFeeResponse, error)
+	SetLockupTier(context.Context, *MsgSetLockupTier) (*MsgSetLockupTierResponse, error)
+	RemoveLockupTier(context.Context, *MsgRemoveLockupTier) (*MsgRemoveLockupTierResponse, error)
+	SetRatioSchedule(context.Context, *MsgSetRatioSchedule) (*MsgSetRatioScheduleResponse, error)
+	RemoveRatioSchedule(context.Context, *MsgRemoveRatioSchedule) (*MsgRemoveRatioScheduleResponse, error)
+	SetWithdrawalConfig(context.Context, *MsgSetWithdrawalConfig) (*MsgSetWithdrawalConfigResponse, error)
+	RequestWithdrawal(context.Context, *MsgRequestWithdrawal) (*MsgRequestWithdrawalResponse, error)
+	SignWithdrawal(context.Context, *MsgSignWithdrawal) (*MsgSignWithdrawalResponse, error)
+	ConfirmWithdrawal(context.Context, *MsgConfirmWithdrawal) (*MsgConfirmWithdrawalResponse, error)
+	CancelWithdrawal(context.Context, *MsgCancelWithdrawal) (*MsgCancelWithdrawalResponse, error)
+	ArchiveMigrations(context.Context, *MsgArchiveMigrations) (*MsgArchiveMigrationsResponse, error)
+	SetFeeWaiverConfig(context.Context, *MsgSetFeeWaiverConfig) (*MsgSetFeeWaiverConfigResponse, error)
+	SetFeeAllowanceConfig(context.Context, *MsgSetFeeAllowanceConfig) (*MsgSetFeeAllowanceConfigResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Migrate(ctx context.Context, req *MsgMigrate) (*MsgMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (*UnimplementedMsgServer) AddMigrator(ctx context.Context, req *MsgAddMigrator) (*MsgAddMigratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMigrator not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMigrator(ctx context.Context, req *MsgRemoveMigrator) (*MsgRemoveMigratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMigrator not implemented")
+}
+func (*UnimplementedMsgServer) UpdateConfig(ctx context.Context, req *MsgUpdateConfig) (*MsgUpdateConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfig not implemented")
+}
+func (*UnimplementedMsgServer) RevertMigration(ctx context.Context, req *MsgRevertMigration) (*MsgRevertMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertMigration not implemented")
+}
+func (*UnimplementedMsgServer) SetTokenSunset(ctx context.Context, req *MsgSetTokenSunset) (*MsgSetTokenSunsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTokenSunset not implemented")
+}
+func (*UnimplementedMsgServer) SetSourceChain(ctx context.Context, req *MsgSetSourceChain) (*MsgSetSourceChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSourceChain not implemented")
+}
+func (*UnimplementedMsgServer) SetMigrationFee(ctx context.Context, req *MsgSetMigrationFee) (*MsgSetMigrationFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMigrationFee not implemented")
+}
+func (*UnimplementedMsgServer) SetLockupTier(ctx context.Context, req *MsgSetLockupTier) (*MsgSetLockupTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLockupTier not implemented")
+}
+func (*UnimplementedMsgServer) RemoveLockupTier(ctx context.Context, req *MsgRemoveLockupTier) (*MsgRemoveLockupTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLockupTier not implemented")
+}
+func (*UnimplementedMsgServer) SetRatioSchedule(ctx context.Context, req *MsgSetRatioSchedule) (*MsgSetRatioScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRatioSchedule not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRatioSchedule(ctx context.Context, req *MsgRemoveRatioSchedule) (*MsgRemoveRatioScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRatioSchedule not implemented")
+}
+func (*UnimplementedMsgServer) SetWithdrawalConfig(ctx context.Context, req *MsgSetWithdrawalConfig) (*MsgSetWithdrawalConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawalConfig not implemented")
+}
+func (*UnimplementedMsgServer) RequestWithdrawal(ctx context.Context, req *MsgRequestWithdrawal) (*MsgRequestWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) SignWithdrawal(ctx context.Context, req *MsgSignWithdrawal) (*MsgSignWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmWithdrawal(ctx context.Context, req *MsgConfirmWithdrawal) (*MsgConfirmWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) CancelWithdrawal(ctx context.Context, req *MsgCancelWithdrawal) (*MsgCancelWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) ArchiveMigrations(ctx context.Context, req *MsgArchiveMigrations) (*MsgArchiveMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveMigrations not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeWaiverConfig(ctx context.Context, req *MsgSetFeeWaiverConfig) (*MsgSetFeeWaiverConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeWaiverConfig not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeAllowanceConfig(ctx context.Context, req *MsgSetFeeAllowanceConfig) (*MsgSetFeeAllowanceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeAllowanceConfig not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Migrate(ctx, req.(*MsgMigrate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddMigrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMigrator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMigrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/AddMigrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMigrator(ctx, req.(*MsgAddMigrator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMigrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMigrator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMigrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/RemoveMigrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMigrator(ctx, req.(*MsgRemoveMigrator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/UpdateConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateConfig(ctx, req.(*MsgUpdateConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevertMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevertMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevertMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/RevertMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevertMigration(ctx, req.(*MsgRevertMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTokenSunset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTokenSunset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTokenSunset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/SetTokenSunset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTokenSunset(ctx, req.(*MsgSetTokenSunset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSourceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSourceChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSourceChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/SetSourceChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSourceChain(ctx, req.(*MsgSetSourceChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMigrationFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMigrationFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMigrationFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/SetMigrationFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMigrationFee(ctx, req.(*MsgSetMigrationFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLockupTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLockupTier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLockupTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/SetLockupTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLockupTier(ctx, req.(*MsgSetLockupTier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveLockupTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveLockupTier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLockupTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/RemoveLockupTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLockupTier(ctx, req.(*MsgRemoveLockupTier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRatioSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRatioSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRatioSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/SetRatioSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRatioSchedule(ctx, req.(*MsgSetRatioSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRatioSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRatioSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRatioSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/RemoveRatioSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRatioSchedule(ctx, req.(*MsgRemoveRatioSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawalConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawalConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/SetWithdrawalConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawalConfig(ctx, req.(*MsgSetWithdrawalConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/RequestWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestWithdrawal(ctx, req.(*MsgRequestWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SignWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/SignWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignWithdrawal(ctx, req.(*MsgSignWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfirmWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/ConfirmWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmWithdrawal(ctx, req.(*MsgConfirmWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/CancelWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelWithdrawal(ctx, req.(*MsgCancelWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ArchiveMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArchiveMigrations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/ArchiveMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveMigrations(ctx, req.(*MsgArchiveMigrations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeWaiverConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeWaiverConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeWaiverConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/SetFeeWaiverConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeWaiverConfig(ctx, req.(*MsgSetFeeWaiverConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeAllowanceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeAllowanceConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeAllowanceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.migration.Msg/SetFeeAllowanceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeAllowanceConfig(ctx, req.(*MsgSetFeeAllowanceConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "selfchain.migration.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Migrate",
+			Handler:    _Msg_Migrate_Handler,
+		},
+		{
+			MethodName: "AddMigrator",
+			Handler:    _Msg_AddMigrator_Handler,
+		},
+		{
+			MethodName: "RemoveMigrator",
+			Handler:    _Msg_RemoveMigrator_Handler,
+		},
+		{
+			MethodName: "UpdateConfig",
+			Handler:    _Msg_UpdateConfig_Handler,
+		},
+		{
+			MethodName: "RevertMigration",
+			Handler:    _Msg_RevertMigration_Handler,
+		},
+		{
+			MethodName: "SetTokenSunset",
+			Handler:    _Msg_SetTokenSunset_Handler,
+		},
+		{
+			MethodName: "SetSourceChain",
+			Handler:    _Msg_SetSourceChain_Handler,
+		},
+		{
+			MethodName: "SetMigrationFee",
+			Handler:    _Msg_SetMigrationFee_Handler,
+		},
+		{
+			MethodName: "SetLockupTier",
+			Handler:    _Msg_SetLockupTier_Handler,
+		},
+		{
+			MethodName: "RemoveLockupTier",
+			Handler:    _Msg_RemoveLockupTier_Handler,
+		},
+		{
+			MethodName: "SetRatioSchedule",
+			Handler:    _Msg_SetRatioSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveRatioSchedule",
+			Handler:    _Msg_RemoveRatioSchedule_Handler,
+		},
+		{
+			MethodName: "SetWithdrawalConfig",
+			Handler:    _Msg_SetWithdrawalConfig_Handler,
+		},
+		{
+			MethodName: "RequestWithdrawal",
+			Handler:    _Msg_RequestWithdrawal_Handler,
+		},
+		{
+			MethodName: "SignWithdrawal",
+			Handler:    _Msg_SignWithdrawal_Handler,
+		},
+		{
+			MethodName: "ConfirmWithdrawal",
+			Handler:    _Msg_ConfirmWithdrawal_Handler,
+		},
+		{
+			MethodName: "CancelWithdrawal",
+			Handler:    _Msg_CancelWithdrawal_Handler,
+		},
+		{
+			MethodName: "ArchiveMigrations",
+			Handler:    _Msg_ArchiveMigrations_Handler,
+		},
+		{
+			MethodName: "SetFeeWaiverConfig",
+			Handler:    _Msg_SetFeeWaiverConfig_Handler,
+		},
+		{
+			MethodName: "SetFeeAllowanceConfig",
+			Handler:    _Msg_SetFeeAllowanceConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "selfchain/migration/tx.proto",
+}
+
+func (m *MsgMigrate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackMsg) > 0 {
+		i -= len(m.CallbackMsg)
+		copy(dAtA[i:], m.CallbackMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CallbackMsg)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SourceBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LockupTier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockupTier))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SourceChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Token != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMigrator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMigrator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMigrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrator) > 0 {
+		i -= len(m.Migrator)
+		copy(dAtA[i:], m.Migrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Migrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMigratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMigratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMigratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMigrator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMigrator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMigrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrator) > 0 {
+		i -= len(m.Migrator)
+		copy(dAtA[i:], m.Migrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Migrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMigratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMigratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMigratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinMigrationAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinMigrationAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VestingCliff != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VestingCliff))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VestingDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VestingDuration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevertMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevertMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevertMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgHash) > 0 {
+		i -= len(m.MsgHash)
+		copy(dAtA[i:], m.MsgHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevertMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevertMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevertMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShortfallFeeAllowance) > 0 {
+		i -= len(m.ShortfallFeeAllowance)
+		copy(dAtA[i:], m.ShortfallFeeAllowance)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortfallFeeAllowance)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ShortfallFee) > 0 {
+		i -= len(m.ShortfallFee)
+		copy(dAtA[i:], m.ShortfallFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortfallFee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ShortfallSpent) > 0 {
+		i -= len(m.ShortfallSpent)
+		copy(dAtA[i:], m.ShortfallSpent)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortfallSpent)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Shortfall) > 0 {
+		i -= len(m.Shortfall)
+		copy(dAtA[i:], m.Shortfall)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Shortfall)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Burned) > 0 {
+		i -= len(m.Burned)
+		copy(dAtA[i:], m.Burned)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Burned)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recovered) > 0 {
+		i -= len(m.Recovered)
+		copy(dAtA[i:], m.Recovered)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recovered)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClawedBack) > 0 {
+		i -= len(m.ClawedBack)
+		copy(dAtA[i:], m.ClawedBack)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClawedBack)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTokenSunset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTokenSunset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTokenSunset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LegacyMinted) > 0 {
+		i -= len(m.LegacyMinted)
+		copy(dAtA[i:], m.LegacyMinted)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LegacyMinted)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SweepMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SweepMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Cap) > 0 {
+		i -= len(m.Cap)
+		copy(dAtA[i:], m.Cap)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cap)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Token != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTokenSunsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTokenSunsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTokenSunsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSourceChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSourceChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSourceChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Tokens) > 0 {
+		dAtA2 := make([]byte, len(m.Tokens)*10)
+		var j1 int
+		for _, num := range m.Tokens {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Confirmations != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Confirmations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSourceChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMigrationFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMigrationFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMigrationFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Recipient != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Recipient))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.WaiveAbove) > 0 {
+		i -= len(m.WaiveAbove)
+		copy(dAtA[i:], m.WaiveAbove)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WaiveAbove)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WaiveBelow) > 0 {
+		i -= len(m.WaiveBelow)
+		copy(dAtA[i:], m.WaiveBelow)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WaiveBelow)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BasisPoints != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Token != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMigrationFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMigrationFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMigrationFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLockupTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLockupTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLockupTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BonusRatio != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BonusRatio))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DurationMultiplier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Tier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLockupTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLockupTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLockupTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLockupTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLockupTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLockupTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLockupTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLockupTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLockupTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRatioSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRatioSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRatioSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Interpolation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interpolation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Token != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRatioScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRatioScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRatioScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRatioSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRatioSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRatioSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRatioScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRatioScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRatioScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawalConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawalConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawalConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundDelay != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RefundDelay))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignatureThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignatureThreshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawalConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawalConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawalConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Token != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EthRecipient) > 0 {
+		i -= len(m.EthRecipient)
+		copy(dAtA[i:], m.EthRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveMigrations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveMigrations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveMigrations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BeforeBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BeforeBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Token != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveMigrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveMigrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveMigrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Archived != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Archived))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeWaiverConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeWaiverConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeWaiverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigratorGasQuota != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MigratorGasQuota))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockGasBudget != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockGasBudget))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeWaiverConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeWaiverConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeWaiverConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeAllowanceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeAllowanceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeAllowanceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SpendLimit) > 0 {
+		i -= len(m.SpendLimit)
+		copy(dAtA[i:], m.SpendLimit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpendLimit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeAllowanceConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeAllowanceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeAllowanceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMigrate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovTx(uint64(m.Token))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	if m.SourceChainId != 0 {
+		n += 1 + sovTx(uint64(m.SourceChainId))
+	}
+	if m.LockupTier != 0 {
+		n += 1 + sovTx(uint64(m.LockupTier))
+	}
+	if m.SourceBlock != 0 {
+		n += 1 + sovTx(uint64(m.SourceBlock))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CallbackMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMigrator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Migrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddMigratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMigrator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Migrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMigratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VestingDuration != 0 {
+		n += 1 + sovTx(uint64(m.VestingDuration))
+	}
+	if m.VestingCliff != 0 {
+		n += 1 + sovTx(uint64(m.VestingCliff))
+	}
+	if m.MinMigrationAmount != 0 {
+		n += 1 + sovTx(uint64(m.MinMigrationAmount))
+	}
+	return n
+}
+
+func (m *MsgUpdateConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevertMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevertMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClawedBack)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recovered)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Burned)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Shortfall)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ShortfallSpent)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ShortfallFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ShortfallFeeAllowance)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTokenSunset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovTx(uint64(m.Token))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	l = len(m.Cap)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SweepMode != 0 {
+		n += 1 + sovTx(uint64(m.SweepMode))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LegacyMinted)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTokenSunsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSourceChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovTx(uint64(m.Confirmations))
+	}
+	if len(m.Tokens) > 0 {
+		l = 0
+		for _, e := range m.Tokens {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetSourceChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMigrationFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovTx(uint64(m.Token))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovTx(uint64(m.BasisPoints))
+	}
+	l = len(m.WaiveBelow)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WaiveAbove)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Recipient != 0 {
+		n += 1 + sovTx(uint64(m.Recipient))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetMigrationFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetLockupTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Tier != 0 {
+		n += 1 + sovTx(uint64(m.Tier))
+	}
+	if m.DurationMultiplier != 0 {
+		n += 1 + sovTx(uint64(m.DurationMultiplier))
+	}
+	if m.BonusRatio != 0 {
+		n += 1 + sovTx(uint64(m.BonusRatio))
+	}
+	return n
+}
+
+func (m *MsgSetLockupTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveLockupTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Tier != 0 {
+		n += 1 + sovTx(uint64(m.Tier))
+	}
+	return n
+}
+
+func (m *MsgRemoveLockupTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRatioSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovTx(uint64(m.Token))
+	}
+	if m.Interpolation != 0 {
+		n += 1 + sovTx(uint64(m.Interpolation))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetRatioScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRatioSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovTx(uint64(m.Token))
+	}
+	return n
+}
+
+func (m *MsgRemoveRatioScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetWithdrawalConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignatureThreshold != 0 {
+		n += 1 + sovTx(uint64(m.SignatureThreshold))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovTx(uint64(m.Expiry))
+	}
+	if m.RefundDelay != 0 {
+		n += 1 + sovTx(uint64(m.RefundDelay))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawalConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EthRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovTx(uint64(m.Token))
+	}
+	if m.SourceChainId != 0 {
+		n += 1 + sovTx(uint64(m.SourceChainId))
+	}
+	return n
+}
+
+func (m *MsgRequestWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgSignWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfirmWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfirmWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgArchiveMigrations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovTx(uint64(m.Token))
+	}
+	if m.BeforeBlock != 0 {
+		n += 1 + sovTx(uint64(m.BeforeBlock))
+	}
+	if m.SourceChainId != 0 {
+		n += 1 + sovTx(uint64(m.SourceChainId))
+	}
+	return n
+}
+
+func (m *MsgArchiveMigrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Archived != 0 {
+		n += 1 + sovTx(uint64(m.Archived))
+	}
+	if m.Done {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFeeWaiverConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockGasBudget != 0 {
+		n += 1 + sovTx(uint64(m.BlockGasBudget))
+	}
+	if m.MigratorGasQuota != 0 {
+		n += 1 + sovTx(uint64(m.MigratorGasQuota))
+	}
+	return n
+}
+
+func (m *MsgSetFeeWaiverConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeAllowanceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpendLimit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovTx(uint64(m.Expiration))
+	}
+	return n
+}
+
+func (m *MsgSetFeeAllowanceConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTier", wireType)
+			}
+			m.LockupTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockupTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBlock", wireType)
+			}
+			m.SourceBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMigrator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMigrator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMigrator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMigratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMigratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMigratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMigrator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMigrator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMigrator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMigratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMigratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMigratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			m.VestingDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingCliff", wireType)
+			}
+			m.VestingCliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingCliff |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMigrationAmount", wireType)
+			}
+			m.MinMigrationAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMigrationAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevertMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevertMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevertMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevertMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevertMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevertMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBack = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovered", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recovered = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortfall = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortfallSpent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortfallSpent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortfallFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortfallFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortfallFeeAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortfallFeeAllowance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTokenSunset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTokenSunset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTokenSunset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepMode", wireType)
+			}
+			m.SweepMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SweepMode |= SweepMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyMinted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTokenSunsetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTokenSunsetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTokenSunsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSourceChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSourceChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSourceChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Tokens = append(m.Tokens, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Tokens) == 0 {
+					m.Tokens = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Tokens = append(m.Tokens, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSourceChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSourceChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMigrationFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMigrationFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMigrationFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaiveBelow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WaiveBelow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaiveAbove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WaiveAbove = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			m.Recipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recipient |= FeeRecipient(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMigrationFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMigrationFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMigrationFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLockupTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLockupTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLockupTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMultiplier", wireType)
+			}
+			m.DurationMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusRatio", wireType)
+			}
+			m.BonusRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BonusRatio |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLockupTierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLockupTierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLockupTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLockupTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLockupTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLockupTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLockupTierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLockupTierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLockupTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRatioSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRatioSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRatioSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interpolation", wireType)
+			}
+			m.Interpolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interpolation |= RatioInterpolation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, RatioPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRatioScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRatioScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRatioScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRatioSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRatioSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRatioSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRatioScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRatioScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRatioScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWithdrawalConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawalConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawalConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureThreshold", wireType)
+			}
+			m.SignatureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundDelay", wireType)
+			}
+			m.RefundDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.VestingDuration != 0 {
-		n += 1 + sovTx(uint64(m.VestingDuration))
+	return nil
+}
+func (m *MsgSetWithdrawalConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawalConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawalConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.VestingCliff != 0 {
-		n += 1 + sovTx(uint64(m.VestingCliff))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.MinMigrationAmount != 0 {
-		n += 1 + sovTx(uint64(m.MinMigrationAmount))
+	return nil
+}
+func (m *MsgRequestWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
+func (m *MsgSignWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1116,10 +8700,10 @@ func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1155,8 +8739,27 @@ func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1184,11 +8787,111 @@ func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1216,11 +8919,30 @@ func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1237,22 +8959,122 @@ func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1280,32 +9102,13 @@ func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
-			}
-			m.Token = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Token |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.LogIndex = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1315,7 +9118,7 @@ func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogIndex |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1341,7 +9144,7 @@ func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1364,10 +9167,10 @@ func (m *MsgMigrateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1391,7 +9194,7 @@ func (m *MsgMigrateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMigrator) Unmarshal(dAtA []byte) error {
+func (m *MsgArchiveMigrations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1414,10 +9217,10 @@ func (m *MsgAddMigrator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMigrator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgArchiveMigrations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMigrator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgArchiveMigrations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1453,10 +9256,10 @@ func (m *MsgAddMigrator) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			m.Token = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1466,24 +9269,49 @@ func (m *MsgAddMigrator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Token |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeBlock", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.BeforeBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Migrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1505,7 +9333,7 @@ func (m *MsgAddMigrator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMigratorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgArchiveMigrationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1528,12 +9356,51 @@ func (m *MsgAddMigratorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMigratorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgArchiveMigrationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMigratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgArchiveMigrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			m.Archived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Archived |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1555,7 +9422,7 @@ func (m *MsgAddMigratorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMigrator) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeWaiverConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,10 +9445,10 @@ func (m *MsgRemoveMigrator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMigrator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeWaiverConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMigrator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeWaiverConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1617,10 +9484,10 @@ func (m *MsgRemoveMigrator) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasBudget", wireType)
 			}
-			var stringLen uint64
+			m.BlockGasBudget = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1630,24 +9497,30 @@ func (m *MsgRemoveMigrator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockGasBudget |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratorGasQuota", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MigratorGasQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratorGasQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Migrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1669,7 +9542,7 @@ func (m *MsgRemoveMigrator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMigratorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeWaiverConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1692,10 +9565,10 @@ func (m *MsgRemoveMigratorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMigratorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeWaiverConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMigratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeWaiverConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1719,7 +9592,7 @@ func (m *MsgRemoveMigratorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeAllowanceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,10 +9615,10 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeAllowanceConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeAllowanceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1781,10 +9654,10 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
-			m.VestingDuration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1794,35 +9667,29 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VestingDuration |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingCliff", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.VestingCliff = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VestingCliff |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinMigrationAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			m.MinMigrationAmount = 0
+			m.Expiration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1832,7 +9699,7 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinMigrationAmount |= uint64(b&0x7F) << shift
+				m.Expiration |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1858,7 +9725,7 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeAllowanceConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1881,10 +9748,10 @@ func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeAllowanceConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeAllowanceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"golang.org/x/crypto/sha3"
+)
+
+// WithdrawalSignatureLength is the length of an ECDSA signature in the [R || S || V] format
+const WithdrawalSignatureLength = 65
+
+// ParseEthAddress decodes an hex encoded Ethereum address, with or without the 0x prefix
+func ParseEthAddress(address string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) != 20 {
+		return nil, fmt.Errorf("expected 20 bytes, got %d", len(bz))
+	}
+
+	return bz, nil
+}
+
+// WithdrawnTokenAmount returns the ERC-20 amount, in wei, the vault releases for the given uslf amount
+// converted at the given ratio
+func WithdrawnTokenAmount(amount sdkmath.Uint, ratio uint64) sdkmath.Uint {
+	return amount.Mul(sdkmath.NewUintFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(12), nil))).MulUint64(100).QuoUint64(ratio)
+}
+
+// Payload returns the canonical payload of the withdrawal, the equivalent of the solidity
+// abi.encode(uint256 chainId, uint256 nonce, address recipient, uint256 token, uint256 amount, uint256 expiresAt)
+func (w Withdrawal) Payload() []byte {
+	recipient, err := ParseEthAddress(w.EthRecipient)
+	if err != nil {
+		panic(err)
+	}
+
+	var payload []byte
+	payload = append(payload, abiWord(new(big.Int).SetUint64(w.SourceChainId))...)
+	payload = append(payload, abiWord(new(big.Int).SetUint64(w.Id))...)
+	payload = append(payload, abiWord(new(big.Int).SetBytes(recipient))...)
+	payload = append(payload, abiWord(new(big.Int).SetUint64(w.Token))...)
+	payload = append(payload, abiWord(sdkmath.NewUintFromString(w.TokenAmount).BigInt())...)
+	payload = append(payload, abiWord(new(big.Int).SetUint64(w.ExpiresAt))...)
+
+	return payload
+}
+
+// ComputePayloadHash returns the hex encoded keccak256 hash of the payload of the withdrawal
+func (w Withdrawal) ComputePayloadHash() string {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(w.Payload())
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// IsOpen returns whether the withdrawal can still be executed or refunded
+func (w Withdrawal) IsOpen() bool {
+	return w.Status == WithdrawalStatus_WITHDRAWAL_STATUS_PENDING || w.Status == WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED
+}
+
+// HasSigned returns whether the migrator has signed the withdrawal
+func (w Withdrawal) HasSigned(migrator string) bool {
+	for _, signature := range w.Signatures {
+		if signature.Migrator == migrator {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasConfirmed returns whether the migrator has confirmed the execution of the withdrawal
+func (w Withdrawal) HasConfirmed(migrator string) bool {
+	for _, confirmation := range w.Confirmations {
+		if confirmation.Migrator == migrator {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasCancelled returns whether the migrator has attested the withdrawal was not executed
+func (w Withdrawal) HasCancelled(migrator string) bool {
+	for _, cancellation := range w.Cancellations {
+		if cancellation == migrator {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConfirmationsOf returns the number of migrators that have confirmed the withdrawal was executed by the
+// given transaction
+func (w Withdrawal) ConfirmationsOf(ethTxHash string) uint64 {
+	var count uint64
+	for _, confirmation := range w.Confirmations {
+		if confirmation.EthTxHash == ethTxHash {
+			count++
+		}
+	}
+
+	return count
+}
+
+func abiWord(value *big.Int) []byte {
+	word := make([]byte, 32)
+	return value.FillBytes(word)
+}
@@ -0,0 +1,1703 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: selfchain/migration/withdrawal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type WithdrawalStatus int32
+
+const (
+	// waiting for enough migrator signatures
+	WithdrawalStatus_WITHDRAWAL_STATUS_PENDING WithdrawalStatus = 0
+	// the signatures can be submitted to the vault
+	WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED WithdrawalStatus = 1
+	// the vault released the tokens
+	WithdrawalStatus_WITHDRAWAL_STATUS_EXECUTED WithdrawalStatus = 2
+	// the withdrawal was never executed and the burnt uslf have been minted back
+	WithdrawalStatus_WITHDRAWAL_STATUS_REFUNDED WithdrawalStatus = 3
+)
+
+var WithdrawalStatus_name = map[int32]string{
+	0: "WITHDRAWAL_STATUS_PENDING",
+	1: "WITHDRAWAL_STATUS_SIGNED",
+	2: "WITHDRAWAL_STATUS_EXECUTED",
+	3: "WITHDRAWAL_STATUS_REFUNDED",
+}
+
+var WithdrawalStatus_value = map[string]int32{
+	"WITHDRAWAL_STATUS_PENDING":  0,
+	"WITHDRAWAL_STATUS_SIGNED":   1,
+	"WITHDRAWAL_STATUS_EXECUTED": 2,
+	"WITHDRAWAL_STATUS_REFUNDED": 3,
+}
+
+func (x WithdrawalStatus) String() string {
+	return proto.EnumName(WithdrawalStatus_name, int32(x))
+}
+
+func (WithdrawalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ccf5fb2d16b6dfd7, []int{0}
+}
+
+type WithdrawalSignature struct {
+	Migrator string `protobuf:"bytes,1,opt,name=migrator,proto3" json:"migrator,omitempty"`
+	// hex encoded 65 bytes signature over the payload hash
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *WithdrawalSignature) Reset()         { *m = WithdrawalSignature{} }
+func (m *WithdrawalSignature) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalSignature) ProtoMessage()    {}
+func (*WithdrawalSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf5fb2d16b6dfd7, []int{0}
+}
+func (m *WithdrawalSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalSignature.Merge(m, src)
+}
+func (m *WithdrawalSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalSignature proto.InternalMessageInfo
+
+func (m *WithdrawalSignature) GetMigrator() string {
+	if m != nil {
+		return m.Migrator
+	}
+	return ""
+}
+
+func (m *WithdrawalSignature) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type WithdrawalConfirmation struct {
+	Migrator  string `protobuf:"bytes,1,opt,name=migrator,proto3" json:"migrator,omitempty"`
+	EthTxHash string `protobuf:"bytes,2,opt,name=ethTxHash,proto3" json:"ethTxHash,omitempty"`
+}
+
+func (m *WithdrawalConfirmation) Reset()         { *m = WithdrawalConfirmation{} }
+func (m *WithdrawalConfirmation) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalConfirmation) ProtoMessage()    {}
+func (*WithdrawalConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf5fb2d16b6dfd7, []int{1}
+}
+func (m *WithdrawalConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalConfirmation.Merge(m, src)
+}
+func (m *WithdrawalConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalConfirmation proto.InternalMessageInfo
+
+func (m *WithdrawalConfirmation) GetMigrator() string {
+	if m != nil {
+		return m.Migrator
+	}
+	return ""
+}
+
+func (m *WithdrawalConfirmation) GetEthTxHash() string {
+	if m != nil {
+		return m.EthTxHash
+	}
+	return ""
+}
+
+// Withdrawal is a request to release ERC-20 tokens from the vault in exchange of burnt uslf
+type Withdrawal struct {
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator       string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	EthRecipient  string `protobuf:"bytes,3,opt,name=ethRecipient,proto3" json:"ethRecipient,omitempty"`
+	Token         uint64 `protobuf:"varint,4,opt,name=token,proto3" json:"token,omitempty"`
+	SourceChainId uint64 `protobuf:"varint,5,opt,name=sourceChainId,proto3" json:"sourceChainId,omitempty"`
+	// uslf burnt by the creator
+	Amount string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ERC-20 amount, in wei, released by the vault
+	TokenAmount string `protobuf:"bytes,7,opt,name=tokenAmount,proto3" json:"tokenAmount,omitempty"`
+	// the vault rejects the withdrawal from this unix time
+	ExpiresAt uint64 `protobuf:"varint,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// unix time from which a withdrawal that never reached the signature threshold is refunded
+	RefundAt uint64           `protobuf:"varint,9,opt,name=refundAt,proto3" json:"refundAt,omitempty"`
+	Status   WithdrawalStatus `protobuf:"varint,10,opt,name=status,proto3,enum=selfchain.migration.WithdrawalStatus" json:"status,omitempty"`
+	// hex encoded keccak256 hash of the payload the migrators sign
+	PayloadHash string                `protobuf:"bytes,11,opt,name=payloadHash,proto3" json:"payloadHash,omitempty"`
+	Signatures  []WithdrawalSignature `protobuf:"bytes,12,rep,name=signatures,proto3" json:"signatures"`
+	EthTxHash   string                `protobuf:"bytes,13,opt,name=ethTxHash,proto3" json:"ethTxHash,omitempty"`
+	// the withdrawal is executed once as many migrators as the signature threshold confirm the same transaction
+	Confirmations []WithdrawalConfirmation `protobuf:"bytes,14,rep,name=confirmations,proto3" json:"confirmations"`
+	// migrators that attest the vault never executed the withdrawal. A signed withdrawal is only refunded once
+	// as many migrators as the signature threshold cancel it
+	Cancellations []string `protobuf:"bytes,15,rep,name=cancellations,proto3" json:"cancellations,omitempty"`
+}
+
+func (m *Withdrawal) Reset()         { *m = Withdrawal{} }
+func (m *Withdrawal) String() string { return proto.CompactTextString(m) }
+func (*Withdrawal) ProtoMessage()    {}
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf5fb2d16b6dfd7, []int{2}
+}
+func (m *Withdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Withdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Withdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Withdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Withdrawal.Merge(m, src)
+}
+func (m *Withdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Withdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Withdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Withdrawal proto.InternalMessageInfo
+
+func (m *Withdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Withdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Withdrawal) GetEthRecipient() string {
+	if m != nil {
+		return m.EthRecipient
+	}
+	return ""
+}
+
+func (m *Withdrawal) GetToken() uint64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *Withdrawal) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+func (m *Withdrawal) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Withdrawal) GetTokenAmount() string {
+	if m != nil {
+		return m.TokenAmount
+	}
+	return ""
+}
+
+func (m *Withdrawal) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Withdrawal) GetRefundAt() uint64 {
+	if m != nil {
+		return m.RefundAt
+	}
+	return 0
+}
+
+func (m *Withdrawal) GetStatus() WithdrawalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return WithdrawalStatus_WITHDRAWAL_STATUS_PENDING
+}
+
+func (m *Withdrawal) GetPayloadHash() string {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return ""
+}
+
+func (m *Withdrawal) GetSignatures() []WithdrawalSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *Withdrawal) GetEthTxHash() string {
+	if m != nil {
+		return m.EthTxHash
+	}
+	return ""
+}
+
+func (m *Withdrawal) GetConfirmations() []WithdrawalConfirmation {
+	if m != nil {
+		return m.Confirmations
+	}
+	return nil
+}
+
+func (m *Withdrawal) GetCancellations() []string {
+	if m != nil {
+		return m.Cancellations
+	}
+	return nil
+}
+
+type WithdrawalConfig struct {
+	// number of migrator signatures the vault requires
+	SignatureThreshold uint64 `protobuf:"varint,1,opt,name=signatureThreshold,proto3" json:"signatureThreshold,omitempty"`
+	// seconds during which the signatures can be submitted to the vault
+	Expiry uint64 `protobuf:"varint,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// seconds, after the expiry, before a withdrawal that never reached the signature threshold is refunded
+	RefundDelay uint64 `protobuf:"varint,3,opt,name=refundDelay,proto3" json:"refundDelay,omitempty"`
+}
+
+func (m *WithdrawalConfig) Reset()         { *m = WithdrawalConfig{} }
+func (m *WithdrawalConfig) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalConfig) ProtoMessage()    {}
+func (*WithdrawalConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf5fb2d16b6dfd7, []int{3}
+}
+func (m *WithdrawalConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalConfig.Merge(m, src)
+}
+func (m *WithdrawalConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalConfig proto.InternalMessageInfo
+
+func (m *WithdrawalConfig) GetSignatureThreshold() uint64 {
+	if m != nil {
+		return m.SignatureThreshold
+	}
+	return 0
+}
+
+func (m *WithdrawalConfig) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *WithdrawalConfig) GetRefundDelay() uint64 {
+	if m != nil {
+		return m.RefundDelay
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("selfchain.migration.WithdrawalStatus", WithdrawalStatus_name, WithdrawalStatus_value)
+	proto.RegisterType((*WithdrawalSignature)(nil), "selfchain.migration.WithdrawalSignature")
+	proto.RegisterType((*WithdrawalConfirmation)(nil), "selfchain.migration.WithdrawalConfirmation")
+	proto.RegisterType((*Withdrawal)(nil), "selfchain.migration.Withdrawal")
+	proto.RegisterType((*WithdrawalConfig)(nil), "selfchain.migration.WithdrawalConfig")
+}
+
+func init() {
+	proto.RegisterFile("selfchain/migration/withdrawal.proto", fileDescriptor_ccf5fb2d16b6dfd7)
+}
+
+var fileDescriptor_ccf5fb2d16b6dfd7 = []byte{
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x6f, 0xda, 0x4e,
+	0x10, 0xc5, 0xe0, 0x90, 0x30, 0xf9, 0xf3, 0xb3, 0x36, 0x51, 0xb4, 0x3f, 0x9a, 0xba, 0x08, 0xa5,
+	0x12, 0x6a, 0x25, 0x90, 0x52, 0xf5, 0xd8, 0x83, 0x83, 0xdd, 0x04, 0xa9, 0xa2, 0x95, 0x31, 0xa2,
+	0xea, 0x25, 0xda, 0xda, 0x0b, 0xb6, 0x6a, 0xbc, 0xc8, 0x5e, 0x14, 0x90, 0xfa, 0x1d, 0xda, 0x8f,
+	0x95, 0x63, 0x8e, 0x39, 0x55, 0x15, 0x7c, 0x91, 0xca, 0x6b, 0x30, 0x86, 0x20, 0x71, 0xf3, 0xbc,
+	0x37, 0xf3, 0x66, 0x3c, 0x6f, 0xb4, 0x70, 0x19, 0x51, 0xbf, 0x6f, 0xbb, 0xc4, 0x0b, 0x1a, 0x43,
+	0x6f, 0x10, 0x12, 0xee, 0xb1, 0xa0, 0x71, 0xef, 0x71, 0xd7, 0x09, 0xc9, 0x3d, 0xf1, 0xeb, 0xa3,
+	0x90, 0x71, 0x86, 0x4e, 0xd3, 0xac, 0x7a, 0x9a, 0x55, 0x3e, 0x1b, 0xb0, 0x01, 0x13, 0x7c, 0x23,
+	0xfe, 0x4a, 0x52, 0xab, 0x9f, 0xe1, 0xb4, 0x97, 0x96, 0x77, 0xbc, 0x41, 0x40, 0xf8, 0x38, 0xa4,
+	0xa8, 0x0c, 0x07, 0x49, 0x25, 0x0b, 0xb1, 0x54, 0x91, 0x6a, 0x25, 0x33, 0x8d, 0xd1, 0x05, 0x94,
+	0xa2, 0x65, 0x22, 0xce, 0x0b, 0x72, 0x05, 0x54, 0x4d, 0x38, 0x5f, 0x09, 0x36, 0x59, 0xd0, 0xf7,
+	0xc2, 0xa1, 0x18, 0x60, 0x97, 0x26, 0xe5, 0xae, 0x35, 0xb9, 0x25, 0x91, 0xbb, 0xd4, 0x4c, 0x81,
+	0xea, 0x93, 0x0c, 0xb0, 0x12, 0x45, 0x27, 0x90, 0xf7, 0x1c, 0x21, 0x21, 0x9b, 0x79, 0xcf, 0x41,
+	0x18, 0xf6, 0xed, 0x90, 0x0a, 0xdd, 0xa4, 0x74, 0x19, 0xa2, 0x2a, 0x1c, 0x51, 0xee, 0x9a, 0xd4,
+	0xf6, 0x46, 0x1e, 0x0d, 0x38, 0x2e, 0x08, 0x7a, 0x0d, 0x43, 0x67, 0xb0, 0xc7, 0xd9, 0x0f, 0x1a,
+	0x60, 0x59, 0x08, 0x26, 0x01, 0xba, 0x84, 0xe3, 0x88, 0x8d, 0x43, 0x9b, 0x36, 0xe3, 0x35, 0xb6,
+	0x1c, 0xbc, 0x27, 0xd8, 0x75, 0x10, 0x9d, 0x43, 0x91, 0x0c, 0xd9, 0x38, 0xe0, 0xb8, 0x28, 0x94,
+	0x17, 0x11, 0xaa, 0xc0, 0xa1, 0x90, 0xd1, 0x12, 0x72, 0x5f, 0x90, 0x59, 0x48, 0xfc, 0xf0, 0x64,
+	0xe4, 0x85, 0x34, 0xd2, 0x38, 0x3e, 0x10, 0xda, 0x2b, 0x20, 0x5e, 0x55, 0x48, 0xfb, 0xe3, 0xc0,
+	0xd1, 0x38, 0x2e, 0x09, 0x32, 0x8d, 0xd1, 0x07, 0x28, 0x46, 0x9c, 0xf0, 0x71, 0x84, 0xa1, 0x22,
+	0xd5, 0x4e, 0xae, 0x5e, 0xd7, 0xb7, 0xb8, 0x5d, 0xcf, 0x98, 0x2a, 0x92, 0xcd, 0x45, 0x51, 0x3c,
+	0xda, 0x88, 0x4c, 0x7d, 0x46, 0x1c, 0xb1, 0xeb, 0xc3, 0x64, 0xb4, 0x0c, 0x84, 0xda, 0x00, 0xa9,
+	0x9d, 0x11, 0x3e, 0xaa, 0x14, 0x6a, 0x87, 0x57, 0xb5, 0x5d, 0x4d, 0x96, 0x05, 0xd7, 0xf2, 0xc3,
+	0x9f, 0x57, 0x39, 0x33, 0xa3, 0xb0, 0xee, 0xed, 0xf1, 0x86, 0xb7, 0xa8, 0x07, 0xc7, 0x76, 0xe6,
+	0x4a, 0x22, 0x7c, 0x22, 0x1a, 0xbe, 0xdd, 0xd1, 0x30, 0x7b, 0x59, 0x8b, 0x9e, 0xeb, 0x3a, 0xb1,
+	0x83, 0x36, 0x09, 0x6c, 0xea, 0xfb, 0x0b, 0xe1, 0xff, 0x2a, 0x85, 0x5a, 0xc9, 0x5c, 0x07, 0xab,
+	0x3f, 0x41, 0xd9, 0x10, 0x1d, 0xa0, 0x3a, 0xa0, 0x74, 0x7c, 0xcb, 0x0d, 0x69, 0xe4, 0x32, 0x7f,
+	0x79, 0x6f, 0x5b, 0x98, 0xf8, 0x0a, 0x84, 0x75, 0x53, 0x71, 0x7e, 0xb2, 0xb9, 0x88, 0xe2, 0x55,
+	0x27, 0xae, 0xe9, 0xd4, 0x27, 0x53, 0x71, 0x7c, 0xb2, 0x99, 0x85, 0xde, 0xfc, 0x92, 0x40, 0xd9,
+	0x74, 0x0a, 0xbd, 0x84, 0xff, 0x7b, 0x2d, 0xeb, 0x56, 0x37, 0xb5, 0x9e, 0xf6, 0xe9, 0xae, 0x63,
+	0x69, 0x56, 0xb7, 0x73, 0xf7, 0xc5, 0x68, 0xeb, 0xad, 0xf6, 0x8d, 0x92, 0x43, 0x17, 0x80, 0x9f,
+	0xd3, 0x9d, 0xd6, 0x4d, 0xdb, 0xd0, 0x15, 0x09, 0xa9, 0x50, 0x7e, 0xce, 0x1a, 0x5f, 0x8d, 0x66,
+	0xd7, 0x32, 0x74, 0x25, 0xbf, 0x9d, 0x37, 0x8d, 0x8f, 0xdd, 0xb6, 0x6e, 0xe8, 0x4a, 0xe1, 0xfa,
+	0xfd, 0xc3, 0x4c, 0x95, 0x1e, 0x67, 0xaa, 0xf4, 0x77, 0xa6, 0x4a, 0xbf, 0xe7, 0x6a, 0xee, 0x71,
+	0xae, 0xe6, 0x9e, 0xe6, 0x6a, 0xee, 0xdb, 0x8b, 0xd5, 0xd3, 0x33, 0xc9, 0x3c, 0x3e, 0x7c, 0x3a,
+	0xa2, 0xd1, 0xf7, 0xa2, 0x78, 0x4d, 0xde, 0xfd, 0x1b, 0x00, 0x8f, 0x2a, 0x35, 0x49, 0xa0, 0x04,
+	0x00, 0x00,
+}
+
+func (m *WithdrawalSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Migrator) > 0 {
+		i -= len(m.Migrator)
+		copy(dAtA[i:], m.Migrator)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.Migrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Migrator) > 0 {
+		i -= len(m.Migrator)
+		copy(dAtA[i:], m.Migrator)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.Migrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Withdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Withdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Withdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cancellations) > 0 {
+		for iNdEx := len(m.Cancellations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cancellations[iNdEx])
+			copy(dAtA[i:], m.Cancellations[iNdEx])
+			i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.Cancellations[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWithdrawal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWithdrawal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Status != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RefundAt != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.RefundAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TokenAmount) > 0 {
+		i -= len(m.TokenAmount)
+		copy(dAtA[i:], m.TokenAmount)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.TokenAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SourceChainId != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Token != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EthRecipient) > 0 {
+		i -= len(m.EthRecipient)
+		copy(dAtA[i:], m.EthRecipient)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.EthRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundDelay != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.RefundDelay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignatureThreshold != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.SignatureThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWithdrawal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWithdrawal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WithdrawalSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Migrator)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawalConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Migrator)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	return n
+}
+
+func (m *Withdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovWithdrawal(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	l = len(m.EthRecipient)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovWithdrawal(uint64(m.Token))
+	}
+	if m.SourceChainId != 0 {
+		n += 1 + sovWithdrawal(uint64(m.SourceChainId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	l = len(m.TokenAmount)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovWithdrawal(uint64(m.ExpiresAt))
+	}
+	if m.RefundAt != 0 {
+		n += 1 + sovWithdrawal(uint64(m.RefundAt))
+	}
+	if m.Status != 0 {
+		n += 1 + sovWithdrawal(uint64(m.Status))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovWithdrawal(uint64(l))
+		}
+	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovWithdrawal(uint64(l))
+		}
+	}
+	if len(m.Cancellations) > 0 {
+		for _, s := range m.Cancellations {
+			l = len(s)
+			n += 1 + l + sovWithdrawal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WithdrawalConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignatureThreshold != 0 {
+		n += 1 + sovWithdrawal(uint64(m.SignatureThreshold))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovWithdrawal(uint64(m.Expiry))
+	}
+	if m.RefundDelay != 0 {
+		n += 1 + sovWithdrawal(uint64(m.RefundDelay))
+	}
+	return n
+}
+
+func sovWithdrawal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWithdrawal(x uint64) (n int) {
+	return sovWithdrawal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WithdrawalSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Withdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Withdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Withdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAt", wireType)
+			}
+			m.RefundAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= WithdrawalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, WithdrawalSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, WithdrawalConfirmation{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancellations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cancellations = append(m.Cancellations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureThreshold", wireType)
+			}
+			m.SignatureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundDelay", wireType)
+			}
+			m.RefundDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWithdrawal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWithdrawal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWithdrawal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWithdrawal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWithdrawal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWithdrawal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWithdrawal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWithdrawal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEthAddress(t *testing.T) {
+	bz, err := ParseEthAddress("0xbaf6dc2e647aeb6f510f9e318856a1bcd66c5e19")
+	require.NoError(t, err)
+	require.Len(t, bz, 20)
+
+	_, err = ParseEthAddress("baf6dc2e647aeb6f510f9e318856a1bcd66c5e19")
+	require.NoError(t, err)
+
+	_, err = ParseEthAddress("baf6dc2e647aeb6f510f9e318856a1bcd66c5e")
+	require.Error(t, err)
+
+	_, err = ParseEthAddress("not an address")
+	require.Error(t, err)
+}
+
+func TestWithdrawnTokenAmount(t *testing.T) {
+	// 1 SLF gives back 1 FRONT
+	require.Equal(t, sdkmath.NewUintFromString("1000000000000000000"), WithdrawnTokenAmount(sdkmath.NewUint(1000000), FRONT_RATIO))
+
+	// 1 SLF gives back 4 HOTCROSS at a 25% ratio
+	require.Equal(t, sdkmath.NewUintFromString("4000000000000000000"), WithdrawnTokenAmount(sdkmath.NewUint(1000000), 25))
+}
+
+func TestWithdrawal_PayloadHash(t *testing.T) {
+	withdrawal := Withdrawal{
+		Id:            7,
+		EthRecipient:  "0xbaf6dc2e647aeb6f510f9e318856a1bcd66c5e19",
+		Token:         uint64(Front),
+		SourceChainId: EthereumChainId,
+		TokenAmount:   "1000000000000000000",
+		ExpiresAt:     1700000000,
+	}
+
+	payload := withdrawal.Payload()
+	require.Len(t, payload, 6*32)
+	require.Equal(t, byte(7), payload[63])
+	require.Equal(t, withdrawal.EthRecipient[2:], hex.EncodeToString(payload[76:96]))
+
+	// the hash only depends on the signed fields
+	hash := withdrawal.ComputePayloadHash()
+	require.Len(t, hash, 64)
+	withdrawal.Creator = "someone"
+	withdrawal.Status = WithdrawalStatus_WITHDRAWAL_STATUS_SIGNED
+	require.Equal(t, hash, withdrawal.ComputePayloadHash())
+
+	withdrawal.Id = 8
+	require.NotEqual(t, hash, withdrawal.ComputePayloadHash())
+}
@@ -0,0 +1,105 @@
+package ante
+
+import (
+	"bytes"
+
+	"selfchain/x/selfvesting/keeper"
+	"selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReleaseFeeDecorator pays the fee of the transactions made only of releases of the positions of their signer
+// out of the tokens they release, so that beneficiaries without any liquid balance can release their tokens.
+// The fee is sent to the beneficiary out of the releases, and then deducted from it by the wrapped fee
+// decorator like any other fee, which checks it against the minimum gas prices and sets the priority of the
+// transaction. The wrapped decorator deducts the fee of any other transaction, or of one whose releases don't
+// cover it, from the fee payer as usual.
+type ReleaseFeeDecorator struct {
+	k         keeper.Keeper
+	deductFee sdk.AnteDecorator
+}
+
+func NewReleaseFeeDecorator(k keeper.Keeper, deductFee sdk.AnteDecorator) ReleaseFeeDecorator {
+	return ReleaseFeeDecorator{k: k, deductFee: deductFee}
+}
+
+func (d ReleaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// The fee must be paid by the beneficiary itself, whose signature is verified by the decorators that come next
+	beneficiary, positionIds, ok := releasesOf(ctx, d.k, feeTx)
+	if !ok || feeTx.FeeGranter() != nil || !bytes.Equal(feeTx.FeePayer(), sdk.MustAccAddressFromBech32(beneficiary)) {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// Nothing is taken from the released tokens when there is no fee, except while simulating so that the
+	// estimated gas covers the lookup of the positions
+	fee := feeTx.GetFee()
+	if !isUslf(fee) || (fee.IsZero() && !simulate) {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// The fee isn't paid out of the releases if they don't cover it, the wrapped decorator then deducting it
+	// from the balance of the beneficiary
+	if _, err := d.k.PayReleaseFee(ctx, beneficiary, positionIds, sdkmath.NewUintFromBigInt(fee.AmountOf(types.DENOM).BigInt())); err != nil {
+		return ctx, err
+	}
+
+	return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+}
+
+// releasesOf returns the beneficiary and the released positions of a transaction made only of releases
+// sent by the same account. Releasing all positions releases every position of the account.
+func releasesOf(ctx sdk.Context, k keeper.Keeper, tx sdk.Tx) (string, []uint64, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return "", nil, false
+	}
+
+	var beneficiary string
+	positionIds := make([]uint64, 0, len(msgs))
+	for i, msg := range msgs {
+		var creator string
+		var released []uint64
+		switch msg := msg.(type) {
+		case *types.MsgRelease:
+			creator, released = msg.Creator, []uint64{msg.PositionId}
+		case *types.MsgReleaseAll:
+			creator = msg.Creator
+			vestingPositions, _ := k.GetVestingPositions(ctx, msg.Creator)
+			for _, vestingInfo := range vestingPositions.VestingInfos {
+				released = append(released, vestingInfo.Id)
+			}
+		default:
+			return "", nil, false
+		}
+
+		if i > 0 && creator != beneficiary {
+			return "", nil, false
+		}
+		beneficiary = creator
+		positionIds = append(positionIds, released...)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(beneficiary); err != nil {
+		return "", nil, false
+	}
+
+	return beneficiary, positionIds, true
+}
+
+// isUslf returns whether a fee is only made of uslf, the denomination the positions release
+func isUslf(fee sdk.Coins) bool {
+	for _, coin := range fee {
+		if coin.Denom != types.DENOM {
+			return false
+		}
+	}
+
+	return true
+}
@@ -27,6 +27,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListVestingPositions())
 	cmd.AddCommand(CmdShowVestingPositions())
+	cmd.AddCommand(CmdListVestingPosition())
+	cmd.AddCommand(CmdShowVestingPosition())
+	cmd.AddCommand(CmdShowClaimable())
+	cmd.AddCommand(CmdShowUnlockSchedule())
+	cmd.AddCommand(CmdShowEarlyUnlockConfig())
+	cmd.AddCommand(CmdShowFeeAllowance())
+	cmd.AddCommand(CmdShowEarlyUnlockQuote())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"selfchain/x/selfvesting/types"
+)
+
+func CmdShowClaimable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-claimable [beneficiary]",
+		Short: "shows the amount a beneficiary can claim from each of its positions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryClaimableRequest{
+				Beneficiary: args[0],
+			}
+
+			res, err := queryClient.Claimable(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"selfchain/x/selfvesting/types"
+)
+
+func CmdShowEarlyUnlockQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-early-unlock-quote [position-id]",
+		Short: "shows what unlocking a position early would pay out right now",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPositionId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argAmount, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEarlyUnlockQuoteRequest{
+				PositionId: argPositionId,
+				Amount:     argAmount,
+			}
+
+			res, err := queryClient.EarlyUnlockQuote(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagAmount, "", "Unvested amount to unlock, all of it when empty")
+
+	return cmd
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"selfchain/x/selfvesting/types"
+)
+
+func CmdShowFeeAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-fee-allowance [beneficiary]",
+		Short: "shows the fee allowance granted to a beneficiary and the uslf withheld for it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetFeeAllowanceRequest{
+				Beneficiary: args[0],
+			}
+
+			res, err := queryClient.FeeAllowance(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
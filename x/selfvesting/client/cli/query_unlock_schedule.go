@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"selfchain/x/selfvesting/types"
+)
+
+const (
+	flagInterval = "interval"
+	flagLimit    = "limit"
+)
+
+func CmdShowUnlockSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-unlock-schedule [beneficiary]",
+		Short: "shows the projected unlocks of the positions of a beneficiary",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			interval, err := cmd.Flags().GetUint64(flagInterval)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryUnlockScheduleRequest{
+				Beneficiary: args[0],
+				Interval:    interval,
+				Limit:       limit,
+			}
+
+			res, err := queryClient.UnlockSchedule(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagInterval, types.DefaultUnlockScheduleInterval, "seconds between two points of the schedule")
+	cmd.Flags().Uint64(flagLimit, types.DefaultUnlockScheduleLimit, "maximum number of points of the schedule")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
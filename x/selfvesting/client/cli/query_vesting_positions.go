@@ -12,7 +12,7 @@ import (
 func CmdListVestingPositions() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-vesting-positions",
-		Short: "list all vestingPositions",
+		Short: "list the vesting positions of every beneficiary",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -45,17 +45,23 @@ func CmdListVestingPositions() *cobra.Command {
 func CmdShowVestingPositions() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-vesting-positions [beneficiary]",
-		Short: "shows a vestingPositions",
+		Short: "shows the vesting positions of a beneficiary",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			argBeneficiary := args[0]
 
 			params := &types.QueryGetVestingPositionsRequest{
 				Beneficiary: argBeneficiary,
+				Pagination:  pageReq,
 			}
 
 			res, err := queryClient.VestingPositions(context.Background(), params)
@@ -67,6 +73,7 @@ func CmdShowVestingPositions() *cobra.Command {
 		},
 	}
 
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -6,13 +6,11 @@ import (
 	"testing"
 
 	tmcli "github.com/cometbft/cometbft/libs/cli"
-	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"selfchain/testutil/network"
 	"selfchain/testutil/nullify"
 	"selfchain/x/selfvesting/client/cli"
 	"selfchain/x/selfvesting/types"
@@ -21,27 +19,8 @@ import (
 // Prevent strconv unused error
 var _ = strconv.IntSize
 
-func networkWithVestingPositionsObjects(t *testing.T, n int) (*network.Network, []types.VestingPositions) {
-	t.Helper()
-	cfg := network.DefaultConfig()
-	state := types.GenesisState{}
-	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
-
-	for i := 0; i < n; i++ {
-		vestingPositions := types.VestingPositions{
-			Beneficiary: strconv.Itoa(i),
-		}
-		nullify.Fill(&vestingPositions)
-		state.VestingPositionsList = append(state.VestingPositionsList, vestingPositions)
-	}
-	buf, err := cfg.Codec.MarshalJSON(&state)
-	require.NoError(t, err)
-	cfg.GenesisState[types.ModuleName] = buf
-	return network.New(t, cfg), state.VestingPositionsList
-}
-
 func TestShowVestingPositions(t *testing.T) {
-	net, objs := networkWithVestingPositionsObjects(t, 2)
+	net, objs := networkWithVestingPositionObjects(t, 2)
 
 	ctx := net.Validators[0].ClientCtx
 	common := []string{
@@ -53,7 +32,7 @@ func TestShowVestingPositions(t *testing.T) {
 
 		args []string
 		err  error
-		obj  types.VestingPositions
+		obj  types.VestingInfo
 	}{
 		{
 			desc:          "found",
@@ -84,78 +63,13 @@ func TestShowVestingPositions(t *testing.T) {
 				require.NoError(t, err)
 				var resp types.QueryGetVestingPositionsResponse
 				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
-				require.NotNil(t, resp.VestingPositions)
+				require.Equal(t, tc.idBeneficiary, resp.VestingPositions.Beneficiary)
+				require.Len(t, resp.VestingPositions.VestingInfos, 1)
 				require.Equal(t,
 					nullify.Fill(&tc.obj),
-					nullify.Fill(&resp.VestingPositions),
+					nullify.Fill(resp.VestingPositions.VestingInfos[0]),
 				)
 			}
 		})
 	}
 }
-
-func TestListVestingPositions(t *testing.T) {
-	net, objs := networkWithVestingPositionsObjects(t, 5)
-
-	ctx := net.Validators[0].ClientCtx
-	request := func(next []byte, offset, limit uint64, total bool) []string {
-		args := []string{
-			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
-		}
-		if next == nil {
-			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
-		} else {
-			args = append(args, fmt.Sprintf("--%s=%s", flags.FlagPageKey, next))
-		}
-		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagLimit, limit))
-		if total {
-			args = append(args, fmt.Sprintf("--%s", flags.FlagCountTotal))
-		}
-		return args
-	}
-	t.Run("ByOffset", func(t *testing.T) {
-		step := 2
-		for i := 0; i < len(objs); i += step {
-			args := request(nil, uint64(i), uint64(step), false)
-			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListVestingPositions(), args)
-			require.NoError(t, err)
-			var resp types.QueryAllVestingPositionsResponse
-			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
-			require.LessOrEqual(t, len(resp.VestingPositions), step)
-			require.Subset(t,
-				nullify.Fill(objs),
-				nullify.Fill(resp.VestingPositions),
-			)
-		}
-	})
-	t.Run("ByKey", func(t *testing.T) {
-		step := 2
-		var next []byte
-		for i := 0; i < len(objs); i += step {
-			args := request(next, 0, uint64(step), false)
-			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListVestingPositions(), args)
-			require.NoError(t, err)
-			var resp types.QueryAllVestingPositionsResponse
-			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
-			require.LessOrEqual(t, len(resp.VestingPositions), step)
-			require.Subset(t,
-				nullify.Fill(objs),
-				nullify.Fill(resp.VestingPositions),
-			)
-			next = resp.Pagination.NextKey
-		}
-	})
-	t.Run("Total", func(t *testing.T) {
-		args := request(nil, 0, uint64(len(objs)), true)
-		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListVestingPositions(), args)
-		require.NoError(t, err)
-		var resp types.QueryAllVestingPositionsResponse
-		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
-		require.NoError(t, err)
-		require.Equal(t, len(objs), int(resp.Pagination.Total))
-		require.ElementsMatch(t,
-			nullify.Fill(objs),
-			nullify.Fill(resp.VestingPositions),
-		)
-	})
-}
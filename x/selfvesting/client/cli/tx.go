@@ -31,6 +31,15 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdRelease())
+	cmd.AddCommand(CmdReleaseAll())
+	cmd.AddCommand(CmdCreateVestingPosition())
+	cmd.AddCommand(CmdRevokePosition())
+	cmd.AddCommand(CmdTransferPosition())
+	cmd.AddCommand(CmdAcceptPosition())
+	cmd.AddCommand(CmdCancelTransfer())
+	cmd.AddCommand(CmdSplitPosition())
+	cmd.AddCommand(CmdMergePositions())
+	cmd.AddCommand(CmdEarlyUnlock())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"selfchain/x/selfvesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const (
+	flagStartTime  = "start-time"
+	flagRevocable  = "revocable"
+	flagCurve      = "curve"
+	flagStepPeriod = "step-period"
+	flagPeriods    = "periods"
+)
+
+func CmdCreateVestingPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-position [beneficiary] [amount] [cliff] [duration]",
+		Short: "Broadcast message create-vesting-position",
+		Long: `Escrows the amount into a new vesting position of the beneficiary. The cliff is in seconds from the
+start of the vesting. The curve is either linear, cliff, periodic or piecewise. Periodic vestings unlock every
+step period and piecewise vestings take a comma separated list of length:amount periods.
+
+Example: create-vesting-position self1... 1000000 2592000 31536000 --curve periodic --step-period 2592000`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBeneficiary := args[0]
+			argAmount := args[1]
+
+			argCliff, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argDuration, err := cast.ToUint64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argStartTime, err := cmd.Flags().GetUint64(flagStartTime)
+			if err != nil {
+				return err
+			}
+
+			argRevocable, err := cmd.Flags().GetBool(flagRevocable)
+			if err != nil {
+				return err
+			}
+
+			argCurve, err := cmd.Flags().GetString(flagCurve)
+			if err != nil {
+				return err
+			}
+			curve, ok := types.VestingCurve_value["VESTING_CURVE_"+strings.ToUpper(argCurve)]
+			if !ok {
+				return fmt.Errorf("unknown vesting curve %s", argCurve)
+			}
+
+			argStepPeriod, err := cmd.Flags().GetUint64(flagStepPeriod)
+			if err != nil {
+				return err
+			}
+
+			argPeriods, err := cmd.Flags().GetString(flagPeriods)
+			if err != nil {
+				return err
+			}
+			periods, err := parseVestingPeriods(argPeriods)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingPosition(
+				clientCtx.GetFromAddress().String(),
+				argBeneficiary,
+				argAmount,
+				argCliff,
+				argDuration,
+				argStartTime,
+				argRevocable,
+			)
+			msg.Curve = types.VestingCurve(curve)
+			msg.StepPeriod = argStepPeriod
+			msg.Periods = periods
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartTime, 0, "Unix time at which the vesting starts, the block time when unset")
+	cmd.Flags().Bool(flagRevocable, false, "Allow the sender to take back the unvested part of the position")
+	cmd.Flags().String(flagCurve, "linear", "Vesting curve, either linear, cliff, periodic or piecewise")
+	cmd.Flags().Uint64(flagStepPeriod, 0, "Seconds between two tranches of a periodic vesting")
+	cmd.Flags().String(flagPeriods, "", "Comma separated length:amount periods of a piecewise vesting")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseVestingPeriods(arg string) ([]types.VestingPeriod, error) {
+	if arg == "" {
+		return nil, nil
+	}
+
+	var periods []types.VestingPeriod
+	for _, pair := range strings.Split(arg, listSeparator) {
+		lengthAndAmount := strings.Split(pair, ":")
+		if len(lengthAndAmount) != 2 {
+			return nil, fmt.Errorf("invalid vesting period %s", pair)
+		}
+
+		length, err := cast.ToUint64E(lengthAndAmount[0])
+		if err != nil {
+			return nil, err
+		}
+
+		periods = append(periods, types.VestingPeriod{Length: length, Amount: lengthAndAmount[1]})
+	}
+
+	return periods, nil
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const flagAmount = "amount"
+
+func CmdEarlyUnlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "early-unlock [position-id]",
+		Short: "Broadcast message early-unlock",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPositionId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argAmount, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEarlyUnlock(
+				clientCtx.GetFromAddress().String(),
+				argPositionId,
+				argAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagAmount, "", "Unvested amount to unlock, all of it when empty")
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"selfchain/x/selfvesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdMergePositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-positions [position-ids]",
+		Short: "Broadcast message merge-positions",
+		Long:  "Merge the comma separated positions into the first of them",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPositionIds := []uint64{}
+			for _, arg := range strings.Split(args[0], listSeparator) {
+				positionId, err := cast.ToUint64E(arg)
+				if err != nil {
+					return err
+				}
+				argPositionIds = append(argPositionIds, positionId)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMergePositions(
+				clientCtx.GetFromAddress().String(),
+				argPositionIds,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
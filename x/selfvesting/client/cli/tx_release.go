@@ -16,11 +16,11 @@ var _ = strconv.Itoa(0)
 
 func CmdRelease() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "release [pos-index]",
+		Use:   "release [position-id]",
 		Short: "Broadcast message release",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argPosIndex, err := cast.ToUint64E(args[0])
+			argPositionId, err := cast.ToUint64E(args[0])
 			if err != nil {
 				return err
 			}
@@ -32,7 +32,7 @@ func CmdRelease() *cobra.Command {
 
 			msg := types.NewMsgRelease(
 				clientCtx.GetFromAddress().String(),
-				argPosIndex,
+				argPositionId,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const flagRecipient = "recipient"
+
+func CmdSplitPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-position [position-id] [amount]",
+		Short: "Broadcast message split-position",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPositionId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argRecipient, err := cmd.Flags().GetString(flagRecipient)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSplitPosition(
+				clientCtx.GetFromAddress().String(),
+				argPositionId,
+				args[1],
+				argRecipient,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagRecipient, "", "Account the new position goes to, the sender when empty")
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const flagRequireAcceptance = "require-acceptance"
+
+func CmdTransferPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-position [position-id] [recipient]",
+		Short: "Broadcast message transfer-position",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPositionId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argRequireAcceptance, err := cmd.Flags().GetBool(flagRequireAcceptance)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferPosition(
+				clientCtx.GetFromAddress().String(),
+				argPositionId,
+				args[1],
+				argRequireAcceptance,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(flagRequireAcceptance, false, "Wait for the recipient to accept the position")
+
+	return cmd
+}
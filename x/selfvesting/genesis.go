@@ -8,9 +8,37 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	// Set all the vestingPositions
+	// Set all the vestingPosition
+	for _, elem := range genState.VestingPositionList {
+		k.SetVestingPosition(ctx, elem)
+	}
+
+	// Set vestingPosition count
+	k.SetVestingPositionCount(ctx, genState.VestingPositionCount)
+
+	// Set all the legacyPositionIndex
+	for _, elem := range genState.LegacyPositionIndexList {
+		k.SetLegacyPositionIndex(ctx, elem)
+	}
+
+	// Set all the pendingTransfer
+	for _, elem := range genState.PendingTransferList {
+		k.SetPendingTransfer(ctx, elem)
+	}
+
+	// Set earlyUnlockConfig if defined
+	if genState.EarlyUnlockConfig != nil {
+		k.SetEarlyUnlockConfig(ctx, *genState.EarlyUnlockConfig)
+	}
+
+	// Set all the feeAllowance
+	for _, elem := range genState.FeeAllowanceList {
+		k.SetFeeAllowance(ctx, elem)
+	}
+
+	// Positions exported in the layout used before they were stored under their own id are given new ids
 	for _, elem := range genState.VestingPositionsList {
-		k.SetVestingPositions(ctx, elem)
+		k.MigrateLegacyVestingPositions(ctx, elem)
 	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
@@ -21,7 +49,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
-	genesis.VestingPositionsList = k.GetAllVestingPositions(ctx)
+	genesis.VestingPositionList = k.GetAllVestingPosition(ctx)
+	genesis.VestingPositionCount = k.GetVestingPositionCount(ctx)
+	genesis.LegacyPositionIndexList = k.GetAllLegacyPositionIndex(ctx)
+	genesis.PendingTransferList = k.GetAllPendingTransfer(ctx)
+
+	// Get earlyUnlockConfig
+	earlyUnlockConfig, found := k.GetEarlyUnlockConfig(ctx)
+	if found {
+		genesis.EarlyUnlockConfig = &earlyUnlockConfig
+	}
+	genesis.FeeAllowanceList = k.GetAllFeeAllowance(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
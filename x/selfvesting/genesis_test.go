@@ -14,14 +14,43 @@ func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
-		VestingPositionsList: []types.VestingPositions{
+		VestingPositionList: []types.VestingInfo{
 			{
+				Id:          1,
 				Beneficiary: "0",
 			},
 			{
+				Id:          2,
 				Beneficiary: "1",
 			},
 		},
+		VestingPositionCount: 2,
+		LegacyPositionIndexList: []types.LegacyPositionIndex{
+			{
+				Beneficiary: "0",
+				Index:       0,
+				PositionId:  1,
+			},
+		},
+		PendingTransferList: []types.PendingTransfer{
+			{
+				PositionId: 2,
+				From:       "1",
+				Recipient:  "0",
+			},
+		},
+		EarlyUnlockConfig: &types.EarlyUnlockConfig{
+			StartPenaltyBasisPoints: 5000,
+			EndPenaltyBasisPoints:   1000,
+			BurnPenalty:             true,
+		},
+		FeeAllowanceList: []types.FeeAllowance{
+			{
+				Beneficiary: "0",
+				Withheld:    "1000",
+				Expiration:  2000,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -33,6 +62,52 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
-	require.ElementsMatch(t, genesisState.VestingPositionsList, got.VestingPositionsList)
+	require.ElementsMatch(t, genesisState.VestingPositionList, got.VestingPositionList)
+	require.Equal(t, genesisState.VestingPositionCount, got.VestingPositionCount)
+	require.ElementsMatch(t, genesisState.LegacyPositionIndexList, got.LegacyPositionIndexList)
+	require.ElementsMatch(t, genesisState.PendingTransferList, got.PendingTransferList)
+	require.Equal(t, genesisState.EarlyUnlockConfig, got.EarlyUnlockConfig)
+	require.ElementsMatch(t, genesisState.FeeAllowanceList, got.FeeAllowanceList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisLegacyVestingPositions(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		VestingPositionList: []types.VestingInfo{
+			{
+				Id:          1,
+				Beneficiary: "0",
+				Amount:      "100",
+			},
+		},
+		VestingPositionCount: 1,
+		VestingPositionsList: []types.VestingPositions{
+			{
+				Beneficiary: "1",
+				VestingInfos: []*types.VestingInfo{
+					{Amount: "200", TotalClaimed: "0"},
+					{Amount: "300", TotalClaimed: "300"},
+				},
+			},
+		},
+	}
+
+	k, ctx := keepertest.SelfvestingKeeper(t)
+	selfvesting.InitGenesis(ctx, *k, genesisState)
+	got := selfvesting.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+
+	// Positions in the legacy layout are given new ids and fully claimed ones are pruned
+	require.Empty(t, got.VestingPositionsList)
+	require.Equal(t, uint64(3), got.VestingPositionCount)
+	require.ElementsMatch(t, []types.VestingInfo{
+		{Id: 1, Beneficiary: "0", Amount: "100"},
+		{Id: 2, Beneficiary: "1", Amount: "200", TotalClaimed: "0"},
+	}, got.VestingPositionList)
+	require.ElementsMatch(t, []types.LegacyPositionIndex{
+		{Beneficiary: "1", Index: 0, PositionId: 2},
+		{Beneficiary: "1", Index: 1, PositionId: 3},
+	}, got.LegacyPositionIndexList)
+}
@@ -9,25 +9,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func (k Keeper) AddBeneficiary(ctx sdk.Context, req types.AddBeneficiaryRequest) (*types.VestingInfo, error) {
+// AddBeneficiary creates a new vesting position for the beneficiary and returns it together with its id
+func (k Keeper) AddBeneficiary(ctx sdk.Context, req types.AddBeneficiaryRequest) (*types.VestingInfo, uint64, error) {
 	// check the benficiary address is a valid bech32 address
 	_, err := sdk.AccAddressFromBech32(req.Beneficiary)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+		return nil, 0, sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
 	}
 
-	// create and add a new vesting position under the beneficiary key
-	_, positionsExist := k.GetVestingPositions(ctx, req.Beneficiary)
-
-	// if this is the first vesting position then create a default entry
-	if !positionsExist {
-		k.SetVestingPositions(ctx, types.VestingPositions{
-			Beneficiary: req.Beneficiary,
-		})
-	}
-
-	vestingPositions, _ := k.GetVestingPositions(ctx, req.Beneficiary)
-
 	// startTime := uint64(ctx.BlockHeader().Time.Unix())
 	startTime := utils.BlockTime(ctx)
 	newPosition := &types.VestingInfo{
@@ -37,12 +26,19 @@ func (k Keeper) AddBeneficiary(ctx sdk.Context, req types.AddBeneficiaryRequest)
 		Amount:        req.Amount,
 		TotalClaimed:  "0",
 		PeriodClaimed: 0,
+		LockupTier:    req.LockupTier,
+		Beneficiary:   req.Beneficiary,
+		Curve:         req.Curve,
+		StepPeriod:    req.StepPeriod,
+		Periods:       req.Periods,
+	}
+
+	if err := newPosition.Validate(); err != nil {
+		return nil, 0, err
 	}
 
-	k.SetVestingPositions(ctx, types.VestingPositions{
-		Beneficiary:  req.Beneficiary,
-		VestingInfos: append(vestingPositions.VestingInfos, newPosition),
-	})
+	// store the new vesting position under its own id
+	newPosition.Id = k.AppendVestingPosition(ctx, *newPosition)
 
-	return newPosition, nil
+	return newPosition, newPosition.Id, nil
 }
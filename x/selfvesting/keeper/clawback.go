@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClawbackPosition cancels the unreleased part of a vesting position by capping its amount to
+// what has already been claimed, which prunes it. The clawed back tokens stay in the module account and it is up
+// to the caller to decide what to do with them. It returns the clawed back and the claimed amounts.
+// The fees the module paid for the beneficiary out of the position count as claimed. Positions that
+// were fully claimed and pruned return ErrPositionFullyClaimed. The position is clawed back from whoever it
+// has been transferred to.
+func (k Keeper) ClawbackPosition(ctx sdk.Context, positionId uint64) (sdkmath.Uint, sdkmath.Uint, error) {
+	vestingInfo, err := k.getPosition(ctx, positionId)
+	if err != nil {
+		return sdkmath.ZeroUint(), sdkmath.ZeroUint(), err
+	}
+
+	amount := sdkmath.NewUintFromString(vestingInfo.Amount)
+	totalClaimed := sdkmath.NewUintFromString(vestingInfo.TotalClaimed).Add(pendingFeeOf(&vestingInfo))
+
+	// nothing left to claw back
+	if totalClaimed.GTE(amount) {
+		return sdkmath.ZeroUint(), totalClaimed, nil
+	}
+
+	// the capped position is fully claimed and can be pruned
+	clawedBack := amount.Sub(totalClaimed)
+	k.RemoveVestingPosition(ctx, vestingInfo)
+
+	return clawedBack, totalClaimed, nil
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"selfchain/x/selfvesting/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SetEarlyUnlockConfig set earlyUnlockConfig in the store
+func (k Keeper) SetEarlyUnlockConfig(ctx sdk.Context, earlyUnlockConfig types.EarlyUnlockConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EarlyUnlockConfigKey))
+	b := k.cdc.MustMarshal(&earlyUnlockConfig)
+	store.Set([]byte{0}, b)
+}
+
+// GetEarlyUnlockConfig returns earlyUnlockConfig
+func (k Keeper) GetEarlyUnlockConfig(ctx sdk.Context) (val types.EarlyUnlockConfig, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EarlyUnlockConfigKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// earlyUnlock is what unlocking a position early pays out
+type earlyUnlock struct {
+	released           sdkmath.Uint
+	unlocked           sdkmath.Uint
+	penaltyBasisPoints uint64
+	penalty            sdkmath.Uint
+	feeDeducted        sdkmath.Uint
+	netAmount          sdkmath.Uint
+}
+
+// quoteEarlyUnlock computes what unlocking an unvested amount of a position at the given unix time pays out.
+// What has vested and hasn't been claimed yet is released along with it, without penalty. An empty amount
+// unlocks everything that hasn't vested yet.
+func (k Keeper) quoteEarlyUnlock(ctx sdk.Context, vestingInfo types.VestingInfo, amount string, now uint64) (earlyUnlock, error) {
+	config, found := k.GetEarlyUnlockConfig(ctx)
+	if !found {
+		return earlyUnlock{}, types.ErrEarlyUnlockDisabled
+	}
+
+	// the funder of a revocable position could still take back what is unlocked
+	if vestingInfo.Revocable {
+		return earlyUnlock{}, types.ErrEarlyUnlockNotAllowed
+	}
+
+	// governance could no longer claw back what is unlocked from a position opened by a migration
+	if vestingInfo.OpenedByMigration() {
+		return earlyUnlock{}, types.ErrMigratedPosition
+	}
+
+	totalAmount := sdkmath.NewUintFromString(vestingInfo.Amount)
+	totalClaimed := sdkmath.NewUintFromString(vestingInfo.TotalClaimed)
+	vested := vestingInfo.VestedAmountAt(now)
+
+	released := sdkmath.ZeroUint()
+	if vested.GT(totalClaimed) {
+		released = vested.Sub(totalClaimed)
+	}
+
+	unvested := sdkmath.ZeroUint()
+	if claimedOrVested := sdkmath.MaxUint(vested, totalClaimed); totalAmount.GT(claimedOrVested) {
+		unvested = totalAmount.Sub(claimedOrVested)
+	}
+
+	unlocked := unvested
+	if amount != "" {
+		requested, err := sdkmath.ParseUint(amount)
+		if err != nil {
+			return earlyUnlock{}, sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid amount (%s)", err)
+		}
+		if requested.GT(unvested) {
+			return earlyUnlock{}, sdkerrors.Wrapf(errors.ErrInvalidRequest, "only %s is left to unlock", unvested)
+		}
+		unlocked = requested
+	}
+
+	if unlocked.IsZero() {
+		return earlyUnlock{}, sdkerrors.Wrap(errors.ErrInvalidRequest, "nothing is left to unlock")
+	}
+
+	penaltyBasisPoints := config.PenaltyBasisPointsAt(vestingInfo, now)
+	penalty := config.Penalty(unlocked, penaltyBasisPoints)
+
+	// pay back the fees the module paid for the beneficiary out of what is paid out
+	paidOut := released.Add(unlocked).Sub(penalty)
+	feeDeducted := sdkmath.MinUint(pendingFeeOf(&vestingInfo), paidOut)
+
+	return earlyUnlock{
+		released:           released,
+		unlocked:           unlocked,
+		penaltyBasisPoints: penaltyBasisPoints,
+		penalty:            penalty,
+		feeDeducted:        feeDeducted,
+		netAmount:          paidOut.Sub(feeDeducted),
+	}, nil
+}
+
+// disposePenalty burns the penalty of an early unlock or sends it to the community pool
+func (k Keeper) disposePenalty(ctx sdk.Context, config types.EarlyUnlockConfig, penalty sdkmath.Uint) error {
+	penaltyCoins := sdk.NewCoins(sdk.NewCoin(types.DENOM, sdkmath.NewIntFromBigInt(penalty.BigInt())))
+	if penaltyCoins.IsZero() {
+		return nil
+	}
+
+	if config.BurnPenalty {
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, penaltyCoins)
+	}
+
+	return k.distrKeeper.FundCommunityPool(ctx, penaltyCoins, authtypes.NewModuleAddress(types.ModuleName))
+}
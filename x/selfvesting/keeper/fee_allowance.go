@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"time"
+
+	"selfchain/x/selfvesting/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	cosmotypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GrantFeeAllowance lets the beneficiary pay the fees of the selfvesting messages with the coins of the
+// module account, up to the spend limit and until the expiration. A beneficiary that already has an
+// allowance gets its spend limit topped up and its expiration extended. The spend limit is withheld in
+// the module account and what is left of it is refunded to the beneficiary once the allowance expires.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, beneficiary string, spendLimit sdk.Coins, expiration time.Time) error {
+	grantee, err := sdk.AccAddressFromBech32(beneficiary)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+	}
+	granter := authtypes.NewModuleAddress(types.ModuleName)
+
+	withheld := sdkmath.NewUintFromBigInt(spendLimit.AmountOf(types.DENOM).BigInt())
+	feeAllowance := types.FeeAllowance{
+		Beneficiary: beneficiary,
+		Withheld:    withheld.String(),
+		Expiration:  expiration.Unix(),
+	}
+	basic := &feegrant.BasicAllowance{SpendLimit: spendLimit}
+
+	// The allowance of an expired fee allowance has been refunded, only a live one is topped up
+	existing, _ := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	if live, found := k.GetFeeAllowance(ctx, beneficiary); found {
+		if existingBasic, ok := basicAllowanceOf(existing); ok {
+			basic.SpendLimit = existingBasic.SpendLimit.Add(spendLimit...)
+		}
+		feeAllowance.Withheld = sdkmath.NewUintFromString(live.Withheld).Add(withheld).String()
+		if live.Expiration > feeAllowance.Expiration {
+			feeAllowance.Expiration = live.Expiration
+		}
+		k.RemoveFeeAllowance(ctx, live)
+	}
+
+	// The feegrant module prunes an allowance at the expiration it was first granted with, even once it has
+	// been extended. The allowance is therefore granted without an expiration and then given one, the
+	// module refunding and closing it once expired.
+	if existing == nil {
+		unexpiring, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: basic.SpendLimit}, types.FeeAllowanceMsgs())
+		if err != nil {
+			return err
+		}
+		if err := k.feegrantKeeper.GrantAllowance(ctx, granter, grantee, unexpiring); err != nil {
+			return err
+		}
+	}
+
+	allowanceExpiration := time.Unix(feeAllowance.Expiration, 0).UTC()
+	basic.Expiration = &allowanceExpiration
+	allowance, err := feegrant.NewAllowedMsgAllowance(basic, types.FeeAllowanceMsgs())
+	if err != nil {
+		return err
+	}
+	if err := k.feegrantKeeper.UpdateAllowance(ctx, granter, grantee, allowance); err != nil {
+		return err
+	}
+
+	k.SetFeeAllowance(ctx, feeAllowance)
+	return nil
+}
+
+// RefundExpiredFeeAllowances refunds to their beneficiaries what is left of the fee allowances that have
+// expired. Each refund runs in its own cache context: when one fails, e.g. because the beneficiary can't
+// receive funds, its allowance is left untouched and still counts as expired at the next EndBlock.
+func (k Keeper) RefundExpiredFeeAllowances(ctx sdk.Context) {
+	for _, feeAllowance := range k.expiredFeeAllowances(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refundFeeAllowance(cacheCtx, feeAllowance); err != nil {
+			k.Logger(ctx).Error("could not refund fee allowance", "beneficiary", feeAllowance.Beneficiary, "err", err)
+			continue
+		}
+		write()
+	}
+}
+
+func (k Keeper) refundFeeAllowance(ctx sdk.Context, feeAllowance types.FeeAllowance) error {
+	grantee, err := sdk.AccAddressFromBech32(feeAllowance.Beneficiary)
+	if err != nil {
+		return err
+	}
+	granter := authtypes.NewModuleAddress(types.ModuleName)
+
+	existing, _ := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	basic, ok := basicAllowanceOf(existing)
+	if !ok {
+		basic = &feegrant.BasicAllowance{}
+	}
+
+	refunded := basic.SpendLimit
+	if !refunded.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, grantee, refunded); err != nil {
+			return err
+		}
+	}
+
+	// The expired allowance is kept with no spend limit since the feegrant keeper can't revoke it, and is
+	// topped up by the next allowance of the beneficiary
+	if ok {
+		expired := &feegrant.BasicAllowance{Expiration: basic.Expiration}
+		allowance, err := feegrant.NewAllowedMsgAllowance(expired, types.FeeAllowanceMsgs())
+		if err != nil {
+			return err
+		}
+		if err := k.feegrantKeeper.UpdateAllowance(ctx, granter, grantee, allowance); err != nil {
+			return err
+		}
+	}
+	k.RemoveFeeAllowance(ctx, feeAllowance)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundFeeAllowance,
+		sdk.NewAttribute(types.AttributeKeyBeneficiary, feeAllowance.Beneficiary),
+		sdk.NewAttribute(types.AttributeKeyWithheld, feeAllowance.Withheld),
+		sdk.NewAttribute(types.AttributeKeyRefunded, refunded.AmountOf(types.DENOM).String()),
+	))
+
+	return nil
+}
+
+// SetFeeAllowance sets the fee allowance of a beneficiary and queues it for its refund
+func (k Keeper) SetFeeAllowance(ctx sdk.Context, feeAllowance types.FeeAllowance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeAllowanceKeyPrefix))
+	store.Set(types.FeeAllowanceKey(feeAllowance.Beneficiary), k.cdc.MustMarshal(&feeAllowance))
+
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeAllowanceQueueKeyPrefix))
+	queue.Set(types.FeeAllowanceQueueKey(feeAllowance.Expiration, feeAllowance.Beneficiary), []byte(feeAllowance.Beneficiary))
+}
+
+// GetFeeAllowance returns the fee allowance of a beneficiary
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, beneficiary string) (val types.FeeAllowance, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeAllowanceKeyPrefix))
+
+	b := store.Get(types.FeeAllowanceKey(beneficiary))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveFeeAllowance removes the fee allowance of a beneficiary from the store and from the refund queue
+func (k Keeper) RemoveFeeAllowance(ctx sdk.Context, feeAllowance types.FeeAllowance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeAllowanceKeyPrefix))
+	store.Delete(types.FeeAllowanceKey(feeAllowance.Beneficiary))
+
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeAllowanceQueueKeyPrefix))
+	queue.Delete(types.FeeAllowanceQueueKey(feeAllowance.Expiration, feeAllowance.Beneficiary))
+}
+
+// GetAllFeeAllowance returns all feeAllowance
+func (k Keeper) GetAllFeeAllowance(ctx sdk.Context) (list []types.FeeAllowance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeAllowanceKeyPrefix))
+	iterator := cosmotypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeAllowance
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// expiredFeeAllowances returns the fee allowances that can no longer be used. The feegrant module accepts
+// an allowance until its expiration included, so it is only refunded from the next second.
+func (k Keeper) expiredFeeAllowances(ctx sdk.Context) (list []types.FeeAllowance) {
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeAllowanceQueueKeyPrefix))
+	iterator := queue.Iterator(nil, types.FeeAllowanceQueueKey(ctx.BlockTime().Unix(), ""))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if feeAllowance, found := k.GetFeeAllowance(ctx, string(iterator.Value())); found {
+			list = append(list, feeAllowance)
+		}
+	}
+
+	return
+}
+
+// basicAllowanceOf returns the basic allowance behind an allowance granted by the module
+func basicAllowanceOf(allowance feegrant.FeeAllowanceI) (*feegrant.BasicAllowance, bool) {
+	allowed, ok := allowance.(*feegrant.AllowedMsgAllowance)
+	if !ok {
+		return nil, false
+	}
+
+	inner, err := allowed.GetAllowance()
+	if err != nil {
+		return nil, false
+	}
+
+	basic, ok := inner.(*feegrant.BasicAllowance)
+	return basic, ok
+}
@@ -19,7 +19,12 @@ type (
 		memKey     storetypes.StoreKey
 		paramstore paramtypes.Subspace
 
-		bankKeeper types.BankKeeper
+		bankKeeper     types.BankKeeper
+		feegrantKeeper types.FeegrantKeeper
+		distrKeeper    types.DistrKeeper
+
+		// the address capable of executing governance gated messages, typically the x/gov module account
+		authority string
 	}
 )
 
@@ -30,6 +35,9 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 
 	bankKeeper types.BankKeeper,
+	feegrantKeeper types.FeegrantKeeper,
+	distrKeeper types.DistrKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -42,10 +50,19 @@ func NewKeeper(
 		memKey:     memKey,
 		paramstore: ps,
 
-		bankKeeper: bankKeeper,
+		bankKeeper:     bankKeeper,
+		feegrantKeeper: feegrantKeeper,
+		distrKeeper:    distrKeeper,
+
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
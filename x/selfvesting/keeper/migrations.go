@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	cosmotypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves the positions stored as one list per beneficiary under their own ids. Beneficiaries
+// are migrated in the order of their store keys and their positions in the order of the list.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefix(types.VestingPositionsKeyPrefix))
+	iterator := cosmotypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	var positions []types.VestingPositions
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VestingPositions
+		if err := m.keeper.cdc.Unmarshal(iterator.Value(), &val); err != nil {
+			iterator.Close()
+			return err
+		}
+
+		keys = append(keys, iterator.Key())
+		positions = append(positions, val)
+	}
+	iterator.Close()
+
+	for i, vestingPositions := range positions {
+		store.Delete(keys[i])
+		m.keeper.MigrateLegacyVestingPositions(ctx, vestingPositions)
+	}
+
+	return nil
+}
+
+// MigrateLegacyVestingPositions stores the positions of a beneficiary, held as one list, under new ids and
+// records the id each index of the list was given. Fully claimed positions don't owe anything and are
+// pruned right away.
+func (k Keeper) MigrateLegacyVestingPositions(ctx sdk.Context, vestingPositions types.VestingPositions) {
+	for index, vestingInfo := range vestingPositions.VestingInfos {
+		vestingInfo.Beneficiary = vestingPositions.Beneficiary
+
+		fullyClaimed := sdkmath.NewUintFromString(vestingInfo.TotalClaimed).GTE(sdkmath.NewUintFromString(vestingInfo.Amount))
+		var id uint64
+		if fullyClaimed && pendingFeeOf(vestingInfo).IsZero() {
+			id = k.GetVestingPositionCount(ctx) + 1
+			k.SetVestingPositionCount(ctx, id)
+		} else {
+			id = k.AppendVestingPosition(ctx, *vestingInfo)
+		}
+
+		k.SetLegacyPositionIndex(ctx, types.LegacyPositionIndex{
+			Beneficiary: vestingPositions.Beneficiary,
+			Index:       uint64(index),
+			PositionId:  id,
+		})
+	}
+}
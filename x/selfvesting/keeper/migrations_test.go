@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/x/selfvesting/keeper"
+	"selfchain/x/selfvesting/types"
+
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := keepertest.SelfvestingKeeper(t)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	raw := ctx.MultiStore().(*rootmulti.Store).GetStoreByName(types.StoreKey).(storetypes.KVStore)
+	legacyStore := prefix.NewStore(raw, types.KeyPrefix(types.VestingPositionsKeyPrefix))
+
+	legacyPositions := []types.VestingPositions{
+		{
+			Beneficiary: "alice",
+			VestingInfos: []*types.VestingInfo{
+				{Amount: "100", TotalClaimed: "100"},
+				{Amount: "200", TotalClaimed: "50", PendingFee: "10"},
+			},
+		},
+		{
+			Beneficiary: "bob",
+			VestingInfos: []*types.VestingInfo{
+				{Amount: "500", TotalClaimed: "0"},
+			},
+		},
+	}
+	for _, vestingPositions := range legacyPositions {
+		legacyStore.Set(types.VestingPositionsKey(vestingPositions.Beneficiary), cdc.MustMarshal(&vestingPositions))
+	}
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+	iterator := storetypes.KVStorePrefixIterator(legacyStore, []byte{})
+	require.False(t, iterator.Valid())
+	iterator.Close()
+
+	// Positions are given ids in the order of the beneficiaries and of their lists
+	require.Equal(t, uint64(3), k.GetVestingPositionCount(ctx))
+	for _, tc := range []struct {
+		beneficiary string
+		index       uint64
+		id          uint64
+	}{
+		{"alice", 0, 1},
+		{"alice", 1, 2},
+		{"bob", 0, 3},
+	} {
+		legacyPositionIndex, found := k.GetLegacyPositionIndex(ctx, tc.beneficiary, tc.index)
+		require.True(t, found)
+		require.Equal(t, tc.id, legacyPositionIndex.PositionId)
+	}
+
+	// The fully claimed position is pruned
+	_, found := k.GetVestingPosition(ctx, 1)
+	require.False(t, found)
+
+	position, found := k.GetVestingPosition(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, types.VestingInfo{Id: 2, Beneficiary: "alice", Amount: "200", TotalClaimed: "50", PendingFee: "10"}, position)
+
+	alicePositions, found := k.GetVestingPositions(ctx, "alice")
+	require.True(t, found)
+	require.Len(t, alicePositions.VestingInfos, 1)
+
+	bobPositions, found := k.GetVestingPositions(ctx, "bob")
+	require.True(t, found)
+	require.Equal(t, uint64(3), bobPositions.VestingInfos[0].Id)
+	require.Equal(t, "500", bobPositions.VestingInfos[0].Amount)
+}
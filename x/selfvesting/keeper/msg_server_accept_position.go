@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AcceptPosition completes a transfer of a position offered to the creator
+func (k msgServer) AcceptPosition(goCtx context.Context, msg *types.MsgAcceptPosition) (*types.MsgAcceptPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pendingTransfer, found := k.GetPendingTransfer(ctx, msg.PositionId)
+	if !found || pendingTransfer.Recipient != msg.Creator {
+		return nil, types.ErrNoPendingTransfer
+	}
+
+	vestingInfo, err := k.getBeneficiaryPosition(ctx, pendingTransfer.From, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	k.transferPosition(ctx, vestingInfo, msg.Creator)
+
+	return &types.MsgAcceptPositionResponse{}, nil
+}
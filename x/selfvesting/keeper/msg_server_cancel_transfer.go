@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CancelTransfer drops a transfer of a position that is waiting for its recipient. Both the beneficiary and
+// the recipient can drop it.
+func (k msgServer) CancelTransfer(goCtx context.Context, msg *types.MsgCancelTransfer) (*types.MsgCancelTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pendingTransfer, found := k.GetPendingTransfer(ctx, msg.PositionId)
+	if !found || (pendingTransfer.From != msg.Creator && pendingTransfer.Recipient != msg.Creator) {
+		return nil, types.ErrNoPendingTransfer
+	}
+
+	k.RemovePendingTransfer(ctx, msg.PositionId)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelTransfer,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyFrom, pendingTransfer.From),
+		sdk.NewAttribute(types.AttributeKeyRecipient, pendingTransfer.Recipient),
+	))
+
+	return &types.MsgCancelTransferResponse{}, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ClawbackGrant returns the unvested part of a grant to the community pool
+func (k msgServer) ClawbackGrant(goCtx context.Context, msg *types.MsgClawbackGrant) (*types.MsgClawbackGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	vestingInfo, err := k.getPosition(ctx, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !vestingInfo.Revocable || vestingInfo.Funder != communityPoolFunder {
+		return nil, types.ErrNotAGrant
+	}
+
+	amountReturned := k.revokeUnvested(ctx, vestingInfo, utils.BlockTime(ctx))
+
+	returnedCoins := sdk.NewCoins(sdk.NewCoin(types.DENOM, sdkmath.NewIntFromBigInt(amountReturned.BigInt())))
+	if !returnedCoins.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, returnedCoins, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClawbackGrant,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyBeneficiary, vestingInfo.Beneficiary),
+		sdk.NewAttribute(types.AttributeKeyAmountReturned, amountReturned.String()),
+	))
+
+	return &types.MsgClawbackGrantResponse{AmountReturned: amountReturned.String()}, nil
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// communityPoolFunder is the funder recorded on grants, their unvested part goes back to the community pool
+var communityPoolFunder = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+
+// CreateGrant pays a grant approved by governance out of the community pool into a vesting position of the grantee
+func (k msgServer) CreateGrant(goCtx context.Context, msg *types.MsgCreateGrant) (*types.MsgCreateGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	now := utils.BlockTime(ctx)
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = now
+	}
+	if startTime < now {
+		return nil, types.ErrInvalidStartTime
+	}
+
+	position := types.VestingInfo{
+		StartTime:    startTime,
+		Duration:     msg.Duration,
+		Cliff:        startTime + msg.Cliff,
+		Amount:       msg.Amount,
+		TotalClaimed: "0",
+		Beneficiary:  msg.Grantee,
+		Funder:       communityPoolFunder,
+		Revocable:    true,
+	}
+	if err := position.Validate(); err != nil {
+		return nil, err
+	}
+
+	// module accounts can't receive funds from the community pool, the grant goes through the authority
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	grantCoins := sdk.NewCoins(sdk.NewCoin(
+		types.DENOM,
+		sdkmath.NewIntFromBigInt(sdkmath.NewUintFromString(msg.Amount).BigInt()),
+	))
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, grantCoins, authority); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, authority, types.ModuleName, grantCoins); err != nil {
+		return nil, err
+	}
+
+	positionId := k.AppendVestingPosition(ctx, position)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateGrant,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyBeneficiary, msg.Grantee),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+	))
+
+	return &types.MsgCreateGrantResponse{PositionId: positionId}, nil
+}
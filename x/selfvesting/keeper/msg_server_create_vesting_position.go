@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateVestingPosition escrows the funds of the creator into a new position of the beneficiary. A position
+// created for another account must meet the minimum amount and fit in the maximum number of positions of the
+// beneficiary.
+func (k msgServer) CreateVestingPosition(goCtx context.Context, msg *types.MsgCreateVestingPosition) (*types.MsgCreateVestingPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	now := utils.BlockTime(ctx)
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = now
+	}
+	if startTime < now {
+		return nil, types.ErrInvalidStartTime
+	}
+
+	position := msg.Position(startTime)
+	if err := position.Validate(); err != nil {
+		return nil, err
+	}
+
+	// A beneficiary funding its own position doesn't need to be protected from it
+	if msg.Creator != msg.Beneficiary {
+		if err := k.checkPositionLimits(ctx, msg.Beneficiary, sdkmath.NewUintFromString(msg.Amount)); err != nil {
+			return nil, err
+		}
+	}
+
+	creator, _ := sdk.AccAddressFromBech32(msg.Creator)
+	escrowedCoins := sdk.NewCoins(sdk.NewCoin(
+		types.DENOM,
+		sdkmath.NewIntFromBigInt(sdkmath.NewUintFromString(msg.Amount).BigInt()),
+	))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, escrowedCoins); err != nil {
+		return nil, err
+	}
+
+	positionId := k.AppendVestingPosition(ctx, position)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateVestingPosition,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyBeneficiary, msg.Beneficiary),
+		sdk.NewAttribute(types.AttributeKeyFunder, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+	))
+
+	return &types.MsgCreateVestingPositionResponse{PositionId: positionId}, nil
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EarlyUnlock releases what has vested from a position of the beneficiary together with part of what has not
+// vested yet, minus a penalty on the unvested part
+func (k msgServer) EarlyUnlock(goCtx context.Context, msg *types.MsgEarlyUnlock) (*types.MsgEarlyUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingInfo, err := k.getBeneficiaryPosition(ctx, msg.Creator, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := utils.BlockTime(ctx)
+	quote, err := k.quoteEarlyUnlock(ctx, vestingInfo, msg.Amount, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// the unlocked tokens are claimed ahead of their vesting, so releases pay out nothing more until the
+	// vesting catches up with them
+	totalClaimed := sdkmath.NewUintFromString(vestingInfo.TotalClaimed)
+	vestingInfo.TotalClaimed = totalClaimed.Add(quote.released).Add(quote.unlocked).String()
+	if now > vestingInfo.StartTime {
+		vestingInfo.PeriodClaimed = max(vestingInfo.PeriodClaimed, now-vestingInfo.StartTime)
+	}
+	vestingInfo.PendingFee = pendingFeeOf(&vestingInfo).Sub(quote.feeDeducted).String()
+	k.storeOrPrune(ctx, vestingInfo)
+
+	if err := k.sendReleased(ctx, msg.Creator, quote.netAmount); err != nil {
+		return nil, err
+	}
+
+	config, _ := k.GetEarlyUnlockConfig(ctx)
+	if err := k.disposePenalty(ctx, config, quote.penalty); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEarlyUnlock,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyBeneficiary, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyReleased, quote.released.String()),
+		sdk.NewAttribute(types.AttributeKeyUnlocked, quote.unlocked.String()),
+		sdk.NewAttribute(types.AttributeKeyPenalty, quote.penalty.String()),
+		sdk.NewAttribute(types.AttributeKeyPenaltyBurned, strconv.FormatBool(config.BurnPenalty)),
+	))
+
+	return &types.MsgEarlyUnlockResponse{
+		Released:    quote.released.String(),
+		Unlocked:    quote.unlocked.String(),
+		Penalty:     quote.penalty.String(),
+		FeeDeducted: quote.feeDeducted.String(),
+		NetAmount:   quote.netAmount.String(),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MergePositions combines positions of the beneficiary with the same schedule into the first of them. The
+// other positions are removed.
+func (k msgServer) MergePositions(goCtx context.Context, msg *types.MsgMergePositions) (*types.MsgMergePositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	merged, err := k.getMovablePosition(ctx, msg.Creator, msg.PositionIds[0])
+	if err != nil {
+		return nil, err
+	}
+
+	mergedIds := make([]string, 0, len(msg.PositionIds)-1)
+	for _, positionId := range msg.PositionIds[1:] {
+		vestingInfo, err := k.getMovablePosition(ctx, msg.Creator, positionId)
+		if err != nil {
+			return nil, err
+		}
+
+		merged, err = merged.Merge(vestingInfo, utils.BlockTime(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		k.RemoveVestingPosition(ctx, vestingInfo)
+		mergedIds = append(mergedIds, strconv.FormatUint(positionId, 10))
+	}
+
+	// a transfer offered for the first position doesn't hold for the merged one
+	k.RemovePendingTransfer(ctx, merged.Id)
+	k.SetVestingPosition(ctx, merged)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMergePositions,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(merged.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyMergedIds, strings.Join(mergedIds, ",")),
+	))
+
+	return &types.MsgMergePositionsResponse{PositionId: merged.Id}, nil
+}
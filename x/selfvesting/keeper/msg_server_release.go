@@ -11,24 +11,16 @@ import (
 )
 
 func getTokenReleaseInfo(
-	k msgServer,
+	k Keeper,
 	ctx sdk.Context,
 	beneficiary string,
-	posIndex uint64,
+	positionId uint64,
 ) (*types.VestingInfo, uint64, sdkmath.Uint, error) {
-	vestingPositions, positionsExist := k.GetVestingPositions(ctx, beneficiary)
-
-	if !positionsExist {
-		return &types.VestingInfo{}, 0, sdkmath.Uint{}, types.ErrNoVestingPositions
-	}
-
-	// Check that position at the given index exist
-	if int(posIndex) >= len(vestingPositions.VestingInfos) {
-		return &types.VestingInfo{}, 0, sdkmath.Uint{}, types.ErrPositionIndexOutOfBounds
+	vestingInfo, err := k.getBeneficiaryPosition(ctx, beneficiary, positionId)
+	if err != nil {
+		return &types.VestingInfo{}, 0, sdkmath.Uint{}, err
 	}
 
-	vestingInfo := vestingPositions.VestingInfos[posIndex]
-
 	// convert string values to uint 256
 	amount := sdkmath.NewUintFromString(vestingInfo.Amount)
 	totalClaimed := sdkmath.NewUintFromString(vestingInfo.TotalClaimed)
@@ -41,29 +33,30 @@ func getTokenReleaseInfo(
 	now := utils.BlockTime(ctx)
 	// For vesting created with a future start date, that hasn't been reached, return 0, 0
 	if now < vestingInfo.Cliff {
-		return vestingInfo, 0, sdkmath.Uint{}, types.ErrCliffViolation
+		return &vestingInfo, 0, sdkmath.Uint{}, types.ErrCliffViolation
 	}
 
 	elapsedPeriod := now - vestingInfo.StartTime
 	periodToVest := elapsedPeriod - vestingInfo.PeriodClaimed
 
-	if elapsedPeriod >= vestingInfo.Duration {
-		amountToVest := amount.Sub(totalClaimed)
-		return vestingInfo, periodToVest, amountToVest, nil
-	} else {
-		amountToVest := amount.MulUint64(periodToVest).QuoUint64(vestingInfo.Duration)
-		return vestingInfo, periodToVest, amountToVest, nil
+	// release whatever vested to date that hasn't been claimed yet, so that the released total doesn't depend
+	// on how often the position is released
+	vested := vestingInfo.VestedAmountAt(now)
+	if vested.LTE(totalClaimed) {
+		return &vestingInfo, periodToVest, sdkmath.ZeroUint(), nil
 	}
+
+	return &vestingInfo, periodToVest, vested.Sub(totalClaimed), nil
 }
 
 func (k msgServer) Release(goCtx context.Context, msg *types.MsgRelease) (*types.MsgReleaseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	vestingInfo, periodToVest, amountToVest, calcError := getTokenReleaseInfo(
-		k,
+		k.Keeper,
 		ctx,
 		msg.Creator,
-		msg.PosIndex,
+		msg.PositionId,
 	)
 
 	if calcError != nil {
@@ -71,35 +64,66 @@ func (k msgServer) Release(goCtx context.Context, msg *types.MsgRelease) (*types
 	}
 
 	if amountToVest.GT(sdkmath.NewUint(0)) {
-		totalClaimed := sdkmath.NewUintFromString(vestingInfo.TotalClaimed)
-		vestingInfo.PeriodClaimed += periodToVest
-		vestingInfo.TotalClaimed = totalClaimed.Add(amountToVest).String()
-
-		// store state changes
-		vestingPositions, _ := k.GetVestingPositions(ctx, msg.Creator)
-		vestingPositions.VestingInfos[msg.PosIndex] = vestingInfo
-		k.SetVestingPositions(ctx, types.VestingPositions{
-			Beneficiary:  msg.Creator,
-			VestingInfos: vestingPositions.VestingInfos,
-		})
+		feeDeducted := k.releasePosition(ctx, vestingInfo, periodToVest, amountToVest)
 
 		// transfer amountToVest to the beneficiary
-		beneficiary, _ := sdk.AccAddressFromBech32(msg.Creator)
-		vestedCoins := sdk.NewCoins(sdk.NewCoin(
-			types.DENOM,
-			sdkmath.NewIntFromBigInt(amountToVest.BigInt()),
-		))
-
-		k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, beneficiary, vestedCoins)
+		if err := k.sendReleased(ctx, msg.Creator, amountToVest.Sub(feeDeducted)); err != nil {
+			return nil, err
+		}
 
 		return &types.MsgReleaseResponse{
 			PeriodToVest: periodToVest,
 			AmountToVest: amountToVest.String(),
+			FeeDeducted:  feeDeducted.String(),
 		}, nil
 	}
 
 	return &types.MsgReleaseResponse{
 		PeriodToVest: 0,
 		AmountToVest: "0",
+		FeeDeducted:  "0",
 	}, nil
 }
+
+// releasePosition records the release of the vested amount of a position and pays back the fees the module
+// paid for the beneficiary out of it. It returns the part of the released amount that paid back fees.
+func (k Keeper) releasePosition(
+	ctx sdk.Context,
+	vestingInfo *types.VestingInfo,
+	periodToVest uint64,
+	amountToVest sdkmath.Uint,
+) sdkmath.Uint {
+	totalClaimed := sdkmath.NewUintFromString(vestingInfo.TotalClaimed)
+	vestingInfo.PeriodClaimed += periodToVest
+	vestingInfo.TotalClaimed = totalClaimed.Add(amountToVest).String()
+
+	// pay back the fees the module paid for the beneficiary out of the released amount
+	pendingFee := pendingFeeOf(vestingInfo)
+	feeDeducted := sdkmath.MinUint(pendingFee, amountToVest)
+	vestingInfo.PendingFee = pendingFee.Sub(feeDeducted).String()
+
+	// store state changes. Fully claimed positions are pruned once they don't owe any fee.
+	fullyClaimed := totalClaimed.Add(amountToVest).GTE(sdkmath.NewUintFromString(vestingInfo.Amount))
+	if fullyClaimed && pendingFeeOf(vestingInfo).IsZero() {
+		k.RemoveVestingPosition(ctx, *vestingInfo)
+	} else {
+		k.SetVestingPosition(ctx, *vestingInfo)
+	}
+
+	return feeDeducted
+}
+
+// sendReleased transfers released tokens from the module to the beneficiary
+func (k Keeper) sendReleased(ctx sdk.Context, beneficiary string, amount sdkmath.Uint) error {
+	beneficiaryAddr, _ := sdk.AccAddressFromBech32(beneficiary)
+	vestedCoins := sdk.NewCoins(sdk.NewCoin(
+		types.DENOM,
+		sdkmath.NewIntFromBigInt(amount.BigInt()),
+	))
+
+	if vestedCoins.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, beneficiaryAddr, vestedCoins)
+}
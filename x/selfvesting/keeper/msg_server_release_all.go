@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReleaseAll releases whatever is vested from every position of the signer. Positions with nothing vested,
+// because their cliff hasn't been reached or they are fully claimed, are skipped.
+func (k msgServer) ReleaseAll(goCtx context.Context, msg *types.MsgReleaseAll) (*types.MsgReleaseAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingPositions, positionsExist := k.GetVestingPositions(ctx, msg.Creator)
+	if !positionsExist {
+		return nil, types.ErrNoVestingPositions
+	}
+
+	releases := []types.PositionRelease{}
+	totalReleased := sdkmath.ZeroUint()
+	totalFeeDeducted := sdkmath.ZeroUint()
+	for _, position := range vestingPositions.VestingInfos {
+		vestingInfo, periodToVest, amountToVest, err := getTokenReleaseInfo(k.Keeper, ctx, msg.Creator, position.Id)
+		if errors.Is(err, types.ErrCliffViolation) || errors.Is(err, types.ErrPositionFullyClaimed) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if amountToVest.IsZero() {
+			continue
+		}
+
+		feeDeducted := k.releasePosition(ctx, vestingInfo, periodToVest, amountToVest)
+		totalReleased = totalReleased.Add(amountToVest)
+		totalFeeDeducted = totalFeeDeducted.Add(feeDeducted)
+
+		releases = append(releases, types.PositionRelease{
+			PositionId:   position.Id,
+			PeriodToVest: periodToVest,
+			AmountToVest: amountToVest.String(),
+			FeeDeducted:  feeDeducted.String(),
+		})
+	}
+
+	// transfer everything released at once to the beneficiary
+	if err := k.sendReleased(ctx, msg.Creator, totalReleased.Sub(totalFeeDeducted)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseAllResponse{
+		Releases:     releases,
+		AmountToVest: totalReleased.String(),
+		FeeDeducted:  totalFeeDeducted.String(),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RevokePosition returns the unvested part of a revocable position to the account that funded it
+func (k msgServer) RevokePosition(goCtx context.Context, msg *types.MsgRevokePosition) (*types.MsgRevokePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingInfo, err := k.getPosition(ctx, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !vestingInfo.Revocable || vestingInfo.Funder != msg.Creator {
+		return nil, types.ErrPositionNotRevocable
+	}
+
+	amountReturned := k.revokeUnvested(ctx, vestingInfo, utils.BlockTime(ctx))
+
+	funder, _ := sdk.AccAddressFromBech32(msg.Creator)
+	returnedCoins := sdk.NewCoins(sdk.NewCoin(types.DENOM, sdkmath.NewIntFromBigInt(amountReturned.BigInt())))
+	if !returnedCoins.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, returnedCoins); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevokePosition,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyBeneficiary, vestingInfo.Beneficiary),
+		sdk.NewAttribute(types.AttributeKeyFunder, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyAmountReturned, amountReturned.String()),
+	))
+
+	return &types.MsgRevokePositionResponse{AmountReturned: amountReturned.String()}, nil
+}
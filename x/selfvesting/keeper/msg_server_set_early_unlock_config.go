@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/selfvesting/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetEarlyUnlockConfig sets the penalty of early unlocks
+func (k msgServer) SetEarlyUnlockConfig(goCtx context.Context, msg *types.MsgSetEarlyUnlockConfig) (*types.MsgSetEarlyUnlockConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	k.Keeper.SetEarlyUnlockConfig(ctx, msg.Config())
+
+	return &types.MsgSetEarlyUnlockConfigResponse{}, nil
+}
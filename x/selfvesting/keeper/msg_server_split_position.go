@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SplitPosition carves an amount out of a position of the beneficiary into a new position with the same
+// schedule, which can go to another account as long as it meets the limits of positions created for it
+func (k msgServer) SplitPosition(goCtx context.Context, msg *types.MsgSplitPosition) (*types.MsgSplitPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingInfo, err := k.getMovablePosition(ctx, msg.Creator, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, carved, err := vestingInfo.Split(sdkmath.NewUintFromString(msg.Amount), utils.BlockTime(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// the carved position goes to the recipient without its consent
+	transferred := msg.Recipient != "" && msg.Recipient != msg.Creator
+	if transferred {
+		if err := k.checkPositionLimits(ctx, msg.Recipient, unclaimedOf(carved)); err != nil {
+			return nil, err
+		}
+	}
+
+	// a transfer offered for the whole position doesn't hold for what is left of it
+	k.RemovePendingTransfer(ctx, msg.PositionId)
+	k.storeOrPrune(ctx, remaining)
+	carved.Id = k.AppendVestingPosition(ctx, carved)
+	k.storeOrPrune(ctx, carved)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSplitPosition,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(carved.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+	))
+
+	if transferred {
+		if carved, found := k.GetVestingPosition(ctx, carved.Id); found {
+			k.transferPosition(ctx, carved, msg.Recipient)
+		}
+	}
+
+	return &types.MsgSplitPositionResponse{PositionId: carved.Id}, nil
+}
+
+// storeOrPrune stores a position, or prunes it once it doesn't hold anything to release or to pay back
+func (k Keeper) storeOrPrune(ctx sdk.Context, vestingInfo types.VestingInfo) {
+	fullyClaimed := sdkmath.NewUintFromString(vestingInfo.TotalClaimed).GTE(sdkmath.NewUintFromString(vestingInfo.Amount))
+	if fullyClaimed && pendingFeeOf(&vestingInfo).IsZero() {
+		k.RemoveVestingPosition(ctx, vestingInfo)
+	} else {
+		k.SetVestingPosition(ctx, vestingInfo)
+	}
+}
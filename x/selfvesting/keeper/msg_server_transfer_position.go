@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferPosition moves a position of the beneficiary to the recipient, or offers it to the recipient when the
+// transfer requires acceptance. A transfer that doesn't require acceptance must meet the minimum amount and fit
+// in the maximum number of positions of the recipient.
+func (k msgServer) TransferPosition(goCtx context.Context, msg *types.MsgTransferPosition) (*types.MsgTransferPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingInfo, err := k.getMovablePosition(ctx, msg.Creator, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// A position moved without the consent of the recipient is held to the limits of positions created for it
+	if !msg.RequireAcceptance {
+		if err := k.checkPositionLimits(ctx, msg.Recipient, unclaimedOf(vestingInfo)); err != nil {
+			return nil, err
+		}
+		k.transferPosition(ctx, vestingInfo, msg.Recipient)
+		return &types.MsgTransferPositionResponse{}, nil
+	}
+
+	k.SetPendingTransfer(ctx, types.PendingTransfer{
+		PositionId: msg.PositionId,
+		From:       msg.Creator,
+		Recipient:  msg.Recipient,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOfferPosition,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyFrom, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+	))
+
+	return &types.MsgTransferPositionResponse{Pending: true}, nil
+}
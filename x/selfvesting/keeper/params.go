@@ -5,9 +5,28 @@ import (
 	"selfchain/x/selfvesting/types"
 )
 
+// MinPositionAmount returns the minimum amount of a position given to a beneficiary without its consent. Chains
+// upgraded from before the parameter existed use its default value.
+func (k Keeper) MinPositionAmount(ctx sdk.Context) (res string) {
+	res = types.DefaultMinPositionAmount
+	k.paramstore.GetIfExists(ctx, types.KeyMinPositionAmount, &res)
+	return
+}
+
+// MaxPositionsPerBeneficiary returns the maximum number of positions a beneficiary can be given without its
+// consent. Chains upgraded from before the parameter existed use its default value.
+func (k Keeper) MaxPositionsPerBeneficiary(ctx sdk.Context) (res uint64) {
+	res = types.DefaultMaxPositionsPerBeneficiary
+	k.paramstore.GetIfExists(ctx, types.KeyMaxPositionsPerBeneficiary, &res)
+	return
+}
+
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.MinPositionAmount(ctx),
+		k.MaxPositionsPerBeneficiary(ctx),
+	)
 }
 
 // SetParams set the params
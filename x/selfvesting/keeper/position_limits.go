@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"selfchain/x/selfvesting/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	cosmotypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// checkPositionLimits ensures a position of the amount can be given to the beneficiary without its consent.
+// Positions below the minimum amount, or past the maximum number of positions of the beneficiary, would let
+// anyone grow the positions of the beneficiary until releasing all of them runs out of gas.
+func (k Keeper) checkPositionLimits(ctx sdk.Context, beneficiary string, amount sdkmath.Uint) error {
+	params := k.GetParams(ctx)
+
+	minAmount := sdkmath.NewUintFromString(params.MinPositionAmount)
+	if amount.LT(minAmount) {
+		return sdkerrors.Wrapf(types.ErrPositionTooSmall, "%s is less than %s", amount, minAmount)
+	}
+
+	if k.countVestingPositions(ctx, beneficiary, params.MaxPositionsPerBeneficiary) >= params.MaxPositionsPerBeneficiary {
+		return sdkerrors.Wrapf(types.ErrTooManyPositions, "%s already has %d positions", beneficiary, params.MaxPositionsPerBeneficiary)
+	}
+
+	return nil
+}
+
+// countVestingPositions returns the number of positions of a beneficiary, counting no further than max
+func (k Keeper) countVestingPositions(ctx sdk.Context, beneficiary string, max uint64) uint64 {
+	iterator := cosmotypes.KVStorePrefixIterator(k.beneficiaryPositionStore(ctx, beneficiary), []byte{})
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid() && count < max; iterator.Next() {
+		count++
+	}
+
+	return count
+}
+
+// unclaimedOf returns the amount of a position that hasn't been claimed yet
+func unclaimedOf(vestingInfo types.VestingInfo) sdkmath.Uint {
+	return sdkmath.NewUintFromString(vestingInfo.Amount).Sub(sdkmath.NewUintFromString(vestingInfo.TotalClaimed))
+}
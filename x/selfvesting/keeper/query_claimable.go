@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Claimable returns what releasing each position of a beneficiary would pay out right now
+func (k Keeper) Claimable(goCtx context.Context, req *types.QueryClaimableRequest) (*types.QueryClaimableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingPositions, found := k.GetVestingPositions(ctx, req.Beneficiary)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	now := utils.BlockTime(ctx)
+	positions := []types.PositionClaimable{}
+	totalClaimable := sdkmath.ZeroUint()
+	for _, position := range vestingPositions.VestingInfos {
+		amountToVest := sdkmath.ZeroUint()
+		_, _, vested, err := getTokenReleaseInfo(k, ctx, req.Beneficiary, position.Id)
+		switch {
+		case err == nil:
+			amountToVest = vested
+		case errors.Is(err, types.ErrCliffViolation), errors.Is(err, types.ErrPositionFullyClaimed):
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		feeDeducted := sdkmath.MinUint(pendingFeeOf(position), amountToVest)
+		claimable := amountToVest.Sub(feeDeducted)
+		totalClaimable = totalClaimable.Add(claimable)
+
+		positions = append(positions, types.PositionClaimable{
+			PositionId:     position.Id,
+			AmountToVest:   amountToVest.String(),
+			FeeDeducted:    feeDeducted.String(),
+			Claimable:      claimable.String(),
+			NextUnlockTime: position.NextUnlockTime(now),
+		})
+	}
+
+	return &types.QueryClaimableResponse{Positions: positions, TotalClaimable: totalClaimable.String()}, nil
+}
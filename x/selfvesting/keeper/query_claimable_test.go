@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/x/selfvesting/keeper"
+	"selfchain/x/selfvesting/types"
+)
+
+// createScheduledPositions creates a position still vesting that owes a release fee and a fully vested one
+func createScheduledPositions(keeper *keeper.Keeper, ctx sdk.Context, beneficiary string) {
+	keeper.AppendVestingPosition(ctx, types.VestingInfo{
+		Beneficiary:  beneficiary,
+		StartTime:    1000,
+		Cliff:        2000,
+		Duration:     10000,
+		Amount:       "10000",
+		TotalClaimed: "0",
+		PendingFee:   "300",
+	})
+	keeper.AppendVestingPosition(ctx, types.VestingInfo{
+		Beneficiary:  beneficiary,
+		StartTime:    0,
+		Cliff:        0,
+		Duration:     1000,
+		Amount:       "500",
+		TotalClaimed: "200",
+	})
+}
+
+func TestClaimableQuery(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	createScheduledPositions(keeper, ctx, "alice")
+
+	for _, tc := range []struct {
+		desc     string
+		now      int64
+		request  *types.QueryClaimableRequest
+		response *types.QueryClaimableResponse
+		err      error
+	}{
+		{
+			desc:    "BeforeCliff",
+			now:     1500,
+			request: &types.QueryClaimableRequest{Beneficiary: "alice"},
+			response: &types.QueryClaimableResponse{
+				Positions: []types.PositionClaimable{
+					{PositionId: 1, AmountToVest: "0", FeeDeducted: "0", Claimable: "0", NextUnlockTime: 2000},
+					{PositionId: 2, AmountToVest: "300", FeeDeducted: "0", Claimable: "300", NextUnlockTime: 0},
+				},
+				TotalClaimable: "300",
+			},
+		},
+		{
+			desc:    "Vesting",
+			now:     3000,
+			request: &types.QueryClaimableRequest{Beneficiary: "alice"},
+			response: &types.QueryClaimableResponse{
+				Positions: []types.PositionClaimable{
+					{PositionId: 1, AmountToVest: "2000", FeeDeducted: "300", Claimable: "1700", NextUnlockTime: 3001},
+					{PositionId: 2, AmountToVest: "300", FeeDeducted: "0", Claimable: "300", NextUnlockTime: 0},
+				},
+				TotalClaimable: "2000",
+			},
+		},
+		{
+			desc:    "KeyNotFound",
+			now:     3000,
+			request: &types.QueryClaimableRequest{Beneficiary: "bob"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			wctx := sdk.WrapSDKContext(ctx.WithBlockTime(time.Unix(tc.now, 0)))
+			response, err := keeper.Claimable(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) EarlyUnlockConfig(goCtx context.Context, req *types.QueryGetEarlyUnlockConfigRequest) (*types.QueryGetEarlyUnlockConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetEarlyUnlockConfig(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetEarlyUnlockConfigResponse{EarlyUnlockConfig: val}, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EarlyUnlockQuote returns what unlocking a position early would pay out right now
+func (k Keeper) EarlyUnlockQuote(goCtx context.Context, req *types.QueryEarlyUnlockQuoteRequest) (*types.QueryEarlyUnlockQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingInfo, found := k.GetVestingPosition(ctx, req.PositionId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	quote, err := k.quoteEarlyUnlock(ctx, vestingInfo, req.Amount, utils.BlockTime(ctx))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryEarlyUnlockQuoteResponse{
+		Released:           quote.released.String(),
+		Unlocked:           quote.unlocked.String(),
+		PenaltyBasisPoints: quote.penaltyBasisPoints,
+		Penalty:            quote.penalty.String(),
+		FeeDeducted:        quote.feeDeducted.String(),
+		NetAmount:          quote.netAmount.String(),
+	}, nil
+}
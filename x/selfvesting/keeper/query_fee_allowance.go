@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) FeeAllowance(goCtx context.Context, req *types.QueryGetFeeAllowanceRequest) (*types.QueryGetFeeAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetFeeAllowance(ctx, req.Beneficiary)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetFeeAllowanceResponse{FeeAllowance: val}, nil
+}
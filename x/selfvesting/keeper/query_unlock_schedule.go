@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"selfchain/x/selfvesting/types"
+	"selfchain/x/selfvesting/utils"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnlockSchedule projects the unlocks of the positions of a beneficiary as points spaced by the requested
+// interval, starting one interval from now. The schedule ends once every position is fully vested.
+func (k Keeper) UnlockSchedule(goCtx context.Context, req *types.QueryUnlockScheduleRequest) (*types.QueryUnlockScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	interval := req.Interval
+	if interval == 0 {
+		interval = types.DefaultUnlockScheduleInterval
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.DefaultUnlockScheduleLimit
+	}
+	if limit > types.MaxUnlockScheduleLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("limit exceeds %d", types.MaxUnlockScheduleLimit))
+	}
+
+	vestingPositions, found := k.GetVestingPositions(ctx, req.Beneficiary)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	now := utils.BlockTime(ctx)
+	vestingEnd := now
+	for _, position := range vestingPositions.VestingInfos {
+		if end := position.VestingEnd(); end > vestingEnd {
+			vestingEnd = end
+		}
+	}
+
+	// claimableAt returns what the positions hold unreleased at time t
+	claimableAt := func(t uint64) sdkmath.Uint {
+		claimable := sdkmath.ZeroUint()
+		for _, position := range vestingPositions.VestingInfos {
+			vested := position.VestedAmountAt(t)
+			totalClaimed := sdkmath.NewUintFromString(position.TotalClaimed)
+			if vested.GT(totalClaimed) {
+				claimable = claimable.Add(vested.Sub(totalClaimed))
+			}
+		}
+
+		return claimable
+	}
+
+	points := []types.UnlockSchedulePoint{}
+	previous := claimableAt(now)
+	for t := now; uint64(len(points)) < limit && t < vestingEnd && t <= math.MaxUint64-interval; {
+		t += interval
+		claimable := claimableAt(t)
+		points = append(points, types.UnlockSchedulePoint{
+			Time:      t,
+			Unlocked:  claimable.Sub(previous).String(),
+			Claimable: claimable.String(),
+		})
+		previous = claimable
+	}
+
+	return &types.QueryUnlockScheduleResponse{Points: points}, nil
+}
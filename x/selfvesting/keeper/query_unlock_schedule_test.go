@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/x/selfvesting/types"
+)
+
+func TestUnlockScheduleQuery(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	createScheduledPositions(keeper, ctx, "alice")
+	wctx := sdk.WrapSDKContext(ctx.WithBlockTime(time.Unix(3000, 0)))
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryUnlockScheduleRequest
+		response *types.QueryUnlockScheduleResponse
+		err      error
+	}{
+		{
+			desc:    "UntilFullyVested",
+			request: &types.QueryUnlockScheduleRequest{Beneficiary: "alice", Interval: 3000},
+			response: &types.QueryUnlockScheduleResponse{Points: []types.UnlockSchedulePoint{
+				{Time: 6000, Unlocked: "3000", Claimable: "5300"},
+				{Time: 9000, Unlocked: "3000", Claimable: "8300"},
+				{Time: 12000, Unlocked: "2000", Claimable: "10300"},
+			}},
+		},
+		{
+			desc:    "Limit",
+			request: &types.QueryUnlockScheduleRequest{Beneficiary: "alice", Interval: 3000, Limit: 2},
+			response: &types.QueryUnlockScheduleResponse{Points: []types.UnlockSchedulePoint{
+				{Time: 6000, Unlocked: "3000", Claimable: "5300"},
+				{Time: 9000, Unlocked: "3000", Claimable: "8300"},
+			}},
+		},
+		{
+			desc:    "DefaultInterval",
+			request: &types.QueryUnlockScheduleRequest{Beneficiary: "alice"},
+			response: &types.QueryUnlockScheduleResponse{Points: []types.UnlockSchedulePoint{
+				{Time: 3000 + types.DefaultUnlockScheduleInterval, Unlocked: "8000", Claimable: "10300"},
+			}},
+		},
+		{
+			desc:    "LimitTooLarge",
+			request: &types.QueryUnlockScheduleRequest{Beneficiary: "alice", Limit: types.MaxUnlockScheduleLimit + 1},
+			err:     status.Error(codes.InvalidArgument, "limit exceeds 1000"),
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryUnlockScheduleRequest{Beneficiary: "bob"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.UnlockSchedule(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
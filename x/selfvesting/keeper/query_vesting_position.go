@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"selfchain/x/selfvesting/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) VestingPositionAll(goCtx context.Context, req *types.QueryAllVestingPositionRequest) (*types.QueryAllVestingPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var vestingPositions []types.VestingInfo
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	vestingPositionStore := prefix.NewStore(store, types.KeyPrefix(types.VestingPositionKey))
+
+	pageRes, err := query.Paginate(vestingPositionStore, req.Pagination, func(key []byte, value []byte) error {
+		var vestingPosition types.VestingInfo
+		if err := k.cdc.Unmarshal(value, &vestingPosition); err != nil {
+			return err
+		}
+
+		vestingPositions = append(vestingPositions, vestingPosition)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllVestingPositionResponse{VestingPosition: vestingPositions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) VestingPosition(goCtx context.Context, req *types.QueryGetVestingPositionRequest) (*types.QueryGetVestingPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	vestingPosition, found := k.GetVestingPosition(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetVestingPositionResponse{VestingPosition: vestingPosition}, nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/testutil/nullify"
+	"selfchain/x/selfvesting/types"
+)
+
+func TestVestingPositionQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNVestingPosition(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetVestingPositionRequest
+		response *types.QueryGetVestingPositionResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetVestingPositionRequest{Id: msgs[0].Id},
+			response: &types.QueryGetVestingPositionResponse{VestingPosition: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetVestingPositionRequest{Id: msgs[1].Id},
+			response: &types.QueryGetVestingPositionResponse{VestingPosition: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetVestingPositionRequest{Id: uint64(len(msgs)) + 1},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.VestingPosition(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestVestingPositionQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNVestingPosition(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllVestingPositionRequest {
+		return &types.QueryAllVestingPositionRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.VestingPositionAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.VestingPosition), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.VestingPosition),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.VestingPositionAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.VestingPosition), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.VestingPosition),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.VestingPositionAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.VestingPosition),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.VestingPositionAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
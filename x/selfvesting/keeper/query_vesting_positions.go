@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,47 +13,110 @@ import (
 	"selfchain/x/selfvesting/types"
 )
 
+// VestingPositionsAll returns the positions of every beneficiary, paginated over the beneficiaries so that the
+// positions of a beneficiary are never split across pages
 func (k Keeper) VestingPositionsAll(goCtx context.Context, req *types.QueryAllVestingPositionsRequest) (*types.QueryAllVestingPositionsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
-
-	var vestingPositionss []types.VestingPositions
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	store := ctx.KVStore(k.storeKey)
-	vestingPositionsStore := prefix.NewStore(store, types.KeyPrefix(types.VestingPositionsKeyPrefix))
-
-	pageRes, err := query.Paginate(vestingPositionsStore, req.Pagination, func(key []byte, value []byte) error {
-		var vestingPositions types.VestingPositions
-		if err := k.cdc.Unmarshal(value, &vestingPositions); err != nil {
-			return err
-		}
-
-		vestingPositionss = append(vestingPositionss, vestingPositions)
-		return nil
-	})
-
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VestingPositionBeneficiaryKeyPrefix))
+	vestingPositionss, pageRes, err := k.paginateBeneficiaries(ctx, store, req.Pagination)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	return &types.QueryAllVestingPositionsResponse{VestingPositions: vestingPositionss, Pagination: pageRes}, nil
 }
 
+// VestingPositions returns the positions of a beneficiary, paginated over the index of its positions
 func (k Keeper) VestingPositions(goCtx context.Context, req *types.QueryGetVestingPositionsRequest) (*types.QueryGetVestingPositionsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	val, found := k.GetVestingPositions(
-		ctx,
-		req.Beneficiary,
-	)
-	if !found {
+	val := types.VestingPositions{Beneficiary: req.Beneficiary}
+	beneficiaryStore := k.beneficiaryPositionStore(ctx, req.Beneficiary)
+
+	pageRes, err := query.Paginate(beneficiaryStore, req.Pagination, func(key []byte, value []byte) error {
+		vestingPosition, _ := k.GetVestingPosition(ctx, binary.BigEndian.Uint64(key))
+		val.VestingInfos = append(val.VestingInfos, &vestingPosition)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// an empty first page means the beneficiary has no positions
+	if len(val.VestingInfos) == 0 && req.Pagination.GetKey() == nil && req.Pagination.GetOffset() == 0 {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetVestingPositionsResponse{VestingPositions: val}, nil
+	return &types.QueryGetVestingPositionsResponse{VestingPositions: val, Pagination: pageRes}, nil
+}
+
+// paginateBeneficiaries pages through the index of the positions by beneficiary one beneficiary at a time. The
+// next key of a page is the index prefix of the first beneficiary of the next page.
+func (k Keeper) paginateBeneficiaries(ctx sdk.Context, store prefix.Store, pageRequest *query.PageRequest) ([]types.VestingPositions, *query.PageResponse, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	if pageRequest.Offset > 0 && pageRequest.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if pageRequest.Reverse {
+		return nil, nil, fmt.Errorf("invalid request, reverse pagination is not supported")
+	}
+
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal && pageRequest.Key == nil
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = pageRequest.Key == nil
+	}
+
+	iterator := store.Iterator(pageRequest.Key, nil)
+	defer iterator.Close()
+
+	var list []types.VestingPositions
+	var nextKey []byte
+	var beneficiary string
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		// Index keys are the beneficiary followed by a slash and the id of the position
+		key := iterator.Key()
+		if current := string(key[:len(key)-9]); count == 0 || current != beneficiary {
+			beneficiary = current
+			count++
+		}
+
+		if count <= pageRequest.Offset {
+			continue
+		}
+		if count > pageRequest.Offset+limit {
+			if nextKey == nil {
+				nextKey = types.VestingPositionBeneficiaryKey(beneficiary)
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+
+		if len(list) == 0 || list[len(list)-1].Beneficiary != beneficiary {
+			list = append(list, types.VestingPositions{Beneficiary: beneficiary})
+		}
+		vestingPosition, _ := k.GetVestingPosition(ctx, binary.BigEndian.Uint64(key[len(key)-8:]))
+		list[len(list)-1].VestingInfos = append(list[len(list)-1].VestingInfos, &vestingPosition)
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return list, pageRes, nil
 }
@@ -12,16 +12,27 @@ import (
 
 	keepertest "selfchain/testutil/keeper"
 	"selfchain/testutil/nullify"
+	"selfchain/x/selfvesting/keeper"
 	"selfchain/x/selfvesting/types"
 )
 
 // Prevent strconv unused error
 var _ = strconv.IntSize
 
+// positionsOf returns the positions of a beneficiary among the created ones
+func positionsOf(items []types.VestingInfo, beneficiary string) (list []*types.VestingInfo) {
+	for i := range items {
+		if items[i].Beneficiary == beneficiary {
+			list = append(list, &items[i])
+		}
+	}
+	return
+}
+
 func TestVestingPositionsQuerySingle(t *testing.T) {
 	keeper, ctx := keepertest.SelfvestingKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	msgs := createNVestingPositions(keeper, ctx, 2)
+	msgs := createNVestingPosition(keeper, ctx, 4)
 	for _, tc := range []struct {
 		desc     string
 		request  *types.QueryGetVestingPositionsRequest
@@ -31,16 +42,22 @@ func TestVestingPositionsQuerySingle(t *testing.T) {
 		{
 			desc: "First",
 			request: &types.QueryGetVestingPositionsRequest{
-				Beneficiary: msgs[0].Beneficiary,
+				Beneficiary: "0",
 			},
-			response: &types.QueryGetVestingPositionsResponse{VestingPositions: msgs[0]},
+			response: &types.QueryGetVestingPositionsResponse{VestingPositions: types.VestingPositions{
+				Beneficiary:  "0",
+				VestingInfos: positionsOf(msgs, "0"),
+			}},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetVestingPositionsRequest{
-				Beneficiary: msgs[1].Beneficiary,
+				Beneficiary: "1",
 			},
-			response: &types.QueryGetVestingPositionsResponse{VestingPositions: msgs[1]},
+			response: &types.QueryGetVestingPositionsResponse{VestingPositions: types.VestingPositions{
+				Beneficiary:  "1",
+				VestingInfos: positionsOf(msgs, "1"),
+			}},
 		},
 		{
 			desc: "KeyNotFound",
@@ -61,8 +78,8 @@ func TestVestingPositionsQuerySingle(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t,
-					nullify.Fill(tc.response),
-					nullify.Fill(response),
+					nullify.Fill(tc.response.VestingPositions),
+					nullify.Fill(response.VestingPositions),
 				)
 			}
 		})
@@ -72,7 +89,76 @@ func TestVestingPositionsQuerySingle(t *testing.T) {
 func TestVestingPositionsQueryPaginated(t *testing.T) {
 	keeper, ctx := keepertest.SelfvestingKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	msgs := createNVestingPositions(keeper, ctx, 5)
+	msgs := positionsOf(createNVestingPosition(keeper, ctx, 10), "0")
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryGetVestingPositionsRequest {
+		return &types.QueryGetVestingPositionsRequest{
+			Beneficiary: "0",
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.VestingPositions(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.VestingPositions.VestingInfos), step)
+			require.Subset(t,
+				msgs,
+				resp.VestingPositions.VestingInfos,
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.VestingPositions(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.VestingPositions.VestingInfos), step)
+			require.Subset(t,
+				msgs,
+				resp.VestingPositions.VestingInfos,
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.VestingPositions(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			msgs,
+			resp.VestingPositions.VestingInfos,
+		)
+	})
+}
+
+// createNBeneficiaries creates two positions for each of n beneficiaries and returns their positions
+func createNBeneficiaries(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.VestingPositions {
+	items := make([]types.VestingPositions, n)
+	for i := range items {
+		items[i].Beneficiary = strconv.Itoa(i)
+	}
+	for j := 0; j < 2; j++ {
+		for i := range items {
+			vestingPosition := types.VestingInfo{Beneficiary: items[i].Beneficiary}
+			vestingPosition.Id = keeper.AppendVestingPosition(ctx, vestingPosition)
+			items[i].VestingInfos = append(items[i].VestingInfos, &vestingPosition)
+		}
+	}
+	return items
+}
+
+func TestVestingPositionsQueryAllPaginated(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNBeneficiaries(keeper, ctx, 5)
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllVestingPositionsRequest {
 		return &types.QueryAllVestingPositionsRequest{
@@ -99,16 +185,21 @@ func TestVestingPositionsQueryPaginated(t *testing.T) {
 	t.Run("ByKey", func(t *testing.T) {
 		step := 2
 		var next []byte
+		var all []types.VestingPositions
 		for i := 0; i < len(msgs); i += step {
 			resp, err := keeper.VestingPositionsAll(wctx, request(next, 0, uint64(step), false))
 			require.NoError(t, err)
 			require.LessOrEqual(t, len(resp.VestingPositions), step)
-			require.Subset(t,
-				nullify.Fill(msgs),
-				nullify.Fill(resp.VestingPositions),
-			)
+			all = append(all, resp.VestingPositions...)
 			next = resp.Pagination.NextKey
 		}
+
+		// Every beneficiary is listed once with all of its positions
+		require.Nil(t, next)
+		require.Equal(t,
+			nullify.Fill(msgs),
+			nullify.Fill(all),
+		)
 	})
 	t.Run("Total", func(t *testing.T) {
 		resp, err := keeper.VestingPositionsAll(wctx, request(nil, 0, 0, true))
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"errors"
+
+	"selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PayReleaseFee pays the fee of a transaction releasing positions of the beneficiary out of the amounts it
+// releases. The module sends the fee to the beneficiary right away, for the fee decorator of the SDK to
+// deduct it as the fee of the transaction, and each position it is charged to pays it back on its next
+// release. Positions with nothing vested are not charged. It returns false,
+// without paying anything, if the amounts that can be released now don't cover the fee.
+func (k Keeper) PayReleaseFee(ctx sdk.Context, beneficiary string, positionIds []uint64, fee sdkmath.Uint) (bool, error) {
+	// Charge the positions in the order they are released, each one up to what it releases net of the
+	// fees it already owes. Releasing a position twice doesn't release more. All of them are looked up
+	// even once the fee is covered so that simulating without a fee uses as much gas.
+	charged := make(map[uint64]bool)
+	var chargedPositions []*types.VestingInfo
+	remaining := fee
+	for _, positionId := range positionIds {
+		if charged[positionId] {
+			continue
+		}
+		charged[positionId] = true
+
+		vestingInfo, _, amountToVest, err := getTokenReleaseInfo(k, ctx, beneficiary, positionId)
+		if errors.Is(err, types.ErrCliffViolation) || errors.Is(err, types.ErrPositionFullyClaimed) {
+			continue
+		}
+		if err != nil {
+			return false, nil
+		}
+
+		pendingFee := pendingFeeOf(vestingInfo)
+		if amountToVest.LTE(pendingFee) {
+			continue
+		}
+
+		charge := sdkmath.MinUint(amountToVest.Sub(pendingFee), remaining)
+		if !charge.IsZero() {
+			vestingInfo.PendingFee = pendingFee.Add(charge).String()
+			chargedPositions = append(chargedPositions, vestingInfo)
+			remaining = remaining.Sub(charge)
+		}
+	}
+
+	if !remaining.IsZero() {
+		return false, nil
+	}
+	for _, vestingInfo := range chargedPositions {
+		k.SetVestingPosition(ctx, *vestingInfo)
+	}
+
+	if fee.IsZero() {
+		return true, nil
+	}
+
+	feeCoins := sdk.NewCoins(sdk.NewCoin(types.DENOM, sdkmath.NewIntFromBigInt(fee.BigInt())))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(beneficiary), feeCoins); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// pendingFeeOf returns the fees a position owes to the module. Positions created before fees could be
+// paid out of releases don't hold any.
+func pendingFeeOf(vestingInfo *types.VestingInfo) sdkmath.Uint {
+	if vestingInfo.PendingFee == "" {
+		return sdkmath.ZeroUint()
+	}
+
+	return sdkmath.NewUintFromString(vestingInfo.PendingFee)
+}
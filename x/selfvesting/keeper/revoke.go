@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// revokeUnvested caps a position to what has vested so far and returns the unvested amount it gave up. What
+// has vested stays with the beneficiary and can be released at once. The fees the module paid for the
+// beneficiary out of the position count as vested so that they can still be paid back. The tokens given up
+// stay in the module account and it is up to the caller to decide what to do with them.
+func (k Keeper) revokeUnvested(ctx sdk.Context, vestingInfo types.VestingInfo, now uint64) sdkmath.Uint {
+	amount := sdkmath.NewUintFromString(vestingInfo.Amount)
+	totalClaimed := sdkmath.NewUintFromString(vestingInfo.TotalClaimed)
+	kept := sdkmath.MaxUint(vestingInfo.VestedAmountAt(now), totalClaimed.Add(pendingFeeOf(&vestingInfo)))
+	if kept.GTE(amount) {
+		return sdkmath.ZeroUint()
+	}
+
+	// what is kept vests right away
+	vestingInfo.Amount = kept.String()
+	vestingInfo.Curve = types.VestingCurve_VESTING_CURVE_CLIFF
+	vestingInfo.StartTime = min(vestingInfo.StartTime, now)
+	vestingInfo.Cliff = min(vestingInfo.Cliff, now)
+	vestingInfo.StepPeriod = 0
+	vestingInfo.Periods = nil
+	vestingInfo.Revocable = false
+
+	// the capped position is pruned once it doesn't hold anything to release or to pay back
+	if kept.Equal(totalClaimed) {
+		k.RemoveVestingPosition(ctx, vestingInfo)
+	} else {
+		k.SetVestingPosition(ctx, vestingInfo)
+	}
+
+	return amount.Sub(kept)
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"strconv"
+
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// transferPosition moves a position, with what has been claimed from it, to the recipient. A transfer of the
+// position waiting for its recipient is dropped.
+func (k Keeper) transferPosition(ctx sdk.Context, vestingInfo types.VestingInfo, recipient string) {
+	from := vestingInfo.Beneficiary
+	vestingInfo.Beneficiary = recipient
+	k.SetVestingPosition(ctx, vestingInfo)
+	k.RemovePendingTransfer(ctx, vestingInfo.Id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferPosition,
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(vestingInfo.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyFrom, from),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+	))
+}
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"selfchain/x/selfvesting/types"
+
+	"cosmossdk.io/store/prefix"
+	cosmotypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetVestingPositionCount get the total number of vestingPosition
+func (k Keeper) GetVestingPositionCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.VestingPositionCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetVestingPositionCount set the total number of vestingPosition
+func (k Keeper) SetVestingPositionCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.VestingPositionCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendVestingPosition appends a vestingPosition in the store with a new id and update the count.
+// Ids start at 1 so that a zero id never refers to a position.
+func (k Keeper) AppendVestingPosition(
+	ctx sdk.Context,
+	vestingPosition types.VestingInfo,
+) uint64 {
+	// Create the vestingPosition
+	id := k.GetVestingPositionCount(ctx) + 1
+
+	// Set the ID of the appended value
+	vestingPosition.Id = id
+
+	k.SetVestingPosition(ctx, vestingPosition)
+
+	// Update vestingPosition count
+	k.SetVestingPositionCount(ctx, id)
+
+	return id
+}
+
+// SetVestingPosition set a specific vestingPosition in the store and index it under its beneficiary. A position
+// moved to another beneficiary is removed from the positions of the previous one.
+func (k Keeper) SetVestingPosition(ctx sdk.Context, vestingPosition types.VestingInfo) {
+	if previous, found := k.GetVestingPosition(ctx, vestingPosition.Id); found && previous.Beneficiary != vestingPosition.Beneficiary {
+		previousStore := k.beneficiaryPositionStore(ctx, previous.Beneficiary)
+		previousStore.Delete(GetVestingPositionIDBytes(vestingPosition.Id))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VestingPositionKey))
+	b := k.cdc.MustMarshal(&vestingPosition)
+	store.Set(GetVestingPositionIDBytes(vestingPosition.Id), b)
+
+	beneficiaryStore := k.beneficiaryPositionStore(ctx, vestingPosition.Beneficiary)
+	beneficiaryStore.Set(GetVestingPositionIDBytes(vestingPosition.Id), []byte{})
+}
+
+// GetVestingPosition returns a vestingPosition from its id
+func (k Keeper) GetVestingPosition(ctx sdk.Context, id uint64) (val types.VestingInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VestingPositionKey))
+	b := store.Get(GetVestingPositionIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveVestingPosition removes a vestingPosition from the store together with its pending transfer
+func (k Keeper) RemoveVestingPosition(ctx sdk.Context, vestingPosition types.VestingInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VestingPositionKey))
+	store.Delete(GetVestingPositionIDBytes(vestingPosition.Id))
+
+	beneficiaryStore := k.beneficiaryPositionStore(ctx, vestingPosition.Beneficiary)
+	beneficiaryStore.Delete(GetVestingPositionIDBytes(vestingPosition.Id))
+
+	k.RemovePendingTransfer(ctx, vestingPosition.Id)
+}
+
+// GetAllVestingPosition returns all vestingPosition
+func (k Keeper) GetAllVestingPosition(ctx sdk.Context) (list []types.VestingInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VestingPositionKey))
+	iterator := cosmotypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VestingInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetVestingPositions returns the positions of a beneficiary in the order they were created
+func (k Keeper) GetVestingPositions(
+	ctx sdk.Context,
+	beneficiary string,
+) (val types.VestingPositions, found bool) {
+	beneficiaryStore := k.beneficiaryPositionStore(ctx, beneficiary)
+	iterator := cosmotypes.KVStorePrefixIterator(beneficiaryStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		vestingPosition, _ := k.GetVestingPosition(ctx, binary.BigEndian.Uint64(iterator.Key()))
+		val.VestingInfos = append(val.VestingInfos, &vestingPosition)
+	}
+
+	if len(val.VestingInfos) == 0 {
+		return val, false
+	}
+
+	val.Beneficiary = beneficiary
+	return val, true
+}
+
+// GetVestingPositionIDBytes returns the byte representation of the ID
+func GetVestingPositionIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// beneficiaryPositionStore returns the store holding the ids of the positions of a beneficiary
+func (k Keeper) beneficiaryPositionStore(ctx sdk.Context, beneficiary string) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VestingPositionBeneficiaryKeyPrefix))
+	return prefix.NewStore(store, types.VestingPositionBeneficiaryKey(beneficiary))
+}
+
+// getPosition returns a position from its id. Positions are pruned once fully claimed so a known id that is
+// not in the store anymore refers to a fully claimed position.
+func (k Keeper) getPosition(ctx sdk.Context, id uint64) (types.VestingInfo, error) {
+	vestingPosition, found := k.GetVestingPosition(ctx, id)
+	if !found {
+		if id != 0 && id <= k.GetVestingPositionCount(ctx) {
+			return vestingPosition, types.ErrPositionFullyClaimed
+		}
+		return vestingPosition, types.ErrPositionNotFound
+	}
+
+	return vestingPosition, nil
+}
+
+// getBeneficiaryPosition returns a position of the beneficiary from its id
+func (k Keeper) getBeneficiaryPosition(ctx sdk.Context, beneficiary string, id uint64) (types.VestingInfo, error) {
+	vestingPosition, err := k.getPosition(ctx, id)
+	if err != nil {
+		return vestingPosition, err
+	}
+
+	if vestingPosition.Beneficiary != beneficiary {
+		return types.VestingInfo{}, types.ErrPositionNotFound
+	}
+
+	return vestingPosition, nil
+}
+
+// getMovablePosition returns a position of the beneficiary that can be transferred, split or merged. Governance
+// can revert a migration and claw its position back from the beneficiary, so positions opened by migrations
+// stay as they are.
+func (k Keeper) getMovablePosition(ctx sdk.Context, beneficiary string, id uint64) (types.VestingInfo, error) {
+	vestingPosition, err := k.getBeneficiaryPosition(ctx, beneficiary, id)
+	if err != nil {
+		return vestingPosition, err
+	}
+
+	if vestingPosition.OpenedByMigration() {
+		return types.VestingInfo{}, types.ErrMigratedPosition
+	}
+
+	return vestingPosition, nil
+}
+
+// SetLegacyPositionIndex set a specific legacyPositionIndex in the store from its index
+func (k Keeper) SetLegacyPositionIndex(ctx sdk.Context, legacyPositionIndex types.LegacyPositionIndex) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LegacyPositionIndexKeyPrefix))
+	b := k.cdc.MustMarshal(&legacyPositionIndex)
+	store.Set(types.LegacyPositionIndexKey(
+		legacyPositionIndex.Beneficiary,
+		legacyPositionIndex.Index,
+	), b)
+}
+
+// GetLegacyPositionIndex returns a legacyPositionIndex from its index
+func (k Keeper) GetLegacyPositionIndex(
+	ctx sdk.Context,
+	beneficiary string,
+	index uint64,
+) (val types.LegacyPositionIndex, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LegacyPositionIndexKeyPrefix))
+
+	b := store.Get(types.LegacyPositionIndexKey(
+		beneficiary,
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllLegacyPositionIndex returns all legacyPositionIndex
+func (k Keeper) GetAllLegacyPositionIndex(ctx sdk.Context) (list []types.LegacyPositionIndex) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LegacyPositionIndexKeyPrefix))
+	iterator := cosmotypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LegacyPositionIndex
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetPendingTransfer set the pending transfer of a position in the store
+func (k Keeper) SetPendingTransfer(ctx sdk.Context, pendingTransfer types.PendingTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingTransferKeyPrefix))
+	b := k.cdc.MustMarshal(&pendingTransfer)
+	store.Set(GetVestingPositionIDBytes(pendingTransfer.PositionId), b)
+}
+
+// GetPendingTransfer returns the pending transfer of a position
+func (k Keeper) GetPendingTransfer(ctx sdk.Context, positionId uint64) (val types.PendingTransfer, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingTransferKeyPrefix))
+	b := store.Get(GetVestingPositionIDBytes(positionId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingTransfer removes the pending transfer of a position from the store
+func (k Keeper) RemovePendingTransfer(ctx sdk.Context, positionId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingTransferKeyPrefix))
+	store.Delete(GetVestingPositionIDBytes(positionId))
+}
+
+// GetAllPendingTransfer returns all pendingTransfer
+func (k Keeper) GetAllPendingTransfer(ctx sdk.Context) (list []types.PendingTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingTransferKeyPrefix))
+	iterator := cosmotypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/testutil/nullify"
+	"selfchain/x/selfvesting/keeper"
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// createNVestingPosition creates n positions spread over two beneficiaries
+func createNVestingPosition(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.VestingInfo {
+	items := make([]types.VestingInfo, n)
+	for i := range items {
+		items[i].Beneficiary = strconv.Itoa(i % 2)
+		items[i].Id = keeper.AppendVestingPosition(ctx, items[i])
+	}
+	return items
+}
+
+func TestVestingPositionGet(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	items := createNVestingPosition(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetVestingPosition(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestVestingPositionRemove(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	items := createNVestingPosition(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveVestingPosition(ctx, item)
+		_, found := keeper.GetVestingPosition(ctx, item.Id)
+		require.False(t, found)
+	}
+
+	// the beneficiary index is cleaned up as well
+	_, found := keeper.GetVestingPositions(ctx, "0")
+	require.False(t, found)
+}
+
+func TestVestingPositionGetAll(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	items := createNVestingPosition(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllVestingPosition(ctx)),
+	)
+}
+
+func TestVestingPositionCount(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	items := createNVestingPosition(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetVestingPositionCount(ctx))
+
+	// ids start at 1 and are never reused
+	require.Equal(t, uint64(1), items[0].Id)
+	keeper.RemoveVestingPosition(ctx, items[9])
+	require.Equal(t, count+1, keeper.AppendVestingPosition(ctx, types.VestingInfo{}))
+}
+
+func TestVestingPositionsOfBeneficiary(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	items := createNVestingPosition(keeper, ctx, 10)
+
+	// the positions of a beneficiary come in the order they were created
+	got, found := keeper.GetVestingPositions(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "1", got.Beneficiary)
+	require.Len(t, got.VestingInfos, 5)
+	for i, vestingInfo := range got.VestingInfos {
+		require.Equal(t,
+			nullify.Fill(&items[2*i+1]),
+			nullify.Fill(vestingInfo),
+		)
+	}
+
+	keeper.RemoveVestingPosition(ctx, items[1])
+	got, _ = keeper.GetVestingPositions(ctx, "1")
+	require.Len(t, got.VestingInfos, 4)
+	require.Equal(t, items[3].Id, got.VestingInfos[0].Id)
+
+	_, found = keeper.GetVestingPositions(ctx, "2")
+	require.False(t, found)
+}
+
+func TestLegacyPositionIndexGet(t *testing.T) {
+	keeper, ctx := keepertest.SelfvestingKeeper(t)
+	items := make([]types.LegacyPositionIndex, 10)
+	for i := range items {
+		items[i].Beneficiary = strconv.Itoa(i % 2)
+		items[i].Index = uint64(i / 2)
+		items[i].PositionId = uint64(i + 1)
+		keeper.SetLegacyPositionIndex(ctx, items[i])
+	}
+
+	for _, item := range items {
+		rst, found := keeper.GetLegacyPositionIndex(ctx, item.Beneficiary, item.Index)
+		require.True(t, found)
+		require.Equal(t, item, rst)
+	}
+	require.ElementsMatch(t, items, keeper.GetAllLegacyPositionIndex(ctx))
+
+	_, found := keeper.GetLegacyPositionIndex(ctx, "0", 5)
+	require.False(t, found)
+}
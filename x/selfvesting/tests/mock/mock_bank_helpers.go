@@ -4,7 +4,9 @@ import (
 	"context"
 	"selfchain/x/selfvesting/types"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -12,7 +14,7 @@ func coinsOf(amount uint64) sdk.Coins {
 	return sdk.Coins{
 		sdk.Coin{
 			Denom:  types.DENOM,
-			Amount: sdk.NewInt(int64(amount)),
+			Amount: sdkmath.NewInt(int64(amount)),
 		},
 	}
 }
@@ -26,6 +28,20 @@ func (escrow *MockBankKeeper) ExpectReceiveCoins(context context.Context, who st
 	return escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), types.ModuleName, whoAddr, coinsOf(amount))
 }
 
-func (escrow *MockBankKeeper) ExpectMintToModule(context context.Context, amount uint64) *gomock.Call {
-	return escrow.EXPECT().MintCoins(sdk.UnwrapSDKContext(context), types.ModuleName, coinsOf(amount))
+func (escrow *MockBankKeeper) ExpectEscrowCoins(context context.Context, who string, amount uint64) *gomock.Call {
+	whoAddr, err := sdk.AccAddressFromBech32(who)
+	if err != nil {
+		panic(err)
+	}
+
+	return escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), whoAddr, types.ModuleName, coinsOf(amount))
 }
+
+func (escrow *MockBankKeeper) ExpectPayFee(context context.Context, beneficiary string, amount uint64) *gomock.Call {
+	return escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), types.ModuleName, sdk.MustAccAddressFromBech32(beneficiary), coinsOf(amount))
+}
+
+func (escrow *MockBankKeeper) ExpectDeductFee(context context.Context, feePayer string, amount uint64) *gomock.Call {
+	return escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), sdk.MustAccAddressFromBech32(feePayer), authtypes.FeeCollectorName, coinsOf(amount))
+}
+
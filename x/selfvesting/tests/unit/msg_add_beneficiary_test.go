@@ -21,7 +21,7 @@ import (
 func setup(t testing.TB) (types.MsgServer, context.Context, keeper.Keeper, *gomock.Controller, *mocktest.MockBankKeeper) {
 	ctrl := gomock.NewController(t)
 	bankMock := mocktest.NewMockBankKeeper(ctrl)
-	k, ctx := keepertest.SelfvestingKeeperWithMocks(t, bankMock)
+	k, ctx := keepertest.SelfvestingKeeperWithMocks(t, bankMock, nil)
 
 	// setup genesis params for this module
 	genesis := *types.DefaultGenesis()
@@ -41,7 +41,7 @@ func TestShouldFailIfInvalidBeneficiaryAddr(t *testing.T) {
 	_, ctx, keeper, ctrl, _ := setup(t)
 	defer ctrl.Finish()
 
-	_, err := keeper.AddBeneficiary(sdk.UnwrapSDKContext(ctx), types.AddBeneficiaryRequest{
+	_, _, err := keeper.AddBeneficiary(sdk.UnwrapSDKContext(ctx), types.AddBeneficiaryRequest{
 		Beneficiary: "Invalid Address",
 		Cliff:       0,
 		Duration:    0,
@@ -65,13 +65,13 @@ func TestShouldCreateNewVestingPosition(t *testing.T) {
 		Amount:      "100000000000",
 	}
 
-	_, err := keeper.AddBeneficiary(sdkContext, addBeneficiaryRequest)
+	_, positionId, err := keeper.AddBeneficiary(sdkContext, addBeneficiaryRequest)
 
-	vestingPositions, _ := keeper.GetVestingPositions(sdkContext, test.Alice)
-	vestingInfo := vestingPositions.VestingInfos[0]
+	vestingInfo, _ := keeper.GetVestingPosition(sdkContext, positionId)
 
-	require.Equal(t, vestingPositions.Beneficiary, test.Alice)
-	require.Equal(t, len(vestingPositions.VestingInfos), 1)
+	require.Equal(t, positionId, uint64(1))
+	require.Equal(t, vestingInfo.Id, positionId)
+	require.Equal(t, vestingInfo.Beneficiary, test.Alice)
 	require.Equal(t, vestingInfo.StartTime, startTime)
 	require.Equal(t, vestingInfo.Cliff, startTime+604800)
 	require.Equal(t, vestingInfo.Duration, uint64(2592000))
@@ -89,7 +89,9 @@ func TestShouldCreateNewVestingPosition(t *testing.T) {
 		Amount:      "200000000000",
 	}
 
-	keeper.AddBeneficiary(sdkContext, addBeneficiaryRequest2)
+	_, positionId_2, _ := keeper.AddBeneficiary(sdkContext, addBeneficiaryRequest2)
+
+	require.Equal(t, positionId_2, uint64(2))
 
 	vestingPositions_1, _ := keeper.GetVestingPositions(sdkContext, test.Alice)
 	vestingInfo_1 := vestingPositions_1.VestingInfos[0]
@@ -105,11 +107,9 @@ func TestShouldCreateNewVestingPosition(t *testing.T) {
 	require.Equal(t, vestingInfo_1.PeriodClaimed, uint64(0))
 
 	// second position should be stored
-	vestingPositions_2, _ := keeper.GetVestingPositions(sdkContext, test.Alice)
-	vestingInfo_2 := vestingPositions_2.VestingInfos[1]
+	vestingInfo_2, _ := keeper.GetVestingPosition(sdkContext, positionId_2)
 
-	require.Equal(t, vestingPositions_2.Beneficiary, test.Alice)
-	require.Equal(t, len(vestingPositions_2.VestingInfos), 2)
+	require.Equal(t, vestingInfo_2.Beneficiary, test.Alice)
 	require.Equal(t, vestingInfo_2.StartTime, startTime)
 	require.Equal(t, vestingInfo_2.Cliff, startTime+604800)
 	require.Equal(t, vestingInfo_2.Duration, uint64(2592000))
@@ -0,0 +1,167 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/selfvesting/tests"
+	"selfchain/x/selfvesting/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createPosition(revocable bool) *types.MsgCreateVestingPosition {
+	return types.NewMsgCreateVestingPosition(test.Carol, test.Alice, "300000000000", 7*SECONDS_IN_DAY, 30*SECONDS_IN_DAY, 0, revocable)
+}
+
+func TestShouldEscrowFundsIntoNewPosition(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	// the vesting starts a day from now
+	sdkCtx := afterDays(ctx, 1)
+	msg := createPosition(true)
+	msg.StartTime = 2 * SECONDS_IN_DAY
+	bankMock.ExpectEscrowCoins(sdkCtx, test.Carol, 300000000000)
+
+	res, err := server.CreateVestingPosition(sdkCtx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.PositionId)
+
+	position, found := k.GetVestingPosition(sdkCtx, res.PositionId)
+	require.True(t, found)
+	require.Equal(t, types.VestingInfo{
+		Id:           4,
+		Beneficiary:  test.Alice,
+		StartTime:    2 * SECONDS_IN_DAY,
+		Cliff:        9 * SECONDS_IN_DAY,
+		Duration:     30 * SECONDS_IN_DAY,
+		Amount:       "300000000000",
+		TotalClaimed: "0",
+		Funder:       test.Carol,
+		Revocable:    true,
+	}, position)
+
+	vestingPositions, _ := k.GetVestingPositions(sdkCtx, test.Alice)
+	require.Len(t, vestingPositions.VestingInfos, 3)
+}
+
+func TestShouldNotStartVestingInThePast(t *testing.T) {
+	server, ctx, _, ctrl, _ := setup_release(t)
+	defer ctrl.Finish()
+
+	msg := createPosition(true)
+	msg.StartTime = SECONDS_IN_DAY
+
+	_, err := server.CreateVestingPosition(afterDays(ctx, 2), msg)
+	require.ErrorIs(t, err, types.ErrInvalidStartTime)
+}
+
+func TestShouldReturnUnvestedFundsOnRevoke(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	bankMock.ExpectEscrowCoins(afterDays(ctx, 0), test.Carol, 300000000000)
+	res, err := server.CreateVestingPosition(afterDays(ctx, 0), createPosition(true))
+	require.NoError(t, err)
+
+	// a third is vested after 10 days, the rest goes back to Carol
+	sdkCtx := afterDays(ctx, 10)
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Carol, 200000000000)
+
+	revoked, err := server.RevokePosition(sdkCtx, &types.MsgRevokePosition{Creator: test.Carol, PositionId: res.PositionId})
+	require.NoError(t, err)
+	require.Equal(t, "200000000000", revoked.AmountReturned)
+
+	// Alice keeps what vested and can release it right away
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 100000000000)
+	released, err := server.Release(sdkCtx, &types.MsgRelease{Creator: test.Alice, PositionId: res.PositionId})
+	require.NoError(t, err)
+	require.Equal(t, "100000000000", released.AmountToVest)
+
+	_, found := k.GetVestingPosition(sdkCtx, res.PositionId)
+	require.False(t, found)
+}
+
+func TestShouldReturnEverythingWhenRevokedBeforeCliff(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	bankMock.ExpectEscrowCoins(afterDays(ctx, 0), test.Carol, 300000000000)
+	res, err := server.CreateVestingPosition(afterDays(ctx, 0), createPosition(true))
+	require.NoError(t, err)
+
+	sdkCtx := afterDays(ctx, 6)
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Carol, 300000000000)
+
+	revoked, err := server.RevokePosition(sdkCtx, &types.MsgRevokePosition{Creator: test.Carol, PositionId: res.PositionId})
+	require.NoError(t, err)
+	require.Equal(t, "300000000000", revoked.AmountReturned)
+
+	_, found := k.GetVestingPosition(sdkCtx, res.PositionId)
+	require.False(t, found)
+}
+
+func TestShouldOnlyRevokeRevocablePositionsOfTheFunder(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	bankMock.ExpectEscrowCoins(afterDays(ctx, 0), test.Carol, 300000000000).Times(2)
+	revocable, err := server.CreateVestingPosition(afterDays(ctx, 0), createPosition(true))
+	require.NoError(t, err)
+	irrevocable, err := server.CreateVestingPosition(afterDays(ctx, 0), createPosition(false))
+	require.NoError(t, err)
+
+	sdkCtx := afterDays(ctx, 10)
+	for _, msg := range []*types.MsgRevokePosition{
+		{Creator: test.Bob, PositionId: revocable.PositionId},
+		{Creator: test.Carol, PositionId: irrevocable.PositionId},
+		// positions opened by migrations have no funder
+		{Creator: test.Carol, PositionId: 1},
+	} {
+		_, err := server.RevokePosition(sdkCtx, msg)
+		require.ErrorIs(t, err, types.ErrPositionNotRevocable)
+	}
+
+	_, err = server.RevokePosition(sdkCtx, &types.MsgRevokePosition{Creator: test.Carol, PositionId: 42})
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+}
+
+func TestShouldRejectDustPositionsForOtherAccounts(t *testing.T) {
+	server, ctx, _, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	sdkCtx := afterDays(ctx, 0)
+	msg := createPosition(false)
+	msg.Amount = "999999"
+
+	_, err := server.CreateVestingPosition(sdkCtx, msg)
+	require.ErrorIs(t, err, types.ErrPositionTooSmall)
+
+	// A beneficiary can fund a position of its own of any amount
+	msg.Creator = test.Alice
+	bankMock.ExpectEscrowCoins(sdkCtx, test.Alice, 999999)
+	_, err = server.CreateVestingPosition(sdkCtx, msg)
+	require.NoError(t, err)
+}
+
+func TestShouldCapPositionsCreatedForOtherAccounts(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	sdkCtx := afterDays(ctx, 0)
+	k.SetParams(sdkCtx, types.NewParams(types.DefaultMinPositionAmount, 3))
+
+	// Alice already has two positions
+	bankMock.ExpectEscrowCoins(sdkCtx, test.Carol, 300000000000)
+	_, err := server.CreateVestingPosition(sdkCtx, createPosition(false))
+	require.NoError(t, err)
+
+	_, err = server.CreateVestingPosition(sdkCtx, createPosition(false))
+	require.ErrorIs(t, err, types.ErrTooManyPositions)
+
+	vestingPositions, _ := k.GetVestingPositions(sdkCtx, test.Alice)
+	require.Len(t, vestingPositions.VestingInfos, 3)
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"selfchain/x/selfvesting/keeper"
+	test "selfchain/x/selfvesting/tests"
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+// enableEarlyUnlock has governance set a penalty declining from 50% to 10% over the vesting
+func enableEarlyUnlock(t *testing.T, server types.MsgServer, ctx context.Context, k keeper.Keeper, burnPenalty bool) {
+	_, err := server.SetEarlyUnlockConfig(afterDays(ctx, 0), types.NewMsgSetEarlyUnlockConfig(k.GetAuthority(), 5000, 1000, burnPenalty))
+	require.NoError(t, err)
+}
+
+func TestShouldUnlockEarlyAsQuoted(t *testing.T) {
+	server, ctx, k, ctrl, bankMock, distrMock := setup_grants(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+	enableEarlyUnlock(t, server, ctx, k, false)
+
+	// after 10 days a third is vested and the penalty has declined to 36.67%
+	sdkCtx := afterDays(ctx, 10)
+	quote, err := k.EarlyUnlockQuote(sdkCtx, &types.QueryEarlyUnlockQuoteRequest{PositionId: positionId, Amount: "50000000000"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryEarlyUnlockQuoteResponse{
+		Released:           "100000000000",
+		Unlocked:           "50000000000",
+		PenaltyBasisPoints: 3667,
+		Penalty:            "18335000000",
+		FeeDeducted:        "0",
+		NetAmount:          "131665000000",
+	}, quote)
+
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 131665000000)
+	distrMock.EXPECT().FundCommunityPool(sdkCtx, sdk.NewCoins(sdk.NewInt64Coin(types.DENOM, 18335000000)), authtypes.NewModuleAddress(types.ModuleName))
+
+	res, err := server.EarlyUnlock(sdkCtx, types.NewMsgEarlyUnlock(test.Alice, positionId, "50000000000"))
+	require.NoError(t, err)
+	require.Equal(t, quote.Released, res.Released)
+	require.Equal(t, quote.Unlocked, res.Unlocked)
+	require.Equal(t, quote.Penalty, res.Penalty)
+	require.Equal(t, quote.NetAmount, res.NetAmount)
+
+	// the unlocked tokens were the next ones to vest, so nothing is released until day 15
+	released, err := server.Release(afterDays(ctx, 15), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+	require.Equal(t, "0", released.AmountToVest)
+
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 20), test.Alice, 50000000000)
+	released, err = server.Release(afterDays(ctx, 20), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+	require.Equal(t, "50000000000", released.AmountToVest)
+}
+
+func TestShouldBurnPenaltyOfUnlockingWholePosition(t *testing.T) {
+	server, ctx, k, ctrl, bankMock, _ := setup_grants(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+	enableEarlyUnlock(t, server, ctx, k, true)
+
+	// nothing is vested yet so the whole position is unlocked at the full penalty
+	sdkCtx := afterDays(ctx, 0)
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 150000000000)
+	bankMock.EXPECT().BurnCoins(sdkCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DENOM, 150000000000)))
+
+	res, err := server.EarlyUnlock(sdkCtx, types.NewMsgEarlyUnlock(test.Alice, positionId, ""))
+	require.NoError(t, err)
+	require.Equal(t, "0", res.Released)
+	require.Equal(t, "300000000000", res.Unlocked)
+	require.Equal(t, "150000000000", res.Penalty)
+
+	_, found := k.GetVestingPosition(sdkCtx, positionId)
+	require.False(t, found)
+}
+
+func TestShouldDeclinePenaltyTowardEndOfVesting(t *testing.T) {
+	server, ctx, k, ctrl, bankMock, _ := setup_grants(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+	enableEarlyUnlock(t, server, ctx, k, false)
+
+	penalties := []uint64{}
+	for _, days := range []int64{0, 15, 29} {
+		quote, err := k.EarlyUnlockQuote(afterDays(ctx, days), &types.QueryEarlyUnlockQuoteRequest{PositionId: positionId, Amount: "1000000000"})
+		require.NoError(t, err)
+		penalties = append(penalties, quote.PenaltyBasisPoints)
+	}
+	require.Equal(t, []uint64{5000, 3000, 1134}, penalties)
+}
+
+func TestShouldNotUnlockEarly(t *testing.T) {
+	server, ctx, k, ctrl, bankMock, _ := setup_grants(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+	sdkCtx := afterDays(ctx, 10)
+
+	_, err := server.EarlyUnlock(sdkCtx, types.NewMsgEarlyUnlock(test.Alice, positionId, ""))
+	require.ErrorIs(t, err, types.ErrEarlyUnlockDisabled)
+
+	_, err = server.SetEarlyUnlockConfig(sdkCtx, types.NewMsgSetEarlyUnlockConfig(test.Carol, 5000, 1000, false))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	enableEarlyUnlock(t, server, ctx, k, false)
+
+	// only the unvested 200000000000 can be unlocked
+	_, err = server.EarlyUnlock(sdkCtx, types.NewMsgEarlyUnlock(test.Alice, positionId, "200000000001"))
+	require.ErrorIs(t, err, errors.ErrInvalidRequest)
+
+	_, err = server.EarlyUnlock(sdkCtx, types.NewMsgEarlyUnlock(test.Bob, positionId, ""))
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+
+	// the funder of a revocable position could take back what is unlocked
+	revocableId := fundPosition(t, server, ctx, bankMock, true)
+	_, err = server.EarlyUnlock(sdkCtx, types.NewMsgEarlyUnlock(test.Alice, revocableId, ""))
+	require.ErrorIs(t, err, types.ErrEarlyUnlockNotAllowed)
+}
+
+func TestShouldNotUnlockMigratedPositionsEarly(t *testing.T) {
+	server, ctx, k, ctrl, _, _ := setup_grants(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	enableEarlyUnlock(t, server, ctx, k, false)
+
+	positionId := beneficiaryPositionIds(ctx, k, test.Alice)[0]
+	_, err := server.EarlyUnlock(afterDays(ctx, 10), types.NewMsgEarlyUnlock(test.Alice, positionId, ""))
+	require.ErrorIs(t, err, types.ErrMigratedPosition)
+
+	_, err = k.EarlyUnlockQuote(afterDays(ctx, 10), &types.QueryEarlyUnlockQuoteRequest{PositionId: positionId})
+	require.ErrorContains(t, err, types.ErrMigratedPosition.Error())
+}
@@ -0,0 +1,201 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/x/selfvesting"
+	"selfchain/x/selfvesting/keeper"
+	test "selfchain/x/selfvesting/tests"
+	mocktest "selfchain/x/selfvesting/tests/mock"
+	"selfchain/x/selfvesting/types"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockFeegrantKeeper keeps the allowances in memory and, like the feegrant module, queues for pruning the
+// ones granted with an expiration
+type mockFeegrantKeeper struct {
+	allowances map[string]feegrant.FeeAllowanceI
+	queued     map[string]bool
+}
+
+func newMockFeegrantKeeper() *mockFeegrantKeeper {
+	return &mockFeegrantKeeper{
+		allowances: make(map[string]feegrant.FeeAllowanceI),
+		queued:     make(map[string]bool),
+	}
+}
+
+func (m *mockFeegrantKeeper) GetAllowance(_ context.Context, _, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	allowance, found := m.allowances[grantee.String()]
+	if !found {
+		return nil, errors.New("fee-grant not found")
+	}
+
+	return allowance, nil
+}
+
+func (m *mockFeegrantKeeper) GrantAllowance(_ context.Context, _, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	if _, found := m.allowances[grantee.String()]; found {
+		return errors.New("fee allowance already exists")
+	}
+
+	exp, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+	m.queued[grantee.String()] = exp != nil
+	m.allowances[grantee.String()] = feeAllowance
+
+	return nil
+}
+
+func (m *mockFeegrantKeeper) UpdateAllowance(_ context.Context, _, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	if _, found := m.allowances[grantee.String()]; !found {
+		return errors.New("fee-grant not found")
+	}
+
+	m.allowances[grantee.String()] = feeAllowance
+	return nil
+}
+
+// basicAllowance returns the basic allowance behind the allowance of a beneficiary
+func (m *mockFeegrantKeeper) basicAllowance(t *testing.T, beneficiary string) *feegrant.BasicAllowance {
+	allowance, found := m.allowances[beneficiary]
+	require.True(t, found)
+
+	inner, err := allowance.(*feegrant.AllowedMsgAllowance).GetAllowance()
+	require.NoError(t, err)
+
+	return inner.(*feegrant.BasicAllowance)
+}
+
+// spend has the beneficiary pay a fee with its allowance
+func (m *mockFeegrantKeeper) spend(t *testing.T, ctx sdk.Context, beneficiary string, fee sdk.Coins) {
+	allowance := m.allowances[beneficiary]
+	_, err := allowance.Accept(ctx, fee, []sdk.Msg{&types.MsgReleaseAll{Creator: beneficiary}})
+	require.NoError(t, err)
+}
+
+// expectRefund expects a refund of the module to a beneficiary, made in a branch of the context
+func expectRefund(bankMock *mocktest.MockBankKeeper, beneficiary string, amount int64) *gomock.Call {
+	return bankMock.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(beneficiary), uslf(amount))
+}
+
+func setupWithFeegrant(t testing.TB) (sdk.Context, keeper.Keeper, *gomock.Controller, *mocktest.MockBankKeeper, *mockFeegrantKeeper) {
+	if sdk.GetConfig().GetBech32AccountAddrPrefix() != "self" {
+		test.InitSDKConfig()
+	}
+
+	ctrl := gomock.NewController(t)
+	bankMock := mocktest.NewMockBankKeeper(ctrl)
+	feegrantMock := newMockFeegrantKeeper()
+	k, ctx := keepertest.SelfvestingKeeperWithFeegrant(t, bankMock, feegrantMock, nil)
+
+	selfvesting.InitGenesis(ctx, *k, *types.DefaultGenesis())
+
+	return ctx.WithBlockTime(time.Unix(1000, 0)), *k, ctrl, bankMock, feegrantMock
+}
+
+func TestShouldWithholdFeeAllowance(t *testing.T) {
+	ctx, k, ctrl, _, feegrantMock := setupWithFeegrant(t)
+	defer ctrl.Finish()
+
+	err := k.GrantFeeAllowance(ctx, test.Alice, uslf(1000), time.Unix(2000, 0))
+	require.NoError(t, err)
+
+	feeAllowance, found := k.GetFeeAllowance(ctx, test.Alice)
+	require.True(t, found)
+	require.Equal(t, types.FeeAllowance{Beneficiary: test.Alice, Withheld: "1000", Expiration: 2000}, feeAllowance)
+
+	// The allowance expires but isn't pruned by the feegrant module, which would lose what is left of it
+	basic := feegrantMock.basicAllowance(t, test.Alice)
+	require.Equal(t, uslf(1000), basic.SpendLimit)
+	require.Equal(t, int64(2000), basic.Expiration.Unix())
+	require.False(t, feegrantMock.queued[test.Alice])
+}
+
+func TestShouldTopUpAndExtendFeeAllowance(t *testing.T) {
+	ctx, k, ctrl, _, feegrantMock := setupWithFeegrant(t)
+	defer ctrl.Finish()
+
+	require.NoError(t, k.GrantFeeAllowance(ctx, test.Alice, uslf(1000), time.Unix(2000, 0)))
+	feegrantMock.spend(t, ctx, test.Alice, uslf(300))
+
+	require.NoError(t, k.GrantFeeAllowance(ctx.WithBlockTime(time.Unix(1500, 0)), test.Alice, uslf(1000), time.Unix(2500, 0)))
+
+	feeAllowance, found := k.GetFeeAllowance(ctx, test.Alice)
+	require.True(t, found)
+	require.Equal(t, types.FeeAllowance{Beneficiary: test.Alice, Withheld: "2000", Expiration: 2500}, feeAllowance)
+
+	basic := feegrantMock.basicAllowance(t, test.Alice)
+	require.Equal(t, uslf(1700), basic.SpendLimit)
+	require.Equal(t, int64(2500), basic.Expiration.Unix())
+
+	// Only the last expiration is queued for the refund
+	sdkCtx := ctx.WithBlockTime(time.Unix(2001, 0))
+	k.RefundExpiredFeeAllowances(sdkCtx)
+	_, found = k.GetFeeAllowance(sdkCtx, test.Alice)
+	require.True(t, found)
+}
+
+func TestShouldRefundExpiredFeeAllowance(t *testing.T) {
+	ctx, k, ctrl, bankMock, feegrantMock := setupWithFeegrant(t)
+	defer ctrl.Finish()
+
+	require.NoError(t, k.GrantFeeAllowance(ctx, test.Alice, uslf(1000), time.Unix(2000, 0)))
+	feegrantMock.spend(t, ctx, test.Alice, uslf(400))
+
+	// The allowance can still be used until its expiration
+	k.RefundExpiredFeeAllowances(ctx.WithBlockTime(time.Unix(2000, 0)))
+	_, found := k.GetFeeAllowance(ctx, test.Alice)
+	require.True(t, found)
+
+	sdkCtx := ctx.WithBlockTime(time.Unix(2001, 0))
+	expectRefund(bankMock, test.Alice, 600)
+	k.RefundExpiredFeeAllowances(sdkCtx)
+
+	_, found = k.GetFeeAllowance(sdkCtx, test.Alice)
+	require.False(t, found)
+	require.Empty(t, feegrantMock.basicAllowance(t, test.Alice).SpendLimit)
+
+	events := sdkCtx.EventManager().Events()
+	require.NotEmpty(t, events)
+	refund := events[len(events)-1]
+	require.Equal(t, types.EventTypeRefundFeeAllowance, refund.Type)
+	refunded, found := refund.GetAttribute(types.AttributeKeyRefunded)
+	require.True(t, found)
+	require.Equal(t, "600", refunded.Value)
+
+	// A later allowance doesn't top up the one that has been refunded
+	require.NoError(t, k.GrantFeeAllowance(sdkCtx, test.Alice, uslf(1000), time.Unix(3000, 0)))
+	require.Equal(t, uslf(1000), feegrantMock.basicAllowance(t, test.Alice).SpendLimit)
+}
+
+func TestShouldRetryFailedFeeAllowanceRefund(t *testing.T) {
+	ctx, k, ctrl, bankMock, feegrantMock := setupWithFeegrant(t)
+	defer ctrl.Finish()
+
+	require.NoError(t, k.GrantFeeAllowance(ctx, test.Alice, uslf(1000), time.Unix(2000, 0)))
+
+	sdkCtx := ctx.WithBlockTime(time.Unix(2001, 0))
+	expectRefund(bankMock, test.Alice, 1000).Return(errors.New("insufficient funds"))
+	k.RefundExpiredFeeAllowances(sdkCtx)
+
+	_, found := k.GetFeeAllowance(sdkCtx, test.Alice)
+	require.True(t, found)
+	require.Equal(t, uslf(1000), feegrantMock.basicAllowance(t, test.Alice).SpendLimit)
+
+	expectRefund(bankMock, test.Alice, 1000)
+	k.RefundExpiredFeeAllowances(sdkCtx)
+
+	_, found = k.GetFeeAllowance(sdkCtx, test.Alice)
+	require.False(t, found)
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "selfchain/testutil/keeper"
+	"selfchain/x/selfvesting"
+	"selfchain/x/selfvesting/keeper"
+	test "selfchain/x/selfvesting/tests"
+	mocktest "selfchain/x/selfvesting/tests/mock"
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setup_grants(t testing.TB) (types.MsgServer, context.Context, keeper.Keeper, *gomock.Controller, *mocktest.MockBankKeeper, *mocktest.MockDistrKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := mocktest.NewMockBankKeeper(ctrl)
+	distrMock := mocktest.NewMockDistrKeeper(ctrl)
+	k, ctx := keepertest.SelfvestingKeeperWithMocks(t, bankMock, distrMock)
+
+	selfvesting.InitGenesis(ctx, *k, *types.DefaultGenesis())
+
+	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx), *k, ctrl, bankMock, distrMock
+}
+
+func createGrant(authority string) *types.MsgCreateGrant {
+	return types.NewMsgCreateGrant(authority, test.Alice, "300000000000", 7*SECONDS_IN_DAY, 30*SECONDS_IN_DAY, 0)
+}
+
+// expectGrantPaid expects the grant to go from the community pool to the module through the authority
+func expectGrantPaid(ctx context.Context, k keeper.Keeper, bankMock *mocktest.MockBankKeeper, distrMock *mocktest.MockDistrKeeper, amount uint64) {
+	authority := sdk.MustAccAddressFromBech32(k.GetAuthority())
+	grantCoins := sdk.NewCoins(sdk.NewInt64Coin(types.DENOM, int64(amount)))
+
+	distrMock.EXPECT().DistributeFromFeePool(sdk.UnwrapSDKContext(ctx), grantCoins, authority)
+	bankMock.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(ctx), authority, types.ModuleName, grantCoins)
+}
+
+func TestShouldPayGrantOutOfCommunityPool(t *testing.T) {
+	server, ctx, k, ctrl, bankMock, distrMock := setup_grants(t)
+	defer ctrl.Finish()
+
+	sdkCtx := afterDays(ctx, 0)
+	expectGrantPaid(sdkCtx, k, bankMock, distrMock, 300000000000)
+
+	res, err := server.CreateGrant(sdkCtx, createGrant(k.GetAuthority()))
+	require.NoError(t, err)
+
+	position, found := k.GetVestingPosition(sdkCtx, res.PositionId)
+	require.True(t, found)
+	require.Equal(t, test.Alice, position.Beneficiary)
+	require.Equal(t, "300000000000", position.Amount)
+	require.Equal(t, uint64(7*SECONDS_IN_DAY), position.Cliff)
+	require.Equal(t, authtypes.NewModuleAddress(distrtypes.ModuleName).String(), position.Funder)
+	require.True(t, position.Revocable)
+}
+
+func TestShouldOnlyLetGovernanceCreateGrants(t *testing.T) {
+	server, ctx, _, ctrl, _, _ := setup_grants(t)
+	defer ctrl.Finish()
+
+	_, err := server.CreateGrant(afterDays(ctx, 0), createGrant(test.Carol))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+}
+
+func TestShouldClawbackUnvestedGrantToCommunityPool(t *testing.T) {
+	server, ctx, k, ctrl, bankMock, distrMock := setup_grants(t)
+	defer ctrl.Finish()
+
+	expectGrantPaid(afterDays(ctx, 0), k, bankMock, distrMock, 300000000000)
+	res, err := server.CreateGrant(afterDays(ctx, 0), createGrant(k.GetAuthority()))
+	require.NoError(t, err)
+
+	// a third is vested after 10 days, the rest goes back to the community pool
+	sdkCtx := afterDays(ctx, 10)
+	distrMock.EXPECT().FundCommunityPool(
+		sdkCtx,
+		sdk.NewCoins(sdk.NewInt64Coin(types.DENOM, 200000000000)),
+		authtypes.NewModuleAddress(types.ModuleName),
+	)
+
+	clawedBack, err := server.ClawbackGrant(sdkCtx, types.NewMsgClawbackGrant(k.GetAuthority(), res.PositionId))
+	require.NoError(t, err)
+	require.Equal(t, "200000000000", clawedBack.AmountReturned)
+
+	// the grantee keeps what vested
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 100000000000)
+	released, err := server.Release(sdkCtx, &types.MsgRelease{Creator: test.Alice, PositionId: res.PositionId})
+	require.NoError(t, err)
+	require.Equal(t, "100000000000", released.AmountToVest)
+}
+
+func TestShouldOnlyClawbackGrants(t *testing.T) {
+	server, ctx, k, ctrl, bankMock, _ := setup_grants(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	// a revocable position funded by an account is not a grant
+	bankMock.ExpectEscrowCoins(afterDays(ctx, 0), test.Carol, 300000000000)
+	funded, err := server.CreateVestingPosition(afterDays(ctx, 0), createPosition(true))
+	require.NoError(t, err)
+
+	for _, positionId := range []uint64{1, funded.PositionId} {
+		_, err := server.ClawbackGrant(afterDays(ctx, 10), types.NewMsgClawbackGrant(k.GetAuthority(), positionId))
+		require.ErrorIs(t, err, types.ErrNotAGrant)
+	}
+
+	_, err = server.ClawbackGrant(afterDays(ctx, 10), types.NewMsgClawbackGrant(test.Carol, 1))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+}
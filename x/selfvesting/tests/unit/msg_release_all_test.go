@@ -0,0 +1,105 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/selfvesting/tests"
+	"selfchain/x/selfvesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReleaseAllVestedPositions(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	// Half of both positions of Alice is vested and sent at once
+	sdkCtx := afterDays(ctx, 15)
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 150000000000)
+
+	res, err := server.ReleaseAll(sdkCtx, &types.MsgReleaseAll{Creator: test.Alice})
+	require.NoError(t, err)
+	require.Equal(t, "150000000000", res.AmountToVest)
+	require.Equal(t, "0", res.FeeDeducted)
+	require.Equal(t, []types.PositionRelease{
+		{PositionId: 1, PeriodToVest: 15 * SECONDS_IN_DAY, AmountToVest: "50000000000", FeeDeducted: "0"},
+		{PositionId: 2, PeriodToVest: 15 * SECONDS_IN_DAY, AmountToVest: "100000000000", FeeDeducted: "0"},
+	}, res.Releases)
+
+	position, _ := k.GetVestingPosition(sdkCtx, 2)
+	require.Equal(t, "100000000000", position.TotalClaimed)
+
+	// The position of Bob is left untouched
+	position, _ = k.GetVestingPosition(sdkCtx, 3)
+	require.Equal(t, "0", position.TotalClaimed)
+}
+
+func TestShouldSkipPositionsWithNothingVested(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	// Alice gets a third position whose cliff is a week away
+	_, positionId, err := k.AddBeneficiary(afterDays(ctx, 30), types.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    2592000,
+		Amount:      "300000000000",
+	})
+	require.NoError(t, err)
+
+	sdkCtx := afterDays(ctx, 30)
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 300000000000)
+
+	res, err := server.ReleaseAll(sdkCtx, &types.MsgReleaseAll{Creator: test.Alice})
+	require.NoError(t, err)
+	require.Equal(t, "300000000000", res.AmountToVest)
+	require.Len(t, res.Releases, 2)
+
+	// The fully claimed positions are pruned and the one before its cliff is still there
+	vestingPositions, _ := k.GetVestingPositions(sdkCtx, test.Alice)
+	require.Len(t, vestingPositions.VestingInfos, 1)
+	require.Equal(t, positionId, vestingPositions.VestingInfos[0].Id)
+
+	// Releasing again succeeds without releasing anything
+	res, err = server.ReleaseAll(sdkCtx, &types.MsgReleaseAll{Creator: test.Alice})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.AmountToVest)
+	require.Empty(t, res.Releases)
+}
+
+func TestShouldFailReleaseAllWithoutPositions(t *testing.T) {
+	server, ctx, k, ctrl, _ := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	_, err := server.ReleaseAll(ctx, &types.MsgReleaseAll{Creator: test.Carol})
+	require.ErrorIs(t, err, types.ErrNoVestingPositions)
+}
+
+func TestShouldPayReleaseAllFeeOutOfReleasedTokens(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	releaseAll := mockFeeTx{fee: uslf(60000000000), msgs: []sdk.Msg{&types.MsgReleaseAll{Creator: test.Alice}}}
+
+	// Nothing can be released before the cliff
+	err := payFeeOutOfRelease(t, afterDays(ctx, 1), k, releaseAll, false)
+	require.NoError(t, err)
+
+	// The fee is charged to the positions in order
+	sdkCtx := afterDays(ctx, 15)
+	bankMock.ExpectPayFee(sdkCtx, test.Alice, 60000000000)
+	err = payFeeOutOfRelease(t, sdkCtx, k, releaseAll, false)
+	require.NoError(t, err)
+
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 150000000000-60000000000)
+	res, err := server.ReleaseAll(sdkCtx, &types.MsgReleaseAll{Creator: test.Alice})
+	require.NoError(t, err)
+	require.Equal(t, "60000000000", res.FeeDeducted)
+	require.Equal(t, "50000000000", res.Releases[0].FeeDeducted)
+	require.Equal(t, "10000000000", res.Releases[1].FeeDeducted)
+}
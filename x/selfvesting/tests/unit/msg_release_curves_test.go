@@ -0,0 +1,154 @@
+package test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	test "selfchain/x/selfvesting/tests"
+	"selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReleaseAtOnceAtTheCliff(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	_, positionId, err := k.AddBeneficiary(afterDays(ctx, 0), types.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       604800,
+		Duration:    2592000,
+		Amount:      "100000000000",
+		Curve:       types.VestingCurve_VESTING_CURVE_CLIFF,
+	})
+	require.NoError(t, err)
+
+	// Nothing is released before the cliff
+	_, err = server.Release(afterDays(ctx, 6), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.ErrorIs(t, err, types.ErrCliffViolation)
+
+	sdkCtx := afterDays(ctx, 7)
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 100000000000)
+
+	res, err := server.Release(sdkCtx, &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+	require.Equal(t, "100000000000", res.AmountToVest)
+
+	_, found := k.GetVestingPosition(sdkCtx, positionId)
+	require.False(t, found)
+}
+
+func TestShouldReleaseMonthlyTranches(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	// 90 days vesting unlocking every 30 days
+	_, positionId, err := k.AddBeneficiary(afterDays(ctx, 0), types.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Duration:    90 * SECONDS_IN_DAY,
+		Amount:      "300000000000",
+		Curve:       types.VestingCurve_VESTING_CURVE_PERIODIC,
+		StepPeriod:  30 * SECONDS_IN_DAY,
+	})
+	require.NoError(t, err)
+
+	res, err := server.Release(afterDays(ctx, 29), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.AmountToVest)
+
+	sdkCtx := afterDays(ctx, 59)
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 100000000000)
+
+	res, err = server.Release(sdkCtx, &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+	require.Equal(t, "100000000000", res.AmountToVest)
+}
+
+func TestShouldRejectIncompleteCurves(t *testing.T) {
+	_, ctx, k, ctrl, _ := setup_release(t)
+	defer ctrl.Finish()
+
+	_, _, err := k.AddBeneficiary(afterDays(ctx, 0), types.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Amount:      "100",
+		Curve:       types.VestingCurve_VESTING_CURVE_PIECEWISE,
+		Periods:     []types.VestingPeriod{{Length: 10, Amount: "60"}},
+	})
+	require.Error(t, err)
+}
+
+// randomCurveRequest returns a position of a random curve
+func randomCurveRequest(r *rand.Rand) types.AddBeneficiaryRequest {
+	amount := uint64(r.Int63n(1000000000000) + 1)
+	req := types.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Cliff:       uint64(r.Int63n(30 * SECONDS_IN_DAY)),
+		Duration:    uint64(r.Int63n(70*SECONDS_IN_DAY) + 1),
+		Amount:      sdkmath.NewUint(amount).String(),
+		Curve:       types.VestingCurve(r.Intn(len(types.VestingCurve_name))),
+	}
+
+	switch req.Curve {
+	case types.VestingCurve_VESTING_CURVE_PERIODIC:
+		req.StepPeriod = uint64(r.Int63n(int64(req.Duration)) + 1)
+	case types.VestingCurve_VESTING_CURVE_PIECEWISE:
+		remaining := amount
+		for i := 0; remaining > 0 && i < types.MaxVestingPeriods; i++ {
+			periodAmount := uint64(r.Int63n(int64(remaining))) + 1
+			if i == types.MaxVestingPeriods-1 {
+				periodAmount = remaining
+			}
+			remaining -= periodAmount
+			req.Periods = append(req.Periods, types.VestingPeriod{
+				Length: uint64(r.Int63n(7*SECONDS_IN_DAY) + 1),
+				Amount: sdkmath.NewUint(periodAmount).String(),
+			})
+		}
+	}
+
+	return req
+}
+
+func TestReleasedNeverExceedsAmount(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 200; i++ {
+		server, ctx, k, ctrl, bankMock := setup_release(t)
+		bankMock.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+		req := randomCurveRequest(r)
+		position, positionId, err := k.AddBeneficiary(afterDays(ctx, 0), req)
+		require.NoError(t, err)
+		amount := sdkmath.NewUintFromString(req.Amount)
+
+		// release at random times until the position is fully vested
+		released := sdkmath.ZeroUint()
+		vestingEnd := int64(position.VestingEnd())
+		for now := int64(0); now < vestingEnd; now += r.Int63n(10*SECONDS_IN_DAY) + 1 {
+			sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Unix(now, 0))
+			res, err := server.Release(sdkCtx, &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+			if err != nil {
+				require.True(t, errors.Is(err, types.ErrCliffViolation) || errors.Is(err, types.ErrPositionFullyClaimed), err)
+				continue
+			}
+
+			released = released.Add(sdkmath.NewUintFromString(res.AmountToVest))
+			require.True(t, released.LTE(amount), "curve %s released %s out of %s", req.Curve, released, amount)
+			require.True(t, released.LTE(position.VestedAmountAt(uint64(now))), "curve %s released more than vested", req.Curve)
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Unix(vestingEnd, 0))
+		res, err := server.Release(sdkCtx, &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+		if err == nil {
+			released = released.Add(sdkmath.NewUintFromString(res.AmountToVest))
+		}
+		require.Equal(t, amount, released, "curve %s", req.Curve)
+
+		ctrl.Finish()
+	}
+}
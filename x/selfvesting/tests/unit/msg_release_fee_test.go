@@ -0,0 +1,278 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"selfchain/x/selfvesting/ante"
+	"selfchain/x/selfvesting/keeper"
+	test "selfchain/x/selfvesting/tests"
+	mocktest "selfchain/x/selfvesting/tests/mock"
+	"selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+type mockFeeTx struct {
+	msgs    []sdk.Msg
+	fee     sdk.Coins
+	granter string
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx mockFeeTx) GetMsgsV2() ([]protov2.Message, error) {
+	return nil, nil
+}
+
+func (tx mockFeeTx) GetGas() uint64 {
+	return 200000
+}
+
+func (tx mockFeeTx) GetFee() sdk.Coins {
+	return tx.fee
+}
+
+func (tx mockFeeTx) FeePayer() []byte {
+	return tx.msgs[0].(sdk.LegacyMsg).GetSigners()[0]
+}
+
+func (tx mockFeeTx) FeeGranter() []byte {
+	if tx.granter == "" {
+		return nil
+	}
+	return sdk.MustAccAddressFromBech32(tx.granter)
+}
+
+func releaseTx(fee sdk.Coins, positionIds ...uint64) mockFeeTx {
+	tx := mockFeeTx{fee: fee}
+	for _, positionId := range positionIds {
+		tx.msgs = append(tx.msgs, &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	}
+
+	return tx
+}
+
+func uslf(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(types.DENOM, sdkmath.NewInt(amount)))
+}
+
+// deductFeeRecorder stands for the SDK fee decorator and records whether it has been called
+type deductFeeRecorder struct {
+	called *bool
+}
+
+func (d deductFeeRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.called = true
+	return next(ctx, tx, simulate)
+}
+
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+// payFeeOutOfRelease runs the release fee decorator on a transaction, the fee being always deducted by the
+// wrapped fee decorator whether or not it has been paid out of the released tokens
+func payFeeOutOfRelease(t *testing.T, ctx sdk.Context, k keeper.Keeper, tx sdk.Tx, simulate bool) error {
+	deducted := false
+	decorator := ante.NewReleaseFeeDecorator(k, deductFeeRecorder{called: &deducted})
+	_, err := decorator.AnteHandle(ctx, tx, simulate, nextAnteHandler)
+	require.True(t, err != nil || deducted)
+
+	return err
+}
+
+// mockAccountKeeper provides the SDK fee decorator with the accounts of any fee payer
+type mockAccountKeeper struct {
+	authante.AccountKeeper
+}
+
+func (mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+// feeBankKeeper has the SDK fee decorator deduct the fees with the bank mock
+type feeBankKeeper struct {
+	authtypes.BankKeeper
+	bankMock *mocktest.MockBankKeeper
+}
+
+func (b feeBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.bankMock.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// deductReleaseFee runs the release fee decorator wrapping the SDK fee decorator with its default fee checker
+func deductReleaseFee(ctx sdk.Context, k keeper.Keeper, bankMock *mocktest.MockBankKeeper, tx sdk.Tx) (sdk.Context, error) {
+	deductFee := authante.NewDeductFeeDecorator(mockAccountKeeper{}, feeBankKeeper{bankMock: bankMock}, nil, nil)
+	return ante.NewReleaseFeeDecorator(k, deductFee).AnteHandle(ctx, tx, false, nextAnteHandler)
+}
+
+// afterDays moves the block time to a number of days after the start of the positions
+func afterDays(ctx context.Context, days int64) sdk.Context {
+	return sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Unix(days*SECONDS_IN_DAY, 0))
+}
+
+func TestShouldPayReleaseFeeOutOfReleasedTokens(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	sdkCtx := afterDays(ctx, 15)
+
+	// The fee is sent to the beneficiary, which pays it through the SDK fee decorator
+	gomock.InOrder(
+		bankMock.ExpectPayFee(sdkCtx, test.Alice, 5000),
+		bankMock.ExpectDeductFee(sdkCtx, test.Alice, 5000),
+	)
+	_, err := deductReleaseFee(sdkCtx, k, bankMock, releaseTx(uslf(5000), 1))
+	require.NoError(t, err)
+
+	position, _ := k.GetVestingPosition(sdkCtx, 1)
+	require.Equal(t, "5000", position.PendingFee)
+
+	// The fee is paid back out of the release
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 50000000000-5000)
+	res, err := server.Release(sdkCtx, &types.MsgRelease{Creator: test.Alice, PositionId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "50000000000", res.AmountToVest)
+	require.Equal(t, "5000", res.FeeDeducted)
+
+	position, _ = k.GetVestingPosition(sdkCtx, 1)
+	require.Equal(t, "50000000000", position.TotalClaimed)
+	require.Equal(t, "0", position.PendingFee)
+}
+
+func TestShouldChargeReleaseFeeAcrossPositions(t *testing.T) {
+	_, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	sdkCtx := afterDays(ctx, 15)
+
+	// Releasing the first position twice doesn't release more than once
+	err := payFeeOutOfRelease(t, sdkCtx, k, releaseTx(uslf(60000000000), 1, 1), false)
+	require.NoError(t, err)
+	position, _ := k.GetVestingPosition(sdkCtx, 1)
+	require.Empty(t, position.PendingFee)
+
+	bankMock.ExpectPayFee(sdkCtx, test.Alice, 60000000000)
+	err = payFeeOutOfRelease(t, sdkCtx, k, releaseTx(uslf(60000000000), 1, 2), false)
+	require.NoError(t, err)
+
+	position, _ = k.GetVestingPosition(sdkCtx, 1)
+	require.Equal(t, "50000000000", position.PendingFee)
+	position, _ = k.GetVestingPosition(sdkCtx, 2)
+	require.Equal(t, "10000000000", position.PendingFee)
+
+	// The first position owes all it can release so far
+	err = payFeeOutOfRelease(t, sdkCtx, k, releaseTx(uslf(1), 1), false)
+	require.NoError(t, err)
+	position, _ = k.GetVestingPosition(sdkCtx, 1)
+	require.Equal(t, "50000000000", position.PendingFee)
+}
+
+func TestShouldLetSignerPayFeeNotCoveredByRelease(t *testing.T) {
+	_, ctx, k, ctrl, _ := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	// Nothing can be released before the cliff
+	err := payFeeOutOfRelease(t, afterDays(ctx, 1), k, releaseTx(uslf(5000), 1), false)
+	require.NoError(t, err)
+
+	sdkCtx := afterDays(ctx, 15)
+
+	err = payFeeOutOfRelease(t, sdkCtx, k, releaseTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 5000)), 1), false)
+	require.NoError(t, err)
+
+	granted := releaseTx(uslf(5000), 1)
+	granted.granter = test.Carol
+	err = payFeeOutOfRelease(t, sdkCtx, k, granted, false)
+	require.NoError(t, err)
+
+	mixed := releaseTx(uslf(5000), 1)
+	mixed.msgs = append(mixed.msgs, &types.MsgRelease{Creator: test.Bob, PositionId: 3})
+	err = payFeeOutOfRelease(t, sdkCtx, k, mixed, false)
+	require.NoError(t, err)
+
+	// Positions of other beneficiaries can't pay the fee
+	err = payFeeOutOfRelease(t, sdkCtx, k, releaseTx(uslf(5000), 3), false)
+	require.NoError(t, err)
+
+	position, _ := k.GetVestingPosition(sdkCtx, 1)
+	require.Empty(t, position.PendingFee)
+	position, _ = k.GetVestingPosition(sdkCtx, 3)
+	require.Empty(t, position.PendingFee)
+}
+
+func TestShouldCheckReleaseFeeWithSDKFeeChecker(t *testing.T) {
+	_, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	sdkCtx := afterDays(ctx, 15).
+		WithIsCheckTx(true).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.DENOM, sdkmath.LegacyNewDecWithPrec(1, 1))))
+
+	bankMock.ExpectPayFee(sdkCtx, test.Alice, 5000)
+	_, err := deductReleaseFee(sdkCtx, k, bankMock, releaseTx(uslf(5000), 1))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// The priority is the gas price of the fee
+	gomock.InOrder(
+		bankMock.ExpectPayFee(sdkCtx, test.Alice, 400000),
+		bankMock.ExpectDeductFee(sdkCtx, test.Alice, 400000),
+	)
+	newCtx, err := deductReleaseFee(sdkCtx, k, bankMock, releaseTx(uslf(400000), 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), newCtx.Priority())
+}
+
+func TestShouldSimulateReleaseWithoutFee(t *testing.T) {
+	_, ctx, k, ctrl, _ := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	// Gas estimation runs without a fee and the signer doesn't need any balance
+	sdkCtx := afterDays(ctx, 15)
+	err := payFeeOutOfRelease(t, sdkCtx, k, releaseTx(nil, 1), true)
+	require.NoError(t, err)
+
+	position, _ := k.GetVestingPosition(sdkCtx, 1)
+	require.Empty(t, position.PendingFee)
+}
+
+func TestShouldCountPendingFeeAsClaimedOnClawback(t *testing.T) {
+	_, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	sdkCtx := afterDays(ctx, 15)
+
+	bankMock.ExpectPayFee(sdkCtx, test.Alice, 5000)
+	err := payFeeOutOfRelease(t, sdkCtx, k, releaseTx(uslf(5000), 1), false)
+	require.NoError(t, err)
+
+	clawedBack, claimed, err := k.ClawbackPosition(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewUint(100000000000-5000), clawedBack)
+	require.Equal(t, sdkmath.NewUint(5000), claimed)
+
+	// The capped position is fully claimed and pruned
+	_, found := k.GetVestingPosition(sdkCtx, 1)
+	require.False(t, found)
+}
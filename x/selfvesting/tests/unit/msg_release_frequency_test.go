@@ -0,0 +1,109 @@
+package test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	test "selfchain/x/selfvesting/tests"
+	"selfchain/x/selfvesting/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzReleaseDoesNotDependOnFrequency releases a position many times and an identical one once. Both
+// must have released the same amount, which is what vested to date.
+func FuzzReleaseDoesNotDependOnFrequency(f *testing.F) {
+	// fuzzing runs the target alone, without the test that sets up the address prefixes
+	if sdk.GetConfig().GetBech32AccountAddrPrefix() != "self" {
+		test.InitSDKConfig()
+	}
+
+	f.Add(uint64(100000000000), uint64(2592000), uint64(604800), int64(1), uint8(30))
+	f.Add(uint64(999999999999), uint64(7777777), uint64(0), int64(2), uint8(200))
+	f.Add(uint64(7), uint64(1000), uint64(10), int64(3), uint8(100))
+	f.Add(uint64(1), uint64(1), uint64(0), int64(4), uint8(5))
+
+	f.Fuzz(func(t *testing.T, amount uint64, duration uint64, cliff uint64, seed int64, releases uint8) {
+		if amount == 0 || duration == 0 || duration > 100*365*SECONDS_IN_DAY || cliff > duration {
+			t.Skip()
+		}
+
+		server, ctx, k, ctrl, bankMock := setup_release(t)
+		defer ctrl.Finish()
+		bankMock.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+		req := types.AddBeneficiaryRequest{
+			Cliff:    cliff,
+			Duration: duration,
+			Amount:   sdkmath.NewUint(amount).String(),
+		}
+		req.Beneficiary = test.Alice
+		_, frequentId, err := k.AddBeneficiary(afterDays(ctx, 0), req)
+		require.NoError(t, err)
+		req.Beneficiary = test.Bob
+		_, onceId, err := k.AddBeneficiary(afterDays(ctx, 0), req)
+		require.NoError(t, err)
+
+		// release the first position at random times before the end
+		r := rand.New(rand.NewSource(seed))
+		end := r.Int63n(int64(duration) + 1)
+		times := make([]int64, releases)
+		for i := range times {
+			times[i] = r.Int63n(end + 1)
+		}
+		sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+		released := sdkmath.ZeroUint()
+		for _, now := range append(times, end) {
+			sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Unix(now, 0))
+			res, err := server.Release(sdkCtx, &types.MsgRelease{Creator: test.Alice, PositionId: frequentId})
+			if err == nil {
+				released = released.Add(sdkmath.NewUintFromString(res.AmountToVest))
+			}
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Unix(end, 0))
+		releasedOnce := sdkmath.ZeroUint()
+		res, err := server.Release(sdkCtx, &types.MsgRelease{Creator: test.Bob, PositionId: onceId})
+		if err == nil {
+			releasedOnce = sdkmath.NewUintFromString(res.AmountToVest)
+		}
+
+		vested := types.VestingInfo{Cliff: cliff, Duration: duration, Amount: req.Amount}.VestedAmountAt(uint64(end))
+		require.Equal(t, releasedOnce, released)
+		require.Equal(t, vested, released)
+	})
+}
+
+func TestShouldReleaseRoundingDriftOfEarlierReleases(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	_, positionId, err := k.AddBeneficiary(afterDays(ctx, 0), types.AddBeneficiaryRequest{
+		Beneficiary: test.Alice,
+		Duration:    6,
+		Amount:      "100",
+	})
+	require.NoError(t, err)
+
+	// releasing the position every second for 3 seconds used to truncate each release to 16 out of 16.66
+	sdkCtx := afterDays(ctx, 0)
+	position, _ := k.GetVestingPosition(sdkCtx, positionId)
+	position.TotalClaimed = "48"
+	position.PeriodClaimed = 3
+	k.SetVestingPosition(sdkCtx, position)
+
+	// the 50 vested after 3 seconds are now fully released
+	sdkCtx = sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Unix(3, 0))
+	bankMock.ExpectReceiveCoins(sdkCtx, test.Alice, 2)
+
+	res, err := server.Release(sdkCtx, &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+	require.Equal(t, "2", res.AmountToVest)
+	require.Equal(t, uint64(0), res.PeriodToVest)
+}
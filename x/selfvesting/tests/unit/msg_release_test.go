@@ -24,7 +24,7 @@ const (
 func setup_release(t testing.TB) (types.MsgServer, context.Context, keeper.Keeper, *gomock.Controller, *mocktest.MockBankKeeper) {
 	ctrl := gomock.NewController(t)
 	bankMock := mocktest.NewMockBankKeeper(ctrl)
-	k, ctx := keepertest.SelfvestingKeeperWithMocks(t, bankMock)
+	k, ctx := keepertest.SelfvestingKeeperWithMocks(t, bankMock, nil)
 
 	// setup genesis params for this module
 	genesis := *types.DefaultGenesis()
@@ -74,8 +74,7 @@ func TestShouldReleaseLinearly(t *testing.T) {
 	defer ctrl.Finish()
 
 	sdkContext := sdk.UnwrapSDKContext(ctx)
-	vestingPositions, _ := keeper.GetVestingPositions(sdkContext, test.Alice)
-	vestingInfo := vestingPositions.VestingInfos[0]
+	vestingInfo, _ := keeper.GetVestingPosition(sdkContext, 1)
 
 	// move 15 days (i.e. half of the vesting duration) into the future
 	moveTo := time.Unix(0, int64(vestingInfo.StartTime)+(NANO_SECONDS_IN_SECONDS*SECONDS_IN_DAY*15))
@@ -84,15 +83,14 @@ func TestShouldReleaseLinearly(t *testing.T) {
 	bankMock.ExpectReceiveCoins(ctx_1, test.Alice, 50000000000)
 
 	server.Release(ctx_1, &types.MsgRelease{
-		Creator:  test.Alice,
-		PosIndex: 0,
+		Creator:    test.Alice,
+		PositionId: 1,
 	})
 
-	vestingPositions_1, _ := keeper.GetVestingPositions(ctx_1, test.Alice)
-	vestingInfo_1 := vestingPositions_1.VestingInfos[0]
+	vestingInfo_1, found := keeper.GetVestingPosition(ctx_1, 1)
 
-	require.Equal(t, vestingPositions_1.Beneficiary, test.Alice)
-	require.Equal(t, len(vestingPositions_1.VestingInfos), 2)
+	require.True(t, found)
+	require.Equal(t, vestingInfo_1.Beneficiary, test.Alice)
 	require.Equal(t, vestingInfo_1.StartTime, uint64(0))
 	require.Equal(t, vestingInfo_1.Cliff, uint64(0+604800))
 	require.Equal(t, vestingInfo_1.Duration, uint64(2592000))
@@ -105,27 +103,25 @@ func TestShouldReleaseLinearly(t *testing.T) {
 	ctx_2 := sdkContext.WithBlockTime(moveTo_2)
 
 	bankMock.ExpectReceiveCoins(ctx_2, test.Alice, 50000000000)
-	server.Release(ctx_2, &types.MsgRelease{
-		Creator:  test.Alice,
-		PosIndex: 0,
+	res, _ := server.Release(ctx_2, &types.MsgRelease{
+		Creator:    test.Alice,
+		PositionId: 1,
 	})
 
-	vestingPositions_2, _ := keeper.GetVestingPositions(ctx_2, test.Alice)
-	vestingInfo_2 := vestingPositions_2.VestingInfos[0]
+	require.Equal(t, res.AmountToVest, "50000000000")
+
+	// The fully claimed position is pruned and the other position of Alice remains
+	_, found = keeper.GetVestingPosition(ctx_2, 1)
+	require.False(t, found)
 
-	require.Equal(t, vestingPositions_2.Beneficiary, test.Alice)
-	require.Equal(t, len(vestingPositions_2.VestingInfos), 2)
-	require.Equal(t, vestingInfo_2.StartTime, uint64(0))
-	require.Equal(t, vestingInfo_2.Cliff, uint64(0+604800))
-	require.Equal(t, vestingInfo_2.Duration, uint64(2592000))
-	require.Equal(t, vestingInfo_2.Amount, "100000000000")
-	require.Equal(t, vestingInfo_2.TotalClaimed, "100000000000")
-	require.Equal(t, vestingInfo_2.PeriodClaimed, uint64(2592000))
+	vestingPositions_2, _ := keeper.GetVestingPositions(ctx_2, test.Alice)
+	require.Equal(t, len(vestingPositions_2.VestingInfos), 1)
+	require.Equal(t, vestingPositions_2.VestingInfos[0].Id, uint64(2))
 
 	// Additional calls will cause a failure since the full amount was released
 	_, releaseError := server.Release(ctx_2, &types.MsgRelease{
-		Creator:  test.Alice,
-		PosIndex: 0,
+		Creator:    test.Alice,
+		PositionId: 1,
 	})
 
 	require.ErrorIs(t, releaseError, types.ErrPositionFullyClaimed)
@@ -135,15 +131,14 @@ func TestShouldReleaseLinearly(t *testing.T) {
 	ctx_3 := sdkContext.WithBlockTime(moveTo_3)
 	bankMock.ExpectReceiveCoins(ctx_3, test.Alice, 50000000000)
 	server.Release(ctx_3, &types.MsgRelease{
-		Creator:  test.Alice,
-		PosIndex: 1,
+		Creator:    test.Alice,
+		PositionId: 2,
 	})
 
-	vestingPositions_3, _ := keeper.GetVestingPositions(ctx_3, test.Alice)
-	vestingInfo_3 := vestingPositions_3.VestingInfos[1]
+	vestingInfo_3, found := keeper.GetVestingPosition(ctx_3, 2)
 
-	require.Equal(t, vestingPositions_3.Beneficiary, test.Alice)
-	require.Equal(t, len(vestingPositions_3.VestingInfos), 2)
+	require.True(t, found)
+	require.Equal(t, vestingInfo_3.Beneficiary, test.Alice)
 	require.Equal(t, vestingInfo_3.StartTime, uint64(0))
 	require.Equal(t, vestingInfo_3.Cliff, uint64(0+604800))
 	require.Equal(t, vestingInfo_3.Duration, uint64(2592000))
@@ -154,36 +149,28 @@ func TestShouldReleaseLinearly(t *testing.T) {
 	// Alice can release the entire amount after the end of the vesting period
 	ctx_4 := sdkContext.WithBlockTime(moveTo_2)
 	bankMock.ExpectReceiveCoins(ctx_4, test.Alice, 150000000000)
-	server.Release(ctx_4, &types.MsgRelease{
-		Creator:  test.Alice,
-		PosIndex: 1,
+	res, _ = server.Release(ctx_4, &types.MsgRelease{
+		Creator:    test.Alice,
+		PositionId: 2,
 	})
 
-	vestingPositions_4, _ := keeper.GetVestingPositions(ctx_4, test.Alice)
-	vestingInfo_4 := vestingPositions_4.VestingInfos[1]
+	require.Equal(t, res.AmountToVest, "150000000000")
 
-	require.Equal(t, vestingPositions_4.Beneficiary, test.Alice)
-	require.Equal(t, len(vestingPositions_4.VestingInfos), 2)
-	require.Equal(t, vestingInfo_4.StartTime, uint64(0))
-	require.Equal(t, vestingInfo_4.Cliff, uint64(0+604800))
-	require.Equal(t, vestingInfo_4.Duration, uint64(2592000))
-	require.Equal(t, vestingInfo_4.Amount, "200000000000")
-	require.Equal(t, vestingInfo_4.TotalClaimed, "200000000000")
-	require.Equal(t, vestingInfo_4.PeriodClaimed, uint64(2592000))
+	_, found = keeper.GetVestingPositions(ctx_4, test.Alice)
+	require.False(t, found)
 
 	// Bob should also be able to release in parallel with Alice. Here he starts at 1/4 of the total vesting duration
 	ctx_5 := sdkContext.WithBlockTime(moveTo_3)
 	bankMock.ExpectReceiveCoins(ctx_5, test.Bob, 125000000000)
 	server.Release(ctx_5, &types.MsgRelease{
-		Creator:  test.Bob,
-		PosIndex: 0,
+		Creator:    test.Bob,
+		PositionId: 3,
 	})
 
-	vestingPositions_5, _ := keeper.GetVestingPositions(ctx_5, test.Bob)
-	vestingInfo_5 := vestingPositions_5.VestingInfos[0]
+	vestingInfo_5, found := keeper.GetVestingPosition(ctx_5, 3)
 
-	require.Equal(t, vestingPositions_5.Beneficiary, test.Bob)
-	require.Equal(t, len(vestingPositions_5.VestingInfos), 1)
+	require.True(t, found)
+	require.Equal(t, vestingInfo_5.Beneficiary, test.Bob)
 	require.Equal(t, vestingInfo_5.StartTime, uint64(0))
 	require.Equal(t, vestingInfo_5.Cliff, uint64(0+604800))
 	require.Equal(t, vestingInfo_5.Duration, uint64(2592000))
@@ -194,48 +181,41 @@ func TestShouldReleaseLinearly(t *testing.T) {
 	// Finally Bob can claim the full amount after the end of the vesting period
 	ctx_6 := sdkContext.WithBlockTime(moveTo_2)
 	bankMock.ExpectReceiveCoins(ctx_6, test.Bob, 375000000000)
-	server.Release(ctx_6, &types.MsgRelease{
-		Creator:  test.Bob,
-		PosIndex: 0,
+	res, _ = server.Release(ctx_6, &types.MsgRelease{
+		Creator:    test.Bob,
+		PositionId: 3,
 	})
 
-	vestingPositions_6, _ := keeper.GetVestingPositions(ctx_6, test.Bob)
-	vestingInfo_6 := vestingPositions_6.VestingInfos[0]
-
-	require.Equal(t, vestingPositions_6.Beneficiary, test.Bob)
-	require.Equal(t, len(vestingPositions_6.VestingInfos), 1)
-	require.Equal(t, vestingInfo_6.StartTime, uint64(0))
-	require.Equal(t, vestingInfo_6.Cliff, uint64(0+604800))
-	require.Equal(t, vestingInfo_6.Duration, uint64(2592000))
-	require.Equal(t, vestingInfo_6.Amount, "500000000000")
-	require.Equal(t, vestingInfo_6.TotalClaimed, "500000000000")
-	require.Equal(t, vestingInfo_6.PeriodClaimed, uint64(2592000))
+	require.Equal(t, res.AmountToVest, "375000000000")
+
+	_, found = keeper.GetVestingPositions(ctx_6, test.Bob)
+	require.False(t, found)
 }
 
-func TestShouldFailIfNoVestingPositionExistForAccount(t *testing.T) {
+func TestShouldFailIfPositionBelongsToAnotherAccount(t *testing.T) {
 	server, ctx, keeper, ctrl, _ := setup_release(t)
 	setup_positions(t, ctx, keeper)
 	defer ctrl.Finish()
 
 	_, releaseError := server.Release(ctx, &types.MsgRelease{
-		Creator:  test.Carol,
-		PosIndex: 0,
+		Creator:    test.Carol,
+		PositionId: 1,
 	})
 
-	require.ErrorIs(t, releaseError, types.ErrNoVestingPositions)
+	require.ErrorIs(t, releaseError, types.ErrPositionNotFound)
 }
 
-func TestShouldFailIfPosIndexOutofBounds(t *testing.T) {
+func TestShouldFailIfPositionDoesNotExist(t *testing.T) {
 	server, ctx, keeper, ctrl, _ := setup_release(t)
 	setup_positions(t, ctx, keeper)
 	defer ctrl.Finish()
 
 	_, releaseError := server.Release(ctx, &types.MsgRelease{
-		Creator:  test.Alice,
-		PosIndex: 2,
+		Creator:    test.Alice,
+		PositionId: 4,
 	})
 
-	require.ErrorIs(t, releaseError, types.ErrPositionIndexOutOfBounds)
+	require.ErrorIs(t, releaseError, types.ErrPositionNotFound)
 }
 
 func TestShouldFailIfCliffNotReached(t *testing.T) {
@@ -248,8 +228,8 @@ func TestShouldFailIfCliffNotReached(t *testing.T) {
 	defer ctrl.Finish()
 
 	_, releaseError := server.Release(sdkContext, &types.MsgRelease{
-		Creator:  test.Alice,
-		PosIndex: 1,
+		Creator:    test.Alice,
+		PositionId: 2,
 	})
 
 	require.ErrorIs(t, releaseError, types.ErrCliffViolation)
@@ -0,0 +1,173 @@
+package test
+
+import (
+	"testing"
+
+	test "selfchain/x/selfvesting/tests"
+	"selfchain/x/selfvesting/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldSplitPositionToAnotherAccount(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 10), test.Alice, 100000000000)
+	_, err := server.Release(afterDays(ctx, 10), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+
+	// Bob gets 25.92 SLF of the position together with their share of what Alice claimed
+	res, err := server.SplitPosition(afterDays(ctx, 10), types.NewMsgSplitPosition(test.Alice, positionId, "25920000000", test.Bob))
+	require.NoError(t, err)
+
+	carved, _ := k.GetVestingPosition(afterDays(ctx, 10), res.PositionId)
+	require.Equal(t, test.Bob, carved.Beneficiary)
+	require.Equal(t, "25920000000", carved.Amount)
+	require.Equal(t, "8640000000", carved.TotalClaimed)
+
+	remaining, _ := k.GetVestingPosition(afterDays(ctx, 10), positionId)
+	require.Equal(t, "274080000000", remaining.Amount)
+	require.Equal(t, "91360000000", remaining.TotalClaimed)
+
+	// together they release what is left of the position
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 30), test.Alice, 182720000000)
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 30), test.Bob, 17280000000)
+	_, err = server.Release(afterDays(ctx, 30), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+	_, err = server.Release(afterDays(ctx, 30), &types.MsgRelease{Creator: test.Bob, PositionId: res.PositionId})
+	require.NoError(t, err)
+}
+
+func TestShouldLimitPositionsSplitToAnotherAccount(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 10), test.Alice, 100000000000)
+	_, err := server.Release(afterDays(ctx, 10), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+
+	// Bob would get 17.28 SLF left to claim
+	k.SetParams(afterDays(ctx, 10), types.NewParams("17280000001", types.DefaultMaxPositionsPerBeneficiary))
+	_, err = server.SplitPosition(afterDays(ctx, 10), types.NewMsgSplitPosition(test.Alice, positionId, "25920000000", test.Bob))
+	require.ErrorIs(t, err, types.ErrPositionTooSmall)
+
+	position, _ := k.GetVestingPosition(afterDays(ctx, 10), positionId)
+	require.Equal(t, "300000000000", position.Amount)
+	require.Empty(t, beneficiaryPositionIds(ctx, k, test.Bob))
+
+	// Alice can keep the carved position
+	_, err = server.SplitPosition(afterDays(ctx, 10), types.NewMsgSplitPosition(test.Alice, positionId, "25920000000", ""))
+	require.NoError(t, err)
+}
+
+func TestShouldNotSplitPositionInexactly(t *testing.T) {
+	server, ctx, _, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	// neither 100 SLF nor 200 SLF vest whole tokens every second over 30 days
+	_, err := server.SplitPosition(afterDays(ctx, 10), types.NewMsgSplitPosition(test.Alice, positionId, "100000000000", ""))
+	require.ErrorIs(t, err, types.ErrInexactSchedule)
+
+	_, err = server.SplitPosition(afterDays(ctx, 10), types.NewMsgSplitPosition(test.Bob, positionId, "25920000000", ""))
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+}
+
+func TestShouldMergePositionsWithTheSameSchedule(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	msg := createPosition(false)
+	msg.Amount = "25920000000"
+	bankMock.ExpectEscrowCoins(afterDays(ctx, 0), test.Carol, 25920000000)
+	other, err := server.CreateVestingPosition(afterDays(ctx, 0), msg)
+	require.NoError(t, err)
+
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 10), test.Alice, 8640000000)
+	_, err = server.Release(afterDays(ctx, 10), &types.MsgRelease{Creator: test.Alice, PositionId: other.PositionId})
+	require.NoError(t, err)
+
+	res, err := server.MergePositions(afterDays(ctx, 10), types.NewMsgMergePositions(test.Alice, []uint64{positionId, other.PositionId}))
+	require.NoError(t, err)
+	require.Equal(t, positionId, res.PositionId)
+	require.Equal(t, []uint64{positionId}, beneficiaryPositionIds(ctx, k, test.Alice))
+
+	merged, _ := k.GetVestingPosition(afterDays(ctx, 10), positionId)
+	require.Equal(t, "325920000000", merged.Amount)
+	require.Equal(t, "8640000000", merged.TotalClaimed)
+
+	// the merged position releases what both positions would have
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 10), test.Alice, 100000000000)
+	released, err := server.Release(afterDays(ctx, 10), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+	require.Equal(t, "100000000000", released.AmountToVest)
+
+	_, err = server.Release(afterDays(ctx, 10), &types.MsgRelease{Creator: test.Alice, PositionId: other.PositionId})
+	require.ErrorIs(t, err, types.ErrPositionFullyClaimed)
+}
+
+func TestShouldOnlyMergeCompatiblePositions(t *testing.T) {
+	server, ctx, _, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	// a position with another cliff
+	msg := createPosition(false)
+	msg.Amount = "25920000000"
+	msg.Cliff = 0
+	bankMock.ExpectEscrowCoins(afterDays(ctx, 0), test.Carol, 25920000000)
+	other, err := server.CreateVestingPosition(afterDays(ctx, 0), msg)
+	require.NoError(t, err)
+
+	_, err = server.MergePositions(afterDays(ctx, 1), types.NewMsgMergePositions(test.Alice, []uint64{positionId, other.PositionId}))
+	require.ErrorIs(t, err, types.ErrIncompatiblePositions)
+
+	// two positions that don't vest whole tokens every second
+	second := fundPosition(t, server, ctx, bankMock, false)
+	_, err = server.MergePositions(afterDays(ctx, 1), types.NewMsgMergePositions(test.Alice, []uint64{positionId, second}))
+	require.ErrorIs(t, err, types.ErrInexactSchedule)
+
+	_, err = server.MergePositions(afterDays(ctx, 1), types.NewMsgMergePositions(test.Bob, []uint64{positionId, second}))
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+}
+
+func TestShouldNotSplitMigratedPositions(t *testing.T) {
+	server, ctx, k, ctrl, _ := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	positionId := beneficiaryPositionIds(ctx, k, test.Alice)[0]
+	for _, recipient := range []string{"", test.Bob} {
+		_, err := server.SplitPosition(afterDays(ctx, 1), types.NewMsgSplitPosition(test.Alice, positionId, "25920000000", recipient))
+		require.ErrorIs(t, err, types.ErrMigratedPosition)
+	}
+
+	position, _ := k.GetVestingPosition(afterDays(ctx, 1), positionId)
+	require.Equal(t, "100000000000", position.Amount)
+}
+
+func TestShouldNotMergeMigratedPositions(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	migrated := beneficiaryPositionIds(ctx, k, test.Alice)
+	funded := fundPosition(t, server, ctx, bankMock, false)
+
+	// neither into a funded position nor the other way round
+	for _, positionIds := range [][]uint64{migrated, {funded, migrated[0]}, {migrated[0], funded}} {
+		_, err := server.MergePositions(afterDays(ctx, 1), types.NewMsgMergePositions(test.Alice, positionIds))
+		require.ErrorIs(t, err, types.ErrMigratedPosition)
+	}
+
+	require.Equal(t, append(migrated, funded), beneficiaryPositionIds(ctx, k, test.Alice))
+}
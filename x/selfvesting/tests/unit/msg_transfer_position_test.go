@@ -0,0 +1,211 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"selfchain/x/selfvesting/keeper"
+	test "selfchain/x/selfvesting/tests"
+	mocktest "selfchain/x/selfvesting/tests/mock"
+	"selfchain/x/selfvesting/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fundPosition has Carol fund a position of Alice and returns its id
+func fundPosition(t *testing.T, server types.MsgServer, ctx context.Context, bankMock *mocktest.MockBankKeeper, revocable bool) uint64 {
+	bankMock.ExpectEscrowCoins(afterDays(ctx, 0), test.Carol, 300000000000)
+	res, err := server.CreateVestingPosition(afterDays(ctx, 0), createPosition(revocable))
+	require.NoError(t, err)
+
+	return res.PositionId
+}
+
+func beneficiaryPositionIds(ctx context.Context, k keeper.Keeper, beneficiary string) []uint64 {
+	vestingPositions, _ := k.GetVestingPositions(afterDays(ctx, 0), beneficiary)
+
+	ids := []uint64{}
+	for _, vestingInfo := range vestingPositions.VestingInfos {
+		ids = append(ids, vestingInfo.Id)
+	}
+	return ids
+}
+
+func TestShouldTransferPositionWithItsClaimedState(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	// Alice releases the first third before moving the position to Bob
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 10), test.Alice, 100000000000)
+	_, err := server.Release(afterDays(ctx, 10), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.NoError(t, err)
+
+	res, err := server.TransferPosition(afterDays(ctx, 10), types.NewMsgTransferPosition(test.Alice, positionId, test.Bob, false))
+	require.NoError(t, err)
+	require.False(t, res.Pending)
+
+	position, _ := k.GetVestingPosition(afterDays(ctx, 10), positionId)
+	require.Equal(t, test.Bob, position.Beneficiary)
+	require.Equal(t, "100000000000", position.TotalClaimed)
+	require.Empty(t, beneficiaryPositionIds(ctx, k, test.Alice))
+	require.Equal(t, []uint64{positionId}, beneficiaryPositionIds(ctx, k, test.Bob))
+
+	_, err = server.Release(afterDays(ctx, 20), &types.MsgRelease{Creator: test.Alice, PositionId: positionId})
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+
+	// Bob only gets what vested since Alice's release
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 20), test.Bob, 100000000000)
+	released, err := server.Release(afterDays(ctx, 20), &types.MsgRelease{Creator: test.Bob, PositionId: positionId})
+	require.NoError(t, err)
+	require.Equal(t, "100000000000", released.AmountToVest)
+}
+
+func TestShouldWaitForRecipientToAcceptTransfer(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	res, err := server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Alice, positionId, test.Bob, true))
+	require.NoError(t, err)
+	require.True(t, res.Pending)
+	require.Equal(t, []uint64{positionId}, beneficiaryPositionIds(ctx, k, test.Alice))
+
+	_, err = server.AcceptPosition(afterDays(ctx, 1), types.NewMsgAcceptPosition(test.Carol, positionId))
+	require.ErrorIs(t, err, types.ErrNoPendingTransfer)
+
+	_, err = server.AcceptPosition(afterDays(ctx, 1), types.NewMsgAcceptPosition(test.Bob, positionId))
+	require.NoError(t, err)
+	require.Empty(t, beneficiaryPositionIds(ctx, k, test.Alice))
+	require.Equal(t, []uint64{positionId}, beneficiaryPositionIds(ctx, k, test.Bob))
+
+	_, found := k.GetPendingTransfer(afterDays(ctx, 1), positionId)
+	require.False(t, found)
+
+	// the offer can't be accepted twice
+	_, err = server.AcceptPosition(afterDays(ctx, 1), types.NewMsgAcceptPosition(test.Bob, positionId))
+	require.ErrorIs(t, err, types.ErrNoPendingTransfer)
+}
+
+func TestShouldCancelPendingTransfer(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	_, err := server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Alice, positionId, test.Bob, true))
+	require.NoError(t, err)
+
+	_, err = server.CancelTransfer(afterDays(ctx, 1), types.NewMsgCancelTransfer(test.Carol, positionId))
+	require.ErrorIs(t, err, types.ErrNoPendingTransfer)
+
+	// Bob declines the position
+	_, err = server.CancelTransfer(afterDays(ctx, 1), types.NewMsgCancelTransfer(test.Bob, positionId))
+	require.NoError(t, err)
+
+	_, err = server.AcceptPosition(afterDays(ctx, 1), types.NewMsgAcceptPosition(test.Bob, positionId))
+	require.ErrorIs(t, err, types.ErrNoPendingTransfer)
+	require.Equal(t, []uint64{positionId}, beneficiaryPositionIds(ctx, k, test.Alice))
+}
+
+func TestShouldOnlyTransferOwnPositions(t *testing.T) {
+	server, ctx, _, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	_, err := server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Bob, positionId, test.Carol, false))
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+}
+
+func TestShouldDropPendingTransferOfPrunedPosition(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, true)
+
+	_, err := server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Alice, positionId, test.Bob, true))
+	require.NoError(t, err)
+
+	// revoking before the cliff prunes the position
+	bankMock.ExpectReceiveCoins(afterDays(ctx, 1), test.Carol, 300000000000)
+	_, err = server.RevokePosition(afterDays(ctx, 1), types.NewMsgRevokePosition(test.Carol, positionId))
+	require.NoError(t, err)
+
+	_, found := k.GetPendingTransfer(afterDays(ctx, 1), positionId)
+	require.False(t, found)
+}
+
+func TestShouldClawbackTransferredPosition(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+
+	_, err := server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Alice, positionId, test.Bob, false))
+	require.NoError(t, err)
+
+	clawedBack, claimed, err := k.ClawbackPosition(afterDays(ctx, 1), positionId)
+	require.NoError(t, err)
+	require.Equal(t, "300000000000", clawedBack.String())
+	require.Equal(t, "0", claimed.String())
+	require.Empty(t, beneficiaryPositionIds(ctx, k, test.Bob))
+}
+
+func TestShouldOnlyTransferDustPositionsOnAcceptance(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	positionId := fundPosition(t, server, ctx, bankMock, false)
+	k.SetParams(afterDays(ctx, 0), types.NewParams("300000000001", types.DefaultMaxPositionsPerBeneficiary))
+
+	_, err := server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Alice, positionId, test.Bob, false))
+	require.ErrorIs(t, err, types.ErrPositionTooSmall)
+
+	// Bob can still take the position by accepting it
+	_, err = server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Alice, positionId, test.Bob, true))
+	require.NoError(t, err)
+	_, err = server.AcceptPosition(afterDays(ctx, 1), types.NewMsgAcceptPosition(test.Bob, positionId))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{positionId}, beneficiaryPositionIds(ctx, k, test.Bob))
+}
+
+func TestShouldCapPositionsTransferredWithoutAcceptance(t *testing.T) {
+	server, ctx, k, ctrl, bankMock := setup_release(t)
+	defer ctrl.Finish()
+
+	first := fundPosition(t, server, ctx, bankMock, false)
+	second := fundPosition(t, server, ctx, bankMock, false)
+	k.SetParams(afterDays(ctx, 0), types.NewParams(types.DefaultMinPositionAmount, 1))
+
+	_, err := server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Alice, first, test.Bob, false))
+	require.NoError(t, err)
+
+	_, err = server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Alice, second, test.Bob, false))
+	require.ErrorIs(t, err, types.ErrTooManyPositions)
+
+	_, err = server.TransferPosition(afterDays(ctx, 1), types.NewMsgTransferPosition(test.Alice, second, test.Bob, true))
+	require.NoError(t, err)
+	_, err = server.AcceptPosition(afterDays(ctx, 1), types.NewMsgAcceptPosition(test.Bob, second))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{first, second}, beneficiaryPositionIds(ctx, k, test.Bob))
+}
+
+func TestShouldNotTransferMigratedPositions(t *testing.T) {
+	server, ctx, k, ctrl, _ := setup_release(t)
+	setup_positions(t, ctx, k)
+	defer ctrl.Finish()
+
+	// governance could no longer claw the position back from the account the migration went to
+	positionId := beneficiaryPositionIds(ctx, k, test.Alice)[0]
+	for _, requireAcceptance := range []bool{false, true} {
+		_, err := server.TransferPosition(afterDays(ctx, 10), types.NewMsgTransferPosition(test.Alice, positionId, test.Bob, requireAcceptance))
+		require.ErrorIs(t, err, types.ErrMigratedPosition)
+	}
+
+	_, err := server.AcceptPosition(afterDays(ctx, 10), types.NewMsgAcceptPosition(test.Bob, positionId))
+	require.ErrorIs(t, err, types.ErrNoPendingTransfer)
+	require.Equal(t, []uint64{positionId, positionId + 1}, beneficiaryPositionIds(ctx, k, test.Alice))
+}
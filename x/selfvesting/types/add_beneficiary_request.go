@@ -5,4 +5,10 @@ type AddBeneficiaryRequest struct {
 	Cliff       uint64
 	Duration    uint64
 	Amount      string
+	LockupTier  uint64
+
+	// curve of the position, linear when unset
+	Curve      VestingCurve
+	StepPeriod uint64
+	Periods    []VestingPeriod
 }
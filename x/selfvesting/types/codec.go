@@ -9,12 +9,36 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRelease{}, "selfvesting/Release", nil)
+	cdc.RegisterConcrete(&MsgReleaseAll{}, "selfvesting/ReleaseAll", nil)
+	cdc.RegisterConcrete(&MsgCreateVestingPosition{}, "selfvesting/CreateVestingPosition", nil)
+	cdc.RegisterConcrete(&MsgRevokePosition{}, "selfvesting/RevokePosition", nil)
+	cdc.RegisterConcrete(&MsgCreateGrant{}, "selfvesting/CreateGrant", nil)
+	cdc.RegisterConcrete(&MsgClawbackGrant{}, "selfvesting/ClawbackGrant", nil)
+	cdc.RegisterConcrete(&MsgTransferPosition{}, "selfvesting/TransferPosition", nil)
+	cdc.RegisterConcrete(&MsgAcceptPosition{}, "selfvesting/AcceptPosition", nil)
+	cdc.RegisterConcrete(&MsgCancelTransfer{}, "selfvesting/CancelTransfer", nil)
+	cdc.RegisterConcrete(&MsgSplitPosition{}, "selfvesting/SplitPosition", nil)
+	cdc.RegisterConcrete(&MsgMergePositions{}, "selfvesting/MergePositions", nil)
+	cdc.RegisterConcrete(&MsgSetEarlyUnlockConfig{}, "selfvesting/SetEarlyUnlockConfig", nil)
+	cdc.RegisterConcrete(&MsgEarlyUnlock{}, "selfvesting/EarlyUnlock", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRelease{},
+		&MsgReleaseAll{},
+		&MsgCreateVestingPosition{},
+		&MsgRevokePosition{},
+		&MsgCreateGrant{},
+		&MsgClawbackGrant{},
+		&MsgTransferPosition{},
+		&MsgAcceptPosition{},
+		&MsgCancelTransfer{},
+		&MsgSplitPosition{},
+		&MsgMergePositions{},
+		&MsgSetEarlyUnlockConfig{},
+		&MsgEarlyUnlock{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -1,3 +1,18 @@
 package types
 
 const DENOM = "uslf"
+
+const (
+	// DefaultUnlockScheduleInterval is the number of seconds between two points of an unlock schedule
+	DefaultUnlockScheduleInterval uint64 = 86400
+	// DefaultUnlockScheduleLimit is the number of points of an unlock schedule when no limit is requested
+	DefaultUnlockScheduleLimit uint64 = 30
+	// MaxUnlockScheduleLimit is the maximum number of points of an unlock schedule
+	MaxUnlockScheduleLimit uint64 = 1000
+)
+
+// MaxVestingPeriods is the maximum number of periods of a piecewise vesting
+const MaxVestingPeriods = 100
+
+// MaxPenaltyBasisPoints is the early unlock penalty taking the whole unlocked amount
+const MaxPenaltyBasisPoints uint64 = 10000
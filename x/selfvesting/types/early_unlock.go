@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the penalties are basis points that don't grow over time
+func (c EarlyUnlockConfig) Validate() error {
+	if c.StartPenaltyBasisPoints > MaxPenaltyBasisPoints || c.EndPenaltyBasisPoints > MaxPenaltyBasisPoints {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "penalty cannot exceed %d basis points", MaxPenaltyBasisPoints)
+	}
+
+	if c.EndPenaltyBasisPoints > c.StartPenaltyBasisPoints {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "penalty cannot grow toward the end of the vesting")
+	}
+
+	return nil
+}
+
+// PenaltyBasisPointsAt returns the penalty of unlocking the position early at the given unix time. It declines
+// linearly from the start to the end of the vesting of the position.
+func (c EarlyUnlockConfig) PenaltyBasisPointsAt(v VestingInfo, now uint64) uint64 {
+	start, end := v.StartTime, v.VestingEnd()
+	if now >= end {
+		return c.EndPenaltyBasisPoints
+	}
+	if now <= start {
+		return c.StartPenaltyBasisPoints
+	}
+
+	decline := sdkmath.NewUint(c.StartPenaltyBasisPoints - c.EndPenaltyBasisPoints).MulUint64(now - start).QuoUint64(end - start)
+	return c.StartPenaltyBasisPoints - decline.Uint64()
+}
+
+// Penalty returns the penalty taken from an unlocked amount
+func (c EarlyUnlockConfig) Penalty(unlocked sdkmath.Uint, basisPoints uint64) sdkmath.Uint {
+	return unlocked.MulUint64(basisPoints).QuoUint64(MaxPenaltyBasisPoints)
+}
@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: selfchain/selfvesting/early_unlock.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EarlyUnlockConfig lets beneficiaries unlock the unvested part of their positions before it vests, minus a
+// penalty. The penalty declines linearly from the start to the end of the vesting of the position. Early
+// unlocks are disabled until it is set.
+type EarlyUnlockConfig struct {
+	// penalty in basis points of the unlocked amount at the start of the vesting
+	StartPenaltyBasisPoints uint64 `protobuf:"varint,1,opt,name=startPenaltyBasisPoints,proto3" json:"startPenaltyBasisPoints,omitempty"`
+	// penalty in basis points of the unlocked amount at the end of the vesting
+	EndPenaltyBasisPoints uint64 `protobuf:"varint,2,opt,name=endPenaltyBasisPoints,proto3" json:"endPenaltyBasisPoints,omitempty"`
+	// whether penalties are burned rather than sent to the community pool
+	BurnPenalty bool `protobuf:"varint,3,opt,name=burnPenalty,proto3" json:"burnPenalty,omitempty"`
+}
+
+func (m *EarlyUnlockConfig) Reset()         { *m = EarlyUnlockConfig{} }
+func (m *EarlyUnlockConfig) String() string { return proto.CompactTextString(m) }
+func (*EarlyUnlockConfig) ProtoMessage()    {}
+func (*EarlyUnlockConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3408d334d1e1e821, []int{0}
+}
+func (m *EarlyUnlockConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EarlyUnlockConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EarlyUnlockConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EarlyUnlockConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarlyUnlockConfig.Merge(m, src)
+}
+func (m *EarlyUnlockConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *EarlyUnlockConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarlyUnlockConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EarlyUnlockConfig proto.InternalMessageInfo
+
+func (m *EarlyUnlockConfig) GetStartPenaltyBasisPoints() uint64 {
+	if m != nil {
+		return m.StartPenaltyBasisPoints
+	}
+	return 0
+}
+
+func (m *EarlyUnlockConfig) GetEndPenaltyBasisPoints() uint64 {
+	if m != nil {
+		return m.EndPenaltyBasisPoints
+	}
+	return 0
+}
+
+func (m *EarlyUnlockConfig) GetBurnPenalty() bool {
+	if m != nil {
+		return m.BurnPenalty
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*EarlyUnlockConfig)(nil), "selfchain.selfvesting.EarlyUnlockConfig")
+}
+
+func init() {
+	proto.RegisterFile("selfchain/selfvesting/early_unlock.proto", fileDescriptor_3408d334d1e1e821)
+}
+
+var fileDescriptor_3408d334d1e1e821 = []byte{
+	// 200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0x4e, 0xcd, 0x49,
+	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0xca, 0x52, 0x8b, 0x4b, 0x32, 0xf3, 0xd2, 0xf5,
+	0x53, 0x13, 0x8b, 0x72, 0x2a, 0xe3, 0x4b, 0xf3, 0x72, 0xf2, 0x93, 0xb3, 0xf5, 0x0a, 0x8a, 0xf2,
+	0x4b, 0xf2, 0x85, 0x44, 0xe1, 0x2a, 0xf5, 0x90, 0x54, 0x2a, 0x2d, 0x65, 0xe4, 0x12, 0x74, 0x05,
+	0xa9, 0x0e, 0x05, 0x2b, 0x76, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x17, 0xb2, 0xe0, 0x12, 0x2f, 0x2e,
+	0x49, 0x2c, 0x2a, 0x09, 0x48, 0xcd, 0x4b, 0xcc, 0x29, 0xa9, 0x74, 0x4a, 0x2c, 0xce, 0x2c, 0x0e,
+	0xc8, 0xcf, 0xcc, 0x2b, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0xc2, 0x25, 0x2d, 0x64,
+	0xc2, 0x25, 0x9a, 0x9a, 0x97, 0x82, 0x45, 0x1f, 0x13, 0x58, 0x1f, 0x76, 0x49, 0x21, 0x05, 0x2e,
+	0xee, 0xa4, 0xd2, 0xa2, 0x3c, 0xa8, 0x8c, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0x47, 0x10, 0xb2, 0x90,
+	0x93, 0xf9, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
+	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xc9, 0x22, 0x82, 0xa0,
+	0x02, 0x25, 0x10, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xde, 0x37, 0x06, 0x0c, 0x00,
+	0xc2, 0xe9, 0x97, 0x20, 0x2a, 0x01, 0x00, 0x00,
+}
+
+func (m *EarlyUnlockConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarlyUnlockConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarlyUnlockConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnPenalty {
+		i--
+		if m.BurnPenalty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndPenaltyBasisPoints != 0 {
+		i = encodeVarintEarlyUnlock(dAtA, i, uint64(m.EndPenaltyBasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartPenaltyBasisPoints != 0 {
+		i = encodeVarintEarlyUnlock(dAtA, i, uint64(m.StartPenaltyBasisPoints))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEarlyUnlock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEarlyUnlock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EarlyUnlockConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartPenaltyBasisPoints != 0 {
+		n += 1 + sovEarlyUnlock(uint64(m.StartPenaltyBasisPoints))
+	}
+	if m.EndPenaltyBasisPoints != 0 {
+		n += 1 + sovEarlyUnlock(uint64(m.EndPenaltyBasisPoints))
+	}
+	if m.BurnPenalty {
+		n += 2
+	}
+	return n
+}
+
+func sovEarlyUnlock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEarlyUnlock(x uint64) (n int) {
+	return sovEarlyUnlock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EarlyUnlockConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEarlyUnlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarlyUnlockConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarlyUnlockConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPenaltyBasisPoints", wireType)
+			}
+			m.StartPenaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEarlyUnlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPenaltyBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPenaltyBasisPoints", wireType)
+			}
+			m.EndPenaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEarlyUnlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndPenaltyBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnPenalty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEarlyUnlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnPenalty = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEarlyUnlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEarlyUnlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEarlyUnlock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEarlyUnlock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEarlyUnlock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEarlyUnlock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEarlyUnlock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEarlyUnlock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEarlyUnlock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEarlyUnlock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEarlyUnlock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEarlyUnlock = fmt.Errorf("proto: unexpected end of group")
+)
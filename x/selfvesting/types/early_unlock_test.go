@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEarlyUnlockConfig_Validate(t *testing.T) {
+	require.NoError(t, EarlyUnlockConfig{StartPenaltyBasisPoints: 5000, EndPenaltyBasisPoints: 1000}.Validate())
+	require.NoError(t, EarlyUnlockConfig{StartPenaltyBasisPoints: 10000, EndPenaltyBasisPoints: 10000}.Validate())
+
+	err := EarlyUnlockConfig{StartPenaltyBasisPoints: 10001}.Validate()
+	require.ErrorIs(t, err, errors.ErrInvalidRequest)
+
+	err = EarlyUnlockConfig{StartPenaltyBasisPoints: 1000, EndPenaltyBasisPoints: 5000}.Validate()
+	require.ErrorIs(t, err, errors.ErrInvalidRequest)
+}
+
+func TestEarlyUnlockConfig_PenaltyDeclinesToTheEndOfTheVesting(t *testing.T) {
+	config := EarlyUnlockConfig{StartPenaltyBasisPoints: 5000, EndPenaltyBasisPoints: 1000}
+	v := VestingInfo{StartTime: 100, Cliff: 200, Duration: 1000, Amount: "1000", TotalClaimed: "0"}
+
+	require.Equal(t, uint64(5000), config.PenaltyBasisPointsAt(v, 0))
+	require.Equal(t, uint64(5000), config.PenaltyBasisPointsAt(v, 100))
+	require.Equal(t, uint64(4000), config.PenaltyBasisPointsAt(v, 350))
+	require.Equal(t, uint64(3000), config.PenaltyBasisPointsAt(v, 600))
+	require.Equal(t, uint64(1000), config.PenaltyBasisPointsAt(v, 1100))
+	require.Equal(t, uint64(1000), config.PenaltyBasisPointsAt(v, 2000))
+
+	require.Equal(t, sdkmath.NewUint(300), config.Penalty(sdkmath.NewUint(1000), 3000))
+	require.Equal(t, sdkmath.NewUint(0), config.Penalty(sdkmath.NewUint(3), 3000))
+}
@@ -12,5 +12,18 @@ var (
 	ErrPositionIndexOutOfBounds = sdkerrors.Register(ModuleName, 1101, "Position index out of bounds")
 	ErrPositionFullyClaimed     = sdkerrors.Register(ModuleName, 1102, "Tokens fully claimed")
 	ErrCliffViolation           = sdkerrors.Register(ModuleName, 1103, "Cliff period violation")
+	ErrPositionNotFound         = sdkerrors.Register(ModuleName, 1104, "Vesting position not found")
+	ErrInvalidStartTime         = sdkerrors.Register(ModuleName, 1105, "Vesting cannot start in the past")
+	ErrPositionNotRevocable     = sdkerrors.Register(ModuleName, 1106, "Vesting position cannot be revoked by this account")
+	ErrInvalidSigner            = sdkerrors.Register(ModuleName, 1107, "Expected gov account as the only signer")
+	ErrNotAGrant                = sdkerrors.Register(ModuleName, 1108, "Vesting position is not a community pool grant")
+	ErrNoPendingTransfer        = sdkerrors.Register(ModuleName, 1109, "No transfer of the vesting position is pending for this account")
+	ErrIncompatiblePositions    = sdkerrors.Register(ModuleName, 1110, "Vesting positions have different schedules")
+	ErrInexactSchedule          = sdkerrors.Register(ModuleName, 1111, "Vesting positions would release different amounts once split or merged")
+	ErrEarlyUnlockDisabled      = sdkerrors.Register(ModuleName, 1112, "Early unlocks are disabled")
+	ErrEarlyUnlockNotAllowed    = sdkerrors.Register(ModuleName, 1113, "Revocable vesting positions cannot be unlocked early")
+	ErrPositionTooSmall         = sdkerrors.Register(ModuleName, 1114, "Vesting position is below the minimum amount")
+	ErrTooManyPositions         = sdkerrors.Register(ModuleName, 1115, "Beneficiary has too many vesting positions")
+	ErrMigratedPosition         = sdkerrors.Register(ModuleName, 1116, "Vesting positions opened by migrations cannot be transferred, split, merged or unlocked early")
 	ErrInvalidRequest = sdkerrors.Register(ModuleName, 2, "invalid request")
 )
@@ -0,0 +1,32 @@
+package types
+
+// selfvesting module event types
+const (
+	EventTypeCreateVestingPosition = "create_vesting_position"
+	EventTypeRevokePosition        = "revoke_position"
+	EventTypeCreateGrant           = "create_grant"
+	EventTypeClawbackGrant         = "clawback_grant"
+	EventTypeOfferPosition         = "offer_position"
+	EventTypeTransferPosition      = "transfer_position"
+	EventTypeCancelTransfer        = "cancel_transfer"
+	EventTypeSplitPosition         = "split_position"
+	EventTypeMergePositions        = "merge_positions"
+	EventTypeEarlyUnlock           = "early_unlock"
+	EventTypeRefundFeeAllowance    = "refund_fee_allowance"
+
+	AttributeKeyPositionId     = "position_id"
+	AttributeKeyBeneficiary    = "beneficiary"
+	AttributeKeyFunder         = "funder"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyAmountReturned = "amount_returned"
+	AttributeKeyFrom           = "from"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyNewPositionId  = "new_position_id"
+	AttributeKeyMergedIds      = "merged_position_ids"
+	AttributeKeyReleased       = "released"
+	AttributeKeyUnlocked       = "unlocked"
+	AttributeKeyPenalty        = "penalty"
+	AttributeKeyPenaltyBurned  = "penalty_burned"
+	AttributeKeyWithheld       = "withheld"
+	AttributeKeyRefunded       = "refunded"
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeAllowanceMsgs returns the messages whose fees can be paid by the allowances granted by the module
+func FeeAllowanceMsgs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgRelease{}),
+		sdk.MsgTypeURL(&MsgReleaseAll{}),
+	}
+}
+
+// Validate checks that the fee allowance withholds a valid amount for a valid beneficiary
+func (a FeeAllowance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Beneficiary); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+	}
+
+	if _, err := sdkmath.ParseUint(a.Withheld); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid withheld amount (%s)", err)
+	}
+
+	return nil
+}
@@ -10,7 +10,11 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		VestingPositionsList: []VestingPositions{},
+		VestingPositionsList:    []VestingPositions{},
+		VestingPositionList:     []VestingInfo{},
+		LegacyPositionIndexList: []LegacyPositionIndex{},
+		PendingTransferList:     []PendingTransfer{},
+		FeeAllowanceList:        []FeeAllowance{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -29,6 +33,64 @@ func (gs GenesisState) Validate() error {
 		}
 		vestingPositionsIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated ID in vestingPosition. Ids start at 1.
+	vestingPositionIdMap := make(map[uint64]bool)
+	vestingPositionCount := gs.GetVestingPositionCount()
+	for _, elem := range gs.VestingPositionList {
+		if _, ok := vestingPositionIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for vestingPosition")
+		}
+		if elem.Id == 0 || elem.Id > vestingPositionCount {
+			return fmt.Errorf("vestingPosition id should be lower or equal than the last id")
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid vestingPosition %d: %w", elem.Id, err)
+		}
+		vestingPositionIdMap[elem.Id] = true
+	}
+	// Check for duplicated index in legacyPositionIndex
+	legacyPositionIndexIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.LegacyPositionIndexList {
+		index := string(LegacyPositionIndexKey(elem.Beneficiary, elem.Index))
+		if _, ok := legacyPositionIndexIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for legacyPositionIndex")
+		}
+		legacyPositionIndexIndexMap[index] = struct{}{}
+	}
+	// Check that pending transfers are unique and offered by the beneficiary of their position
+	pendingTransferIdMap := make(map[uint64]bool)
+	vestingPositionBeneficiaries := make(map[uint64]string)
+	for _, elem := range gs.VestingPositionList {
+		vestingPositionBeneficiaries[elem.Id] = elem.Beneficiary
+	}
+	for _, elem := range gs.PendingTransferList {
+		if _, ok := pendingTransferIdMap[elem.PositionId]; ok {
+			return fmt.Errorf("duplicated position id for pendingTransfer")
+		}
+		if beneficiary, ok := vestingPositionBeneficiaries[elem.PositionId]; !ok || beneficiary != elem.From {
+			return fmt.Errorf("pendingTransfer of position %d is not offered by its beneficiary", elem.PositionId)
+		}
+		pendingTransferIdMap[elem.PositionId] = true
+	}
+	if gs.EarlyUnlockConfig != nil {
+		if err := gs.EarlyUnlockConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid earlyUnlockConfig: %w", err)
+		}
+	}
+	// Check for duplicated beneficiary in feeAllowance
+	feeAllowanceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FeeAllowanceList {
+		index := string(FeeAllowanceKey(elem.Beneficiary))
+		if _, ok := feeAllowanceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for feeAllowance")
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid feeAllowance of %s: %w", elem.Beneficiary, err)
+		}
+		feeAllowanceIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
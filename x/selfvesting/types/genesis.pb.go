@@ -25,8 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the selfvesting module's genesis state.
 type GenesisState struct {
-	Params               Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	VestingPositionsList []VestingPositions `protobuf:"bytes,2,rep,name=vestingPositionsList,proto3" json:"vestingPositionsList"`
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// positions in the layout used before they were stored under their own id. They are given new ids
+	// when imported.
+	VestingPositionsList    []VestingPositions    `protobuf:"bytes,2,rep,name=vestingPositionsList,proto3" json:"vestingPositionsList"`
+	VestingPositionList     []VestingInfo         `protobuf:"bytes,3,rep,name=vestingPositionList,proto3" json:"vestingPositionList"`
+	VestingPositionCount    uint64                `protobuf:"varint,4,opt,name=vestingPositionCount,proto3" json:"vestingPositionCount,omitempty"`
+	LegacyPositionIndexList []LegacyPositionIndex `protobuf:"bytes,5,rep,name=legacyPositionIndexList,proto3" json:"legacyPositionIndexList"`
+	PendingTransferList     []PendingTransfer     `protobuf:"bytes,6,rep,name=pendingTransferList,proto3" json:"pendingTransferList"`
+	EarlyUnlockConfig       *EarlyUnlockConfig    `protobuf:"bytes,7,opt,name=earlyUnlockConfig,proto3" json:"earlyUnlockConfig,omitempty"`
+	FeeAllowanceList        []FeeAllowance        `protobuf:"bytes,8,rep,name=feeAllowanceList,proto3" json:"feeAllowanceList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +84,48 @@ func (m *GenesisState) GetVestingPositionsList() []VestingPositions {
 	return nil
 }
 
+func (m *GenesisState) GetVestingPositionList() []VestingInfo {
+	if m != nil {
+		return m.VestingPositionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingPositionCount() uint64 {
+	if m != nil {
+		return m.VestingPositionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLegacyPositionIndexList() []LegacyPositionIndex {
+	if m != nil {
+		return m.LegacyPositionIndexList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingTransferList() []PendingTransfer {
+	if m != nil {
+		return m.PendingTransferList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEarlyUnlockConfig() *EarlyUnlockConfig {
+	if m != nil {
+		return m.EarlyUnlockConfig
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeAllowanceList() []FeeAllowance {
+	if m != nil {
+		return m.FeeAllowanceList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "selfchain.selfvesting.GenesisState")
 }
@@ -85,22 +135,34 @@ func init() {
 }
 
 var fileDescriptor_831cef2378296f8c = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4e, 0xcd, 0x49,
-	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0xca, 0x52, 0x8b, 0x4b, 0x32, 0xf3, 0xd2, 0xf5,
-	0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0xe1,
-	0x8a, 0xf4, 0x90, 0x14, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x55, 0xe8, 0x83, 0x58, 0x10,
-	0xc5, 0x52, 0x4a, 0xd8, 0x4d, 0x2c, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x1a, 0x28, 0xa5, 0x8b, 0x5d,
-	0x0d, 0x94, 0x8e, 0x2f, 0xc8, 0x2f, 0xce, 0x2c, 0xc9, 0xcc, 0xcf, 0x83, 0x2a, 0x57, 0x5a, 0xc7,
-	0xc8, 0xc5, 0xe3, 0x0e, 0x71, 0x51, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x35, 0x17, 0x1b, 0xc4,
-	0x3c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x59, 0x3d, 0xac, 0x2e, 0xd4, 0x0b, 0x00, 0x2b,
-	0x72, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x45, 0x28, 0x91, 0x4b, 0x04, 0x2a, 0x1f,
-	0x00, 0xb3, 0xc7, 0x27, 0xb3, 0xb8, 0x44, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x1d, 0x87,
-	0x51, 0x61, 0x68, 0x5a, 0xa0, 0x86, 0x62, 0x35, 0xca, 0xc9, 0xfc, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x64, 0x11, 0x3e, 0xaf, 0x40, 0xf1, 0x7b, 0x49, 0x65, 0x41, 0x6a,
-	0x71, 0x12, 0x1b, 0xd8, 0xc3, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0xe3, 0xbb, 0xc7,
-	0x97, 0x01, 0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x8e, 0xd2, 0x40,
+	0x18, 0xc7, 0x5b, 0x97, 0x45, 0x33, 0xeb, 0x41, 0x67, 0xd7, 0xd8, 0x90, 0x6c, 0x25, 0x90, 0x68,
+	0x35, 0xb1, 0x24, 0x78, 0xf0, 0xe0, 0x49, 0x88, 0x1a, 0x12, 0x0e, 0x04, 0x85, 0x03, 0x07, 0xc9,
+	0x58, 0xbe, 0xd6, 0xd1, 0x3a, 0xd3, 0x74, 0x06, 0x85, 0xb7, 0xf0, 0x75, 0x7c, 0x03, 0x8e, 0x1c,
+	0x3d, 0x19, 0x03, 0x2f, 0x62, 0x98, 0x4e, 0x5d, 0xa0, 0x53, 0x4e, 0x34, 0x33, 0xbf, 0xef, 0xf7,
+	0xfd, 0x67, 0xf8, 0x06, 0x35, 0x05, 0xc4, 0x61, 0xf0, 0x99, 0x50, 0xd6, 0xda, 0x7d, 0x7d, 0x07,
+	0x21, 0x29, 0x8b, 0x5a, 0x11, 0x30, 0x10, 0x54, 0xf8, 0x49, 0xca, 0x25, 0xc7, 0x0f, 0xfe, 0x43,
+	0xfe, 0x1e, 0x54, 0xbb, 0x8a, 0x78, 0xc4, 0x15, 0xd1, 0xda, 0x7d, 0x65, 0x70, 0xad, 0x61, 0x36,
+	0x26, 0x24, 0x25, 0xdf, 0xb4, 0xb0, 0xf6, 0xdc, 0xcc, 0xe8, 0xdf, 0x69, 0xc2, 0x05, 0x95, 0x94,
+	0xb3, 0x1c, 0xf7, 0x4e, 0xe3, 0x94, 0x85, 0xfc, 0x34, 0x09, 0x24, 0x8d, 0x97, 0xd3, 0x39, 0x8b,
+	0x79, 0xf0, 0x55, 0x93, 0x4f, 0xcd, 0x64, 0x08, 0x30, 0x25, 0x71, 0xcc, 0x7f, 0x10, 0x16, 0x40,
+	0x86, 0x36, 0x7e, 0x9d, 0xa3, 0xbb, 0xef, 0xb2, 0x0b, 0x79, 0x2f, 0x89, 0x04, 0xfc, 0x0a, 0x55,
+	0xb3, 0xe3, 0x38, 0x76, 0xdd, 0xf6, 0x2e, 0xda, 0xd7, 0xbe, 0xf1, 0x82, 0xfc, 0x81, 0x82, 0x3a,
+	0x95, 0xd5, 0x9f, 0x47, 0xd6, 0x50, 0x97, 0x60, 0x82, 0xae, 0xf4, 0xfe, 0x20, 0x3f, 0x66, 0x9f,
+	0x0a, 0xe9, 0xdc, 0xaa, 0x9f, 0x79, 0x17, 0xed, 0x27, 0x25, 0xaa, 0xf1, 0x51, 0x89, 0x96, 0x1a,
+	0x55, 0x78, 0x82, 0x2e, 0x8f, 0xd6, 0x55, 0x87, 0x33, 0xd5, 0xa1, 0x71, 0xba, 0x43, 0x8f, 0x85,
+	0x5c, 0xcb, 0x4d, 0x12, 0xdc, 0x2e, 0xc4, 0xef, 0xf2, 0x39, 0x93, 0x4e, 0xa5, 0x6e, 0x7b, 0x95,
+	0xa1, 0x71, 0x0f, 0x7f, 0x41, 0x0f, 0x63, 0x88, 0x48, 0xb0, 0xcc, 0x97, 0x7b, 0x6c, 0x06, 0x0b,
+	0x95, 0xe9, 0x5c, 0x65, 0x7a, 0x56, 0x92, 0xa9, 0x5f, 0xac, 0xd2, 0xd9, 0xca, 0x84, 0xf8, 0x23,
+	0xba, 0x4c, 0x80, 0xcd, 0x28, 0x8b, 0x3e, 0xa4, 0x84, 0x89, 0x10, 0x52, 0xd5, 0xa7, 0xaa, 0xfa,
+	0x3c, 0x2e, 0xfb, 0xa3, 0x0e, 0x2b, 0xf2, 0xf3, 0x1b, 0x44, 0x78, 0x8c, 0xee, 0xab, 0x69, 0x1a,
+	0xa9, 0x61, 0xea, 0x72, 0x16, 0xd2, 0xc8, 0xb9, 0xad, 0xc6, 0xc0, 0x2b, 0xb1, 0xbf, 0x39, 0xe6,
+	0x87, 0x45, 0x05, 0x1e, 0xa1, 0x7b, 0x21, 0xc0, 0xeb, 0x7c, 0xf4, 0x54, 0xe8, 0x3b, 0x2a, 0x74,
+	0xb3, 0x44, 0xfb, 0x76, 0x0f, 0xd7, 0x89, 0x0b, 0x8a, 0xce, 0xcb, 0xd5, 0xc6, 0xb5, 0xd7, 0x1b,
+	0xd7, 0xfe, 0xbb, 0x71, 0xed, 0x9f, 0x5b, 0xd7, 0x5a, 0x6f, 0x5d, 0xeb, 0xf7, 0xd6, 0xb5, 0x26,
+	0xd7, 0x37, 0x0f, 0x60, 0x71, 0xf0, 0x04, 0xe4, 0x32, 0x01, 0xf1, 0xa9, 0xaa, 0x66, 0xff, 0xc5,
+	0xbf, 0x01, 0x00, 0x7c, 0xcc, 0x9a, 0x64, 0x21, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +185,79 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeAllowanceList) > 0 {
+		for iNdEx := len(m.FeeAllowanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.EarlyUnlockConfig != nil {
+		{
+			size, err := m.EarlyUnlockConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PendingTransferList) > 0 {
+		for iNdEx := len(m.PendingTransferList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTransferList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.LegacyPositionIndexList) > 0 {
+		for iNdEx := len(m.LegacyPositionIndexList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LegacyPositionIndexList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.VestingPositionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VestingPositionCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.VestingPositionList) > 0 {
+		for iNdEx := len(m.VestingPositionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPositionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.VestingPositionsList) > 0 {
 		for iNdEx := len(m.VestingPositionsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -175,6 +310,37 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VestingPositionList) > 0 {
+		for _, e := range m.VestingPositionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.VestingPositionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.VestingPositionCount))
+	}
+	if len(m.LegacyPositionIndexList) > 0 {
+		for _, e := range m.LegacyPositionIndexList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTransferList) > 0 {
+		for _, e := range m.PendingTransferList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EarlyUnlockConfig != nil {
+		l = m.EarlyUnlockConfig.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FeeAllowanceList) > 0 {
+		for _, e := range m.FeeAllowanceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -280,6 +446,197 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPositionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPositionList = append(m.VestingPositionList, VestingInfo{})
+			if err := m.VestingPositionList[len(m.VestingPositionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPositionCount", wireType)
+			}
+			m.VestingPositionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingPositionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyPositionIndexList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyPositionIndexList = append(m.LegacyPositionIndexList, LegacyPositionIndex{})
+			if err := m.LegacyPositionIndexList[len(m.LegacyPositionIndexList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransferList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTransferList = append(m.PendingTransferList, PendingTransfer{})
+			if err := m.PendingTransferList[len(m.PendingTransferList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EarlyUnlockConfig == nil {
+				m.EarlyUnlockConfig = &EarlyUnlockConfig{}
+			}
+			if err := m.EarlyUnlockConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowanceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowanceList = append(m.FeeAllowanceList, FeeAllowance{})
+			if err := m.FeeAllowanceList[len(m.FeeAllowanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
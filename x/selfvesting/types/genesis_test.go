@@ -3,11 +3,14 @@ package types_test
 import (
 	"testing"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 	"selfchain/x/selfvesting/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	beneficiary := authtypes.NewModuleAddress("beneficiary").String()
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -21,6 +24,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 
 				VestingPositionsList: []types.VestingPositions{
 					{
@@ -30,6 +34,48 @@ func TestGenesisState_Validate(t *testing.T) {
 						Beneficiary: "1",
 					},
 				},
+				VestingPositionList: []types.VestingInfo{
+					{
+						Id:           1,
+						Beneficiary:  "0",
+						Amount:       "100",
+						TotalClaimed: "0",
+					},
+					{
+						Id:           2,
+						Amount:       "100",
+						TotalClaimed: "0",
+					},
+				},
+				VestingPositionCount: 2,
+				LegacyPositionIndexList: []types.LegacyPositionIndex{
+					{
+						Beneficiary: "0",
+						Index:       0,
+					},
+					{
+						Beneficiary: "0",
+						Index:       1,
+					},
+				},
+				PendingTransferList: []types.PendingTransfer{
+					{
+						PositionId: 1,
+						From:       "0",
+						Recipient:  "1",
+					},
+				},
+				EarlyUnlockConfig: &types.EarlyUnlockConfig{
+					StartPenaltyBasisPoints: 5000,
+					EndPenaltyBasisPoints:   1000,
+				},
+				FeeAllowanceList: []types.FeeAllowance{
+					{
+						Beneficiary: beneficiary,
+						Withheld:    "1000",
+						Expiration:  2000,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -48,6 +94,220 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated vestingPosition",
+			genState: &types.GenesisState{
+				VestingPositionList: []types.VestingInfo{
+					{
+						Id:           1,
+						Amount:       "100",
+						TotalClaimed: "0",
+					},
+					{
+						Id:           1,
+						Amount:       "100",
+						TotalClaimed: "0",
+					},
+				},
+				VestingPositionCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid vestingPosition count",
+			genState: &types.GenesisState{
+				VestingPositionList: []types.VestingInfo{
+					{
+						Id:           2,
+						Amount:       "100",
+						TotalClaimed: "0",
+					},
+				},
+				VestingPositionCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "zero vestingPosition id",
+			genState: &types.GenesisState{
+				VestingPositionList: []types.VestingInfo{
+					{
+						Id:           0,
+						Amount:       "100",
+						TotalClaimed: "0",
+					},
+				},
+				VestingPositionCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "valid piecewise vestingPosition",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				VestingPositionList: []types.VestingInfo{
+					{
+						Id:           1,
+						Amount:       "100",
+						TotalClaimed: "0",
+						Curve:        types.VestingCurve_VESTING_CURVE_PIECEWISE,
+						Periods: []types.VestingPeriod{
+							{Length: 10, Amount: "40"},
+							{Length: 20, Amount: "60"},
+						},
+					},
+				},
+				VestingPositionCount: 1,
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid vestingPosition curve",
+			genState: &types.GenesisState{
+				VestingPositionList: []types.VestingInfo{
+					{
+						Id:           1,
+						Amount:       "100",
+						TotalClaimed: "0",
+						Curve:        types.VestingCurve_VESTING_CURVE_PERIODIC,
+					},
+				},
+				VestingPositionCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated legacyPositionIndex",
+			genState: &types.GenesisState{
+				LegacyPositionIndexList: []types.LegacyPositionIndex{
+					{
+						Beneficiary: "0",
+						Index:       0,
+					},
+					{
+						Beneficiary: "0",
+						Index:       0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pendingTransfer",
+			genState: &types.GenesisState{
+				VestingPositionList: []types.VestingInfo{
+					{
+						Id:           1,
+						Beneficiary:  "0",
+						Amount:       "100",
+						TotalClaimed: "0",
+					},
+				},
+				VestingPositionCount: 1,
+				PendingTransferList: []types.PendingTransfer{
+					{
+						PositionId: 1,
+						From:       "0",
+						Recipient:  "1",
+					},
+					{
+						PositionId: 1,
+						From:       "0",
+						Recipient:  "1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pendingTransfer not offered by the beneficiary",
+			genState: &types.GenesisState{
+				VestingPositionList: []types.VestingInfo{
+					{
+						Id:           1,
+						Beneficiary:  "0",
+						Amount:       "100",
+						TotalClaimed: "0",
+					},
+				},
+				VestingPositionCount: 1,
+				PendingTransferList: []types.PendingTransfer{
+					{
+						PositionId: 1,
+						From:       "1",
+						Recipient:  "1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pendingTransfer of an unknown vestingPosition",
+			genState: &types.GenesisState{
+				VestingPositionList: []types.VestingInfo{
+					{
+						Id:           1,
+						Beneficiary:  "0",
+						Amount:       "100",
+						TotalClaimed: "0",
+					},
+				},
+				VestingPositionCount: 1,
+				PendingTransferList: []types.PendingTransfer{
+					{
+						PositionId: 2,
+						From:       "0",
+						Recipient:  "1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid earlyUnlockConfig",
+			genState: &types.GenesisState{
+				EarlyUnlockConfig: &types.EarlyUnlockConfig{
+					StartPenaltyBasisPoints: 1000,
+					EndPenaltyBasisPoints:   5000,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated feeAllowance",
+			genState: &types.GenesisState{
+				FeeAllowanceList: []types.FeeAllowance{
+					{
+						Beneficiary: beneficiary,
+						Withheld:    "1000",
+					},
+					{
+						Beneficiary: beneficiary,
+						Withheld:    "2000",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid feeAllowance",
+			genState: &types.GenesisState{
+				FeeAllowanceList: []types.FeeAllowance{
+					{
+						Beneficiary: beneficiary,
+						Withheld:    "-1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultMinPositionAmount, 0),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
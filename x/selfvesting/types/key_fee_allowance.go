@@ -0,0 +1,39 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// FeeAllowanceKeyPrefix is the prefix to retrieve the fee allowance of a beneficiary
+	FeeAllowanceKeyPrefix = "FeeAllowance/value/"
+
+	// FeeAllowanceQueueKeyPrefix is the prefix to retrieve the fee allowances by expiration
+	FeeAllowanceQueueKeyPrefix = "FeeAllowance/queue/"
+)
+
+// FeeAllowanceKey returns the store key to retrieve the fee allowance of a beneficiary
+func FeeAllowanceKey(
+	beneficiary string,
+) []byte {
+	var key []byte
+
+	beneficiaryBytes := []byte(beneficiary)
+	key = append(key, beneficiaryBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// FeeAllowanceQueueKey returns the store key of a fee allowance in the expiration queue
+func FeeAllowanceQueueKey(
+	expiration int64,
+	beneficiary string,
+) []byte {
+	var key []byte
+
+	expirationBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(expirationBytes, uint64(expiration))
+	key = append(key, expirationBytes...)
+	key = append(key, FeeAllowanceKey(beneficiary)...)
+
+	return key
+}
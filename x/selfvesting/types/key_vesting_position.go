@@ -0,0 +1,54 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// VestingPositionKey is the prefix to retrieve all VestingPosition
+	VestingPositionKey      = "VestingPosition/value/"
+	VestingPositionCountKey = "VestingPosition/count/"
+
+	// VestingPositionBeneficiaryKeyPrefix is the prefix to retrieve the ids of the positions of a beneficiary
+	VestingPositionBeneficiaryKeyPrefix = "VestingPosition/beneficiary/"
+
+	// LegacyPositionIndexKeyPrefix is the prefix to retrieve the id of a position from the index it had
+	// in the positions of its beneficiary before positions were stored under their own id
+	LegacyPositionIndexKeyPrefix = "VestingPosition/legacy/"
+
+	// PendingTransferKeyPrefix is the prefix to retrieve the transfer of a position waiting for its recipient
+	PendingTransferKeyPrefix = "VestingPosition/pendingTransfer/"
+
+	// EarlyUnlockConfigKey is the key to retrieve the EarlyUnlockConfig
+	EarlyUnlockConfigKey = "EarlyUnlockConfig/value/"
+)
+
+// VestingPositionBeneficiaryKey returns the store key prefix of the positions of a beneficiary
+func VestingPositionBeneficiaryKey(
+	beneficiary string,
+) []byte {
+	var key []byte
+
+	beneficiaryBytes := []byte(beneficiary)
+	key = append(key, beneficiaryBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// LegacyPositionIndexKey returns the store key to retrieve a LegacyPositionIndex from the index fields
+func LegacyPositionIndexKey(
+	beneficiary string,
+	index uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, VestingPositionBeneficiaryKey(beneficiary)...)
+
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -5,7 +5,8 @@ import "encoding/binary"
 var _ binary.ByteOrder
 
 const (
-	// VestingPositionsKeyPrefix is the prefix to retrieve all VestingPositions
+	// VestingPositionsKeyPrefix is the prefix to retrieve all VestingPositions. Positions were stored per
+	// beneficiary under this prefix before they were stored under their own id.
 	VestingPositionsKeyPrefix = "VestingPositions/value/"
 )
 
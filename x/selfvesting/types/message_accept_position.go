@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgAcceptPosition = "accept_position"
+
+var _ sdk.Msg = &MsgAcceptPosition{}
+
+func NewMsgAcceptPosition(creator string, positionId uint64) *MsgAcceptPosition {
+	return &MsgAcceptPosition{
+		Creator:    creator,
+		PositionId: positionId,
+	}
+}
+
+func (msg *MsgAcceptPosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptPosition) Type() string {
+	return TypeMsgAcceptPosition
+}
+
+func (msg *MsgAcceptPosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptPosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "invalid creator address (%s)", err)
+	}
+	return nil
+}
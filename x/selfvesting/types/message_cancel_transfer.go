@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgCancelTransfer = "cancel_transfer"
+
+var _ sdk.Msg = &MsgCancelTransfer{}
+
+func NewMsgCancelTransfer(creator string, positionId uint64) *MsgCancelTransfer {
+	return &MsgCancelTransfer{
+		Creator:    creator,
+		PositionId: positionId,
+	}
+}
+
+func (msg *MsgCancelTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelTransfer) Type() string {
+	return TypeMsgCancelTransfer
+}
+
+func (msg *MsgCancelTransfer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgClawbackGrant = "clawback_grant"
+
+var _ sdk.Msg = &MsgClawbackGrant{}
+
+func NewMsgClawbackGrant(authority string, positionId uint64) *MsgClawbackGrant {
+	return &MsgClawbackGrant{
+		Authority:  authority,
+		PositionId: positionId,
+	}
+}
+
+func (msg *MsgClawbackGrant) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClawbackGrant) Type() string {
+	return TypeMsgClawbackGrant
+}
+
+func (msg *MsgClawbackGrant) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgClawbackGrant) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClawbackGrant) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return nil
+}
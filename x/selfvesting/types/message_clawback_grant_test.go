@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgClawbackGrant_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgClawbackGrant
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgClawbackGrant{
+				Authority: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "valid authority",
+			msg: MsgClawbackGrant{
+				Authority: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateGrant = "create_grant"
+
+var _ sdk.Msg = &MsgCreateGrant{}
+
+func NewMsgCreateGrant(authority string, grantee string, amount string, cliff uint64, duration uint64, startTime uint64) *MsgCreateGrant {
+	return &MsgCreateGrant{
+		Authority: authority,
+		Grantee:   grantee,
+		Amount:    amount,
+		Cliff:     cliff,
+		Duration:  duration,
+		StartTime: startTime,
+	}
+}
+
+func (msg *MsgCreateGrant) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateGrant) Type() string {
+	return TypeMsgCreateGrant
+}
+
+func (msg *MsgCreateGrant) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgCreateGrant) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateGrant) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+
+	amount, err := sdkmath.ParseUint(msg.Amount)
+	if err != nil || amount.IsZero() {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "amount must be a positive integer")
+	}
+
+	return nil
+}
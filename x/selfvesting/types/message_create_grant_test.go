@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgCreateGrant_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateGrant
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgCreateGrant{
+				Authority: "invalid_address",
+				Grantee:   sample.AccAddress(),
+				Amount:    "100",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "invalid grantee",
+			msg: MsgCreateGrant{
+				Authority: sample.AccAddress(),
+				Grantee:   "invalid_address",
+				Amount:    "100",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgCreateGrant{
+				Authority: sample.AccAddress(),
+				Grantee:   sample.AccAddress(),
+				Amount:    "0",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid grant",
+			msg: MsgCreateGrant{
+				Authority: sample.AccAddress(),
+				Grantee:   sample.AccAddress(),
+				Amount:    "100",
+				Duration:  10,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateVestingPosition = "create_vesting_position"
+
+var _ sdk.Msg = &MsgCreateVestingPosition{}
+
+func NewMsgCreateVestingPosition(
+	creator string,
+	beneficiary string,
+	amount string,
+	cliff uint64,
+	duration uint64,
+	startTime uint64,
+	revocable bool,
+) *MsgCreateVestingPosition {
+	return &MsgCreateVestingPosition{
+		Creator:     creator,
+		Beneficiary: beneficiary,
+		Amount:      amount,
+		Cliff:       cliff,
+		Duration:    duration,
+		StartTime:   startTime,
+		Revocable:   revocable,
+	}
+}
+
+func (msg *MsgCreateVestingPosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateVestingPosition) Type() string {
+	return TypeMsgCreateVestingPosition
+}
+
+func (msg *MsgCreateVestingPosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateVestingPosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateVestingPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Beneficiary)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+	}
+
+	amount, err := sdkmath.ParseUint(msg.Amount)
+	if err != nil || amount.IsZero() {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "amount must be a positive integer")
+	}
+
+	return msg.Position(msg.StartTime).Validate()
+}
+
+// Position returns the position the message opens when the vesting starts at startTime
+func (msg *MsgCreateVestingPosition) Position(startTime uint64) VestingInfo {
+	return VestingInfo{
+		StartTime:    startTime,
+		Duration:     msg.Duration,
+		Cliff:        startTime + msg.Cliff,
+		Amount:       msg.Amount,
+		TotalClaimed: "0",
+		Beneficiary:  msg.Beneficiary,
+		Curve:        msg.Curve,
+		StepPeriod:   msg.StepPeriod,
+		Periods:      msg.Periods,
+		Funder:       msg.Creator,
+		Revocable:    msg.Revocable,
+	}
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgCreateVestingPosition_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateVestingPosition
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateVestingPosition{
+				Creator:     "invalid_address",
+				Beneficiary: sample.AccAddress(),
+				Amount:      "100",
+			},
+			err: ErrInvalidRequest,
+		}, {
+			name: "invalid beneficiary",
+			msg: MsgCreateVestingPosition{
+				Creator:     sample.AccAddress(),
+				Beneficiary: "invalid_address",
+				Amount:      "100",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgCreateVestingPosition{
+				Creator:     sample.AccAddress(),
+				Beneficiary: sample.AccAddress(),
+				Amount:      "0",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "incomplete curve",
+			msg: MsgCreateVestingPosition{
+				Creator:     sample.AccAddress(),
+				Beneficiary: sample.AccAddress(),
+				Amount:      "100",
+				Duration:    100,
+				Curve:       VestingCurve_VESTING_CURVE_PERIODIC,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgCreateVestingPosition{
+				Creator:     sample.AccAddress(),
+				Beneficiary: sample.AccAddress(),
+				Amount:      "100",
+				Cliff:       10,
+				Duration:    100,
+				Revocable:   true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgEarlyUnlock = "early_unlock"
+
+var _ sdk.Msg = &MsgEarlyUnlock{}
+
+func NewMsgEarlyUnlock(creator string, positionId uint64, amount string) *MsgEarlyUnlock {
+	return &MsgEarlyUnlock{
+		Creator:    creator,
+		PositionId: positionId,
+		Amount:     amount,
+	}
+}
+
+func (msg *MsgEarlyUnlock) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgEarlyUnlock) Type() string {
+	return TypeMsgEarlyUnlock
+}
+
+func (msg *MsgEarlyUnlock) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgEarlyUnlock) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgEarlyUnlock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "invalid creator address (%s)", err)
+	}
+
+	if msg.Amount != "" {
+		amount, err := sdkmath.ParseUint(msg.Amount)
+		if err != nil || amount.IsZero() {
+			return sdkerrors.Wrap(errors.ErrInvalidRequest, "amount must be a positive integer")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgEarlyUnlock_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgEarlyUnlock
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgEarlyUnlock{
+				Creator: "invalid_address",
+			},
+			err: ErrInvalidRequest,
+		}, {
+			name: "zero amount",
+			msg: MsgEarlyUnlock{
+				Creator: sample.AccAddress(),
+				Amount:  "0",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "invalid amount",
+			msg: MsgEarlyUnlock{
+				Creator: sample.AccAddress(),
+				Amount:  "-100",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid amount",
+			msg: MsgEarlyUnlock{
+				Creator: sample.AccAddress(),
+				Amount:  "100",
+			},
+		}, {
+			name: "whole position",
+			msg: MsgEarlyUnlock{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgMergePositions = "merge_positions"
+
+var _ sdk.Msg = &MsgMergePositions{}
+
+func NewMsgMergePositions(creator string, positionIds []uint64) *MsgMergePositions {
+	return &MsgMergePositions{
+		Creator:     creator,
+		PositionIds: positionIds,
+	}
+}
+
+func (msg *MsgMergePositions) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMergePositions) Type() string {
+	return TypeMsgMergePositions
+}
+
+func (msg *MsgMergePositions) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMergePositions) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMergePositions) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.PositionIds) < 2 {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "at least two positions must be merged")
+	}
+
+	positionIds := make(map[uint64]bool)
+	for _, positionId := range msg.PositionIds {
+		if positionIds[positionId] {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "duplicated position %d", positionId)
+		}
+		positionIds[positionId] = true
+	}
+
+	return nil
+}
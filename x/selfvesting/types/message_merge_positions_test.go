@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgMergePositions_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgMergePositions
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgMergePositions{
+				Creator:     "invalid_address",
+				PositionIds: []uint64{1, 2},
+			},
+			err: ErrInvalidRequest,
+		}, {
+			name: "single position",
+			msg: MsgMergePositions{
+				Creator:     sample.AccAddress(),
+				PositionIds: []uint64{1},
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "duplicated position",
+			msg: MsgMergePositions{
+				Creator:     sample.AccAddress(),
+				PositionIds: []uint64{1, 2, 1},
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid merge",
+			msg: MsgMergePositions{
+				Creator:     sample.AccAddress(),
+				PositionIds: []uint64{1, 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
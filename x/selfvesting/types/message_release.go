@@ -9,10 +9,10 @@ const TypeMsgRelease = "release"
 
 var _ sdk.Msg = &MsgRelease{}
 
-func NewMsgRelease(creator string, posIndex uint64) *MsgRelease {
+func NewMsgRelease(creator string, positionId uint64) *MsgRelease {
 	return &MsgRelease{
-		Creator:  creator,
-		PosIndex: posIndex,
+		Creator:    creator,
+		PositionId: positionId,
 	}
 }
 
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgReleaseAll = "release_all"
+
+var _ sdk.Msg = &MsgReleaseAll{}
+
+func NewMsgReleaseAll(creator string) *MsgReleaseAll {
+	return &MsgReleaseAll{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgReleaseAll) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReleaseAll) Type() string {
+	return TypeMsgReleaseAll
+}
+
+func (msg *MsgReleaseAll) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReleaseAll) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReleaseAll) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgReleaseAll_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReleaseAll
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgReleaseAll{
+				Creator: "invalid_address",
+			},
+			err: ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgReleaseAll{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,7 +3,10 @@ package types
 import (
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 	"selfchain/testutil/sample"
 )
 
@@ -37,3 +40,18 @@ func TestMsgRelease_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRelease_RejectsPositionIndex(t *testing.T) {
+	// A MsgRelease still carrying the index of the position as field 2
+	legacy := protowire.AppendTag(nil, 1, protowire.BytesType)
+	legacy = protowire.AppendString(legacy, sample.AccAddress())
+	legacy = protowire.AppendTag(legacy, 2, protowire.VarintType)
+	legacy = protowire.AppendVarint(legacy, 1)
+
+	err := unknownproto.RejectUnknownFieldsStrict(legacy, &MsgRelease{}, codectypes.NewInterfaceRegistry())
+	require.Error(t, err)
+
+	var msg MsgRelease
+	require.NoError(t, msg.Unmarshal(legacy))
+	require.Zero(t, msg.PositionId)
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgRevokePosition = "revoke_position"
+
+var _ sdk.Msg = &MsgRevokePosition{}
+
+func NewMsgRevokePosition(creator string, positionId uint64) *MsgRevokePosition {
+	return &MsgRevokePosition{
+		Creator:    creator,
+		PositionId: positionId,
+	}
+}
+
+func (msg *MsgRevokePosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokePosition) Type() string {
+	return TypeMsgRevokePosition
+}
+
+func (msg *MsgRevokePosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevokePosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "invalid creator address (%s)", err)
+	}
+	return nil
+}
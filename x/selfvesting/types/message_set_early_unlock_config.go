@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetEarlyUnlockConfig = "set_early_unlock_config"
+
+var _ sdk.Msg = &MsgSetEarlyUnlockConfig{}
+
+func NewMsgSetEarlyUnlockConfig(authority string, startPenaltyBasisPoints uint64, endPenaltyBasisPoints uint64, burnPenalty bool) *MsgSetEarlyUnlockConfig {
+	return &MsgSetEarlyUnlockConfig{
+		Authority:               authority,
+		StartPenaltyBasisPoints: startPenaltyBasisPoints,
+		EndPenaltyBasisPoints:   endPenaltyBasisPoints,
+		BurnPenalty:             burnPenalty,
+	}
+}
+
+func (msg *MsgSetEarlyUnlockConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetEarlyUnlockConfig) Type() string {
+	return TypeMsgSetEarlyUnlockConfig
+}
+
+func (msg *MsgSetEarlyUnlockConfig) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetEarlyUnlockConfig) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Config returns the early unlock config set by the message
+func (msg *MsgSetEarlyUnlockConfig) Config() EarlyUnlockConfig {
+	return EarlyUnlockConfig{
+		StartPenaltyBasisPoints: msg.StartPenaltyBasisPoints,
+		EndPenaltyBasisPoints:   msg.EndPenaltyBasisPoints,
+		BurnPenalty:             msg.BurnPenalty,
+	}
+}
+
+func (msg *MsgSetEarlyUnlockConfig) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return msg.Config().Validate()
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSetEarlyUnlockConfig_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetEarlyUnlockConfig
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgSetEarlyUnlockConfig{
+				Authority: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "penalty above 100%",
+			msg: MsgSetEarlyUnlockConfig{
+				Authority:               sample.AccAddress(),
+				StartPenaltyBasisPoints: 20000,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "growing penalty",
+			msg: MsgSetEarlyUnlockConfig{
+				Authority:               sample.AccAddress(),
+				StartPenaltyBasisPoints: 1000,
+				EndPenaltyBasisPoints:   2000,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "declining penalty",
+			msg: MsgSetEarlyUnlockConfig{
+				Authority:               sample.AccAddress(),
+				StartPenaltyBasisPoints: 2000,
+				EndPenaltyBasisPoints:   1000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
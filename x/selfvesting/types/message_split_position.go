@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSplitPosition = "split_position"
+
+var _ sdk.Msg = &MsgSplitPosition{}
+
+func NewMsgSplitPosition(creator string, positionId uint64, amount string, recipient string) *MsgSplitPosition {
+	return &MsgSplitPosition{
+		Creator:    creator,
+		PositionId: positionId,
+		Amount:     amount,
+		Recipient:  recipient,
+	}
+}
+
+func (msg *MsgSplitPosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSplitPosition) Type() string {
+	return TypeMsgSplitPosition
+}
+
+func (msg *MsgSplitPosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSplitPosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSplitPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "invalid creator address (%s)", err)
+	}
+
+	amount, err := sdkmath.ParseUint(msg.Amount)
+	if err != nil || amount.IsZero() {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "amount must be a positive integer")
+	}
+
+	if msg.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+		}
+	}
+
+	return nil
+}
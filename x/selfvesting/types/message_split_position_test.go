@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgSplitPosition_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSplitPosition
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSplitPosition{
+				Creator: "invalid_address",
+				Amount:  "100",
+			},
+			err: ErrInvalidRequest,
+		}, {
+			name: "zero amount",
+			msg: MsgSplitPosition{
+				Creator: sample.AccAddress(),
+				Amount:  "0",
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "invalid recipient",
+			msg: MsgSplitPosition{
+				Creator:   sample.AccAddress(),
+				Amount:    "100",
+				Recipient: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "valid split",
+			msg: MsgSplitPosition{
+				Creator: sample.AccAddress(),
+				Amount:  "100",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferPosition = "transfer_position"
+
+var _ sdk.Msg = &MsgTransferPosition{}
+
+func NewMsgTransferPosition(creator string, positionId uint64, recipient string, requireAcceptance bool) *MsgTransferPosition {
+	return &MsgTransferPosition{
+		Creator:           creator,
+		PositionId:        positionId,
+		Recipient:         recipient,
+		RequireAcceptance: requireAcceptance,
+	}
+}
+
+func (msg *MsgTransferPosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferPosition) Type() string {
+	return TypeMsgTransferPosition
+}
+
+func (msg *MsgTransferPosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferPosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRequest, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+
+	if msg.Recipient == msg.Creator {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "cannot transfer a position to its beneficiary")
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"selfchain/testutil/sample"
+)
+
+func TestMsgTransferPosition_ValidateBasic(t *testing.T) {
+	beneficiary := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgTransferPosition
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTransferPosition{
+				Creator:   "invalid_address",
+				Recipient: sample.AccAddress(),
+			},
+			err: ErrInvalidRequest,
+		}, {
+			name: "invalid recipient",
+			msg: MsgTransferPosition{
+				Creator:   beneficiary,
+				Recipient: "invalid_address",
+			},
+			err: errors.ErrInvalidAddress,
+		}, {
+			name: "transfer to itself",
+			msg: MsgTransferPosition{
+				Creator:   beneficiary,
+				Recipient: beneficiary,
+			},
+			err: errors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgTransferPosition{
+				Creator:   beneficiary,
+				Recipient: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,10 +1,25 @@
 package types
 
 import (
+	fmt "fmt"
+
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// DefaultMinPositionAmount is 1 slf
+	DefaultMinPositionAmount = "1000000"
+
+	DefaultMaxPositionsPerBeneficiary uint64 = 100
+)
+
+var (
+	KeyMinPositionAmount          = []byte("MinPositionAmount")
+	KeyMaxPositionsPerBeneficiary = []byte("MaxPositionsPerBeneficiary")
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -13,22 +28,67 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(minPositionAmount string, maxPositionsPerBeneficiary uint64) Params {
+	return Params{
+		MinPositionAmount:          minPositionAmount,
+		MaxPositionsPerBeneficiary: maxPositionsPerBeneficiary,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMinPositionAmount,
+		DefaultMaxPositionsPerBeneficiary,
+	)
+}
+
+func validateMinPositionAmount(i interface{}) error {
+	v, ok := i.(string)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, err := sdkmath.ParseUint(v); err != nil {
+		return fmt.Errorf("invalid minimum position amount: %w", err)
+	}
+
+	return nil
+}
+
+func validateMaxPositionsPerBeneficiary(i interface{}) error {
+	v, ok := i.(uint64)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("maximum positions per beneficiary must be positive")
+	}
+
+	return nil
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinPositionAmount, &p.MinPositionAmount, validateMinPositionAmount),
+		paramtypes.NewParamSetPair(KeyMaxPositionsPerBeneficiary, &p.MaxPositionsPerBeneficiary, validateMaxPositionsPerBeneficiary),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateMinPositionAmount(p.MinPositionAmount); err != nil {
+		return err
+	}
+
+	if err := validateMaxPositionsPerBeneficiary(p.MaxPositionsPerBeneficiary); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -25,6 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// minimum uslf amount of a position given to a beneficiary without its consent
+	MinPositionAmount string `protobuf:"bytes,1,opt,name=min_position_amount,json=minPositionAmount,proto3" json:"min_position_amount,omitempty"`
+	// maximum number of positions a beneficiary can be given without its consent
+	MaxPositionsPerBeneficiary uint64 `protobuf:"varint,2,opt,name=max_positions_per_beneficiary,json=maxPositionsPerBeneficiary,proto3" json:"max_positions_per_beneficiary,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +63,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMinPositionAmount() string {
+	if m != nil {
+		return m.MinPositionAmount
+	}
+	return ""
+}
+
+func (m *Params) GetMaxPositionsPerBeneficiary() uint64 {
+	if m != nil {
+		return m.MaxPositionsPerBeneficiary
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "selfchain.selfvesting.Params")
 }
@@ -68,16 +86,21 @@ func init() {
 }
 
 var fileDescriptor_3b917ce417754008 = []byte{
-	// 136 bytes of a gzipped FileDescriptorProto
+	// 220 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x4e, 0xcd, 0x49,
 	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0xca, 0x52, 0x8b, 0x4b, 0x32, 0xf3, 0xd2, 0xf5,
 	0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0xe1, 0x6a,
 	0xf4, 0x90, 0xd4, 0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x55, 0xe8, 0x83, 0x58, 0x10, 0xc5,
-	0x4a, 0x7c, 0x5c, 0x6c, 0x01, 0x60, 0xcd, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0x99, 0x9f,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x2c, 0xc2, 0xea, 0x0a, 0x14, 0xcb,
-	0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xe6, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x3f, 0x49, 0x49, 0x69, 0xa2, 0x00, 0x00, 0x00,
+	0x4a, 0x8d, 0x8c, 0x5c, 0x6c, 0x01, 0x60, 0xdd, 0x42, 0x7a, 0x5c, 0xc2, 0xb9, 0x99, 0x79, 0xf1,
+	0x05, 0xf9, 0xc5, 0x99, 0x25, 0x99, 0xf9, 0x79, 0xf1, 0x89, 0xb9, 0xf9, 0xa5, 0x79, 0x25, 0x12,
+	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x82, 0xb9, 0x99, 0x79, 0x01, 0x50, 0x19, 0x47, 0xb0, 0x84,
+	0x90, 0x23, 0x97, 0x6c, 0x6e, 0x62, 0x05, 0x5c, 0x7d, 0x71, 0x7c, 0x41, 0x6a, 0x51, 0x7c, 0x52,
+	0x6a, 0x5e, 0x6a, 0x5a, 0x66, 0x72, 0x66, 0x62, 0x51, 0xa5, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x4b,
+	0x90, 0x54, 0x6e, 0x62, 0x05, 0x4c, 0x67, 0x71, 0x40, 0x6a, 0x91, 0x13, 0x42, 0x85, 0x15, 0xcb,
+	0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0xe6, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
+	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
+	0x25, 0x8b, 0xf0, 0x6e, 0x05, 0x8a, 0x87, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x7e,
+	0x30, 0x06, 0x0c, 0x00, 0x2c, 0x77, 0x3c, 0x72, 0x16, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -100,6 +123,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPositionsPerBeneficiary != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPositionsPerBeneficiary))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MinPositionAmount) > 0 {
+		i -= len(m.MinPositionAmount)
+		copy(dAtA[i:], m.MinPositionAmount)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinPositionAmount)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +155,13 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.MinPositionAmount)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxPositionsPerBeneficiary != 0 {
+		n += 1 + sovParams(uint64(m.MaxPositionsPerBeneficiary))
+	}
 	return n
 }
 
@@ -158,6 +200,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPositionAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinPositionAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionsPerBeneficiary", wireType)
+			}
+			m.MaxPositionsPerBeneficiary = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPositionsPerBeneficiary |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -114,7 +114,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 }
 
 type QueryGetVestingPositionsRequest struct {
-	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Beneficiary string             `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetVestingPositionsRequest) Reset()         { *m = QueryGetVestingPositionsRequest{} }
@@ -157,8 +158,16 @@ func (m *QueryGetVestingPositionsRequest) GetBeneficiary() string {
 	return ""
 }
 
+func (m *QueryGetVestingPositionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetVestingPositionsResponse struct {
-	VestingPositions VestingPositions `protobuf:"bytes,1,opt,name=vestingPositions,proto3" json:"vestingPositions"`
+	VestingPositions VestingPositions    `protobuf:"bytes,1,opt,name=vestingPositions,proto3" json:"vestingPositions"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetVestingPositionsResponse) Reset()         { *m = QueryGetVestingPositionsResponse{} }
@@ -201,6 +210,13 @@ func (m *QueryGetVestingPositionsResponse) GetVestingPositions() VestingPosition
 	return VestingPositions{}
 }
 
+func (m *QueryGetVestingPositionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryAllVestingPositionsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -297,507 +313,4227 @@ func (m *QueryAllVestingPositionsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "selfchain.selfvesting.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "selfchain.selfvesting.QueryParamsResponse")
-	proto.RegisterType((*QueryGetVestingPositionsRequest)(nil), "selfchain.selfvesting.QueryGetVestingPositionsRequest")
-	proto.RegisterType((*QueryGetVestingPositionsResponse)(nil), "selfchain.selfvesting.QueryGetVestingPositionsResponse")
-	proto.RegisterType((*QueryAllVestingPositionsRequest)(nil), "selfchain.selfvesting.QueryAllVestingPositionsRequest")
-	proto.RegisterType((*QueryAllVestingPositionsResponse)(nil), "selfchain.selfvesting.QueryAllVestingPositionsResponse")
+type QueryGetVestingPositionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("selfchain/selfvesting/query.proto", fileDescriptor_931c644e99d2a099) }
-
-var fileDescriptor_931c644e99d2a099 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6b, 0x53, 0x31,
-	0x1c, 0x6f, 0xe6, 0x2c, 0x98, 0x5d, 0x46, 0x36, 0x41, 0x8a, 0x7d, 0xad, 0x01, 0xb5, 0x16, 0x7c,
-	0x71, 0x13, 0x36, 0x41, 0x10, 0x36, 0xc1, 0x5d, 0xeb, 0x3b, 0x08, 0x7a, 0x91, 0xb4, 0xa4, 0xcf,
-	0xc0, 0x5b, 0xf2, 0xd6, 0x64, 0xc5, 0x22, 0xbb, 0x78, 0xf0, 0xac, 0xf8, 0xcf, 0x78, 0x17, 0x64,
-	0xc7, 0x81, 0x17, 0x4f, 0x22, 0xad, 0x7f, 0x88, 0x34, 0xf9, 0xce, 0xbd, 0xb6, 0xef, 0x3d, 0x2b,
-	0xec, 0xd4, 0x90, 0x7c, 0xbe, 0x9f, 0x1f, 0xe9, 0x27, 0x0f, 0xdf, 0x32, 0x22, 0xe9, 0xf7, 0xde,
-	0x70, 0xa9, 0xd8, 0x74, 0x35, 0x14, 0xc6, 0x4a, 0x15, 0xb3, 0xa3, 0x63, 0x31, 0x18, 0x85, 0xe9,
-	0x40, 0x5b, 0x4d, 0xae, 0xff, 0x85, 0x84, 0x19, 0x48, 0x6d, 0x33, 0xd6, 0xb1, 0x76, 0x08, 0x36,
-	0x5d, 0x79, 0x70, 0xed, 0x66, 0xac, 0x75, 0x9c, 0x08, 0xc6, 0x53, 0xc9, 0xb8, 0x52, 0xda, 0x72,
-	0x2b, 0xb5, 0x32, 0x70, 0xda, 0xee, 0x69, 0x73, 0xa8, 0x0d, 0xeb, 0x72, 0x23, 0xbc, 0x06, 0x1b,
-	0x6e, 0x75, 0x85, 0xe5, 0x5b, 0x2c, 0xe5, 0xb1, 0x54, 0x0e, 0x0c, 0x58, 0x9a, 0xef, 0x2c, 0xe5,
-	0x03, 0x7e, 0x78, 0xce, 0x77, 0x3f, 0x1f, 0x03, 0xbf, 0xaf, 0x53, 0x6d, 0x64, 0x56, 0xbe, 0x55,
-	0x0e, 0x97, 0xaa, 0x0f, 0x31, 0xe8, 0x26, 0x26, 0xcf, 0xa7, 0xf6, 0x3a, 0x4e, 0x2d, 0x12, 0x47,
-	0xc7, 0xc2, 0x58, 0x1a, 0xe1, 0x8d, 0x99, 0x5d, 0x93, 0x6a, 0x65, 0x04, 0x79, 0x8c, 0xab, 0xde,
-	0xd5, 0x0d, 0xd4, 0x44, 0xad, 0xb5, 0xed, 0x7a, 0x98, 0x7b, 0x63, 0xa1, 0x1f, 0xdb, 0x5f, 0x3d,
-	0xfd, 0xd9, 0xa8, 0x44, 0x30, 0x42, 0x9f, 0xe2, 0x86, 0xe3, 0x3c, 0x10, 0xf6, 0x85, 0xc7, 0x75,
-	0xce, 0x5d, 0x83, 0x2c, 0x69, 0xe2, 0xb5, 0xae, 0x50, 0xa2, 0x2f, 0x7b, 0x92, 0x0f, 0x46, 0x4e,
-	0xe4, 0x5a, 0x94, 0xdd, 0xa2, 0x27, 0xb8, 0x59, 0x4c, 0x02, 0x2e, 0x5f, 0xe2, 0xf5, 0xe1, 0xdc,
-	0x19, 0xf8, 0xbd, 0x5b, 0xe0, 0x77, 0x9e, 0x0a, 0x9c, 0x2f, 0xd0, 0x50, 0x09, 0x19, 0xf6, 0x92,
-	0xa4, 0x28, 0xc3, 0x33, 0x8c, 0x2f, 0xfe, 0x61, 0xd0, 0xbd, 0x13, 0xfa, 0x3a, 0x84, 0xd3, 0x3a,
-	0x84, 0xbe, 0x72, 0x50, 0x87, 0xb0, 0xc3, 0x63, 0x01, 0xb3, 0x51, 0x66, 0x92, 0x7e, 0x43, 0x10,
-	0x35, 0x57, 0xab, 0x34, 0xea, 0x95, 0x4b, 0x88, 0x4a, 0x0e, 0x66, 0x72, 0xac, 0xc0, 0xfd, 0xfd,
-	0x2b, 0x87, 0xf7, 0x95, 0x0d, 0xb2, 0xfd, 0x69, 0x15, 0x5f, 0x75, 0x41, 0xc8, 0x07, 0x84, 0xab,
-	0xbe, 0x1a, 0xe4, 0x5e, 0x81, 0xbd, 0xc5, 0x2e, 0xd6, 0xda, 0xcb, 0x40, 0xbd, 0x2e, 0xbd, 0xfd,
-	0xfe, 0xfb, 0xef, 0xcf, 0x2b, 0x0d, 0x52, 0x67, 0x65, 0x6f, 0x8a, 0x7c, 0x45, 0x78, 0x7d, 0xfe,
-	0x22, 0xc8, 0x4e, 0x99, 0x4e, 0x71, 0x69, 0x6b, 0xbb, 0xff, 0x3d, 0x07, 0x66, 0x9f, 0x38, 0xb3,
-	0x8f, 0xc8, 0x0e, 0x5b, 0xf2, 0x71, 0xb3, 0x77, 0x99, 0xa7, 0x70, 0x42, 0xbe, 0x20, 0xbc, 0x31,
-	0x4f, 0xbe, 0x97, 0x24, 0xe5, 0x41, 0x8a, 0x9b, 0x5b, 0x1e, 0xa4, 0xa4, 0x85, 0xf4, 0x81, 0x0b,
-	0xd2, 0x26, 0xad, 0x65, 0x83, 0xec, 0xef, 0x9e, 0x8e, 0x03, 0x74, 0x36, 0x0e, 0xd0, 0xaf, 0x71,
-	0x80, 0x3e, 0x4e, 0x82, 0xca, 0xd9, 0x24, 0xa8, 0xfc, 0x98, 0x04, 0x95, 0x57, 0xf5, 0x0b, 0x8a,
-	0xb7, 0x33, 0x24, 0x76, 0x94, 0x0a, 0xd3, 0xad, 0xba, 0xaf, 0xd6, 0xc3, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x8c, 0xa1, 0x28, 0x5b, 0xce, 0x05, 0x00, 0x00,
+func (m *QueryGetVestingPositionRequest) Reset()         { *m = QueryGetVestingPositionRequest{} }
+func (m *QueryGetVestingPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVestingPositionRequest) ProtoMessage()    {}
+func (*QueryGetVestingPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{6}
+}
+func (m *QueryGetVestingPositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVestingPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVestingPositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVestingPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVestingPositionRequest.Merge(m, src)
+}
+func (m *QueryGetVestingPositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVestingPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVestingPositionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetVestingPositionRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of VestingPositions items.
-	VestingPositions(ctx context.Context, in *QueryGetVestingPositionsRequest, opts ...grpc.CallOption) (*QueryGetVestingPositionsResponse, error)
-	VestingPositionsAll(ctx context.Context, in *QueryAllVestingPositionsRequest, opts ...grpc.CallOption) (*QueryAllVestingPositionsResponse, error)
+func (m *QueryGetVestingPositionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetVestingPositionResponse struct {
+	VestingPosition VestingInfo `protobuf:"bytes,1,opt,name=vestingPosition,proto3" json:"vestingPosition"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetVestingPositionResponse) Reset()         { *m = QueryGetVestingPositionResponse{} }
+func (m *QueryGetVestingPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVestingPositionResponse) ProtoMessage()    {}
+func (*QueryGetVestingPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetVestingPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) VestingPositions(ctx context.Context, in *QueryGetVestingPositionsRequest, opts ...grpc.CallOption) (*QueryGetVestingPositionsResponse, error) {
-	out := new(QueryGetVestingPositionsResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/VestingPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetVestingPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVestingPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetVestingPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVestingPositionResponse.Merge(m, src)
+}
+func (m *QueryGetVestingPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVestingPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVestingPositionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) VestingPositionsAll(ctx context.Context, in *QueryAllVestingPositionsRequest, opts ...grpc.CallOption) (*QueryAllVestingPositionsResponse, error) {
-	out := new(QueryAllVestingPositionsResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/VestingPositionsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetVestingPositionResponse proto.InternalMessageInfo
+
+func (m *QueryGetVestingPositionResponse) GetVestingPosition() VestingInfo {
+	if m != nil {
+		return m.VestingPosition
 	}
-	return out, nil
+	return VestingInfo{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of VestingPositions items.
-	VestingPositions(context.Context, *QueryGetVestingPositionsRequest) (*QueryGetVestingPositionsResponse, error)
-	VestingPositionsAll(context.Context, *QueryAllVestingPositionsRequest) (*QueryAllVestingPositionsResponse, error)
+type QueryAllVestingPositionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllVestingPositionRequest) Reset()         { *m = QueryAllVestingPositionRequest{} }
+func (m *QueryAllVestingPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVestingPositionRequest) ProtoMessage()    {}
+func (*QueryAllVestingPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllVestingPositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) VestingPositions(ctx context.Context, req *QueryGetVestingPositionsRequest) (*QueryGetVestingPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VestingPositions not implemented")
+func (m *QueryAllVestingPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllVestingPositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) VestingPositionsAll(ctx context.Context, req *QueryAllVestingPositionsRequest) (*QueryAllVestingPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VestingPositionsAll not implemented")
+func (m *QueryAllVestingPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVestingPositionRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAllVestingPositionRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.selfvesting.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAllVestingPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVestingPositionRequest.DiscardUnknown(m)
 }
 
-func _Query_VestingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetVestingPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VestingPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.selfvesting.Query/VestingPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VestingPositions(ctx, req.(*QueryGetVestingPositionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryAllVestingPositionRequest proto.InternalMessageInfo
 
-func _Query_VestingPositionsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllVestingPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VestingPositionsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.selfvesting.Query/VestingPositionsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VestingPositionsAll(ctx, req.(*QueryAllVestingPositionsRequest))
+func (m *QueryAllVestingPositionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "selfchain.selfvesting.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "VestingPositions",
-			Handler:    _Query_VestingPositions_Handler,
-		},
-		{
-			MethodName: "VestingPositionsAll",
-			Handler:    _Query_VestingPositionsAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "selfchain/selfvesting/query.proto",
+type QueryAllVestingPositionResponse struct {
+	VestingPosition []VestingInfo       `protobuf:"bytes,1,rep,name=vestingPosition,proto3" json:"vestingPosition"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllVestingPositionResponse) Reset()         { *m = QueryAllVestingPositionResponse{} }
+func (m *QueryAllVestingPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVestingPositionResponse) ProtoMessage()    {}
+func (*QueryAllVestingPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{9}
+}
+func (m *QueryAllVestingPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllVestingPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllVestingPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllVestingPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVestingPositionResponse.Merge(m, src)
+}
+func (m *QueryAllVestingPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllVestingPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVestingPositionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAllVestingPositionResponse proto.InternalMessageInfo
+
+func (m *QueryAllVestingPositionResponse) GetVestingPosition() []VestingInfo {
+	if m != nil {
+		return m.VestingPosition
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllVestingPositionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryClaimableRequest struct {
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryClaimableRequest) Reset()         { *m = QueryClaimableRequest{} }
+func (m *QueryClaimableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRequest) ProtoMessage()    {}
+func (*QueryClaimableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{10}
+}
+func (m *QueryClaimableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetVestingPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryClaimableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRequest.Merge(m, src)
 }
-
-func (m *QueryGetVestingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClaimableRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetVestingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Beneficiary) > 0 {
-		i -= len(m.Beneficiary)
-		copy(dAtA[i:], m.Beneficiary)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Beneficiary)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryClaimableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetVestingPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableRequest) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetVestingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PositionClaimable struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=positionId,proto3" json:"positionId,omitempty"`
+	// amount released from the position by releasing it now
+	AmountToVest string `protobuf:"bytes,2,opt,name=amountToVest,proto3" json:"amountToVest,omitempty"`
+	// part of amountToVest that pays back the fees the module paid for the beneficiary
+	FeeDeducted string `protobuf:"bytes,3,opt,name=feeDeducted,proto3" json:"feeDeducted,omitempty"`
+	// amount the beneficiary receives by releasing the position now
+	Claimable string `protobuf:"bytes,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	// unix time at which more tokens of the position unlock, 0 once the position is fully vested
+	NextUnlockTime uint64 `protobuf:"varint,5,opt,name=nextUnlockTime,proto3" json:"nextUnlockTime,omitempty"`
 }
 
-func (m *QueryGetVestingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.VestingPositions.MarshalToSizedBuffer(dAtA[:i])
+func (m *PositionClaimable) Reset()         { *m = PositionClaimable{} }
+func (m *PositionClaimable) String() string { return proto.CompactTextString(m) }
+func (*PositionClaimable) ProtoMessage()    {}
+func (*PositionClaimable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{11}
+}
+func (m *PositionClaimable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionClaimable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionClaimable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PositionClaimable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionClaimable.Merge(m, src)
+}
+func (m *PositionClaimable) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionClaimable) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionClaimable.DiscardUnknown(m)
 }
 
-func (m *QueryAllVestingPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionClaimable proto.InternalMessageInfo
+
+func (m *PositionClaimable) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllVestingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PositionClaimable) GetAmountToVest() string {
+	if m != nil {
+		return m.AmountToVest
+	}
+	return ""
 }
 
-func (m *QueryAllVestingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *PositionClaimable) GetFeeDeducted() string {
+	if m != nil {
+		return m.FeeDeducted
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllVestingPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PositionClaimable) GetClaimable() string {
+	if m != nil {
+		return m.Claimable
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllVestingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PositionClaimable) GetNextUnlockTime() uint64 {
+	if m != nil {
+		return m.NextUnlockTime
+	}
+	return 0
 }
 
-func (m *QueryAllVestingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.VestingPositions) > 0 {
-		for iNdEx := len(m.VestingPositions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type QueryClaimableResponse struct {
+	Positions      []PositionClaimable `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	TotalClaimable string              `protobuf:"bytes,2,opt,name=totalClaimable,proto3" json:"totalClaimable,omitempty"`
+}
+
+func (m *QueryClaimableResponse) Reset()         { *m = QueryClaimableResponse{} }
+func (m *QueryClaimableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableResponse) ProtoMessage()    {}
+func (*QueryClaimableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{12}
+}
+func (m *QueryClaimableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClaimableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableResponse.Merge(m, src)
+}
+func (m *QueryClaimableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryClaimableResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableResponse) GetPositions() []PositionClaimable {
+	if m != nil {
+		return m.Positions
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryClaimableResponse) GetTotalClaimable() string {
+	if m != nil {
+		return m.TotalClaimable
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryUnlockScheduleRequest struct {
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// seconds between two points of the schedule, one day when unset
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// maximum number of points of the schedule
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *QueryGetVestingPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnlockScheduleRequest) Reset()         { *m = QueryUnlockScheduleRequest{} }
+func (m *QueryUnlockScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockScheduleRequest) ProtoMessage()    {}
+func (*QueryUnlockScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{13}
+}
+func (m *QueryUnlockScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Beneficiary)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryUnlockScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockScheduleRequest.Merge(m, src)
+}
+func (m *QueryUnlockScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryUnlockScheduleRequest) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetVestingPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnlockScheduleRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	var l int
-	_ = l
-	l = m.VestingPositions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAllVestingPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnlockScheduleRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	return 0
+}
+
+type UnlockSchedulePoint struct {
+	Time uint64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// amount unlocking since the previous point
+	Unlocked string `protobuf:"bytes,2,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	// amount releasable at time, before fees, if nothing is released until then
+	Claimable string `protobuf:"bytes,3,opt,name=claimable,proto3" json:"claimable,omitempty"`
+}
+
+func (m *UnlockSchedulePoint) Reset()         { *m = UnlockSchedulePoint{} }
+func (m *UnlockSchedulePoint) String() string { return proto.CompactTextString(m) }
+func (*UnlockSchedulePoint) ProtoMessage()    {}
+func (*UnlockSchedulePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{14}
+}
+func (m *UnlockSchedulePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockSchedulePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockSchedulePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockSchedulePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockSchedulePoint.Merge(m, src)
+}
+func (m *UnlockSchedulePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockSchedulePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockSchedulePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockSchedulePoint proto.InternalMessageInfo
+
+func (m *UnlockSchedulePoint) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *UnlockSchedulePoint) GetUnlocked() string {
+	if m != nil {
+		return m.Unlocked
+	}
+	return ""
+}
+
+func (m *UnlockSchedulePoint) GetClaimable() string {
+	if m != nil {
+		return m.Claimable
+	}
+	return ""
+}
+
+type QueryUnlockScheduleResponse struct {
+	Points []UnlockSchedulePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+}
+
+func (m *QueryUnlockScheduleResponse) Reset()         { *m = QueryUnlockScheduleResponse{} }
+func (m *QueryUnlockScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockScheduleResponse) ProtoMessage()    {}
+func (*QueryUnlockScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{15}
+}
+func (m *QueryUnlockScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockScheduleResponse.Merge(m, src)
+}
+func (m *QueryUnlockScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryUnlockScheduleResponse) GetPoints() []UnlockSchedulePoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type QueryGetEarlyUnlockConfigRequest struct {
+}
+
+func (m *QueryGetEarlyUnlockConfigRequest) Reset()         { *m = QueryGetEarlyUnlockConfigRequest{} }
+func (m *QueryGetEarlyUnlockConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetEarlyUnlockConfigRequest) ProtoMessage()    {}
+func (*QueryGetEarlyUnlockConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{16}
+}
+func (m *QueryGetEarlyUnlockConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetEarlyUnlockConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetEarlyUnlockConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetEarlyUnlockConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetEarlyUnlockConfigRequest.Merge(m, src)
+}
+func (m *QueryGetEarlyUnlockConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetEarlyUnlockConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetEarlyUnlockConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetEarlyUnlockConfigRequest proto.InternalMessageInfo
+
+type QueryGetEarlyUnlockConfigResponse struct {
+	EarlyUnlockConfig EarlyUnlockConfig `protobuf:"bytes,1,opt,name=earlyUnlockConfig,proto3" json:"earlyUnlockConfig"`
+}
+
+func (m *QueryGetEarlyUnlockConfigResponse) Reset()         { *m = QueryGetEarlyUnlockConfigResponse{} }
+func (m *QueryGetEarlyUnlockConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetEarlyUnlockConfigResponse) ProtoMessage()    {}
+func (*QueryGetEarlyUnlockConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{17}
+}
+func (m *QueryGetEarlyUnlockConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetEarlyUnlockConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetEarlyUnlockConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetEarlyUnlockConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetEarlyUnlockConfigResponse.Merge(m, src)
+}
+func (m *QueryGetEarlyUnlockConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetEarlyUnlockConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetEarlyUnlockConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetEarlyUnlockConfigResponse proto.InternalMessageInfo
+
+func (m *QueryGetEarlyUnlockConfigResponse) GetEarlyUnlockConfig() EarlyUnlockConfig {
+	if m != nil {
+		return m.EarlyUnlockConfig
+	}
+	return EarlyUnlockConfig{}
+}
+
+type QueryEarlyUnlockQuoteRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=positionId,proto3" json:"positionId,omitempty"`
+	// unvested amount to unlock, all of it when empty
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryEarlyUnlockQuoteRequest) Reset()         { *m = QueryEarlyUnlockQuoteRequest{} }
+func (m *QueryEarlyUnlockQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEarlyUnlockQuoteRequest) ProtoMessage()    {}
+func (*QueryEarlyUnlockQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{18}
+}
+func (m *QueryEarlyUnlockQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEarlyUnlockQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEarlyUnlockQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEarlyUnlockQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarlyUnlockQuoteRequest.Merge(m, src)
+}
+func (m *QueryEarlyUnlockQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEarlyUnlockQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarlyUnlockQuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEarlyUnlockQuoteRequest proto.InternalMessageInfo
+
+func (m *QueryEarlyUnlockQuoteRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *QueryEarlyUnlockQuoteRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type QueryEarlyUnlockQuoteResponse struct {
+	// vested amount released without penalty
+	Released string `protobuf:"bytes,1,opt,name=released,proto3" json:"released,omitempty"`
+	// unvested amount unlocked
+	Unlocked string `protobuf:"bytes,2,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	// penalty in basis points of the unlocked amount right now
+	PenaltyBasisPoints uint64 `protobuf:"varint,3,opt,name=penaltyBasisPoints,proto3" json:"penaltyBasisPoints,omitempty"`
+	Penalty            string `protobuf:"bytes,4,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	// part of what is paid out that pays back the fees the module paid for the beneficiary
+	FeeDeducted string `protobuf:"bytes,5,opt,name=feeDeducted,proto3" json:"feeDeducted,omitempty"`
+	// amount received by the beneficiary
+	NetAmount string `protobuf:"bytes,6,opt,name=netAmount,proto3" json:"netAmount,omitempty"`
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) Reset()         { *m = QueryEarlyUnlockQuoteResponse{} }
+func (m *QueryEarlyUnlockQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEarlyUnlockQuoteResponse) ProtoMessage()    {}
+func (*QueryEarlyUnlockQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{19}
+}
+func (m *QueryEarlyUnlockQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEarlyUnlockQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEarlyUnlockQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEarlyUnlockQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarlyUnlockQuoteResponse.Merge(m, src)
+}
+func (m *QueryEarlyUnlockQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEarlyUnlockQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarlyUnlockQuoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEarlyUnlockQuoteResponse proto.InternalMessageInfo
+
+func (m *QueryEarlyUnlockQuoteResponse) GetReleased() string {
+	if m != nil {
+		return m.Released
+	}
+	return ""
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) GetUnlocked() string {
+	if m != nil {
+		return m.Unlocked
+	}
+	return ""
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) GetPenaltyBasisPoints() uint64 {
+	if m != nil {
+		return m.PenaltyBasisPoints
+	}
+	return 0
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) GetPenalty() string {
+	if m != nil {
+		return m.Penalty
+	}
+	return ""
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) GetFeeDeducted() string {
+	if m != nil {
+		return m.FeeDeducted
+	}
+	return ""
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) GetNetAmount() string {
+	if m != nil {
+		return m.NetAmount
+	}
+	return ""
+}
+
+type QueryGetFeeAllowanceRequest struct {
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+}
+
+func (m *QueryGetFeeAllowanceRequest) Reset()         { *m = QueryGetFeeAllowanceRequest{} }
+func (m *QueryGetFeeAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFeeAllowanceRequest) ProtoMessage()    {}
+func (*QueryGetFeeAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{20}
+}
+func (m *QueryGetFeeAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFeeAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFeeAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFeeAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFeeAllowanceRequest.Merge(m, src)
+}
+func (m *QueryGetFeeAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFeeAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFeeAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFeeAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryGetFeeAllowanceRequest) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+type QueryGetFeeAllowanceResponse struct {
+	FeeAllowance FeeAllowance `protobuf:"bytes,1,opt,name=feeAllowance,proto3" json:"feeAllowance"`
+}
+
+func (m *QueryGetFeeAllowanceResponse) Reset()         { *m = QueryGetFeeAllowanceResponse{} }
+func (m *QueryGetFeeAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFeeAllowanceResponse) ProtoMessage()    {}
+func (*QueryGetFeeAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931c644e99d2a099, []int{21}
+}
+func (m *QueryGetFeeAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFeeAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFeeAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFeeAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFeeAllowanceResponse.Merge(m, src)
+}
+func (m *QueryGetFeeAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFeeAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFeeAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFeeAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryGetFeeAllowanceResponse) GetFeeAllowance() FeeAllowance {
+	if m != nil {
+		return m.FeeAllowance
+	}
+	return FeeAllowance{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "selfchain.selfvesting.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "selfchain.selfvesting.QueryParamsResponse")
+	proto.RegisterType((*QueryGetVestingPositionsRequest)(nil), "selfchain.selfvesting.QueryGetVestingPositionsRequest")
+	proto.RegisterType((*QueryGetVestingPositionsResponse)(nil), "selfchain.selfvesting.QueryGetVestingPositionsResponse")
+	proto.RegisterType((*QueryAllVestingPositionsRequest)(nil), "selfchain.selfvesting.QueryAllVestingPositionsRequest")
+	proto.RegisterType((*QueryAllVestingPositionsResponse)(nil), "selfchain.selfvesting.QueryAllVestingPositionsResponse")
+	proto.RegisterType((*QueryGetVestingPositionRequest)(nil), "selfchain.selfvesting.QueryGetVestingPositionRequest")
+	proto.RegisterType((*QueryGetVestingPositionResponse)(nil), "selfchain.selfvesting.QueryGetVestingPositionResponse")
+	proto.RegisterType((*QueryAllVestingPositionRequest)(nil), "selfchain.selfvesting.QueryAllVestingPositionRequest")
+	proto.RegisterType((*QueryAllVestingPositionResponse)(nil), "selfchain.selfvesting.QueryAllVestingPositionResponse")
+	proto.RegisterType((*QueryClaimableRequest)(nil), "selfchain.selfvesting.QueryClaimableRequest")
+	proto.RegisterType((*PositionClaimable)(nil), "selfchain.selfvesting.PositionClaimable")
+	proto.RegisterType((*QueryClaimableResponse)(nil), "selfchain.selfvesting.QueryClaimableResponse")
+	proto.RegisterType((*QueryUnlockScheduleRequest)(nil), "selfchain.selfvesting.QueryUnlockScheduleRequest")
+	proto.RegisterType((*UnlockSchedulePoint)(nil), "selfchain.selfvesting.UnlockSchedulePoint")
+	proto.RegisterType((*QueryUnlockScheduleResponse)(nil), "selfchain.selfvesting.QueryUnlockScheduleResponse")
+	proto.RegisterType((*QueryGetEarlyUnlockConfigRequest)(nil), "selfchain.selfvesting.QueryGetEarlyUnlockConfigRequest")
+	proto.RegisterType((*QueryGetEarlyUnlockConfigResponse)(nil), "selfchain.selfvesting.QueryGetEarlyUnlockConfigResponse")
+	proto.RegisterType((*QueryEarlyUnlockQuoteRequest)(nil), "selfchain.selfvesting.QueryEarlyUnlockQuoteRequest")
+	proto.RegisterType((*QueryEarlyUnlockQuoteResponse)(nil), "selfchain.selfvesting.QueryEarlyUnlockQuoteResponse")
+	proto.RegisterType((*QueryGetFeeAllowanceRequest)(nil), "selfchain.selfvesting.QueryGetFeeAllowanceRequest")
+	proto.RegisterType((*QueryGetFeeAllowanceResponse)(nil), "selfchain.selfvesting.QueryGetFeeAllowanceResponse")
+}
+
+func init() { proto.RegisterFile("selfchain/selfvesting/query.proto", fileDescriptor_931c644e99d2a099) }
+
+var fileDescriptor_931c644e99d2a099 = []byte{
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xce, 0x24, 0x9b, 0xa5, 0x79, 0x1b, 0xa5, 0xc9, 0x24, 0xad, 0x56, 0x26, 0xd9, 0xa4, 0x46,
+	0x34, 0x1f, 0x4a, 0xed, 0x64, 0x93, 0x26, 0xe5, 0x43, 0xa0, 0xa4, 0xd0, 0x50, 0x09, 0xa4, 0x74,
+	0x29, 0x95, 0x40, 0x48, 0xd1, 0x64, 0x77, 0x76, 0x63, 0xe1, 0xf5, 0x38, 0x6b, 0x6f, 0x68, 0x14,
+	0xe5, 0x00, 0x07, 0x2e, 0x48, 0x80, 0xc4, 0x1f, 0xe0, 0x3f, 0xf0, 0x21, 0x84, 0x04, 0x1c, 0x90,
+	0x50, 0x8f, 0x95, 0xb8, 0x70, 0x42, 0x90, 0xf0, 0x43, 0x90, 0x67, 0xc6, 0x5e, 0xdb, 0xb1, 0xbd,
+	0x5e, 0xb5, 0x07, 0x4e, 0xb1, 0xc7, 0xef, 0xc7, 0xf3, 0x3c, 0xef, 0xcc, 0xbc, 0x6f, 0x16, 0xae,
+	0x3b, 0xd4, 0x6c, 0xd4, 0x0e, 0x88, 0x61, 0xe9, 0xde, 0xd3, 0x11, 0x75, 0x5c, 0xc3, 0x6a, 0xea,
+	0x87, 0x1d, 0xda, 0x3e, 0xd6, 0xec, 0x36, 0x73, 0x19, 0xbe, 0x1a, 0x98, 0x68, 0x21, 0x13, 0x65,
+	0xaa, 0xc9, 0x9a, 0x8c, 0x5b, 0xe8, 0xde, 0x93, 0x30, 0x56, 0xa6, 0x9b, 0x8c, 0x35, 0x4d, 0xaa,
+	0x13, 0xdb, 0xd0, 0x89, 0x65, 0x31, 0x97, 0xb8, 0x06, 0xb3, 0x1c, 0xf9, 0x75, 0xa9, 0xc6, 0x9c,
+	0x16, 0x73, 0xf4, 0x7d, 0xe2, 0x50, 0x91, 0x43, 0x3f, 0x5a, 0xdd, 0xa7, 0x2e, 0x59, 0xd5, 0x6d,
+	0xd2, 0x34, 0x2c, 0x6e, 0x2c, 0x6d, 0xd5, 0x64, 0x64, 0x36, 0x69, 0x93, 0x96, 0x1f, 0xef, 0x66,
+	0xb2, 0x8d, 0xfc, 0xbb, 0x67, 0x33, 0xc7, 0x08, 0xa7, 0x5f, 0xc8, 0x36, 0x37, 0xac, 0x06, 0xcb,
+	0xb6, 0xa4, 0xa4, 0x6d, 0x1e, 0xef, 0x75, 0x2c, 0x93, 0xd5, 0x3e, 0x92, 0x96, 0x8b, 0xc9, 0x96,
+	0x0d, 0x4a, 0xf7, 0x88, 0x69, 0xb2, 0x8f, 0x89, 0x55, 0xa3, 0xc2, 0x54, 0x9d, 0x02, 0x7c, 0xdf,
+	0xe3, 0xbc, 0xcb, 0x29, 0x54, 0xe9, 0x61, 0x87, 0x3a, 0xae, 0x5a, 0x85, 0xc9, 0xc8, 0xaa, 0x63,
+	0x33, 0xcb, 0xa1, 0xf8, 0x15, 0x28, 0x0a, 0xaa, 0x25, 0x34, 0x87, 0x16, 0x2e, 0x57, 0x66, 0xb4,
+	0xc4, 0x32, 0x68, 0xc2, 0x6d, 0xbb, 0xf0, 0xf8, 0xaf, 0xd9, 0x81, 0xaa, 0x74, 0x51, 0x3f, 0x47,
+	0x30, 0xcb, 0x83, 0xee, 0x50, 0xf7, 0xa1, 0x30, 0xdc, 0xf5, 0xb5, 0x90, 0x79, 0xf1, 0x1c, 0x5c,
+	0xde, 0xa7, 0x16, 0x6d, 0x18, 0x35, 0x83, 0xb4, 0x8f, 0x79, 0x96, 0x91, 0x6a, 0x78, 0x09, 0xdf,
+	0x05, 0xe8, 0x56, 0xa5, 0x34, 0xc8, 0x61, 0xdc, 0xd0, 0x44, 0x09, 0x35, 0xaf, 0x84, 0x9a, 0xd8,
+	0x26, 0xb2, 0x84, 0xda, 0x2e, 0x69, 0x52, 0x19, 0xbd, 0x1a, 0xf2, 0x54, 0x7f, 0x47, 0x30, 0x97,
+	0x8e, 0x46, 0xf2, 0x7d, 0x1f, 0xc6, 0x8f, 0x62, 0xdf, 0x24, 0xf3, 0xf9, 0x14, 0xe6, 0xf1, 0x50,
+	0x52, 0x83, 0x0b, 0x61, 0xf0, 0x4e, 0x02, 0x8f, 0xf9, 0x9e, 0x3c, 0x04, 0xae, 0x08, 0x11, 0x43,
+	0xaa, 0xba, 0x65, 0x9a, 0x69, 0xaa, 0x46, 0x35, 0x43, 0x4f, 0xaf, 0x59, 0x62, 0xae, 0x4c, 0xcd,
+	0x86, 0xfe, 0x57, 0x9a, 0xad, 0x40, 0x39, 0xa5, 0xf6, 0xbe, 0x64, 0x63, 0x30, 0x68, 0xd4, 0xb9,
+	0x54, 0x85, 0xea, 0xa0, 0x51, 0x57, 0x3b, 0xa9, 0x7b, 0x37, 0x20, 0x5e, 0x85, 0x2b, 0x31, 0xc4,
+	0x52, 0x6a, 0x35, 0x9b, 0xf7, 0x3d, 0xab, 0xc1, 0x24, 0xe5, 0x78, 0x00, 0xf5, 0x00, 0xca, 0x29,
+	0x82, 0x3f, 0xeb, 0xda, 0xfe, 0x82, 0x52, 0xf7, 0x51, 0x36, 0xc3, 0xa1, 0xa7, 0x62, 0xf8, 0xec,
+	0x6a, 0xfa, 0x12, 0x5c, 0xe5, 0xf8, 0xef, 0x98, 0xc4, 0x68, 0x91, 0x7d, 0x93, 0xe6, 0xbe, 0x53,
+	0xd4, 0x5f, 0x11, 0x4c, 0xf8, 0x80, 0x02, 0x77, 0x5c, 0x06, 0xf0, 0xef, 0xea, 0x7b, 0xfe, 0x56,
+	0x08, 0xad, 0x60, 0x15, 0x46, 0x49, 0x8b, 0x75, 0x2c, 0xf7, 0x01, 0xf3, 0x78, 0x72, 0xec, 0x23,
+	0xd5, 0xc8, 0x9a, 0x97, 0xbb, 0x41, 0xe9, 0x1b, 0xb4, 0xde, 0xa9, 0xb9, 0xb4, 0x5e, 0x1a, 0x12,
+	0xb9, 0x43, 0x4b, 0x78, 0x1a, 0x46, 0x6a, 0x7e, 0xca, 0x52, 0x81, 0x7f, 0xef, 0x2e, 0xe0, 0x1b,
+	0x30, 0x66, 0xd1, 0x47, 0xee, 0x7b, 0xfc, 0x72, 0x7f, 0x60, 0xb4, 0x68, 0x69, 0x98, 0xe3, 0x88,
+	0xad, 0xaa, 0x5f, 0x20, 0xb8, 0x16, 0x67, 0x2f, 0x8b, 0xf6, 0x36, 0x8c, 0xd8, 0xb1, 0x83, 0xb8,
+	0x90, 0x76, 0x6d, 0xc7, 0x35, 0x90, 0x45, 0xeb, 0x06, 0xf0, 0x00, 0xb9, 0xcc, 0x25, 0x66, 0x60,
+	0x22, 0x69, 0xc7, 0x56, 0x55, 0x1b, 0x14, 0x8e, 0x47, 0x60, 0x7c, 0xb7, 0x76, 0x40, 0xeb, 0x9d,
+	0x3e, 0x4a, 0x82, 0x15, 0xb8, 0x64, 0x58, 0x2e, 0x6d, 0x1f, 0x11, 0x93, 0x67, 0x28, 0x54, 0x83,
+	0x77, 0x3c, 0x05, 0xc3, 0xa6, 0xd1, 0x32, 0x5c, 0x2e, 0x67, 0xa1, 0x2a, 0x5e, 0xd4, 0x1a, 0x4c,
+	0x46, 0x93, 0xed, 0x32, 0xc3, 0x72, 0x31, 0x86, 0x82, 0xeb, 0xe9, 0x26, 0xea, 0xc7, 0x9f, 0xbd,
+	0xe0, 0xa2, 0x5d, 0xd2, 0xba, 0x84, 0x1f, 0xbc, 0x47, 0xeb, 0x31, 0x14, 0xab, 0x87, 0xda, 0x84,
+	0xe7, 0x13, 0x69, 0x49, 0xad, 0xdf, 0x82, 0xa2, 0xed, 0x65, 0xf5, 0x85, 0x5e, 0x4a, 0x11, 0x3a,
+	0x01, 0x68, 0xd0, 0x2c, 0xb9, 0xbf, 0xaa, 0x76, 0xbb, 0xd3, 0x9b, 0x5e, 0x7f, 0x17, 0x1e, 0x77,
+	0x98, 0xd5, 0x30, 0x9a, 0x7e, 0x93, 0xfe, 0x04, 0xc1, 0xf5, 0x0c, 0x23, 0x89, 0xe9, 0x43, 0x98,
+	0xa0, 0xf1, 0x8f, 0xf2, 0x9e, 0x48, 0xdb, 0x07, 0x17, 0x82, 0x49, 0x70, 0x17, 0x03, 0xa9, 0x0f,
+	0x61, 0x9a, 0x43, 0x08, 0xb9, 0xdc, 0xef, 0x30, 0x37, 0xa8, 0x74, 0xaf, 0x43, 0x74, 0x0d, 0x8a,
+	0xe2, 0xc0, 0xc8, 0x42, 0xc8, 0x37, 0xf5, 0x1f, 0x04, 0x33, 0x29, 0x81, 0x25, 0x2f, 0x05, 0x2e,
+	0xb5, 0xa9, 0x49, 0x89, 0x43, 0xeb, 0x72, 0x03, 0x05, 0xef, 0x99, 0x05, 0xd6, 0x00, 0xdb, 0xd4,
+	0x22, 0xa6, 0x7b, 0xbc, 0x4d, 0x1c, 0xc3, 0xd9, 0x15, 0xf5, 0x12, 0x5b, 0x29, 0xe1, 0x0b, 0x2e,
+	0xc1, 0x73, 0x72, 0x55, 0x1e, 0x4f, 0xff, 0x35, 0x7e, 0xb8, 0x87, 0x13, 0x0f, 0xb7, 0x45, 0xdd,
+	0x2d, 0x41, 0xb0, 0x28, 0x36, 0x53, 0xb0, 0xa0, 0xbe, 0x2e, 0x37, 0xd3, 0x0e, 0x75, 0xef, 0x52,
+	0xba, 0xe5, 0x0f, 0x66, 0xf9, 0xef, 0xad, 0x16, 0x4c, 0x27, 0x07, 0x90, 0x12, 0xbd, 0x03, 0xa3,
+	0x8d, 0xd0, 0xba, 0xac, 0xfa, 0x0b, 0x29, 0x55, 0x0f, 0x87, 0x90, 0x05, 0x8f, 0xb8, 0x57, 0xbe,
+	0x1c, 0x83, 0x61, 0x9e, 0x0f, 0x7f, 0x86, 0xa0, 0x28, 0x66, 0x3c, 0xbc, 0x98, 0x12, 0xed, 0xe2,
+	0x50, 0xa9, 0x2c, 0xe5, 0x31, 0x15, 0xd0, 0xd5, 0x17, 0x3f, 0xfd, 0xe3, 0xdf, 0xaf, 0x07, 0x67,
+	0xf1, 0x8c, 0x9e, 0x35, 0x71, 0xe3, 0xdf, 0x10, 0x8c, 0xc7, 0xc7, 0x07, 0xbc, 0x91, 0x95, 0x27,
+	0x7d, 0xf8, 0x54, 0x36, 0xfb, 0xf6, 0x93, 0x60, 0x5f, 0xe3, 0x60, 0x6f, 0xe3, 0x0d, 0x3d, 0xe7,
+	0xe8, 0xaf, 0x9f, 0x84, 0xca, 0x78, 0x8a, 0x7f, 0x44, 0x30, 0x19, 0x0f, 0xbe, 0x65, 0x9a, 0xd9,
+	0x44, 0xd2, 0xe7, 0x3d, 0x65, 0xb3, 0x6f, 0x3f, 0x49, 0x64, 0x85, 0x13, 0x59, 0xc2, 0x0b, 0x79,
+	0x89, 0xe0, 0x1f, 0x10, 0x5c, 0x89, 0x85, 0xc3, 0xb7, 0xfa, 0xd3, 0xd1, 0x47, 0xbd, 0xd1, 0xaf,
+	0x9b, 0x04, 0xbd, 0xce, 0x41, 0x6b, 0x78, 0x39, 0x27, 0x68, 0xfd, 0xc4, 0xa8, 0x9f, 0xe2, 0xef,
+	0x10, 0xe0, 0x58, 0x44, 0x4f, 0xf2, 0x5b, 0xfd, 0x49, 0x97, 0x0b, 0x7b, 0xfa, 0x44, 0xa5, 0xea,
+	0x1c, 0xfb, 0x22, 0x9e, 0xcf, 0x89, 0x1d, 0x7f, 0x83, 0x60, 0xa4, 0x3b, 0xa2, 0x2c, 0x67, 0xa5,
+	0x8d, 0x0f, 0x42, 0xca, 0xcd, 0x9c, 0xd6, 0x12, 0xdb, 0x06, 0xc7, 0xb6, 0x82, 0xb5, 0x14, 0x6c,
+	0x41, 0x57, 0x8c, 0xed, 0xe6, 0xef, 0x11, 0x8c, 0x45, 0x1b, 0x1c, 0x5e, 0xcd, 0xca, 0x9c, 0x38,
+	0x22, 0x28, 0x95, 0x7e, 0x5c, 0x24, 0xe2, 0x57, 0x39, 0xe2, 0x0d, 0xbc, 0x9e, 0x82, 0x58, 0xf4,
+	0x80, 0x3d, 0x47, 0xfa, 0xc5, 0x70, 0xff, 0x84, 0x60, 0xe2, 0x42, 0xe7, 0xc3, 0xbd, 0x2e, 0x85,
+	0xb4, 0xee, 0xac, 0xdc, 0xee, 0xdf, 0x51, 0xd2, 0xa8, 0x70, 0x1a, 0xcb, 0x78, 0x49, 0xef, 0xfd,
+	0x0f, 0xff, 0x5e, 0x4d, 0xc0, 0xfc, 0x19, 0xc1, 0x78, 0xbc, 0x55, 0xe2, 0xb5, 0x2c, 0x08, 0x29,
+	0x1d, 0x5b, 0x59, 0xef, 0xcf, 0x29, 0xe7, 0x15, 0x18, 0xc1, 0x7c, 0xe8, 0xb9, 0xea, 0x27, 0xdd,
+	0x31, 0xe0, 0x14, 0x7f, 0x8b, 0x60, 0x34, 0xdc, 0x80, 0x70, 0xa5, 0x87, 0x7c, 0x09, 0x1d, 0x53,
+	0x59, 0xeb, 0xcb, 0x47, 0x22, 0x7f, 0x99, 0x23, 0x5f, 0xc7, 0x15, 0x3d, 0xc7, 0x8f, 0x26, 0xd1,
+	0x2d, 0xb3, 0xbd, 0xf9, 0xf8, 0xac, 0x8c, 0x9e, 0x9c, 0x95, 0xd1, 0xdf, 0x67, 0x65, 0xf4, 0xd5,
+	0x79, 0x79, 0xe0, 0xc9, 0x79, 0x79, 0xe0, 0xcf, 0xf3, 0xf2, 0xc0, 0x07, 0x33, 0xdd, 0x60, 0x8f,
+	0x22, 0xe1, 0xdc, 0x63, 0x9b, 0x3a, 0xfb, 0x45, 0xfe, 0xe3, 0xcb, 0xda, 0x7f, 0x03, 0x00, 0x79,
+	0xad, 0x34, 0x0b, 0xea, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of VestingPositions items.
+	VestingPositions(ctx context.Context, in *QueryGetVestingPositionsRequest, opts ...grpc.CallOption) (*QueryGetVestingPositionsResponse, error)
+	// Queries the positions of every beneficiary, paginated over the beneficiaries.
+	VestingPositionsAll(ctx context.Context, in *QueryAllVestingPositionsRequest, opts ...grpc.CallOption) (*QueryAllVestingPositionsResponse, error)
+	// Queries a vesting position by id.
+	VestingPosition(ctx context.Context, in *QueryGetVestingPositionRequest, opts ...grpc.CallOption) (*QueryGetVestingPositionResponse, error)
+	VestingPositionAll(ctx context.Context, in *QueryAllVestingPositionRequest, opts ...grpc.CallOption) (*QueryAllVestingPositionResponse, error)
+	// Queries the amount a beneficiary can claim from each of its positions right now.
+	Claimable(ctx context.Context, in *QueryClaimableRequest, opts ...grpc.CallOption) (*QueryClaimableResponse, error)
+	// Queries the projected future unlocks of the positions of a beneficiary.
+	UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error)
+	// Queries the penalty of early unlocks.
+	EarlyUnlockConfig(ctx context.Context, in *QueryGetEarlyUnlockConfigRequest, opts ...grpc.CallOption) (*QueryGetEarlyUnlockConfigResponse, error)
+	// Queries what unlocking a position early would pay out right now.
+	EarlyUnlockQuote(ctx context.Context, in *QueryEarlyUnlockQuoteRequest, opts ...grpc.CallOption) (*QueryEarlyUnlockQuoteResponse, error)
+	// Queries the fee allowance granted to a beneficiary and the uslf withheld for it.
+	FeeAllowance(ctx context.Context, in *QueryGetFeeAllowanceRequest, opts ...grpc.CallOption) (*QueryGetFeeAllowanceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingPositions(ctx context.Context, in *QueryGetVestingPositionsRequest, opts ...grpc.CallOption) (*QueryGetVestingPositionsResponse, error) {
+	out := new(QueryGetVestingPositionsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/VestingPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingPositionsAll(ctx context.Context, in *QueryAllVestingPositionsRequest, opts ...grpc.CallOption) (*QueryAllVestingPositionsResponse, error) {
+	out := new(QueryAllVestingPositionsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/VestingPositionsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingPosition(ctx context.Context, in *QueryGetVestingPositionRequest, opts ...grpc.CallOption) (*QueryGetVestingPositionResponse, error) {
+	out := new(QueryGetVestingPositionResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/VestingPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingPositionAll(ctx context.Context, in *QueryAllVestingPositionRequest, opts ...grpc.CallOption) (*QueryAllVestingPositionResponse, error) {
+	out := new(QueryAllVestingPositionResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/VestingPositionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Claimable(ctx context.Context, in *QueryClaimableRequest, opts ...grpc.CallOption) (*QueryClaimableResponse, error) {
+	out := new(QueryClaimableResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/Claimable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error) {
+	out := new(QueryUnlockScheduleResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/UnlockSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EarlyUnlockConfig(ctx context.Context, in *QueryGetEarlyUnlockConfigRequest, opts ...grpc.CallOption) (*QueryGetEarlyUnlockConfigResponse, error) {
+	out := new(QueryGetEarlyUnlockConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/EarlyUnlockConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EarlyUnlockQuote(ctx context.Context, in *QueryEarlyUnlockQuoteRequest, opts ...grpc.CallOption) (*QueryEarlyUnlockQuoteResponse, error) {
+	out := new(QueryEarlyUnlockQuoteResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/EarlyUnlockQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeAllowance(ctx context.Context, in *QueryGetFeeAllowanceRequest, opts ...grpc.CallOption) (*QueryGetFeeAllowanceResponse, error) {
+	out := new(QueryGetFeeAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Query/FeeAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of VestingPositions items.
+	VestingPositions(context.Context, *QueryGetVestingPositionsRequest) (*QueryGetVestingPositionsResponse, error)
+	// Queries the positions of every beneficiary, paginated over the beneficiaries.
+	VestingPositionsAll(context.Context, *QueryAllVestingPositionsRequest) (*QueryAllVestingPositionsResponse, error)
+	// Queries a vesting position by id.
+	VestingPosition(context.Context, *QueryGetVestingPositionRequest) (*QueryGetVestingPositionResponse, error)
+	VestingPositionAll(context.Context, *QueryAllVestingPositionRequest) (*QueryAllVestingPositionResponse, error)
+	// Queries the amount a beneficiary can claim from each of its positions right now.
+	Claimable(context.Context, *QueryClaimableRequest) (*QueryClaimableResponse, error)
+	// Queries the projected future unlocks of the positions of a beneficiary.
+	UnlockSchedule(context.Context, *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error)
+	// Queries the penalty of early unlocks.
+	EarlyUnlockConfig(context.Context, *QueryGetEarlyUnlockConfigRequest) (*QueryGetEarlyUnlockConfigResponse, error)
+	// Queries what unlocking a position early would pay out right now.
+	EarlyUnlockQuote(context.Context, *QueryEarlyUnlockQuoteRequest) (*QueryEarlyUnlockQuoteResponse, error)
+	// Queries the fee allowance granted to a beneficiary and the uslf withheld for it.
+	FeeAllowance(context.Context, *QueryGetFeeAllowanceRequest) (*QueryGetFeeAllowanceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) VestingPositions(ctx context.Context, req *QueryGetVestingPositionsRequest) (*QueryGetVestingPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPositions not implemented")
+}
+func (*UnimplementedQueryServer) VestingPositionsAll(ctx context.Context, req *QueryAllVestingPositionsRequest) (*QueryAllVestingPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPositionsAll not implemented")
+}
+func (*UnimplementedQueryServer) VestingPosition(ctx context.Context, req *QueryGetVestingPositionRequest) (*QueryGetVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPosition not implemented")
+}
+func (*UnimplementedQueryServer) VestingPositionAll(ctx context.Context, req *QueryAllVestingPositionRequest) (*QueryAllVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPositionAll not implemented")
+}
+func (*UnimplementedQueryServer) Claimable(ctx context.Context, req *QueryClaimableRequest) (*QueryClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claimable not implemented")
+}
+func (*UnimplementedQueryServer) UnlockSchedule(ctx context.Context, req *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSchedule not implemented")
+}
+func (*UnimplementedQueryServer) EarlyUnlockConfig(ctx context.Context, req *QueryGetEarlyUnlockConfigRequest) (*QueryGetEarlyUnlockConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarlyUnlockConfig not implemented")
+}
+func (*UnimplementedQueryServer) EarlyUnlockQuote(ctx context.Context, req *QueryEarlyUnlockQuoteRequest) (*QueryEarlyUnlockQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarlyUnlockQuote not implemented")
+}
+func (*UnimplementedQueryServer) FeeAllowance(ctx context.Context, req *QueryGetFeeAllowanceRequest) (*QueryGetFeeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeAllowance not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVestingPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/VestingPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPositions(ctx, req.(*QueryGetVestingPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingPositionsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVestingPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPositionsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/VestingPositionsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPositionsAll(ctx, req.(*QueryAllVestingPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVestingPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/VestingPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPosition(ctx, req.(*QueryGetVestingPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingPositionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVestingPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPositionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/VestingPositionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPositionAll(ctx, req.(*QueryAllVestingPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Claimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Claimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/Claimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Claimable(ctx, req.(*QueryClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnlockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/UnlockSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockSchedule(ctx, req.(*QueryUnlockScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EarlyUnlockConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEarlyUnlockConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EarlyUnlockConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/EarlyUnlockConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EarlyUnlockConfig(ctx, req.(*QueryGetEarlyUnlockConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EarlyUnlockQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEarlyUnlockQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EarlyUnlockQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/EarlyUnlockQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EarlyUnlockQuote(ctx, req.(*QueryEarlyUnlockQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFeeAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Query/FeeAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeAllowance(ctx, req.(*QueryGetFeeAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "selfchain.selfvesting.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "VestingPositions",
+			Handler:    _Query_VestingPositions_Handler,
+		},
+		{
+			MethodName: "VestingPositionsAll",
+			Handler:    _Query_VestingPositionsAll_Handler,
+		},
+		{
+			MethodName: "VestingPosition",
+			Handler:    _Query_VestingPosition_Handler,
+		},
+		{
+			MethodName: "VestingPositionAll",
+			Handler:    _Query_VestingPositionAll_Handler,
+		},
+		{
+			MethodName: "Claimable",
+			Handler:    _Query_Claimable_Handler,
+		},
+		{
+			MethodName: "UnlockSchedule",
+			Handler:    _Query_UnlockSchedule_Handler,
+		},
+		{
+			MethodName: "EarlyUnlockConfig",
+			Handler:    _Query_EarlyUnlockConfig_Handler,
+		},
+		{
+			MethodName: "EarlyUnlockQuote",
+			Handler:    _Query_EarlyUnlockQuote_Handler,
+		},
+		{
+			MethodName: "FeeAllowance",
+			Handler:    _Query_FeeAllowance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "selfchain/selfvesting/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVestingPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVestingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVestingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVestingPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVestingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVestingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.VestingPositions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVestingPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVestingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVestingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVestingPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVestingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVestingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VestingPositions) > 0 {
+		for iNdEx := len(m.VestingPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVestingPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVestingPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVestingPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVestingPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVestingPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVestingPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VestingPosition.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVestingPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVestingPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVestingPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVestingPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVestingPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVestingPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VestingPosition) > 0 {
+		for iNdEx := len(m.VestingPosition) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPosition[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionClaimable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionClaimable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionClaimable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextUnlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextUnlockTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Claimable) > 0 {
+		i -= len(m.Claimable)
+		copy(dAtA[i:], m.Claimable)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Claimable)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FeeDeducted) > 0 {
+		i -= len(m.FeeDeducted)
+		copy(dAtA[i:], m.FeeDeducted)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeDeducted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AmountToVest) > 0 {
+		i -= len(m.AmountToVest)
+		copy(dAtA[i:], m.AmountToVest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountToVest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalClaimable) > 0 {
+		i -= len(m.TotalClaimable)
+		copy(dAtA[i:], m.TotalClaimable)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalClaimable)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockSchedulePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockSchedulePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockSchedulePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimable) > 0 {
+		i -= len(m.Claimable)
+		copy(dAtA[i:], m.Claimable)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Claimable)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Unlocked) > 0 {
+		i -= len(m.Unlocked)
+		copy(dAtA[i:], m.Unlocked)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Unlocked)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Time != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEarlyUnlockConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEarlyUnlockConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEarlyUnlockConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEarlyUnlockConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEarlyUnlockConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEarlyUnlockConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EarlyUnlockConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarlyUnlockQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarlyUnlockQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarlyUnlockQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAmount) > 0 {
+		i -= len(m.NetAmount)
+		copy(dAtA[i:], m.NetAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NetAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FeeDeducted) > 0 {
+		i -= len(m.FeeDeducted)
+		copy(dAtA[i:], m.FeeDeducted)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeDeducted)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Penalty) > 0 {
+		i -= len(m.Penalty)
+		copy(dAtA[i:], m.Penalty)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Penalty)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PenaltyBasisPoints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PenaltyBasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Unlocked) > 0 {
+		i -= len(m.Unlocked)
+		copy(dAtA[i:], m.Unlocked)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Unlocked)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Released) > 0 {
+		i -= len(m.Released)
+		copy(dAtA[i:], m.Released)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Released)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFeeAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFeeAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFeeAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFeeAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFeeAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFeeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeAllowance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetVestingPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVestingPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VestingPositions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVestingPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVestingPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestingPositions) > 0 {
+		for _, e := range m.VestingPositions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVestingPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetVestingPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VestingPosition.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllVestingPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVestingPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestingPosition) > 0 {
+		for _, e := range m.VestingPosition {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionClaimable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	l = len(m.AmountToVest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FeeDeducted)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Claimable)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextUnlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.NextUnlockTime))
+	}
+	return n
+}
+
+func (m *QueryClaimableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TotalClaimable)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnlockScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *UnlockSchedulePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovQuery(uint64(m.Time))
+	}
+	l = len(m.Unlocked)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Claimable)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnlockScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetEarlyUnlockConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetEarlyUnlockConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EarlyUnlockConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEarlyUnlockQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEarlyUnlockQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Released)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Unlocked)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PenaltyBasisPoints != 0 {
+		n += 1 + sovQuery(uint64(m.PenaltyBasisPoints))
+	}
+	l = len(m.Penalty)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FeeDeducted)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NetAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFeeAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
+	return n
+}
+
+func (m *QueryGetFeeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeAllowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVestingPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVestingPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVestingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVestingPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVestingPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVestingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingPositions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVestingPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVestingPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVestingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVestingPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVestingPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVestingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPositions = append(m.VestingPositions, VestingPositions{})
+			if err := m.VestingPositions[len(m.VestingPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVestingPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVestingPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVestingPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVestingPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVestingPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVestingPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPosition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVestingPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVestingPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVestingPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVestingPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVestingPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVestingPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPosition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPosition = append(m.VestingPosition, VestingInfo{})
+			if err := m.VestingPosition[len(m.VestingPosition)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionClaimable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionClaimable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionClaimable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountToVest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountToVest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDeducted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDeducted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlockTime", wireType)
+			}
+			m.NextUnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, PositionClaimable{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalClaimable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *UnlockSchedulePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockSchedulePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockSchedulePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllVestingPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.VestingPositions) > 0 {
-		for _, e := range m.VestingPositions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryUnlockScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, UnlockSchedulePoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEarlyUnlockConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -820,10 +4556,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEarlyUnlockConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEarlyUnlockConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -847,7 +4583,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEarlyUnlockConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -870,15 +4606,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEarlyUnlockConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEarlyUnlockConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -905,7 +4641,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EarlyUnlockConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -930,7 +4666,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVestingPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEarlyUnlockQuoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -953,15 +4689,34 @@ func (m *QueryGetVestingPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVestingPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEarlyUnlockQuoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVestingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEarlyUnlockQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -989,7 +4744,7 @@ func (m *QueryGetVestingPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1012,7 +4767,7 @@ func (m *QueryGetVestingPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVestingPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEarlyUnlockQuoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1035,17 +4790,17 @@ func (m *QueryGetVestingPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVestingPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEarlyUnlockQuoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVestingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEarlyUnlockQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPositions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1055,24 +4810,170 @@ func (m *QueryGetVestingPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VestingPositions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Released = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyBasisPoints", wireType)
+			}
+			m.PenaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDeducted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDeducted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NetAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1095,7 +4996,7 @@ func (m *QueryGetVestingPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVestingPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFeeAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1118,17 +5019,17 @@ func (m *QueryAllVestingPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVestingPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFeeAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVestingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFeeAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1138,27 +5039,23 @@ func (m *QueryAllVestingPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1181,7 +5078,7 @@ func (m *QueryAllVestingPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVestingPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFeeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1204,49 +5101,15 @@ func (m *QueryAllVestingPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVestingPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFeeAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVestingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFeeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPositions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VestingPositions = append(m.VestingPositions, VestingPositions{})
-			if err := m.VestingPositions[len(m.VestingPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1273,10 +5136,7 @@ func (m *QueryAllVestingPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
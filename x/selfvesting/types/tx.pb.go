@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -28,8 +29,8 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgRelease struct {
-	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	PosIndex uint64 `protobuf:"varint,2,opt,name=posIndex,proto3" json:"posIndex,omitempty"`
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PositionId uint64 `protobuf:"varint,3,opt,name=positionId,proto3" json:"positionId,omitempty"`
 }
 
 func (m *MsgRelease) Reset()         { *m = MsgRelease{} }
@@ -72,9 +73,9 @@ func (m *MsgRelease) GetCreator() string {
 	return ""
 }
 
-func (m *MsgRelease) GetPosIndex() uint64 {
+func (m *MsgRelease) GetPositionId() uint64 {
 	if m != nil {
-		return m.PosIndex
+		return m.PositionId
 	}
 	return 0
 }
@@ -82,6 +83,8 @@ func (m *MsgRelease) GetPosIndex() uint64 {
 type MsgReleaseResponse struct {
 	PeriodToVest uint64 `protobuf:"varint,1,opt,name=periodToVest,proto3" json:"periodToVest,omitempty"`
 	AmountToVest string `protobuf:"bytes,2,opt,name=amountToVest,proto3" json:"amountToVest,omitempty"`
+	// part of the released amount that paid back the fees of release transactions
+	FeeDeducted string `protobuf:"bytes,3,opt,name=feeDeducted,proto3" json:"feeDeducted,omitempty"`
 }
 
 func (m *MsgReleaseResponse) Reset()         { *m = MsgReleaseResponse{} }
@@ -131,233 +134,6422 @@ func (m *MsgReleaseResponse) GetAmountToVest() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgRelease)(nil), "selfchain.selfvesting.MsgRelease")
-	proto.RegisterType((*MsgReleaseResponse)(nil), "selfchain.selfvesting.MsgReleaseResponse")
+func (m *MsgReleaseResponse) GetFeeDeducted() string {
+	if m != nil {
+		return m.FeeDeducted
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("selfchain/selfvesting/tx.proto", fileDescriptor_70a0f46b1e8b78ab) }
+type MsgReleaseAll struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
 
-var fileDescriptor_70a0f46b1e8b78ab = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x4e, 0xcd, 0x49,
-	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0xca, 0x52, 0x8b, 0x4b, 0x32, 0xf3, 0xd2, 0xf5,
-	0x4b, 0x2a, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0xe1, 0xf2, 0x7a, 0x48, 0xf2, 0x4a,
-	0x4e, 0x5c, 0x5c, 0xbe, 0xc5, 0xe9, 0x41, 0xa9, 0x39, 0xa9, 0x89, 0xc5, 0xa9, 0x42, 0x12, 0x5c,
-	0xec, 0xc9, 0x45, 0xa9, 0x89, 0x25, 0xf9, 0x45, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x30,
-	0xae, 0x90, 0x14, 0x17, 0x47, 0x41, 0x7e, 0xb1, 0x67, 0x5e, 0x4a, 0x6a, 0x85, 0x04, 0x93, 0x02,
-	0xa3, 0x06, 0x4b, 0x10, 0x9c, 0xaf, 0x14, 0xc3, 0x25, 0x84, 0x30, 0x23, 0x28, 0xb5, 0xb8, 0x20,
-	0x3f, 0xaf, 0x38, 0x55, 0x48, 0x89, 0x8b, 0xa7, 0x20, 0xb5, 0x28, 0x33, 0x3f, 0x25, 0x24, 0x3f,
-	0x2c, 0xb5, 0xb8, 0x04, 0x6c, 0x20, 0x4b, 0x10, 0x8a, 0x18, 0x48, 0x4d, 0x62, 0x6e, 0x7e, 0x69,
-	0x5e, 0x09, 0x54, 0x0d, 0x13, 0xd8, 0x52, 0x14, 0x31, 0xa3, 0x38, 0x2e, 0x66, 0xdf, 0xe2, 0x74,
-	0xa1, 0x70, 0x2e, 0x76, 0x98, 0x2b, 0x15, 0xf5, 0xb0, 0xfa, 0x45, 0x0f, 0xe1, 0x08, 0x29, 0x4d,
-	0x82, 0x4a, 0x60, 0xee, 0x74, 0x32, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0x59, 0x44, 0x90, 0x56, 0xa0, 0x06, 0x6a, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x60,
-	0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x10, 0x5b, 0xbe, 0x32, 0x7a, 0x01, 0x00, 0x00,
+func (m *MsgReleaseAll) Reset()         { *m = MsgReleaseAll{} }
+func (m *MsgReleaseAll) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseAll) ProtoMessage()    {}
+func (*MsgReleaseAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{2}
+}
+func (m *MsgReleaseAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseAll.Merge(m, src)
+}
+func (m *MsgReleaseAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseAll.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgReleaseAll proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgReleaseAll) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Release(ctx context.Context, in *MsgRelease, opts ...grpc.CallOption) (*MsgReleaseResponse, error)
+// PositionRelease is what has been released from one position
+type PositionRelease struct {
+	PositionId   uint64 `protobuf:"varint,1,opt,name=positionId,proto3" json:"positionId,omitempty"`
+	PeriodToVest uint64 `protobuf:"varint,2,opt,name=periodToVest,proto3" json:"periodToVest,omitempty"`
+	AmountToVest string `protobuf:"bytes,3,opt,name=amountToVest,proto3" json:"amountToVest,omitempty"`
+	FeeDeducted  string `protobuf:"bytes,4,opt,name=feeDeducted,proto3" json:"feeDeducted,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *PositionRelease) Reset()         { *m = PositionRelease{} }
+func (m *PositionRelease) String() string { return proto.CompactTextString(m) }
+func (*PositionRelease) ProtoMessage()    {}
+func (*PositionRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{3}
+}
+func (m *PositionRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionRelease.Merge(m, src)
+}
+func (m *PositionRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionRelease.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_PositionRelease proto.InternalMessageInfo
+
+func (m *PositionRelease) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
 }
 
-func (c *msgClient) Release(ctx context.Context, in *MsgRelease, opts ...grpc.CallOption) (*MsgReleaseResponse, error) {
-	out := new(MsgReleaseResponse)
-	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/Release", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PositionRelease) GetPeriodToVest() uint64 {
+	if m != nil {
+		return m.PeriodToVest
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Release(context.Context, *MsgRelease) (*MsgReleaseResponse, error)
+func (m *PositionRelease) GetAmountToVest() string {
+	if m != nil {
+		return m.AmountToVest
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *PositionRelease) GetFeeDeducted() string {
+	if m != nil {
+		return m.FeeDeducted
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) Release(ctx context.Context, req *MsgRelease) (*MsgReleaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+type MsgReleaseAllResponse struct {
+	// positions that released tokens, the others had nothing vested
+	Releases     []PositionRelease `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases"`
+	AmountToVest string            `protobuf:"bytes,2,opt,name=amountToVest,proto3" json:"amountToVest,omitempty"`
+	FeeDeducted  string            `protobuf:"bytes,3,opt,name=feeDeducted,proto3" json:"feeDeducted,omitempty"`
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgReleaseAllResponse) Reset()         { *m = MsgReleaseAllResponse{} }
+func (m *MsgReleaseAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseAllResponse) ProtoMessage()    {}
+func (*MsgReleaseAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{4}
+}
+func (m *MsgReleaseAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseAllResponse.Merge(m, src)
+}
+func (m *MsgReleaseAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseAllResponse.DiscardUnknown(m)
 }
 
-func _Msg_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRelease)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReleaseAllResponse proto.InternalMessageInfo
+
+func (m *MsgReleaseAllResponse) GetReleases() []PositionRelease {
+	if m != nil {
+		return m.Releases
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Release(ctx, in)
+	return nil
+}
+
+func (m *MsgReleaseAllResponse) GetAmountToVest() string {
+	if m != nil {
+		return m.AmountToVest
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/selfchain.selfvesting.Msg/Release",
+	return ""
+}
+
+func (m *MsgReleaseAllResponse) GetFeeDeducted() string {
+	if m != nil {
+		return m.FeeDeducted
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Release(ctx, req.(*MsgRelease))
+	return ""
+}
+
+type MsgCreateVestingPosition struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Beneficiary string `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Amount      string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// seconds from the start of the vesting to the cliff
+	Cliff    uint64 `protobuf:"varint,4,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// unix time at which the vesting starts, the block time when unset
+	StartTime uint64 `protobuf:"varint,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// whether the creator can take back the unvested part of the position
+	Revocable  bool            `protobuf:"varint,7,opt,name=revocable,proto3" json:"revocable,omitempty"`
+	Curve      VestingCurve    `protobuf:"varint,8,opt,name=curve,proto3,enum=selfchain.selfvesting.VestingCurve" json:"curve,omitempty"`
+	StepPeriod uint64          `protobuf:"varint,9,opt,name=stepPeriod,proto3" json:"stepPeriod,omitempty"`
+	Periods    []VestingPeriod `protobuf:"bytes,10,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *MsgCreateVestingPosition) Reset()         { *m = MsgCreateVestingPosition{} }
+func (m *MsgCreateVestingPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingPosition) ProtoMessage()    {}
+func (*MsgCreateVestingPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{5}
+}
+func (m *MsgCreateVestingPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCreateVestingPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingPosition.Merge(m, src)
+}
+func (m *MsgCreateVestingPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingPosition.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "selfchain.selfvesting.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Release",
-			Handler:    _Msg_Release_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "selfchain/selfvesting/tx.proto",
+var xxx_messageInfo_MsgCreateVestingPosition proto.InternalMessageInfo
+
+func (m *MsgCreateVestingPosition) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgRelease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateVestingPosition) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRelease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateVestingPosition) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func (m *MsgRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PosIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PosIndex))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgCreateVestingPosition) GetCliff() uint64 {
+	if m != nil {
+		return m.Cliff
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgCreateVestingPosition) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgReleaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateVestingPosition) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateVestingPosition) GetRevocable() bool {
+	if m != nil {
+		return m.Revocable
+	}
+	return false
 }
 
-func (m *MsgReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AmountToVest) > 0 {
-		i -= len(m.AmountToVest)
-		copy(dAtA[i:], m.AmountToVest)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountToVest)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgCreateVestingPosition) GetCurve() VestingCurve {
+	if m != nil {
+		return m.Curve
 	}
-	if m.PeriodToVest != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PeriodToVest))
-		i--
-		dAtA[i] = 0x8
+	return VestingCurve_VESTING_CURVE_LINEAR
+}
+
+func (m *MsgCreateVestingPosition) GetStepPeriod() uint64 {
+	if m != nil {
+		return m.StepPeriod
+	}
+	return 0
+}
+
+func (m *MsgCreateVestingPosition) GetPeriods() []VestingPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+type MsgCreateVestingPositionResponse struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=positionId,proto3" json:"positionId,omitempty"`
+}
+
+func (m *MsgCreateVestingPositionResponse) Reset()         { *m = MsgCreateVestingPositionResponse{} }
+func (m *MsgCreateVestingPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingPositionResponse) ProtoMessage()    {}
+func (*MsgCreateVestingPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{6}
+}
+func (m *MsgCreateVestingPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateVestingPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingPositionResponse.Merge(m, src)
+}
+func (m *MsgCreateVestingPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateVestingPositionResponse proto.InternalMessageInfo
+
+func (m *MsgCreateVestingPositionResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type MsgRevokePosition struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=positionId,proto3" json:"positionId,omitempty"`
+}
+
+func (m *MsgRevokePosition) Reset()         { *m = MsgRevokePosition{} }
+func (m *MsgRevokePosition) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePosition) ProtoMessage()    {}
+func (*MsgRevokePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{7}
+}
+func (m *MsgRevokePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePosition.Merge(m, src)
+}
+func (m *MsgRevokePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokePosition proto.InternalMessageInfo
+
+func (m *MsgRevokePosition) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevokePosition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type MsgRevokePositionResponse struct {
+	// unvested amount returned to the funder
+	AmountReturned string `protobuf:"bytes,1,opt,name=amountReturned,proto3" json:"amountReturned,omitempty"`
+}
+
+func (m *MsgRevokePositionResponse) Reset()         { *m = MsgRevokePositionResponse{} }
+func (m *MsgRevokePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePositionResponse) ProtoMessage()    {}
+func (*MsgRevokePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{8}
+}
+func (m *MsgRevokePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePositionResponse.Merge(m, src)
+}
+func (m *MsgRevokePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokePositionResponse proto.InternalMessageInfo
+
+func (m *MsgRevokePositionResponse) GetAmountReturned() string {
+	if m != nil {
+		return m.AmountReturned
+	}
+	return ""
+}
+
+// MsgCreateGrant pays a grant out of the community pool as a vesting position of the grantee. It can only be
+// executed by the governance authority.
+type MsgCreateGrant struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Grantee   string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// seconds from the start of the vesting to the cliff
+	Cliff    uint64 `protobuf:"varint,4,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// unix time at which the vesting starts, the block time when unset
+	StartTime uint64 `protobuf:"varint,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+}
+
+func (m *MsgCreateGrant) Reset()         { *m = MsgCreateGrant{} }
+func (m *MsgCreateGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGrant) ProtoMessage()    {}
+func (*MsgCreateGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{9}
+}
+func (m *MsgCreateGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGrant.Merge(m, src)
+}
+func (m *MsgCreateGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGrant proto.InternalMessageInfo
+
+func (m *MsgCreateGrant) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgCreateGrant) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *MsgCreateGrant) GetCliff() uint64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *MsgCreateGrant) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MsgCreateGrant) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+type MsgCreateGrantResponse struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=positionId,proto3" json:"positionId,omitempty"`
+}
+
+func (m *MsgCreateGrantResponse) Reset()         { *m = MsgCreateGrantResponse{} }
+func (m *MsgCreateGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGrantResponse) ProtoMessage()    {}
+func (*MsgCreateGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{10}
+}
+func (m *MsgCreateGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGrantResponse.Merge(m, src)
+}
+func (m *MsgCreateGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGrantResponse proto.InternalMessageInfo
+
+func (m *MsgCreateGrantResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+// MsgClawbackGrant returns the unvested part of a grant to the community pool. It can only be executed by
+// the governance authority.
+type MsgClawbackGrant struct {
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=positionId,proto3" json:"positionId,omitempty"`
+}
+
+func (m *MsgClawbackGrant) Reset()         { *m = MsgClawbackGrant{} }
+func (m *MsgClawbackGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackGrant) ProtoMessage()    {}
+func (*MsgClawbackGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{11}
+}
+func (m *MsgClawbackGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackGrant.Merge(m, src)
+}
+func (m *MsgClawbackGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackGrant proto.InternalMessageInfo
+
+func (m *MsgClawbackGrant) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgClawbackGrant) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type MsgClawbackGrantResponse struct {
+	// unvested amount returned to the community pool
+	AmountReturned string `protobuf:"bytes,1,opt,name=amountReturned,proto3" json:"amountReturned,omitempty"`
+}
+
+func (m *MsgClawbackGrantResponse) Reset()         { *m = MsgClawbackGrantResponse{} }
+func (m *MsgClawbackGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackGrantResponse) ProtoMessage()    {}
+func (*MsgClawbackGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{12}
+}
+func (m *MsgClawbackGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackGrantResponse.Merge(m, src)
+}
+func (m *MsgClawbackGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackGrantResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackGrantResponse) GetAmountReturned() string {
+	if m != nil {
+		return m.AmountReturned
+	}
+	return ""
+}
+
+// MsgTransferPosition moves a position, with what has been claimed from it, from its beneficiary to the
+// recipient. A transfer that requires acceptance waits for the recipient to accept it with MsgAcceptPosition.
+// It replaces any transfer of the position that was waiting.
+type MsgTransferPosition struct {
+	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PositionId        uint64 `protobuf:"varint,2,opt,name=positionId,proto3" json:"positionId,omitempty"`
+	Recipient         string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	RequireAcceptance bool   `protobuf:"varint,4,opt,name=requireAcceptance,proto3" json:"requireAcceptance,omitempty"`
+}
+
+func (m *MsgTransferPosition) Reset()         { *m = MsgTransferPosition{} }
+func (m *MsgTransferPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPosition) ProtoMessage()    {}
+func (*MsgTransferPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{13}
+}
+func (m *MsgTransferPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPosition.Merge(m, src)
+}
+func (m *MsgTransferPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferPosition proto.InternalMessageInfo
+
+func (m *MsgTransferPosition) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferPosition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgTransferPosition) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgTransferPosition) GetRequireAcceptance() bool {
+	if m != nil {
+		return m.RequireAcceptance
+	}
+	return false
+}
+
+type MsgTransferPositionResponse struct {
+	// whether the transfer waits for the recipient to accept it
+	Pending bool `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *MsgTransferPositionResponse) Reset()         { *m = MsgTransferPositionResponse{} }
+func (m *MsgTransferPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPositionResponse) ProtoMessage()    {}
+func (*MsgTransferPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{14}
+}
+func (m *MsgTransferPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPositionResponse.Merge(m, src)
+}
+func (m *MsgTransferPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferPositionResponse proto.InternalMessageInfo
+
+func (m *MsgTransferPositionResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+// MsgAcceptPosition completes a transfer of a position offered to the creator
+type MsgAcceptPosition struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=positionId,proto3" json:"positionId,omitempty"`
+}
+
+func (m *MsgAcceptPosition) Reset()         { *m = MsgAcceptPosition{} }
+func (m *MsgAcceptPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPosition) ProtoMessage()    {}
+func (*MsgAcceptPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{15}
+}
+func (m *MsgAcceptPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPosition.Merge(m, src)
+}
+func (m *MsgAcceptPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptPosition proto.InternalMessageInfo
+
+func (m *MsgAcceptPosition) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAcceptPosition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type MsgAcceptPositionResponse struct {
+}
+
+func (m *MsgAcceptPositionResponse) Reset()         { *m = MsgAcceptPositionResponse{} }
+func (m *MsgAcceptPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPositionResponse) ProtoMessage()    {}
+func (*MsgAcceptPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{16}
+}
+func (m *MsgAcceptPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPositionResponse.Merge(m, src)
+}
+func (m *MsgAcceptPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptPositionResponse proto.InternalMessageInfo
+
+// MsgCancelTransfer withdraws a transfer of a position that the recipient has not accepted yet. The beneficiary
+// can withdraw it and the recipient can decline it.
+type MsgCancelTransfer struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=positionId,proto3" json:"positionId,omitempty"`
+}
+
+func (m *MsgCancelTransfer) Reset()         { *m = MsgCancelTransfer{} }
+func (m *MsgCancelTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTransfer) ProtoMessage()    {}
+func (*MsgCancelTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{17}
+}
+func (m *MsgCancelTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTransfer.Merge(m, src)
+}
+func (m *MsgCancelTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelTransfer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelTransfer) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type MsgCancelTransferResponse struct {
+}
+
+func (m *MsgCancelTransferResponse) Reset()         { *m = MsgCancelTransferResponse{} }
+func (m *MsgCancelTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTransferResponse) ProtoMessage()    {}
+func (*MsgCancelTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{18}
+}
+func (m *MsgCancelTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTransferResponse proto.InternalMessageInfo
+
+// MsgSplitPosition carves an amount out of a position into a new position with the same schedule. What has
+// been claimed is shared between both so that together they release exactly what the position would have.
+type MsgSplitPosition struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=positionId,proto3" json:"positionId,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// account the new position is transferred to, the creator when empty
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgSplitPosition) Reset()         { *m = MsgSplitPosition{} }
+func (m *MsgSplitPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitPosition) ProtoMessage()    {}
+func (*MsgSplitPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{19}
+}
+func (m *MsgSplitPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitPosition.Merge(m, src)
+}
+func (m *MsgSplitPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitPosition proto.InternalMessageInfo
+
+func (m *MsgSplitPosition) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSplitPosition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgSplitPosition) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *MsgSplitPosition) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type MsgSplitPositionResponse struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=positionId,proto3" json:"positionId,omitempty"`
+}
+
+func (m *MsgSplitPositionResponse) Reset()         { *m = MsgSplitPositionResponse{} }
+func (m *MsgSplitPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitPositionResponse) ProtoMessage()    {}
+func (*MsgSplitPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{20}
+}
+func (m *MsgSplitPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitPositionResponse.Merge(m, src)
+}
+func (m *MsgSplitPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitPositionResponse proto.InternalMessageInfo
+
+func (m *MsgSplitPositionResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+// MsgMergePositions combines positions with the same schedule into the first of them
+type MsgMergePositions struct {
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PositionIds []uint64 `protobuf:"varint,2,rep,packed,name=positionIds,proto3" json:"positionIds,omitempty"`
+}
+
+func (m *MsgMergePositions) Reset()         { *m = MsgMergePositions{} }
+func (m *MsgMergePositions) String() string { return proto.CompactTextString(m) }
+func (*MsgMergePositions) ProtoMessage()    {}
+func (*MsgMergePositions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{21}
+}
+func (m *MsgMergePositions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergePositions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergePositions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergePositions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergePositions.Merge(m, src)
+}
+func (m *MsgMergePositions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergePositions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergePositions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergePositions proto.InternalMessageInfo
+
+func (m *MsgMergePositions) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgMergePositions) GetPositionIds() []uint64 {
+	if m != nil {
+		return m.PositionIds
+	}
+	return nil
+}
+
+type MsgMergePositionsResponse struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=positionId,proto3" json:"positionId,omitempty"`
+}
+
+func (m *MsgMergePositionsResponse) Reset()         { *m = MsgMergePositionsResponse{} }
+func (m *MsgMergePositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMergePositionsResponse) ProtoMessage()    {}
+func (*MsgMergePositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{22}
+}
+func (m *MsgMergePositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergePositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergePositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergePositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergePositionsResponse.Merge(m, src)
+}
+func (m *MsgMergePositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergePositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergePositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergePositionsResponse proto.InternalMessageInfo
+
+func (m *MsgMergePositionsResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+// MsgSetEarlyUnlockConfig sets the penalty of early unlocks. It can only be executed by the governance
+// authority.
+type MsgSetEarlyUnlockConfig struct {
+	Authority               string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	StartPenaltyBasisPoints uint64 `protobuf:"varint,2,opt,name=startPenaltyBasisPoints,proto3" json:"startPenaltyBasisPoints,omitempty"`
+	EndPenaltyBasisPoints   uint64 `protobuf:"varint,3,opt,name=endPenaltyBasisPoints,proto3" json:"endPenaltyBasisPoints,omitempty"`
+	BurnPenalty             bool   `protobuf:"varint,4,opt,name=burnPenalty,proto3" json:"burnPenalty,omitempty"`
+}
+
+func (m *MsgSetEarlyUnlockConfig) Reset()         { *m = MsgSetEarlyUnlockConfig{} }
+func (m *MsgSetEarlyUnlockConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEarlyUnlockConfig) ProtoMessage()    {}
+func (*MsgSetEarlyUnlockConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{23}
+}
+func (m *MsgSetEarlyUnlockConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEarlyUnlockConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEarlyUnlockConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEarlyUnlockConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEarlyUnlockConfig.Merge(m, src)
+}
+func (m *MsgSetEarlyUnlockConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEarlyUnlockConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEarlyUnlockConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEarlyUnlockConfig proto.InternalMessageInfo
+
+func (m *MsgSetEarlyUnlockConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetEarlyUnlockConfig) GetStartPenaltyBasisPoints() uint64 {
+	if m != nil {
+		return m.StartPenaltyBasisPoints
+	}
+	return 0
+}
+
+func (m *MsgSetEarlyUnlockConfig) GetEndPenaltyBasisPoints() uint64 {
+	if m != nil {
+		return m.EndPenaltyBasisPoints
+	}
+	return 0
+}
+
+func (m *MsgSetEarlyUnlockConfig) GetBurnPenalty() bool {
+	if m != nil {
+		return m.BurnPenalty
+	}
+	return false
+}
+
+type MsgSetEarlyUnlockConfigResponse struct {
+}
+
+func (m *MsgSetEarlyUnlockConfigResponse) Reset()         { *m = MsgSetEarlyUnlockConfigResponse{} }
+func (m *MsgSetEarlyUnlockConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEarlyUnlockConfigResponse) ProtoMessage()    {}
+func (*MsgSetEarlyUnlockConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{24}
+}
+func (m *MsgSetEarlyUnlockConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEarlyUnlockConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEarlyUnlockConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEarlyUnlockConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEarlyUnlockConfigResponse.Merge(m, src)
+}
+func (m *MsgSetEarlyUnlockConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEarlyUnlockConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEarlyUnlockConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEarlyUnlockConfigResponse proto.InternalMessageInfo
+
+// MsgEarlyUnlock releases what has vested from a position together with part of what has not vested yet,
+// minus a penalty on the unvested part. The unlocked tokens are the next ones to vest, so that the position
+// releases nothing more until its vesting catches up with them.
+type MsgEarlyUnlock struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PositionId uint64 `protobuf:"varint,2,opt,name=positionId,proto3" json:"positionId,omitempty"`
+	// unvested amount to unlock, all of it when empty
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgEarlyUnlock) Reset()         { *m = MsgEarlyUnlock{} }
+func (m *MsgEarlyUnlock) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyUnlock) ProtoMessage()    {}
+func (*MsgEarlyUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{25}
+}
+func (m *MsgEarlyUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEarlyUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEarlyUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEarlyUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyUnlock.Merge(m, src)
+}
+func (m *MsgEarlyUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEarlyUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEarlyUnlock proto.InternalMessageInfo
+
+func (m *MsgEarlyUnlock) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgEarlyUnlock) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgEarlyUnlock) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type MsgEarlyUnlockResponse struct {
+	// vested amount released without penalty
+	Released string `protobuf:"bytes,1,opt,name=released,proto3" json:"released,omitempty"`
+	// unvested amount unlocked
+	Unlocked string `protobuf:"bytes,2,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	Penalty  string `protobuf:"bytes,3,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	// part of what is paid out that pays back the fees the module paid for the beneficiary
+	FeeDeducted string `protobuf:"bytes,4,opt,name=feeDeducted,proto3" json:"feeDeducted,omitempty"`
+	// amount received by the beneficiary
+	NetAmount string `protobuf:"bytes,5,opt,name=netAmount,proto3" json:"netAmount,omitempty"`
+}
+
+func (m *MsgEarlyUnlockResponse) Reset()         { *m = MsgEarlyUnlockResponse{} }
+func (m *MsgEarlyUnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyUnlockResponse) ProtoMessage()    {}
+func (*MsgEarlyUnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70a0f46b1e8b78ab, []int{26}
+}
+func (m *MsgEarlyUnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEarlyUnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEarlyUnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEarlyUnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyUnlockResponse.Merge(m, src)
+}
+func (m *MsgEarlyUnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEarlyUnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyUnlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEarlyUnlockResponse proto.InternalMessageInfo
+
+func (m *MsgEarlyUnlockResponse) GetReleased() string {
+	if m != nil {
+		return m.Released
+	}
+	return ""
+}
+
+func (m *MsgEarlyUnlockResponse) GetUnlocked() string {
+	if m != nil {
+		return m.Unlocked
+	}
+	return ""
+}
+
+func (m *MsgEarlyUnlockResponse) GetPenalty() string {
+	if m != nil {
+		return m.Penalty
+	}
+	return ""
+}
+
+func (m *MsgEarlyUnlockResponse) GetFeeDeducted() string {
+	if m != nil {
+		return m.FeeDeducted
+	}
+	return ""
+}
+
+func (m *MsgEarlyUnlockResponse) GetNetAmount() string {
+	if m != nil {
+		return m.NetAmount
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MsgRelease)(nil), "selfchain.selfvesting.MsgRelease")
+	proto.RegisterType((*MsgReleaseResponse)(nil), "selfchain.selfvesting.MsgReleaseResponse")
+	proto.RegisterType((*MsgReleaseAll)(nil), "selfchain.selfvesting.MsgReleaseAll")
+	proto.RegisterType((*PositionRelease)(nil), "selfchain.selfvesting.PositionRelease")
+	proto.RegisterType((*MsgReleaseAllResponse)(nil), "selfchain.selfvesting.MsgReleaseAllResponse")
+	proto.RegisterType((*MsgCreateVestingPosition)(nil), "selfchain.selfvesting.MsgCreateVestingPosition")
+	proto.RegisterType((*MsgCreateVestingPositionResponse)(nil), "selfchain.selfvesting.MsgCreateVestingPositionResponse")
+	proto.RegisterType((*MsgRevokePosition)(nil), "selfchain.selfvesting.MsgRevokePosition")
+	proto.RegisterType((*MsgRevokePositionResponse)(nil), "selfchain.selfvesting.MsgRevokePositionResponse")
+	proto.RegisterType((*MsgCreateGrant)(nil), "selfchain.selfvesting.MsgCreateGrant")
+	proto.RegisterType((*MsgCreateGrantResponse)(nil), "selfchain.selfvesting.MsgCreateGrantResponse")
+	proto.RegisterType((*MsgClawbackGrant)(nil), "selfchain.selfvesting.MsgClawbackGrant")
+	proto.RegisterType((*MsgClawbackGrantResponse)(nil), "selfchain.selfvesting.MsgClawbackGrantResponse")
+	proto.RegisterType((*MsgTransferPosition)(nil), "selfchain.selfvesting.MsgTransferPosition")
+	proto.RegisterType((*MsgTransferPositionResponse)(nil), "selfchain.selfvesting.MsgTransferPositionResponse")
+	proto.RegisterType((*MsgAcceptPosition)(nil), "selfchain.selfvesting.MsgAcceptPosition")
+	proto.RegisterType((*MsgAcceptPositionResponse)(nil), "selfchain.selfvesting.MsgAcceptPositionResponse")
+	proto.RegisterType((*MsgCancelTransfer)(nil), "selfchain.selfvesting.MsgCancelTransfer")
+	proto.RegisterType((*MsgCancelTransferResponse)(nil), "selfchain.selfvesting.MsgCancelTransferResponse")
+	proto.RegisterType((*MsgSplitPosition)(nil), "selfchain.selfvesting.MsgSplitPosition")
+	proto.RegisterType((*MsgSplitPositionResponse)(nil), "selfchain.selfvesting.MsgSplitPositionResponse")
+	proto.RegisterType((*MsgMergePositions)(nil), "selfchain.selfvesting.MsgMergePositions")
+	proto.RegisterType((*MsgMergePositionsResponse)(nil), "selfchain.selfvesting.MsgMergePositionsResponse")
+	proto.RegisterType((*MsgSetEarlyUnlockConfig)(nil), "selfchain.selfvesting.MsgSetEarlyUnlockConfig")
+	proto.RegisterType((*MsgSetEarlyUnlockConfigResponse)(nil), "selfchain.selfvesting.MsgSetEarlyUnlockConfigResponse")
+	proto.RegisterType((*MsgEarlyUnlock)(nil), "selfchain.selfvesting.MsgEarlyUnlock")
+	proto.RegisterType((*MsgEarlyUnlockResponse)(nil), "selfchain.selfvesting.MsgEarlyUnlockResponse")
+}
+
+func init() { proto.RegisterFile("selfchain/selfvesting/tx.proto", fileDescriptor_70a0f46b1e8b78ab) }
+
+var fileDescriptor_70a0f46b1e8b78ab = []byte{
+	// 1136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x77, 0x37, 0xc9, 0xe6, 0x85, 0x86, 0xd4, 0x24, 0xa9, 0xe3, 0xc2, 0x76, 0x6b, 0x4a,
+	0xd9, 0xa2, 0x36, 0x1b, 0x05, 0x44, 0x0a, 0x9c, 0x92, 0x14, 0x41, 0x91, 0x56, 0x44, 0x26, 0x04,
+	0x89, 0x0b, 0x38, 0xde, 0xb7, 0xee, 0x28, 0xee, 0x78, 0x99, 0x99, 0x0d, 0xc9, 0xa5, 0x12, 0x7c,
+	0x82, 0xde, 0xfb, 0x01, 0x90, 0x38, 0xf1, 0x31, 0xca, 0xad, 0x47, 0x4e, 0x08, 0x25, 0x9f, 0x03,
+	0x09, 0x79, 0x6c, 0xcf, 0xda, 0xde, 0x5d, 0xaf, 0xa3, 0x46, 0x9c, 0x76, 0xe7, 0xbd, 0xdf, 0xfb,
+	0xf3, 0x9b, 0xf7, 0x66, 0xde, 0xc8, 0xd0, 0xe0, 0xe8, 0xf7, 0xdc, 0x27, 0x0e, 0xa1, 0xed, 0xf0,
+	0xdf, 0x09, 0x72, 0x41, 0xa8, 0xd7, 0x16, 0xa7, 0x1b, 0x7d, 0x16, 0x88, 0x40, 0x5f, 0x55, 0xfa,
+	0x8d, 0x94, 0xde, 0x5c, 0xf1, 0x02, 0x2f, 0x90, 0x88, 0x76, 0xf8, 0x2f, 0x02, 0x9b, 0xad, 0xf1,
+	0xce, 0xe2, 0xdf, 0x1f, 0x08, 0xed, 0xc5, 0x48, 0xeb, 0x10, 0xa0, 0xc3, 0x3d, 0x1b, 0x7d, 0x74,
+	0x38, 0xea, 0x06, 0xcc, 0xbb, 0x0c, 0x1d, 0x11, 0x30, 0x43, 0x6b, 0x6a, 0xad, 0x05, 0x3b, 0x59,
+	0xea, 0x0d, 0x80, 0x7e, 0xc0, 0x89, 0x20, 0x01, 0x7d, 0xdc, 0x35, 0xaa, 0x4d, 0xad, 0x55, 0xb3,
+	0x53, 0x92, 0xaf, 0x6a, 0xf5, 0xca, 0x72, 0xd5, 0xae, 0xf7, 0x03, 0xfe, 0x98, 0x76, 0xf1, 0xd4,
+	0x7a, 0x06, 0xfa, 0xd0, 0xaf, 0x8d, 0xbc, 0x1f, 0x50, 0x8e, 0xba, 0x05, 0x6f, 0xf4, 0x91, 0x91,
+	0xa0, 0x7b, 0x10, 0x1c, 0x22, 0x17, 0x32, 0x48, 0xcd, 0xce, 0xc8, 0x42, 0x8c, 0xf3, 0x34, 0x18,
+	0x50, 0x11, 0x63, 0x2a, 0x32, 0x91, 0x8c, 0x4c, 0x6f, 0xc2, 0x62, 0x0f, 0xf1, 0x11, 0x76, 0x07,
+	0xae, 0xc0, 0x28, 0x9d, 0x05, 0x3b, 0x2d, 0xb2, 0xee, 0xc1, 0xb5, 0x61, 0xfc, 0x1d, 0xdf, 0x9f,
+	0x4c, 0xcd, 0x7a, 0xa1, 0xc1, 0x9b, 0xfb, 0x31, 0x93, 0x64, 0x23, 0xb2, 0x74, 0xb5, 0x3c, 0xdd,
+	0x11, 0x22, 0x95, 0x12, 0x44, 0xaa, 0xd3, 0x89, 0xd4, 0x46, 0x89, 0xfc, 0xa6, 0xc1, 0x6a, 0x86,
+	0x89, 0xda, 0xcc, 0x2f, 0xa1, 0xce, 0x22, 0x29, 0x37, 0xb4, 0x66, 0xb5, 0xb5, 0xb8, 0x75, 0x77,
+	0x63, 0x6c, 0x93, 0x6c, 0xe4, 0xd8, 0xed, 0xd6, 0x5e, 0xfe, 0x7d, 0x6b, 0xc6, 0x56, 0xd6, 0x57,
+	0xb4, 0xe5, 0xff, 0x56, 0xc0, 0xe8, 0x70, 0x6f, 0x2f, 0xdc, 0x56, 0x3c, 0x8c, 0x42, 0x27, 0x91,
+	0x0b, 0x3a, 0xab, 0x09, 0x8b, 0x47, 0x48, 0xb1, 0x47, 0x5c, 0xe2, 0xb0, 0xb3, 0x38, 0x76, 0x5a,
+	0xa4, 0xaf, 0xc1, 0x5c, 0x94, 0x4a, 0x1c, 0x35, 0x5e, 0xe9, 0x2b, 0x30, 0xeb, 0xfa, 0xa4, 0xd7,
+	0x93, 0xdb, 0x56, 0xb3, 0xa3, 0x85, 0x6e, 0x42, 0xbd, 0x3b, 0x60, 0x4e, 0x18, 0xd5, 0x98, 0x95,
+	0x0a, 0xb5, 0xd6, 0xdf, 0x86, 0x05, 0x2e, 0x1c, 0x26, 0x0e, 0xc8, 0x53, 0x34, 0xe6, 0xa4, 0x72,
+	0x28, 0x08, 0xb5, 0x0c, 0x4f, 0x02, 0xd7, 0x39, 0xf2, 0xd1, 0x98, 0x6f, 0x6a, 0xad, 0xba, 0x3d,
+	0x14, 0xe8, 0x9f, 0xc0, 0xac, 0x3b, 0x60, 0x27, 0x68, 0xd4, 0x9b, 0x5a, 0x6b, 0x69, 0xeb, 0xdd,
+	0x09, 0x7b, 0x1d, 0x13, 0xdf, 0x0b, 0xa1, 0x76, 0x64, 0x11, 0x76, 0x13, 0x17, 0xd8, 0xdf, 0x97,
+	0xdd, 0x61, 0x2c, 0x44, 0xdd, 0x34, 0x94, 0xe8, 0x8f, 0x60, 0x3e, 0xea, 0x1c, 0x6e, 0x80, 0x2c,
+	0xe4, 0x9d, 0x62, 0xe7, 0x91, 0x59, 0x5c, 0xc6, 0xc4, 0xd4, 0xda, 0x85, 0xe6, 0xa4, 0xed, 0x57,
+	0x3d, 0x33, 0xa5, 0xaf, 0xad, 0x0e, 0x5c, 0x97, 0xcd, 0x76, 0x12, 0x1c, 0x63, 0x89, 0xda, 0x65,
+	0xdd, 0x55, 0x46, 0xdc, 0xed, 0xc1, 0xfa, 0x88, 0x3b, 0x95, 0xcb, 0x5d, 0x58, 0x8a, 0x0a, 0x69,
+	0xa3, 0x18, 0x30, 0x8a, 0xdd, 0xd8, 0x7b, 0x4e, 0x6a, 0xfd, 0xa1, 0xc1, 0x92, 0x22, 0xf6, 0x05,
+	0x73, 0xa8, 0x08, 0x2b, 0xe5, 0x0c, 0xc4, 0x93, 0x80, 0x11, 0x71, 0x16, 0x5b, 0x0d, 0x05, 0x61,
+	0xbe, 0x5e, 0x08, 0x43, 0x8c, 0xbb, 0x29, 0x59, 0xfe, 0x5f, 0x9d, 0x64, 0x3d, 0x84, 0xb5, 0x6c,
+	0xc6, 0xa5, 0x0b, 0xb0, 0x0f, 0xcb, 0xa1, 0xa5, 0xef, 0xfc, 0x7c, 0xe4, 0xb8, 0xc7, 0x65, 0xd8,
+	0x4e, 0xab, 0xc1, 0x2e, 0x18, 0x79, 0x8f, 0x97, 0x2e, 0xc1, 0x0b, 0x0d, 0xde, 0xea, 0x70, 0xef,
+	0x80, 0x39, 0x94, 0xf7, 0x90, 0xbd, 0x7e, 0x67, 0x44, 0x67, 0xcd, 0x25, 0x7d, 0x82, 0xaa, 0x18,
+	0x43, 0x81, 0x7e, 0x1f, 0xae, 0x33, 0xfc, 0x69, 0x40, 0x18, 0xee, 0xb8, 0x2e, 0xf6, 0x85, 0x43,
+	0x5d, 0x94, 0xb5, 0xa9, 0xdb, 0xa3, 0x0a, 0x6b, 0x1b, 0x6e, 0x8e, 0x49, 0x4e, 0x91, 0x34, 0xc2,
+	0xd3, 0x45, 0xbb, 0x84, 0x7a, 0x32, 0xc9, 0xba, 0x9d, 0x2c, 0xe3, 0x6e, 0x8f, 0x3c, 0x5d, 0x41,
+	0xb7, 0xdf, 0x84, 0xf5, 0x11, 0x77, 0x49, 0x16, 0x71, 0xac, 0xbd, 0x30, 0x61, 0x3f, 0x49, 0xf5,
+	0xb5, 0x63, 0x65, 0xdd, 0xa9, 0x58, 0xbf, 0x6a, 0xb2, 0x8b, 0xbe, 0xe9, 0xfb, 0xe4, 0x0a, 0x78,
+	0x4d, 0x3c, 0x35, 0x99, 0x1a, 0xd6, 0x72, 0x35, 0xb4, 0x3e, 0x05, 0x23, 0x9f, 0x43, 0xe9, 0x53,
+	0xf0, 0xb5, 0xdc, 0xac, 0x0e, 0x32, 0x4f, 0x5d, 0x1b, 0xbc, 0x78, 0x84, 0x0c, 0x8d, 0xb9, 0x51,
+	0x69, 0x56, 0x5b, 0x35, 0x3b, 0x2d, 0xb2, 0x3e, 0x83, 0xf5, 0x11, 0x87, 0xa5, 0xb3, 0xf9, 0x53,
+	0x83, 0x1b, 0x21, 0x15, 0x14, 0x9f, 0x3b, 0xcc, 0x3f, 0xfb, 0x96, 0xfa, 0x81, 0x7b, 0xbc, 0x17,
+	0xd0, 0x1e, 0xf1, 0xa6, 0x9c, 0xcd, 0x87, 0x70, 0x43, 0x5e, 0x0a, 0xfb, 0x48, 0x1d, 0x5f, 0x9c,
+	0xed, 0x3a, 0x9c, 0xf0, 0xfd, 0x80, 0x50, 0xc1, 0xe3, 0x6d, 0x9e, 0xa4, 0xd6, 0x3f, 0x82, 0x55,
+	0xa4, 0xdd, 0x31, 0x76, 0xd1, 0xd3, 0x6b, 0xbc, 0x52, 0xce, 0xd2, 0x01, 0xa3, 0xb1, 0x26, 0x3e,
+	0x31, 0x69, 0x91, 0x75, 0x1b, 0x6e, 0x4d, 0xa0, 0xa2, 0xba, 0xe7, 0x48, 0x5e, 0xb7, 0x29, 0xfd,
+	0xd5, 0xb7, 0x8e, 0xf5, 0xbb, 0x06, 0x6b, 0xd9, 0x20, 0xaa, 0x1a, 0xa6, 0x7a, 0xd6, 0x24, 0xb7,
+	0x91, 0x5a, 0x87, 0xba, 0x81, 0x44, 0x63, 0x37, 0xbe, 0xda, 0xd5, 0x3a, 0x3e, 0xe6, 0x92, 0x77,
+	0x14, 0x2b, 0x59, 0x4e, 0x7f, 0x64, 0x85, 0x55, 0xa4, 0x28, 0x76, 0xa2, 0x4c, 0x67, 0xa3, 0x2a,
+	0x2a, 0xc1, 0xd6, 0xf3, 0x45, 0xa8, 0x76, 0xb8, 0xa7, 0x7f, 0x07, 0xf3, 0xc9, 0xfb, 0xf0, 0xf6,
+	0x84, 0x01, 0x3d, 0x7c, 0xa9, 0x99, 0xf7, 0xa6, 0x42, 0x14, 0xe5, 0x1f, 0x01, 0x52, 0x2f, 0xd5,
+	0x3b, 0x53, 0x0d, 0x77, 0x7c, 0xdf, 0xbc, 0x5f, 0x06, 0xa5, 0x22, 0xfc, 0xa2, 0xc1, 0xea, 0xf8,
+	0x87, 0x59, 0x7b, 0xb2, 0x9f, 0xb1, 0x06, 0xe6, 0xf6, 0x25, 0x0d, 0x54, 0x0e, 0x3e, 0x2c, 0xe5,
+	0x1e, 0x16, 0xad, 0x22, 0x0e, 0x69, 0xa4, 0xb9, 0x59, 0x16, 0xa9, 0xa2, 0xb9, 0xb0, 0x98, 0x7e,
+	0x31, 0xbc, 0x37, 0x2d, 0x6b, 0x09, 0x33, 0x1f, 0x94, 0x82, 0xa9, 0x20, 0x04, 0xae, 0x65, 0x47,
+	0xf5, 0xfb, 0x05, 0xf6, 0x69, 0xa0, 0xd9, 0x2e, 0x09, 0x54, 0xa1, 0x18, 0x2c, 0x8f, 0x8c, 0xdf,
+	0x0f, 0x26, 0x3b, 0xc9, 0x63, 0xcd, 0xad, 0xf2, 0xd8, 0x74, 0xc5, 0x72, 0xc3, 0xb1, 0xa0, 0x62,
+	0x59, 0xa4, 0xb9, 0x59, 0x16, 0x99, 0x8e, 0x96, 0x1b, 0x8f, 0x05, 0xd1, 0xb2, 0x48, 0x73, 0xb3,
+	0x2c, 0x32, 0x5d, 0xba, 0xec, 0x7c, 0x2c, 0x28, 0x5d, 0x06, 0x68, 0xb6, 0x4b, 0x02, 0xd3, 0xc4,
+	0x72, 0xa3, 0xac, 0x80, 0x58, 0x16, 0x69, 0x6e, 0x96, 0x45, 0xaa, 0x68, 0xcf, 0x60, 0x65, 0xec,
+	0xa4, 0xda, 0x28, 0x48, 0x7b, 0x0c, 0xde, 0xfc, 0xf8, 0x72, 0xf8, 0xf4, 0xc1, 0x4b, 0x29, 0x8b,
+	0x0e, 0x5e, 0x0a, 0x66, 0x3e, 0x28, 0x05, 0x4b, 0x82, 0xec, 0x6e, 0xbf, 0x3c, 0x6f, 0x68, 0xaf,
+	0xce, 0x1b, 0xda, 0x3f, 0xe7, 0x0d, 0xed, 0xf9, 0x45, 0x63, 0xe6, 0xd5, 0x45, 0x63, 0xe6, 0xaf,
+	0x8b, 0xc6, 0xcc, 0xf7, 0xef, 0x0c, 0x3f, 0x7d, 0x9c, 0x66, 0xbf, 0xa4, 0x9c, 0xf5, 0x91, 0x1f,
+	0xcd, 0xc9, 0xcf, 0x1e, 0x1f, 0xfe, 0x37, 0x00, 0x31, 0x0a, 0xc0, 0xdb, 0x6f, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Release(ctx context.Context, in *MsgRelease, opts ...grpc.CallOption) (*MsgReleaseResponse, error)
+	ReleaseAll(ctx context.Context, in *MsgReleaseAll, opts ...grpc.CallOption) (*MsgReleaseAllResponse, error)
+	CreateVestingPosition(ctx context.Context, in *MsgCreateVestingPosition, opts ...grpc.CallOption) (*MsgCreateVestingPositionResponse, error)
+	RevokePosition(ctx context.Context, in *MsgRevokePosition, opts ...grpc.CallOption) (*MsgRevokePositionResponse, error)
+	CreateGrant(ctx context.Context, in *MsgCreateGrant, opts ...grpc.CallOption) (*MsgCreateGrantResponse, error)
+	ClawbackGrant(ctx context.Context, in *MsgClawbackGrant, opts ...grpc.CallOption) (*MsgClawbackGrantResponse, error)
+	TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error)
+	AcceptPosition(ctx context.Context, in *MsgAcceptPosition, opts ...grpc.CallOption) (*MsgAcceptPositionResponse, error)
+	CancelTransfer(ctx context.Context, in *MsgCancelTransfer, opts ...grpc.CallOption) (*MsgCancelTransferResponse, error)
+	SplitPosition(ctx context.Context, in *MsgSplitPosition, opts ...grpc.CallOption) (*MsgSplitPositionResponse, error)
+	MergePositions(ctx context.Context, in *MsgMergePositions, opts ...grpc.CallOption) (*MsgMergePositionsResponse, error)
+	SetEarlyUnlockConfig(ctx context.Context, in *MsgSetEarlyUnlockConfig, opts ...grpc.CallOption) (*MsgSetEarlyUnlockConfigResponse, error)
+	EarlyUnlock(ctx context.Context, in *MsgEarlyUnlock, opts ...grpc.CallOption) (*MsgEarlyUnlockResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Release(ctx context.Context, in *MsgRelease, opts ...grpc.CallOption) (*MsgReleaseResponse, error) {
+	out := new(MsgReleaseResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseAll(ctx context.Context, in *MsgReleaseAll, opts ...grpc.CallOption) (*MsgReleaseAllResponse, error) {
+	out := new(MsgReleaseAllResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/ReleaseAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateVestingPosition(ctx context.Context, in *MsgCreateVestingPosition, opts ...grpc.CallOption) (*MsgCreateVestingPositionResponse, error) {
+	out := new(MsgCreateVestingPositionResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/CreateVestingPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokePosition(ctx context.Context, in *MsgRevokePosition, opts ...grpc.CallOption) (*MsgRevokePositionResponse, error) {
+	out := new(MsgRevokePositionResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/RevokePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateGrant(ctx context.Context, in *MsgCreateGrant, opts ...grpc.CallOption) (*MsgCreateGrantResponse, error) {
+	out := new(MsgCreateGrantResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/CreateGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackGrant(ctx context.Context, in *MsgClawbackGrant, opts ...grpc.CallOption) (*MsgClawbackGrantResponse, error) {
+	out := new(MsgClawbackGrantResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/ClawbackGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error) {
+	out := new(MsgTransferPositionResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/TransferPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPosition(ctx context.Context, in *MsgAcceptPosition, opts ...grpc.CallOption) (*MsgAcceptPositionResponse, error) {
+	out := new(MsgAcceptPositionResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/AcceptPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTransfer(ctx context.Context, in *MsgCancelTransfer, opts ...grpc.CallOption) (*MsgCancelTransferResponse, error) {
+	out := new(MsgCancelTransferResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/CancelTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SplitPosition(ctx context.Context, in *MsgSplitPosition, opts ...grpc.CallOption) (*MsgSplitPositionResponse, error) {
+	out := new(MsgSplitPositionResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/SplitPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MergePositions(ctx context.Context, in *MsgMergePositions, opts ...grpc.CallOption) (*MsgMergePositionsResponse, error) {
+	out := new(MsgMergePositionsResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/MergePositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEarlyUnlockConfig(ctx context.Context, in *MsgSetEarlyUnlockConfig, opts ...grpc.CallOption) (*MsgSetEarlyUnlockConfigResponse, error) {
+	out := new(MsgSetEarlyUnlockConfigResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/SetEarlyUnlockConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EarlyUnlock(ctx context.Context, in *MsgEarlyUnlock, opts ...grpc.CallOption) (*MsgEarlyUnlockResponse, error) {
+	out := new(MsgEarlyUnlockResponse)
+	err := c.cc.Invoke(ctx, "/selfchain.selfvesting.Msg/EarlyUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Release(context.Context, *MsgRelease) (*MsgReleaseResponse, error)
+	ReleaseAll(context.Context, *MsgReleaseAll) (*MsgReleaseAllResponse, error)
+	CreateVestingPosition(context.Context, *MsgCreateVestingPosition) (*MsgCreateVestingPositionResponse, error)
+	RevokePosition(context.Context, *MsgRevokePosition) (*MsgRevokePositionResponse, error)
+	CreateGrant(context.Context, *MsgCreateGrant) (*MsgCreateGrantResponse, error)
+	ClawbackGrant(context.Context, *MsgClawbackGrant) (*MsgClawbackGrantResponse, error)
+	TransferPosition(context.Context, *MsgTransferPosition) (*MsgTransferPositionResponse, error)
+	AcceptPosition(context.Context, *MsgAcceptPosition) (*MsgAcceptPositionResponse, error)
+	CancelTransfer(context.Context, *MsgCancelTransfer) (*MsgCancelTransferResponse, error)
+	SplitPosition(context.Context, *MsgSplitPosition) (*MsgSplitPositionResponse, error)
+	MergePositions(context.Context, *MsgMergePositions) (*MsgMergePositionsResponse, error)
+	SetEarlyUnlockConfig(context.Context, *MsgSetEarlyUnlockConfig) (*MsgSetEarlyUnlockConfigResponse, error)
+	EarlyUnlock(context.Context, *MsgEarlyUnlock) (*MsgEarlyUnlockResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Release(ctx context.Context, req *MsgRelease) (*MsgReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseAll(ctx context.Context, req *MsgReleaseAll) (*MsgReleaseAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseAll not implemented")
+}
+func (*UnimplementedMsgServer) CreateVestingPosition(ctx context.Context, req *MsgCreateVestingPosition) (*MsgCreateVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVestingPosition not implemented")
+}
+func (*UnimplementedMsgServer) RevokePosition(ctx context.Context, req *MsgRevokePosition) (*MsgRevokePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePosition not implemented")
+}
+func (*UnimplementedMsgServer) CreateGrant(ctx context.Context, req *MsgCreateGrant) (*MsgCreateGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGrant not implemented")
+}
+func (*UnimplementedMsgServer) ClawbackGrant(ctx context.Context, req *MsgClawbackGrant) (*MsgClawbackGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackGrant not implemented")
+}
+func (*UnimplementedMsgServer) TransferPosition(ctx context.Context, req *MsgTransferPosition) (*MsgTransferPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPosition not implemented")
+}
+func (*UnimplementedMsgServer) AcceptPosition(ctx context.Context, req *MsgAcceptPosition) (*MsgAcceptPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPosition not implemented")
+}
+func (*UnimplementedMsgServer) CancelTransfer(ctx context.Context, req *MsgCancelTransfer) (*MsgCancelTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransfer not implemented")
+}
+func (*UnimplementedMsgServer) SplitPosition(ctx context.Context, req *MsgSplitPosition) (*MsgSplitPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitPosition not implemented")
+}
+func (*UnimplementedMsgServer) MergePositions(ctx context.Context, req *MsgMergePositions) (*MsgMergePositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePositions not implemented")
+}
+func (*UnimplementedMsgServer) SetEarlyUnlockConfig(ctx context.Context, req *MsgSetEarlyUnlockConfig) (*MsgSetEarlyUnlockConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEarlyUnlockConfig not implemented")
+}
+func (*UnimplementedMsgServer) EarlyUnlock(ctx context.Context, req *MsgEarlyUnlock) (*MsgEarlyUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarlyUnlock not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRelease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Release(ctx, req.(*MsgRelease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/ReleaseAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseAll(ctx, req.(*MsgReleaseAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateVestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVestingPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/CreateVestingPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVestingPosition(ctx, req.(*MsgCreateVestingPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/RevokePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokePosition(ctx, req.(*MsgRevokePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/CreateGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGrant(ctx, req.(*MsgCreateGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/ClawbackGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackGrant(ctx, req.(*MsgClawbackGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/TransferPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPosition(ctx, req.(*MsgTransferPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/AcceptPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPosition(ctx, req.(*MsgAcceptPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/CancelTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTransfer(ctx, req.(*MsgCancelTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SplitPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/SplitPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitPosition(ctx, req.(*MsgSplitPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MergePositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergePositions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergePositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/MergePositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergePositions(ctx, req.(*MsgMergePositions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEarlyUnlockConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEarlyUnlockConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEarlyUnlockConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/SetEarlyUnlockConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEarlyUnlockConfig(ctx, req.(*MsgSetEarlyUnlockConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EarlyUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEarlyUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EarlyUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/selfchain.selfvesting.Msg/EarlyUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EarlyUnlock(ctx, req.(*MsgEarlyUnlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "selfchain.selfvesting.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Release",
+			Handler:    _Msg_Release_Handler,
+		},
+		{
+			MethodName: "ReleaseAll",
+			Handler:    _Msg_ReleaseAll_Handler,
+		},
+		{
+			MethodName: "CreateVestingPosition",
+			Handler:    _Msg_CreateVestingPosition_Handler,
+		},
+		{
+			MethodName: "RevokePosition",
+			Handler:    _Msg_RevokePosition_Handler,
+		},
+		{
+			MethodName: "CreateGrant",
+			Handler:    _Msg_CreateGrant_Handler,
+		},
+		{
+			MethodName: "ClawbackGrant",
+			Handler:    _Msg_ClawbackGrant_Handler,
+		},
+		{
+			MethodName: "TransferPosition",
+			Handler:    _Msg_TransferPosition_Handler,
+		},
+		{
+			MethodName: "AcceptPosition",
+			Handler:    _Msg_AcceptPosition_Handler,
+		},
+		{
+			MethodName: "CancelTransfer",
+			Handler:    _Msg_CancelTransfer_Handler,
+		},
+		{
+			MethodName: "SplitPosition",
+			Handler:    _Msg_SplitPosition_Handler,
+		},
+		{
+			MethodName: "MergePositions",
+			Handler:    _Msg_MergePositions_Handler,
+		},
+		{
+			MethodName: "SetEarlyUnlockConfig",
+			Handler:    _Msg_SetEarlyUnlockConfig_Handler,
+		},
+		{
+			MethodName: "EarlyUnlock",
+			Handler:    _Msg_EarlyUnlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "selfchain/selfvesting/tx.proto",
+}
+
+func (m *MsgRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDeducted) > 0 {
+		i -= len(m.FeeDeducted)
+		copy(dAtA[i:], m.FeeDeducted)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeDeducted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AmountToVest) > 0 {
+		i -= len(m.AmountToVest)
+		copy(dAtA[i:], m.AmountToVest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountToVest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PeriodToVest != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PeriodToVest))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDeducted) > 0 {
+		i -= len(m.FeeDeducted)
+		copy(dAtA[i:], m.FeeDeducted)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeDeducted)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AmountToVest) > 0 {
+		i -= len(m.AmountToVest)
+		copy(dAtA[i:], m.AmountToVest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountToVest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PeriodToVest != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PeriodToVest))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDeducted) > 0 {
+		i -= len(m.FeeDeducted)
+		copy(dAtA[i:], m.FeeDeducted)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeDeducted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AmountToVest) > 0 {
+		i -= len(m.AmountToVest)
+		copy(dAtA[i:], m.AmountToVest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountToVest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVestingPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVestingPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.StepPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StepPeriod))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Curve != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Revocable {
+		i--
+		if m.Revocable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Cliff != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cliff))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVestingPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVestingPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountReturned) > 0 {
+		i -= len(m.AmountReturned)
+		copy(dAtA[i:], m.AmountReturned)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountReturned)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Cliff != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cliff))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountReturned) > 0 {
+		i -= len(m.AmountReturned)
+		copy(dAtA[i:], m.AmountReturned)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountReturned)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireAcceptance {
+		i--
+		if m.RequireAcceptance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergePositions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergePositions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergePositions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PositionIds) > 0 {
+		dAtA2 := make([]byte, len(m.PositionIds)*10)
+		var j1 int
+		for _, num := range m.PositionIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergePositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergePositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergePositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEarlyUnlockConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEarlyUnlockConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEarlyUnlockConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnPenalty {
+		i--
+		if m.BurnPenalty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndPenaltyBasisPoints != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndPenaltyBasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartPenaltyBasisPoints != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartPenaltyBasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEarlyUnlockConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEarlyUnlockConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEarlyUnlockConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyUnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAmount) > 0 {
+		i -= len(m.NetAmount)
+		copy(dAtA[i:], m.NetAmount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NetAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FeeDeducted) > 0 {
+		i -= len(m.FeeDeducted)
+		copy(dAtA[i:], m.FeeDeducted)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeDeducted)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Penalty) > 0 {
+		i -= len(m.Penalty)
+		copy(dAtA[i:], m.Penalty)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Penalty)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Unlocked) > 0 {
+		i -= len(m.Unlocked)
+		copy(dAtA[i:], m.Unlocked)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Unlocked)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Released) > 0 {
+		i -= len(m.Released)
+		copy(dAtA[i:], m.Released)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Released)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodToVest != 0 {
+		n += 1 + sovTx(uint64(m.PeriodToVest))
+	}
+	l = len(m.AmountToVest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeeDeducted)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	if m.PeriodToVest != 0 {
+		n += 1 + sovTx(uint64(m.PeriodToVest))
+	}
+	l = len(m.AmountToVest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeeDeducted)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.AmountToVest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeeDeducted)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateVestingPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Cliff != 0 {
+		n += 1 + sovTx(uint64(m.Cliff))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if m.Revocable {
+		n += 2
+	}
+	if m.Curve != 0 {
+		n += 1 + sovTx(uint64(m.Curve))
+	}
+	if m.StepPeriod != 0 {
+		n += 1 + sovTx(uint64(m.StepPeriod))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateVestingPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgRevokePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgRevokePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AmountReturned)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Cliff != 0 {
+		n += 1 + sovTx(uint64(m.Cliff))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	return n
+}
+
+func (m *MsgCreateGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgClawbackGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgClawbackGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AmountReturned)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequireAcceptance {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTransferPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAcceptPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgAcceptPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgCancelTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSplitPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSplitPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgMergePositions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PositionIds) > 0 {
+		l = 0
+		for _, e := range m.PositionIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgMergePositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgSetEarlyUnlockConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartPenaltyBasisPoints != 0 {
+		n += 1 + sovTx(uint64(m.StartPenaltyBasisPoints))
+	}
+	if m.EndPenaltyBasisPoints != 0 {
+		n += 1 + sovTx(uint64(m.EndPenaltyBasisPoints))
+	}
+	if m.BurnPenalty {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetEarlyUnlockConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEarlyUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEarlyUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Released)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Unlocked)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Penalty)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeeDeducted)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NetAmount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodToVest", wireType)
+			}
+			m.PeriodToVest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodToVest |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountToVest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountToVest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDeducted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDeducted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodToVest", wireType)
+			}
+			m.PeriodToVest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodToVest |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountToVest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountToVest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDeducted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDeducted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, PositionRelease{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountToVest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountToVest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDeducted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDeducted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			m.Cliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cliff |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revocable = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= VestingCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepPeriod", wireType)
+			}
+			m.StepPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, VestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountReturned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountReturned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			m.Cliff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cliff |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountReturned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountReturned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireAcceptance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireAcceptance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMergePositions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMergePositions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMergePositions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PositionIds = append(m.PositionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PositionIds) == 0 {
+					m.PositionIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PositionIds = append(m.PositionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMergePositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMergePositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMergePositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEarlyUnlockConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEarlyUnlockConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEarlyUnlockConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPenaltyBasisPoints", wireType)
+			}
+			m.StartPenaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPenaltyBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPenaltyBasisPoints", wireType)
+			}
+			m.EndPenaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndPenaltyBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnPenalty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnPenalty = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgRelease) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PosIndex != 0 {
-		n += 1 + sovTx(uint64(m.PosIndex))
+func (m *MsgSetEarlyUnlockConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEarlyUnlockConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEarlyUnlockConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgReleaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PeriodToVest != 0 {
-		n += 1 + sovTx(uint64(m.PeriodToVest))
-	}
-	l = len(m.AmountToVest)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRelease) Unmarshal(dAtA []byte) error {
+func (m *MsgEarlyUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -380,10 +6572,10 @@ func (m *MsgRelease) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelease: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEarlyUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEarlyUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -420,9 +6612,28 @@ func (m *MsgRelease) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PosIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.PosIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -432,11 +6643,24 @@ func (m *MsgRelease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PosIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -458,7 +6682,7 @@ func (m *MsgRelease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReleaseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEarlyUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -481,17 +6705,17 @@ func (m *MsgReleaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReleaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEarlyUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEarlyUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodToVest", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
 			}
-			m.PeriodToVest = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -501,14 +6725,27 @@ func (m *MsgReleaseResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeriodToVest |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountToVest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -536,7 +6773,103 @@ func (m *MsgReleaseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmountToVest = string(dAtA[iNdEx:postIndex])
+			m.Unlocked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDeducted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDeducted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,328 @@
+package types
+
+import (
+	"math"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the amounts of the position are well formed and that its curve is complete
+func (v VestingInfo) Validate() error {
+	if _, ok := VestingCurve_name[int32(v.Curve)]; !ok {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "unknown vesting curve %d", v.Curve)
+	}
+
+	amount, err := sdkmath.ParseUint(v.Amount)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid amount (%s)", err)
+	}
+
+	totalClaimed, err := sdkmath.ParseUint(v.TotalClaimed)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid total claimed (%s)", err)
+	}
+	if totalClaimed.GT(amount) {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "total claimed exceeds the amount")
+	}
+
+	if v.PendingFee != "" {
+		if _, err := sdkmath.ParseUint(v.PendingFee); err != nil {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid pending fee (%s)", err)
+		}
+	}
+
+	switch v.Curve {
+	case VestingCurve_VESTING_CURVE_PERIODIC:
+		if v.StepPeriod == 0 {
+			return sdkerrors.Wrap(errors.ErrInvalidRequest, "step period of a periodic vesting must be positive")
+		}
+	case VestingCurve_VESTING_CURVE_PIECEWISE:
+		if len(v.Periods) == 0 || len(v.Periods) > MaxVestingPeriods {
+			return sdkerrors.Wrapf(errors.ErrInvalidRequest, "a piecewise vesting must have between 1 and %d periods", MaxVestingPeriods)
+		}
+
+		total := sdkmath.ZeroUint()
+		end := v.StartTime
+		for i, period := range v.Periods {
+			if period.Length == 0 || period.Length > math.MaxUint64-end {
+				return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid length of period %d", i)
+			}
+			end += period.Length
+
+			periodAmount, err := sdkmath.ParseUint(period.Amount)
+			if err != nil || periodAmount.IsZero() {
+				return sdkerrors.Wrapf(errors.ErrInvalidRequest, "amount of period %d must be positive", i)
+			}
+			total = total.Add(periodAmount)
+		}
+
+		if !total.Equal(amount) {
+			return sdkerrors.Wrap(errors.ErrInvalidRequest, "amounts of the periods must add up to the amount")
+		}
+	}
+
+	return nil
+}
+
+// VestedAmountAt returns the amount of the position vested by the given unix time, claimed or not
+func (v VestingInfo) VestedAmountAt(t uint64) sdkmath.Uint {
+	if t < v.Cliff || t < v.StartTime {
+		return sdkmath.ZeroUint()
+	}
+
+	amount := sdkmath.NewUintFromString(v.Amount)
+	elapsedPeriod := t - v.StartTime
+
+	switch v.Curve {
+	case VestingCurve_VESTING_CURVE_CLIFF:
+		return amount
+	case VestingCurve_VESTING_CURVE_PIECEWISE:
+		vested := sdkmath.ZeroUint()
+		end := uint64(0)
+		for _, period := range v.Periods {
+			end += period.Length
+			if end > elapsedPeriod {
+				break
+			}
+			vested = vested.Add(sdkmath.NewUintFromString(period.Amount))
+		}
+
+		return vested
+	}
+
+	if elapsedPeriod >= v.Duration {
+		return amount
+	}
+
+	// a periodic vesting only counts whole steps
+	if v.Curve == VestingCurve_VESTING_CURVE_PERIODIC {
+		elapsedPeriod -= elapsedPeriod % v.StepPeriod
+	}
+
+	return amount.MulUint64(elapsedPeriod).QuoUint64(v.Duration)
+}
+
+// VestingEnd returns the unix time at which the position is fully vested
+func (v VestingInfo) VestingEnd() uint64 {
+	end := v.StartTime + v.Duration
+	switch v.Curve {
+	case VestingCurve_VESTING_CURVE_CLIFF:
+		end = v.Cliff
+	case VestingCurve_VESTING_CURVE_PIECEWISE:
+		end = v.StartTime
+		for _, period := range v.Periods {
+			end += period.Length
+		}
+	}
+
+	if v.Cliff > end {
+		return v.Cliff
+	}
+
+	return end
+}
+
+// NextUnlockTime returns the unix time after now at which more tokens of the position unlock, or 0 once it
+// is fully vested. Whatever vests before the cliff unlocks at the cliff.
+func (v VestingInfo) NextUnlockTime(now uint64) uint64 {
+	end := v.VestingEnd()
+	if now >= end {
+		return 0
+	}
+
+	// whatever vested before the cliff unlocks at once at the cliff
+	if now < v.Cliff && !v.VestedAmountAt(v.Cliff).IsZero() {
+		return v.Cliff
+	}
+
+	next := end
+	switch v.Curve {
+	case VestingCurve_VESTING_CURVE_LINEAR:
+		next = max(now, v.StartTime) + 1
+	case VestingCurve_VESTING_CURVE_PERIODIC:
+		if now >= v.StartTime {
+			next = v.StartTime + ((now-v.StartTime)/v.StepPeriod+1)*v.StepPeriod
+		} else {
+			next = v.StartTime + v.StepPeriod
+		}
+	case VestingCurve_VESTING_CURVE_PIECEWISE:
+		next = v.StartTime
+		for _, period := range v.Periods {
+			next += period.Length
+			if next > now {
+				break
+			}
+		}
+	}
+
+	return min(max(next, v.Cliff), end)
+}
+
+// OpenedByMigration tells whether the position has been opened by a migration rather than funded by an account
+func (v VestingInfo) OpenedByMigration() bool {
+	return v.Funder == ""
+}
+
+// Split carves amount out of the position into a new position with the same schedule and returns what is left
+// of the position and the new position. What has been claimed is shared between both so that, from now on,
+// they vest and release together exactly what the position would have. The new position has no id yet.
+// Positions that owe release fees can't be split.
+func (v VestingInfo) Split(amount sdkmath.Uint, now uint64) (VestingInfo, VestingInfo, error) {
+	total := sdkmath.NewUintFromString(v.Amount)
+	if amount.IsZero() || amount.GTE(total) {
+		return v, VestingInfo{}, sdkerrors.Wrap(errors.ErrInvalidRequest, "split amount must be positive and lower than the amount of the position")
+	}
+
+	if v.PendingFee != "" && !sdkmath.NewUintFromString(v.PendingFee).IsZero() {
+		return v, VestingInfo{}, sdkerrors.Wrap(errors.ErrInvalidRequest, "position owes release fees, release it first")
+	}
+
+	// a linear vesting rounds down what vested, which only adds up across positions when all but one vest
+	// whole tokens every second
+	rest := total.Sub(amount)
+	if v.roundsVestedAmount() && !v.vestsWholeTokens(amount) && !v.vestsWholeTokens(rest) {
+		return v, VestingInfo{}, sdkerrors.Wrapf(ErrInexactSchedule, "the split amount or what is left must be a multiple of the duration %d", v.Duration)
+	}
+
+	remaining, carved := v, v
+	remaining.Amount, carved.Amount = rest.String(), amount.String()
+	carved.Id = 0
+
+	if v.Curve == VestingCurve_VESTING_CURVE_PIECEWISE {
+		remaining.Periods, carved.Periods = splitPeriods(v.Periods, total, amount)
+	}
+
+	// each position must have vested what it is said to have claimed, so that neither releases less than
+	// its share of what the position would have released
+	claimed := sdkmath.NewUintFromString(v.TotalClaimed)
+	remainingVested, carvedVested := remaining.VestedAmountAt(now), carved.VestedAmountAt(now)
+	if claimed.GT(remainingVested.Add(carvedVested)) {
+		return v, VestingInfo{}, sdkerrors.Wrap(ErrInexactSchedule, "the position has been unlocked ahead of its vesting")
+	}
+
+	carvedClaimed := claimed.Mul(amount).Quo(total)
+	carvedClaimed = sdkmath.MinUint(carvedClaimed, carvedVested)
+	if claimed.GT(remainingVested) {
+		carvedClaimed = sdkmath.MaxUint(carvedClaimed, claimed.Sub(remainingVested))
+	}
+	remaining.TotalClaimed, carved.TotalClaimed = claimed.Sub(carvedClaimed).String(), carvedClaimed.String()
+
+	return remaining, carved, nil
+}
+
+// Merge combines another position with the same schedule into the position. The merged position vests and
+// releases exactly what both positions would have together from now on. It keeps the id of the position.
+func (v VestingInfo) Merge(other VestingInfo, now uint64) (VestingInfo, error) {
+	if !v.sameSchedule(other) {
+		return v, ErrIncompatiblePositions
+	}
+
+	// a position that claimed more than it vested would hold back what the other one releases
+	if v.claimedAhead(now) || other.claimedAhead(now) {
+		return v, sdkerrors.Wrap(ErrInexactSchedule, "a position has been unlocked ahead of its vesting")
+	}
+
+	amount, otherAmount := sdkmath.NewUintFromString(v.Amount), sdkmath.NewUintFromString(other.Amount)
+	if v.roundsVestedAmount() && !v.vestsWholeTokens(amount) && !v.vestsWholeTokens(otherAmount) {
+		return v, sdkerrors.Wrapf(ErrInexactSchedule, "the amount of one of the positions must be a multiple of the duration %d", v.Duration)
+	}
+
+	merged := v
+	merged.Amount = amount.Add(otherAmount).String()
+	merged.TotalClaimed = sdkmath.NewUintFromString(v.TotalClaimed).Add(sdkmath.NewUintFromString(other.TotalClaimed)).String()
+	merged.PendingFee = uintOrZero(v.PendingFee).Add(uintOrZero(other.PendingFee)).String()
+	merged.PeriodClaimed = max(v.PeriodClaimed, other.PeriodClaimed)
+
+	if v.Curve == VestingCurve_VESTING_CURVE_PIECEWISE {
+		merged.Periods = make([]VestingPeriod, len(v.Periods))
+		for i, period := range v.Periods {
+			periodAmount := sdkmath.NewUintFromString(period.Amount).Add(sdkmath.NewUintFromString(other.Periods[i].Amount))
+			merged.Periods[i] = VestingPeriod{Length: period.Length, Amount: periodAmount.String()}
+		}
+	}
+
+	return merged, nil
+}
+
+// claimedAhead tells whether more has been claimed from the position than it vested by the given unix time
+func (v VestingInfo) claimedAhead(now uint64) bool {
+	return sdkmath.NewUintFromString(v.TotalClaimed).GT(v.VestedAmountAt(now))
+}
+
+// sameSchedule tells whether both positions vest at the same times and belong to the same funder
+func (v VestingInfo) sameSchedule(other VestingInfo) bool {
+	if v.Curve != other.Curve || v.StartTime != other.StartTime || v.Cliff != other.Cliff ||
+		v.Duration != other.Duration || v.StepPeriod != other.StepPeriod || v.LockupTier != other.LockupTier ||
+		v.Funder != other.Funder || v.Revocable != other.Revocable || len(v.Periods) != len(other.Periods) {
+		return false
+	}
+
+	for i, period := range v.Periods {
+		if period.Length != other.Periods[i].Length {
+			return false
+		}
+	}
+
+	return true
+}
+
+// roundsVestedAmount tells whether the vested amount of the position is rounded down before it is fully vested
+func (v VestingInfo) roundsVestedAmount() bool {
+	return (v.Curve == VestingCurve_VESTING_CURVE_LINEAR || v.Curve == VestingCurve_VESTING_CURVE_PERIODIC) && v.Duration > 0
+}
+
+// vestsWholeTokens tells whether an amount vesting over the duration of the position vests whole tokens every
+// second, so that what it vested is never rounded down
+func (v VestingInfo) vestsWholeTokens(amount sdkmath.Uint) bool {
+	return amount.Mod(sdkmath.NewUint(v.Duration)).IsZero()
+}
+
+// splitPeriods carves amount out of the amounts of the periods in proportion to them. Periods left without
+// tokens are folded into the next period, which vests nothing new and keeps what is vested at any time.
+func splitPeriods(periods []VestingPeriod, total sdkmath.Uint, amount sdkmath.Uint) ([]VestingPeriod, []VestingPeriod) {
+	remaining := make([]VestingPeriod, len(periods))
+	carved := make([]VestingPeriod, len(periods))
+
+	// rounding the running totals rather than each period makes the carved amounts add up to amount
+	cumulated, carvedCumulated := sdkmath.ZeroUint(), sdkmath.ZeroUint()
+	for i, period := range periods {
+		periodAmount := sdkmath.NewUintFromString(period.Amount)
+		cumulated = cumulated.Add(periodAmount)
+
+		carvedAmount := cumulated.Mul(amount).Quo(total).Sub(carvedCumulated)
+		carvedCumulated = carvedCumulated.Add(carvedAmount)
+
+		remaining[i] = VestingPeriod{Length: period.Length, Amount: periodAmount.Sub(carvedAmount).String()}
+		carved[i] = VestingPeriod{Length: period.Length, Amount: carvedAmount.String()}
+	}
+
+	return foldEmptyPeriods(remaining), foldEmptyPeriods(carved)
+}
+
+// foldEmptyPeriods adds the length of periods vesting nothing to the next period and drops them
+func foldEmptyPeriods(periods []VestingPeriod) []VestingPeriod {
+	folded := []VestingPeriod{}
+	length := uint64(0)
+	for _, period := range periods {
+		length += period.Length
+		if sdkmath.NewUintFromString(period.Amount).IsZero() {
+			continue
+		}
+
+		folded = append(folded, VestingPeriod{Length: length, Amount: period.Amount})
+		length = 0
+	}
+
+	return folded
+}
+
+// uintOrZero parses an amount that is left empty when zero
+func uintOrZero(amount string) sdkmath.Uint {
+	if amount == "" {
+		return sdkmath.ZeroUint()
+	}
+
+	return sdkmath.NewUintFromString(amount)
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,6 +23,95 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VestingCurve defines how the amount of a position unlocks once its cliff is reached
+type VestingCurve int32
+
+const (
+	// tokens unlock linearly over the duration
+	VestingCurve_VESTING_CURVE_LINEAR VestingCurve = 0
+	// every token unlocks at once at the cliff
+	VestingCurve_VESTING_CURVE_CLIFF VestingCurve = 1
+	// tokens unlock in equal tranches every stepPeriod over the duration
+	VestingCurve_VESTING_CURVE_PERIODIC VestingCurve = 2
+	// tokens unlock at the end of each of the periods of the position
+	VestingCurve_VESTING_CURVE_PIECEWISE VestingCurve = 3
+)
+
+var VestingCurve_name = map[int32]string{
+	0: "VESTING_CURVE_LINEAR",
+	1: "VESTING_CURVE_CLIFF",
+	2: "VESTING_CURVE_PERIODIC",
+	3: "VESTING_CURVE_PIECEWISE",
+}
+
+var VestingCurve_value = map[string]int32{
+	"VESTING_CURVE_LINEAR":    0,
+	"VESTING_CURVE_CLIFF":     1,
+	"VESTING_CURVE_PERIODIC":  2,
+	"VESTING_CURVE_PIECEWISE": 3,
+}
+
+func (x VestingCurve) String() string {
+	return proto.EnumName(VestingCurve_name, int32(x))
+}
+
+func (VestingCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db524d9bebced67f, []int{0}
+}
+
+// VestingPeriod is a period of a piecewise vesting, the amount unlocks at the end of the period
+type VestingPeriod struct {
+	Length uint64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *VestingPeriod) Reset()         { *m = VestingPeriod{} }
+func (m *VestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*VestingPeriod) ProtoMessage()    {}
+func (*VestingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db524d9bebced67f, []int{0}
+}
+func (m *VestingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPeriod.Merge(m, src)
+}
+func (m *VestingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPeriod proto.InternalMessageInfo
+
+func (m *VestingPeriod) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *VestingPeriod) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 type VestingInfo struct {
 	StartTime     uint64 `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
 	Duration      uint64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
@@ -29,13 +119,29 @@ type VestingInfo struct {
 	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	TotalClaimed  string `protobuf:"bytes,5,opt,name=totalClaimed,proto3" json:"totalClaimed,omitempty"`
 	PeriodClaimed uint64 `protobuf:"varint,6,opt,name=periodClaimed,proto3" json:"periodClaimed,omitempty"`
+	// lockup tier chosen when migrating into the position
+	LockupTier uint64 `protobuf:"varint,7,opt,name=lockupTier,proto3" json:"lockupTier,omitempty"`
+	// fees paid by the module for the beneficiary, to be deducted from the next release of the position
+	PendingFee string `protobuf:"bytes,8,opt,name=pendingFee,proto3" json:"pendingFee,omitempty"`
+	// stable id of the position, assigned when it is created
+	Id          uint64       `protobuf:"varint,9,opt,name=id,proto3" json:"id,omitempty"`
+	Beneficiary string       `protobuf:"bytes,10,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Curve       VestingCurve `protobuf:"varint,11,opt,name=curve,proto3,enum=selfchain.selfvesting.VestingCurve" json:"curve,omitempty"`
+	// seconds between two tranches of a periodic vesting
+	StepPeriod uint64 `protobuf:"varint,12,opt,name=stepPeriod,proto3" json:"stepPeriod,omitempty"`
+	// consecutive periods of a piecewise vesting, starting at startTime
+	Periods []VestingPeriod `protobuf:"bytes,13,rep,name=periods,proto3" json:"periods"`
+	// account that funded the position, empty for positions opened by migrations
+	Funder string `protobuf:"bytes,14,opt,name=funder,proto3" json:"funder,omitempty"`
+	// whether the funder can take back the unvested part of the position
+	Revocable bool `protobuf:"varint,15,opt,name=revocable,proto3" json:"revocable,omitempty"`
 }
 
 func (m *VestingInfo) Reset()         { *m = VestingInfo{} }
 func (m *VestingInfo) String() string { return proto.CompactTextString(m) }
 func (*VestingInfo) ProtoMessage()    {}
 func (*VestingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db524d9bebced67f, []int{0}
+	return fileDescriptor_db524d9bebced67f, []int{1}
 }
 func (m *VestingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -106,7 +212,72 @@ func (m *VestingInfo) GetPeriodClaimed() uint64 {
 	return 0
 }
 
+func (m *VestingInfo) GetLockupTier() uint64 {
+	if m != nil {
+		return m.LockupTier
+	}
+	return 0
+}
+
+func (m *VestingInfo) GetPendingFee() string {
+	if m != nil {
+		return m.PendingFee
+	}
+	return ""
+}
+
+func (m *VestingInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *VestingInfo) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *VestingInfo) GetCurve() VestingCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return VestingCurve_VESTING_CURVE_LINEAR
+}
+
+func (m *VestingInfo) GetStepPeriod() uint64 {
+	if m != nil {
+		return m.StepPeriod
+	}
+	return 0
+}
+
+func (m *VestingInfo) GetPeriods() []VestingPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func (m *VestingInfo) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *VestingInfo) GetRevocable() bool {
+	if m != nil {
+		return m.Revocable
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("selfchain.selfvesting.VestingCurve", VestingCurve_name, VestingCurve_value)
+	proto.RegisterType((*VestingPeriod)(nil), "selfchain.selfvesting.VestingPeriod")
 	proto.RegisterType((*VestingInfo)(nil), "selfchain.selfvesting.VestingInfo")
 }
 
@@ -115,22 +286,74 @@ func init() {
 }
 
 var fileDescriptor_db524d9bebced67f = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0x4e, 0xcd, 0x49,
-	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0xca, 0x52, 0x8b, 0x4b, 0x32, 0xf3, 0xd2, 0xf5,
-	0xa1, 0x74, 0x7c, 0x66, 0x5e, 0x5a, 0xbe, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x90, 0x28, 0x5c,
-	0xa5, 0x1e, 0x92, 0x4a, 0xa5, 0xfd, 0x8c, 0x5c, 0xdc, 0x61, 0x10, 0xb6, 0x67, 0x5e, 0x5a, 0xbe,
-	0x90, 0x0c, 0x17, 0x67, 0x71, 0x49, 0x62, 0x51, 0x49, 0x48, 0x66, 0x6e, 0xaa, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0x4b, 0x10, 0x42, 0x40, 0x48, 0x8a, 0x8b, 0x23, 0xa5, 0xb4, 0x28, 0xb1, 0x24, 0x33,
-	0x3f, 0x4f, 0x82, 0x09, 0x2c, 0x09, 0xe7, 0x0b, 0x89, 0x70, 0xb1, 0x26, 0xe7, 0x64, 0xa6, 0xa5,
-	0x49, 0x30, 0x83, 0x25, 0x20, 0x1c, 0x21, 0x31, 0x2e, 0xb6, 0xc4, 0xdc, 0xfc, 0xd2, 0xbc, 0x12,
-	0x09, 0x16, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x28, 0x4f, 0x48, 0x89, 0x8b, 0xa7, 0x24, 0xbf, 0x24,
-	0x31, 0xc7, 0x39, 0x27, 0x31, 0x33, 0x37, 0x35, 0x45, 0x82, 0x15, 0x2c, 0x8b, 0x22, 0x26, 0xa4,
-	0xc2, 0xc5, 0x5b, 0x90, 0x5a, 0x94, 0x99, 0x9f, 0x02, 0x53, 0xc4, 0x06, 0x36, 0x19, 0x55, 0xd0,
-	0xc9, 0xfc, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x64, 0x11, 0x81, 0x53,
-	0x81, 0x12, 0x3c, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x80, 0x31, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0x6d, 0x8e, 0xfd, 0xfe, 0x44, 0x01, 0x00, 0x00,
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0x8d, 0xf3, 0xaf, 0xc9, 0xe4, 0xcf, 0x2f, 0xda, 0x5f, 0x68, 0x57, 0x01, 0x8c, 0x15, 0x7a,
+	0xb0, 0x38, 0x24, 0x52, 0x39, 0x20, 0x4e, 0x88, 0xba, 0x0e, 0xb2, 0x54, 0x95, 0xca, 0x0d, 0x41,
+	0xe2, 0x12, 0x39, 0xf6, 0x3a, 0x5d, 0xe1, 0xec, 0x5a, 0xf6, 0x26, 0xa2, 0x7c, 0x02, 0x8e, 0x7c,
+	0xac, 0x1e, 0x7b, 0xe4, 0x84, 0x50, 0xf2, 0x45, 0x90, 0xd7, 0x6e, 0x63, 0x23, 0xc4, 0xc9, 0x9e,
+	0xf7, 0xde, 0xbc, 0x99, 0x9d, 0xd1, 0x80, 0x1e, 0x93, 0xc0, 0x77, 0xaf, 0x1d, 0xca, 0xc6, 0xc9,
+	0xdf, 0x86, 0xc4, 0x82, 0xb2, 0xe5, 0x38, 0xfb, 0xce, 0x29, 0xf3, 0xf9, 0x28, 0x8c, 0xb8, 0xe0,
+	0xe8, 0xd1, 0x83, 0x72, 0x94, 0x53, 0x0e, 0xfa, 0x4b, 0xbe, 0xe4, 0x52, 0x31, 0x4e, 0xfe, 0x52,
+	0xf1, 0xf0, 0x0d, 0x74, 0x66, 0xa9, 0xe0, 0x92, 0x44, 0x94, 0x7b, 0xe8, 0x10, 0xea, 0x01, 0x61,
+	0x4b, 0x71, 0x8d, 0x15, 0x4d, 0xd1, 0xab, 0x76, 0x16, 0x25, 0xb8, 0xb3, 0xe2, 0x6b, 0x26, 0x70,
+	0x59, 0x53, 0xf4, 0xa6, 0x9d, 0x45, 0xc3, 0x6f, 0x55, 0x68, 0x65, 0x0e, 0x16, 0xf3, 0x39, 0x7a,
+	0x02, 0xcd, 0x58, 0x38, 0x91, 0x98, 0xd2, 0x15, 0xc9, 0x2c, 0xf6, 0x00, 0x1a, 0x40, 0xc3, 0x5b,
+	0x47, 0x8e, 0xa0, 0x9c, 0x49, 0x9f, 0xaa, 0xfd, 0x10, 0xa3, 0x3e, 0xd4, 0xdc, 0x80, 0xfa, 0x3e,
+	0xae, 0x48, 0x22, 0x0d, 0x72, 0x75, 0xab, 0xf9, 0xba, 0x68, 0x08, 0x6d, 0xc1, 0x85, 0x13, 0x18,
+	0x81, 0x43, 0x57, 0xc4, 0xc3, 0x35, 0xc9, 0x16, 0x30, 0x74, 0x0c, 0x9d, 0x50, 0xbe, 0xea, 0x5e,
+	0x54, 0x97, 0xce, 0x45, 0x10, 0xa9, 0x00, 0x01, 0x77, 0x3f, 0xaf, 0xc3, 0x29, 0x25, 0x11, 0x3e,
+	0x90, 0x92, 0x1c, 0x92, 0xf0, 0x21, 0x61, 0x1e, 0x65, 0xcb, 0x09, 0x21, 0xb8, 0x21, 0xeb, 0xe4,
+	0x10, 0xd4, 0x85, 0x32, 0xf5, 0x70, 0x53, 0xe6, 0x95, 0xa9, 0x87, 0x34, 0x68, 0x2d, 0x08, 0x23,
+	0x3e, 0x75, 0xa9, 0x13, 0xdd, 0x60, 0x90, 0x09, 0x79, 0x08, 0xbd, 0x86, 0x9a, 0xbb, 0x8e, 0x36,
+	0x04, 0xb7, 0x34, 0x45, 0xef, 0x9e, 0x3c, 0x1f, 0xfd, 0x75, 0x63, 0xa3, 0x6c, 0xac, 0x46, 0x22,
+	0xb5, 0xd3, 0x8c, 0xa4, 0x99, 0x58, 0x90, 0x30, 0x5d, 0x16, 0x6e, 0xa7, 0xcd, 0xee, 0x11, 0x74,
+	0x06, 0x07, 0xe9, 0xeb, 0x62, 0xdc, 0xd1, 0x2a, 0x7a, 0xeb, 0xe4, 0xf8, 0xdf, 0xe6, 0x69, 0xda,
+	0x69, 0xf5, 0xf6, 0xe7, 0xb3, 0x92, 0x7d, 0x9f, 0x9a, 0x0c, 0xdd, 0x5f, 0x33, 0x8f, 0x44, 0xb8,
+	0x9b, 0x0e, 0x3d, 0x8d, 0x92, 0xe5, 0x46, 0x64, 0xc3, 0x5d, 0x67, 0x11, 0x10, 0xfc, 0x9f, 0xa6,
+	0xe8, 0x0d, 0x7b, 0x0f, 0xbc, 0xf8, 0x0a, 0xed, 0x7c, 0xcb, 0x08, 0x43, 0x7f, 0x66, 0x5e, 0x4d,
+	0xad, 0x8b, 0x77, 0x73, 0xe3, 0x83, 0x3d, 0x33, 0xe7, 0xe7, 0xd6, 0x85, 0xf9, 0xd6, 0xee, 0x95,
+	0xd0, 0x11, 0xfc, 0x5f, 0x64, 0x8c, 0x73, 0x6b, 0x32, 0xe9, 0x29, 0x68, 0x00, 0x87, 0x45, 0xe2,
+	0xd2, 0xb4, 0xad, 0xf7, 0x67, 0x96, 0xd1, 0x2b, 0xa3, 0xc7, 0x70, 0xf4, 0x07, 0x67, 0x99, 0x86,
+	0xf9, 0xd1, 0xba, 0x32, 0x7b, 0x95, 0xd3, 0x57, 0xb7, 0x5b, 0x55, 0xb9, 0xdb, 0xaa, 0xca, 0xaf,
+	0xad, 0xaa, 0x7c, 0xdf, 0xa9, 0xa5, 0xbb, 0x9d, 0x5a, 0xfa, 0xb1, 0x53, 0x4b, 0x9f, 0x9e, 0xee,
+	0x0f, 0xe7, 0x4b, 0xe1, 0x74, 0xc4, 0x4d, 0x48, 0xe2, 0x45, 0x5d, 0xde, 0xc1, 0xcb, 0xdf, 0x03,
+	0x00, 0x1b, 0xc2, 0x7b, 0x92, 0x60, 0x03, 0x00, 0x00,
+}
+
+func (m *VestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintVestingInfo(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Length != 0 {
+		i = encodeVarintVestingInfo(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *VestingInfo) Marshal() (dAtA []byte, err error) {
@@ -153,6 +376,71 @@ func (m *VestingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Revocable {
+		i--
+		if m.Revocable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintVestingInfo(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.StepPeriod != 0 {
+		i = encodeVarintVestingInfo(dAtA, i, uint64(m.StepPeriod))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Curve != 0 {
+		i = encodeVarintVestingInfo(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintVestingInfo(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Id != 0 {
+		i = encodeVarintVestingInfo(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PendingFee) > 0 {
+		i -= len(m.PendingFee)
+		copy(dAtA[i:], m.PendingFee)
+		i = encodeVarintVestingInfo(dAtA, i, uint64(len(m.PendingFee)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LockupTier != 0 {
+		i = encodeVarintVestingInfo(dAtA, i, uint64(m.LockupTier))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.PeriodClaimed != 0 {
 		i = encodeVarintVestingInfo(dAtA, i, uint64(m.PeriodClaimed))
 		i--
@@ -201,6 +489,22 @@ func encodeVarintVestingInfo(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *VestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovVestingInfo(uint64(m.Length))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovVestingInfo(uint64(l))
+	}
+	return n
+}
+
 func (m *VestingInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -227,6 +531,39 @@ func (m *VestingInfo) Size() (n int) {
 	if m.PeriodClaimed != 0 {
 		n += 1 + sovVestingInfo(uint64(m.PeriodClaimed))
 	}
+	if m.LockupTier != 0 {
+		n += 1 + sovVestingInfo(uint64(m.LockupTier))
+	}
+	l = len(m.PendingFee)
+	if l > 0 {
+		n += 1 + l + sovVestingInfo(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovVestingInfo(uint64(m.Id))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovVestingInfo(uint64(l))
+	}
+	if m.Curve != 0 {
+		n += 1 + sovVestingInfo(uint64(m.Curve))
+	}
+	if m.StepPeriod != 0 {
+		n += 1 + sovVestingInfo(uint64(m.StepPeriod))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovVestingInfo(uint64(l))
+		}
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovVestingInfo(uint64(l))
+	}
+	if m.Revocable {
+		n += 2
+	}
 	return n
 }
 
@@ -236,6 +573,107 @@ func sovVestingInfo(x uint64) (n int) {
 func sozVestingInfo(x uint64) (n int) {
 	return sovVestingInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *VestingPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *VestingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -405,6 +843,232 @@ func (m *VestingInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTier", wireType)
+			}
+			m.LockupTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockupTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= VestingCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepPeriod", wireType)
+			}
+			m.StepPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, VestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revocable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVestingInfo(dAtA[iNdEx:])
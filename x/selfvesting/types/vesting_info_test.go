@@ -0,0 +1,287 @@
+package types
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVestingInfo_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		info  VestingInfo
+		valid bool
+	}{
+		{
+			desc:  "linear",
+			info:  VestingInfo{Amount: "100", TotalClaimed: "0", Duration: 10},
+			valid: true,
+		},
+		{
+			desc: "unknown curve",
+			info: VestingInfo{Amount: "100", TotalClaimed: "0", Curve: 9},
+		},
+		{
+			desc: "invalid amount",
+			info: VestingInfo{Amount: "abc", TotalClaimed: "0"},
+		},
+		{
+			desc: "over claimed",
+			info: VestingInfo{Amount: "100", TotalClaimed: "101"},
+		},
+		{
+			desc: "periodic without step",
+			info: VestingInfo{Amount: "100", TotalClaimed: "0", Duration: 10, Curve: VestingCurve_VESTING_CURVE_PERIODIC},
+		},
+		{
+			desc: "piecewise without periods",
+			info: VestingInfo{Amount: "100", TotalClaimed: "0", Curve: VestingCurve_VESTING_CURVE_PIECEWISE},
+		},
+		{
+			desc: "piecewise periods not adding up",
+			info: VestingInfo{Amount: "100", TotalClaimed: "0", Curve: VestingCurve_VESTING_CURVE_PIECEWISE, Periods: []VestingPeriod{
+				{Length: 10, Amount: "40"},
+				{Length: 10, Amount: "50"},
+			}},
+		},
+		{
+			desc: "piecewise empty period",
+			info: VestingInfo{Amount: "100", TotalClaimed: "0", Curve: VestingCurve_VESTING_CURVE_PIECEWISE, Periods: []VestingPeriod{
+				{Length: 0, Amount: "100"},
+			}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.info.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVestingInfo_Curves(t *testing.T) {
+	base := VestingInfo{StartTime: 100, Cliff: 200, Duration: 1000, Amount: "1000", TotalClaimed: "0"}
+
+	cliff := base
+	cliff.Curve = VestingCurve_VESTING_CURVE_CLIFF
+
+	periodic := base
+	periodic.Curve = VestingCurve_VESTING_CURVE_PERIODIC
+	periodic.StepPeriod = 300
+
+	piecewise := base
+	piecewise.Curve = VestingCurve_VESTING_CURVE_PIECEWISE
+	piecewise.Periods = []VestingPeriod{
+		{Length: 50, Amount: "100"},
+		{Length: 250, Amount: "300"},
+		{Length: 400, Amount: "600"},
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		info   VestingInfo
+		now    uint64
+		vested uint64
+		next   uint64
+	}{
+		{desc: "linear before cliff", info: base, now: 150, vested: 0, next: 200},
+		{desc: "linear at cliff", info: base, now: 200, vested: 100, next: 201},
+		{desc: "linear vested", info: base, now: 1100, vested: 1000, next: 0},
+		{desc: "cliff before cliff", info: cliff, now: 199, vested: 0, next: 200},
+		{desc: "cliff at cliff", info: cliff, now: 200, vested: 1000, next: 0},
+		{desc: "periodic before first step", info: periodic, now: 300, vested: 0, next: 400},
+		{desc: "periodic first step", info: periodic, now: 400, vested: 300, next: 700},
+		{desc: "periodic last partial step", info: periodic, now: 1000, vested: 900, next: 1100},
+		{desc: "periodic vested", info: periodic, now: 1100, vested: 1000, next: 0},
+		{desc: "piecewise before cliff", info: piecewise, now: 160, vested: 0, next: 200},
+		{desc: "piecewise first period at cliff", info: piecewise, now: 200, vested: 100, next: 400},
+		{desc: "piecewise second period", info: piecewise, now: 400, vested: 400, next: 800},
+		{desc: "piecewise vested", info: piecewise, now: 800, vested: 1000, next: 0},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.NoError(t, tc.info.Validate())
+			require.Equal(t, sdkmath.NewUint(tc.vested), tc.info.VestedAmountAt(tc.now))
+			require.Equal(t, tc.next, tc.info.NextUnlockTime(tc.now))
+		})
+	}
+}
+
+// randomPosition returns a position of a random curve short enough to be checked at every second
+func randomPosition(r *rand.Rand) VestingInfo {
+	duration := uint64(r.Intn(500) + 1)
+	amount := uint64(r.Intn(100000) + 1)
+	if r.Intn(2) == 0 {
+		amount = duration * uint64(r.Intn(100)+1)
+	}
+
+	v := VestingInfo{
+		StartTime:    uint64(r.Intn(100)),
+		Duration:     duration,
+		Amount:       sdkmath.NewUint(amount).String(),
+		TotalClaimed: "0",
+		Curve:        VestingCurve(r.Intn(len(VestingCurve_name))),
+	}
+	v.Cliff = v.StartTime + uint64(r.Intn(int(duration)))
+
+	switch v.Curve {
+	case VestingCurve_VESTING_CURVE_PERIODIC:
+		v.StepPeriod = uint64(r.Intn(int(duration)) + 1)
+	case VestingCurve_VESTING_CURVE_PIECEWISE:
+		remaining := amount
+		for remaining > 0 {
+			periodAmount := min(uint64(r.Intn(int(amount))+1), remaining)
+			remaining -= periodAmount
+			v.Periods = append(v.Periods, VestingPeriod{Length: uint64(r.Intn(50) + 1), Amount: sdkmath.NewUint(periodAmount).String()})
+		}
+	}
+
+	return v
+}
+
+// claimableAt returns what the position releases at the given time
+func claimableAt(v VestingInfo, t uint64) sdkmath.Uint {
+	vested, claimed := v.VestedAmountAt(t), sdkmath.NewUintFromString(v.TotalClaimed)
+	if vested.LTE(claimed) {
+		return sdkmath.ZeroUint()
+	}
+
+	return vested.Sub(claimed)
+}
+
+// requireSameClaimable checks that the positions release together what the position would have at every second
+// from now until it is fully vested
+func requireSameClaimable(t *testing.T, v VestingInfo, now uint64, positions ...VestingInfo) {
+	for at := now; at <= v.VestingEnd()+1; at++ {
+		total := sdkmath.ZeroUint()
+		for _, position := range positions {
+			require.NoError(t, position.Validate())
+			total = total.Add(claimableAt(position, at))
+		}
+		require.Equal(t, claimableAt(v, at), total, "curve %s at %d", v.Curve, at)
+	}
+}
+
+func TestVestingInfo_SplitKeepsClaimable(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 500; i++ {
+		v := randomPosition(r)
+		amount := sdkmath.NewUintFromString(v.Amount)
+		if amount.LT(sdkmath.NewUint(2)) {
+			continue
+		}
+
+		// something has been claimed already
+		now := v.StartTime + uint64(r.Intn(int(v.VestingEnd()-v.StartTime)+1))
+		claimed := v.VestedAmountAt(now)
+		if !claimed.IsZero() {
+			claimed = claimed.Sub(sdkmath.NewUint(uint64(r.Int63n(int64(claimed.Uint64())))))
+		}
+		v.TotalClaimed = claimed.String()
+
+		splitAmount := sdkmath.NewUint(uint64(r.Int63n(int64(amount.Uint64()-1))) + 1)
+		if r.Intn(2) == 0 && v.Duration < amount.Uint64() {
+			splitAmount = sdkmath.NewUint(v.Duration * uint64(r.Int63n(int64(amount.Uint64()/v.Duration))+1))
+			if splitAmount.Equal(amount) {
+				continue
+			}
+		}
+
+		remaining, carved, err := v.Split(splitAmount, now)
+		if err != nil {
+			require.ErrorIs(t, err, ErrInexactSchedule)
+			continue
+		}
+
+		require.Equal(t, splitAmount.String(), carved.Amount)
+		require.Equal(t, claimed, sdkmath.NewUintFromString(remaining.TotalClaimed).Add(sdkmath.NewUintFromString(carved.TotalClaimed)))
+		requireSameClaimable(t, v, now, remaining, carved)
+	}
+}
+
+func TestVestingInfo_MergeKeepsClaimable(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	for i := 0; i < 500; i++ {
+		v := randomPosition(r)
+
+		// positions with the same schedule come out of a split
+		other := v
+		other.Amount = sdkmath.NewUint(v.Duration * uint64(r.Intn(100)+1)).String()
+		if v.Curve == VestingCurve_VESTING_CURVE_PIECEWISE {
+			other.Periods = make([]VestingPeriod, len(v.Periods))
+			otherAmount := sdkmath.ZeroUint()
+			for j, period := range v.Periods {
+				other.Periods[j] = VestingPeriod{Length: period.Length, Amount: sdkmath.NewUint(uint64(r.Intn(1000) + 1)).String()}
+				otherAmount = otherAmount.Add(sdkmath.NewUintFromString(other.Periods[j].Amount))
+			}
+			other.Amount = otherAmount.String()
+		}
+
+		now := v.StartTime + uint64(r.Intn(int(v.VestingEnd()-v.StartTime)+1))
+		v.TotalClaimed = v.VestedAmountAt(now).String()
+		other.TotalClaimed = other.VestedAmountAt(now).QuoUint64(2).String()
+
+		merged, err := v.Merge(other, now)
+		require.NoError(t, err)
+
+		// the merged position releases what both would have released
+		for at := now; at <= merged.VestingEnd()+1; at++ {
+			require.NoError(t, merged.Validate())
+			require.Equal(t, claimableAt(v, at).Add(claimableAt(other, at)), claimableAt(merged, at), "curve %s at %d", v.Curve, at)
+		}
+	}
+}
+
+func TestVestingInfo_SplitAndMergeErrors(t *testing.T) {
+	linear := VestingInfo{StartTime: 100, Cliff: 100, Duration: 1000, Amount: "2500", TotalClaimed: "0"}
+
+	_, _, err := linear.Split(sdkmath.NewUint(2500), 100)
+	require.ErrorIs(t, err, errors.ErrInvalidRequest)
+
+	// neither 300 nor 2200 vest whole tokens every second
+	_, _, err = linear.Split(sdkmath.NewUint(300), 100)
+	require.ErrorIs(t, err, ErrInexactSchedule)
+
+	// either part can vest whole tokens every second
+	_, _, err = linear.Split(sdkmath.NewUint(1000), 100)
+	require.NoError(t, err)
+
+	_, _, err = linear.Split(sdkmath.NewUint(500), 100)
+	require.NoError(t, err)
+
+	owingFees := linear
+	owingFees.PendingFee = "10"
+	_, _, err = owingFees.Split(sdkmath.NewUint(1000), 100)
+	require.ErrorIs(t, err, errors.ErrInvalidRequest)
+
+	_, err = linear.Merge(linear, 100)
+	require.ErrorIs(t, err, ErrInexactSchedule)
+
+	later := linear
+	later.StartTime = 200
+	_, err = linear.Merge(later, 100)
+	require.ErrorIs(t, err, ErrIncompatiblePositions)
+
+	otherFunder := linear
+	otherFunder.Funder = "funder"
+	_, err = linear.Merge(otherFunder, 100)
+	require.ErrorIs(t, err, ErrIncompatiblePositions)
+
+	// positions unlocked early release nothing until their vesting catches up
+	unlocked := linear
+	unlocked.TotalClaimed = "1000"
+	_, _, err = unlocked.Split(sdkmath.NewUint(1000), 200)
+	require.ErrorIs(t, err, ErrInexactSchedule)
+
+	whole := linear
+	whole.Amount = "1000"
+	_, err = whole.Merge(unlocked, 200)
+	require.ErrorIs(t, err, ErrInexactSchedule)
+}